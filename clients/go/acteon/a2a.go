@@ -176,7 +176,7 @@ func (c *Client) a2aDoJSON(
 		return &HTTPError{Status: resp.StatusCode, Message: string(respBody)}
 	}
 	if out != nil && len(respBody) > 0 {
-		if err := json.Unmarshal(respBody, out); err != nil {
+		if err := c.decodeJSON(respBody, out); err != nil {
 			return &ConnectionError{Message: err.Error()}
 		}
 	}
@@ -103,10 +103,11 @@ func TestMakePushConfigFull(t *testing.T) {
 // ----------------------------------------------------------------------
 
 // capturedRequest records the bits of an inbound request the tests
-// assert on (path, method, headers, body).
+// assert on (path, query, method, headers, body).
 type capturedRequest struct {
 	method  string
 	path    string
+	query   string
 	headers http.Header
 	body    []byte
 }
@@ -132,6 +133,7 @@ func newCapturingServer(t *testing.T, status int, body any) (string, *capturedRe
 		} else {
 			captured.path = r.URL.Path
 		}
+		captured.query = r.URL.RawQuery
 		captured.headers = r.Header.Clone()
 		b, _ := io.ReadAll(r.Body)
 		captured.body = b
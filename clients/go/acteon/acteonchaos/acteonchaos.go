@@ -0,0 +1,296 @@
+// Package acteonchaos wraps an acteon.ActeonAPI with fault injection,
+// so that consumers can test their retry, fallback, and stream-resume
+// logic against a misbehaving gateway deterministically.
+//
+// Wrap the real client, or an acteontest server's client, and hand the
+// decorator to the code under test:
+//
+//	chaos := acteonchaos.New(client, acteonchaos.Config{
+//		Seed:            1,
+//		Latency:         50 * time.Millisecond,
+//		ServerErrorRate: 0.2,
+//		Methods:         []string{"Dispatch"},
+//	})
+//	chaos.Script("Dispatch", acteonchaos.ConnectionError, acteonchaos.ServerError)
+//	worker := NewWorker(chaos) // takes an acteon.ActeonAPI
+//
+// Scripted faults are injected first, one per call, in order; after
+// that each call draws from the configured rates with a generator
+// seeded by Config.Seed, so a run with the same seed and the same call
+// order injects the same faults. The Client type is generated from
+// *acteon.Client by acteon/internal/apigen; run `go generate ./acteon`
+// after changing the client's methods.
+package acteonchaos
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+// Fault is a kind of injected failure.
+type Fault int
+
+const (
+	// NoFault lets the call through unchanged.
+	NoFault Fault = iota
+	// ConnectionError fails the call with an *acteon.ConnectionError
+	// without forwarding it.
+	ConnectionError
+	// ServerError fails the call with an *acteon.HTTPError carrying
+	// Config.ServerErrorStatus without forwarding it.
+	ServerError
+	// MalformedOutcome forwards the call and replaces the outcome it
+	// returns, or one entry of a batch, with an unknown variant, as
+	// if the gateway had sent a body the client could not interpret.
+	// It applies to the dispatch methods.
+	MalformedOutcome
+	// Disconnect forwards the call and closes the returned stream
+	// after Config.DisconnectAfter items. It applies to methods that
+	// return a channel, such as Stream and Subscribe.
+	Disconnect
+)
+
+func (f Fault) String() string {
+	switch f {
+	case NoFault:
+		return "none"
+	case ConnectionError:
+		return "connection error"
+	case ServerError:
+		return "server error"
+	case MalformedOutcome:
+		return "malformed outcome"
+	case Disconnect:
+		return "disconnect"
+	}
+	return "unknown"
+}
+
+// Config sets the faults a Client injects at random. Rates are
+// probabilities between 0 and 1, drawn once per call.
+type Config struct {
+	// Seed seeds the generator behind the rates and Jitter.
+	Seed int64
+	// Latency delays every call; Jitter adds up to that much more.
+	Latency time.Duration
+	Jitter  time.Duration
+
+	ConnectionErrorRate  float64
+	ServerErrorRate      float64
+	MalformedOutcomeRate float64
+	DisconnectRate       float64
+
+	// ServerErrorStatus is the status of injected server errors;
+	// 503 when zero.
+	ServerErrorStatus int
+	// DisconnectAfter is how many items a disconnected stream
+	// delivers before it closes.
+	DisconnectAfter int
+	// Methods limits latency and random faults to the named client
+	// methods; empty means every method. Scripted faults ignore it.
+	Methods []string
+}
+
+// Injection records a fault injected into one call.
+type Injection struct {
+	Method string
+	Fault  Fault
+}
+
+// Client is an acteon.ActeonAPI that injects faults before delegating
+// to the wrapped client. Methods that take no context, such as
+// Endpoint, always delegate directly. A Client is safe for concurrent
+// use.
+type Client struct {
+	next acteon.ActeonAPI
+	cfg  Config
+
+	mu       sync.Mutex
+	rng      *rand.Rand
+	script   map[string][]Fault
+	injected []Injection
+}
+
+// New returns a Client that injects faults into calls to next.
+func New(next acteon.ActeonAPI, cfg Config) *Client {
+	if cfg.ServerErrorStatus == 0 {
+		cfg.ServerErrorStatus = http.StatusServiceUnavailable
+	}
+	return &Client{
+		next:   next,
+		cfg:    cfg,
+		rng:    rand.New(rand.NewSource(cfg.Seed)),
+		script: map[string][]Fault{},
+	}
+}
+
+// Script queues faults for the next calls to method, one per call and
+// ahead of the random ones. A fault that does not apply to the method,
+// such as Disconnect for Dispatch, lets that call through.
+func (c *Client) Script(method string, faults ...Fault) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.script[method] = append(c.script[method], faults...)
+}
+
+// Injected returns the faults injected so far, in call order.
+func (c *Client) Injected() []Injection {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return slices.Clone(c.injected)
+}
+
+// Reset clears the script and the record of injected faults.
+func (c *Client) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.script = map[string][]Fault{}
+	c.injected = nil
+}
+
+// before picks the fault for a call to method, which can suffer extra
+// on top of the connection and server errors every call can, and
+// applies the latency. It returns the error for faults that stop the
+// call from being forwarded.
+func (c *Client) before(ctx context.Context, method string, extra Fault) (Fault, error) {
+	fault, delay := c.pick(method, extra)
+	if delay > 0 {
+		t := time.NewTimer(delay)
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			t.Stop()
+			return NoFault, ctx.Err()
+		}
+	}
+	switch fault {
+	case ConnectionError:
+		return fault, &acteon.ConnectionError{Message: "acteonchaos: injected connection error"}
+	case ServerError:
+		return fault, &acteon.HTTPError{Status: c.cfg.ServerErrorStatus, Message: "acteonchaos: injected server error"}
+	}
+	return fault, nil
+}
+
+func (c *Client) pick(method string, extra Fault) (Fault, time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	fault := NoFault
+	var delay time.Duration
+	if queued := c.script[method]; len(queued) > 0 {
+		fault, c.script[method] = queued[0], queued[1:]
+	} else if len(c.cfg.Methods) == 0 || slices.Contains(c.cfg.Methods, method) {
+		delay = c.cfg.Latency
+		if c.cfg.Jitter > 0 {
+			delay += time.Duration(c.rng.Int63n(int64(c.cfg.Jitter)))
+		}
+		r := c.rng.Float64()
+		for _, f := range []struct {
+			fault Fault
+			rate  float64
+		}{
+			{ConnectionError, c.cfg.ConnectionErrorRate},
+			{ServerError, c.cfg.ServerErrorRate},
+			{MalformedOutcome, c.cfg.MalformedOutcomeRate},
+			{Disconnect, c.cfg.DisconnectRate},
+		} {
+			if r < f.rate {
+				fault = f.fault
+				break
+			}
+			r -= f.rate
+		}
+	}
+	if fault != ConnectionError && fault != ServerError && fault != extra {
+		fault = NoFault
+	}
+	if fault != NoFault {
+		c.injected = append(c.injected, Injection{Method: method, Fault: fault})
+	}
+	return fault, delay
+}
+
+// malformedOutcome is the body an injected MalformedOutcome decodes
+// from.
+var malformedOutcome = json.RawMessage(`{"Garbled":"acteonchaos"}`)
+
+func malformOutcome(fault Fault, outcome *acteon.ActionOutcome) *acteon.ActionOutcome {
+	if fault != MalformedOutcome || outcome == nil {
+		return outcome
+	}
+	return &acteon.ActionOutcome{Type: acteon.OutcomeUnknown, Raw: malformedOutcome}
+}
+
+// malformBatch replaces the outcome of one successful entry, chosen at
+// random, with a malformed one.
+func (c *Client) malformBatch(fault Fault, results acteon.BatchResults) acteon.BatchResults {
+	if fault != MalformedOutcome {
+		return results
+	}
+	var ok []int
+	for i, r := range results {
+		if r.Outcome != nil {
+			ok = append(ok, i)
+		}
+	}
+	if len(ok) == 0 {
+		return results
+	}
+	c.mu.Lock()
+	i := ok[c.rng.Intn(len(ok))]
+	c.mu.Unlock()
+	results = slices.Clone(results)
+	results[i].Outcome = malformOutcome(fault, results[i].Outcome)
+	return results
+}
+
+// streamContext returns the context to open a stream with and the
+// function that ends it early, for a stream that is to be disconnected.
+func streamContext(ctx context.Context, fault Fault) (context.Context, context.CancelFunc) {
+	if fault != Disconnect {
+		return ctx, nil
+	}
+	return context.WithCancel(ctx)
+}
+
+// disconnect relays the first n items of in and then closes the
+// returned channel and stops the underlying stream. stop is nil when
+// the stream is to be left alone.
+func disconnect[T any](ctx context.Context, stop context.CancelFunc, n int, in <-chan T, err error) (<-chan T, error) {
+	if stop == nil {
+		return in, err
+	}
+	if err != nil {
+		stop()
+		return in, err
+	}
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		defer stop()
+		for range n {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+				select {
+				case out <- v:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
@@ -0,0 +1,2092 @@
+// Code generated by apigen; DO NOT EDIT.
+
+package acteonchaos
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+var _ acteon.ActeonAPI = (*Client)(nil)
+
+// A2ACancelTask delegates to the wrapped client after injecting any fault.
+func (c *Client) A2ACancelTask(ctx context.Context, namespace, tenant, taskID string) (map[string]any, error) {
+	if _, err := c.before(ctx, "A2ACancelTask", NoFault); err != nil {
+		var r0 map[string]any
+		return r0, err
+	}
+	return c.next.A2ACancelTask(ctx, namespace, tenant, taskID)
+}
+
+// A2ADeletePushConfig delegates to the wrapped client after injecting any fault.
+func (c *Client) A2ADeletePushConfig(ctx context.Context, namespace, tenant, taskID, configID string) error {
+	if _, err := c.before(ctx, "A2ADeletePushConfig", NoFault); err != nil {
+		return err
+	}
+	return c.next.A2ADeletePushConfig(ctx, namespace, tenant, taskID, configID)
+}
+
+// A2ADiscoverAgent delegates to the wrapped client after injecting any fault.
+func (c *Client) A2ADiscoverAgent(ctx context.Context, namespace, tenant string) (map[string]any, error) {
+	if _, err := c.before(ctx, "A2ADiscoverAgent", NoFault); err != nil {
+		var r0 map[string]any
+		return r0, err
+	}
+	return c.next.A2ADiscoverAgent(ctx, namespace, tenant)
+}
+
+// A2AGetAuthenticatedExtendedCard delegates to the wrapped client after injecting any fault.
+func (c *Client) A2AGetAuthenticatedExtendedCard(ctx context.Context, namespace, tenant string) (map[string]any, error) {
+	if _, err := c.before(ctx, "A2AGetAuthenticatedExtendedCard", NoFault); err != nil {
+		var r0 map[string]any
+		return r0, err
+	}
+	return c.next.A2AGetAuthenticatedExtendedCard(ctx, namespace, tenant)
+}
+
+// A2AGetPushConfig delegates to the wrapped client after injecting any fault.
+func (c *Client) A2AGetPushConfig(ctx context.Context, namespace, tenant, taskID, configID string) (map[string]any, error) {
+	if _, err := c.before(ctx, "A2AGetPushConfig", NoFault); err != nil {
+		var r0 map[string]any
+		return r0, err
+	}
+	return c.next.A2AGetPushConfig(ctx, namespace, tenant, taskID, configID)
+}
+
+// A2AGetTask delegates to the wrapped client after injecting any fault.
+func (c *Client) A2AGetTask(ctx context.Context, namespace, tenant, taskID string) (map[string]any, error) {
+	if _, err := c.before(ctx, "A2AGetTask", NoFault); err != nil {
+		var r0 map[string]any
+		return r0, err
+	}
+	return c.next.A2AGetTask(ctx, namespace, tenant, taskID)
+}
+
+// A2AListPushConfigs delegates to the wrapped client after injecting any fault.
+func (c *Client) A2AListPushConfigs(ctx context.Context, namespace, tenant, taskID string) ([]map[string]any, error) {
+	if _, err := c.before(ctx, "A2AListPushConfigs", NoFault); err != nil {
+		var r0 []map[string]any
+		return r0, err
+	}
+	return c.next.A2AListPushConfigs(ctx, namespace, tenant, taskID)
+}
+
+// A2ASendMessage delegates to the wrapped client after injecting any fault.
+func (c *Client) A2ASendMessage(ctx context.Context, namespace, tenant string, message map[string]any) (map[string]any, error) {
+	if _, err := c.before(ctx, "A2ASendMessage", NoFault); err != nil {
+		var r0 map[string]any
+		return r0, err
+	}
+	return c.next.A2ASendMessage(ctx, namespace, tenant, message)
+}
+
+// A2ASetPushConfig delegates to the wrapped client after injecting any fault.
+func (c *Client) A2ASetPushConfig(ctx context.Context, namespace, tenant, taskID string, config map[string]any) (map[string]any, error) {
+	if _, err := c.before(ctx, "A2ASetPushConfig", NoFault); err != nil {
+		var r0 map[string]any
+		return r0, err
+	}
+	return c.next.A2ASetPushConfig(ctx, namespace, tenant, taskID, config)
+}
+
+// AppendBusConversationMessage delegates to the wrapped client after injecting any fault.
+func (c *Client) AppendBusConversationMessage(ctx context.Context, namespace, tenant, conversationID string, req *acteon.AppendBusConversationMessage) (map[string]any, error) {
+	if _, err := c.before(ctx, "AppendBusConversationMessage", NoFault); err != nil {
+		var r0 map[string]any
+		return r0, err
+	}
+	return c.next.AppendBusConversationMessage(ctx, namespace, tenant, conversationID, req)
+}
+
+// Apply delegates to the wrapped client after injecting any fault.
+func (c *Client) Apply(ctx context.Context, manifest *acteon.Manifest, opts acteon.ApplyOptions) (*acteon.ApplyResult, error) {
+	if _, err := c.before(ctx, "Apply", NoFault); err != nil {
+		var r0 *acteon.ApplyResult
+		return r0, err
+	}
+	return c.next.Apply(ctx, manifest, opts)
+}
+
+// Approve delegates to the wrapped client after injecting any fault.
+func (c *Client) Approve(ctx context.Context, namespace, tenant, id, sig string, expiresAt int64, kid string) (*acteon.ApprovalActionResponse, error) {
+	if _, err := c.before(ctx, "Approve", NoFault); err != nil {
+		var r0 *acteon.ApprovalActionResponse
+		return r0, err
+	}
+	return c.next.Approve(ctx, namespace, tenant, id, sig, expiresAt, kid)
+}
+
+// ApproveBusApproval delegates to the wrapped client after injecting any fault.
+func (c *Client) ApproveBusApproval(ctx context.Context, namespace, tenant, approvalID string, decision *acteon.BusApprovalDecision) (*acteon.BusApprovalDecisionResponse, error) {
+	if _, err := c.before(ctx, "ApproveBusApproval", NoFault); err != nil {
+		var r0 *acteon.BusApprovalDecisionResponse
+		return r0, err
+	}
+	return c.next.ApproveBusApproval(ctx, namespace, tenant, approvalID, decision)
+}
+
+// AwaitOutcome delegates to the wrapped client after injecting any fault.
+func (c *Client) AwaitOutcome(ctx context.Context, actionID string, timeout time.Duration) (*acteon.AuditRecord, error) {
+	if _, err := c.before(ctx, "AwaitOutcome", NoFault); err != nil {
+		var r0 *acteon.AuditRecord
+		return r0, err
+	}
+	return c.next.AwaitOutcome(ctx, actionID, timeout)
+}
+
+// BusStreamConsumeURL delegates to the wrapped client without injecting faults.
+func (c *Client) BusStreamConsumeURL(namespace, tenant, conversationID, streamID string) string {
+	return c.next.BusStreamConsumeURL(namespace, tenant, conversationID, streamID)
+}
+
+// CacheStats delegates to the wrapped client without injecting faults.
+func (c *Client) CacheStats() acteon.CacheStats {
+	return c.next.CacheStats()
+}
+
+// CancelChain delegates to the wrapped client after injecting any fault.
+func (c *Client) CancelChain(ctx context.Context, chainID string, req *acteon.CancelChainRequest) (*acteon.ChainDetailResponse, error) {
+	if _, err := c.before(ctx, "CancelChain", NoFault); err != nil {
+		var r0 *acteon.ChainDetailResponse
+		return r0, err
+	}
+	return c.next.CancelChain(ctx, chainID, req)
+}
+
+// CancelSwarmRun delegates to the wrapped client after injecting any fault.
+func (c *Client) CancelSwarmRun(ctx context.Context, runID string) (*acteon.SwarmRunSnapshot, error) {
+	if _, err := c.before(ctx, "CancelSwarmRun", NoFault); err != nil {
+		var r0 *acteon.SwarmRunSnapshot
+		return r0, err
+	}
+	return c.next.CancelSwarmRun(ctx, runID)
+}
+
+// CheckQuota delegates to the wrapped client after injecting any fault.
+func (c *Client) CheckQuota(ctx context.Context, namespace, tenant string, n int) (*acteon.QuotaCheckResult, error) {
+	if _, err := c.before(ctx, "CheckQuota", NoFault); err != nil {
+		var r0 *acteon.QuotaCheckResult
+		return r0, err
+	}
+	return c.next.CheckQuota(ctx, namespace, tenant, n)
+}
+
+// CompleteTask delegates to the wrapped client after injecting any fault.
+func (c *Client) CompleteTask(ctx context.Context, taskID string, req *acteon.CompleteTaskRequest) (*acteon.WorkerTask, error) {
+	if _, err := c.before(ctx, "CompleteTask", NoFault); err != nil {
+		var r0 *acteon.WorkerTask
+		return r0, err
+	}
+	return c.next.CompleteTask(ctx, taskID, req)
+}
+
+// ConsumeBusStream delegates to the wrapped client after injecting any fault.
+func (c *Client) ConsumeBusStream(ctx context.Context, namespace, tenant, conversationID, streamID string) (<-chan *acteon.BusStreamItem, error) {
+	fault, err := c.before(ctx, "ConsumeBusStream", Disconnect)
+	if err != nil {
+		var r0 <-chan *acteon.BusStreamItem
+		return r0, err
+	}
+	ctx, stop := streamContext(ctx, fault)
+	r0, err := c.next.ConsumeBusStream(ctx, namespace, tenant, conversationID, streamID)
+	return disconnect(ctx, stop, c.cfg.DisconnectAfter, r0, err)
+}
+
+// ConsumeBusSubscription delegates to the wrapped client after injecting any fault.
+func (c *Client) ConsumeBusSubscription(ctx context.Context, subscriptionID string, opts *acteon.ConsumeBusSubscriptionOptions) (<-chan *acteon.BusConsumeItem, error) {
+	fault, err := c.before(ctx, "ConsumeBusSubscription", Disconnect)
+	if err != nil {
+		var r0 <-chan *acteon.BusConsumeItem
+		return r0, err
+	}
+	ctx, stop := streamContext(ctx, fault)
+	r0, err := c.next.ConsumeBusSubscription(ctx, subscriptionID, opts)
+	return disconnect(ctx, stop, c.cfg.DisconnectAfter, r0, err)
+}
+
+// CreateBackup delegates to the wrapped client after injecting any fault.
+func (c *Client) CreateBackup(ctx context.Context, req *acteon.BackupRequest) (*acteon.Backup, error) {
+	if _, err := c.before(ctx, "CreateBackup", NoFault); err != nil {
+		var r0 *acteon.Backup
+		return r0, err
+	}
+	return c.next.CreateBackup(ctx, req)
+}
+
+// CreateBusConversation delegates to the wrapped client after injecting any fault.
+func (c *Client) CreateBusConversation(ctx context.Context, req *acteon.CreateBusConversation) (*acteon.BusConversation, error) {
+	if _, err := c.before(ctx, "CreateBusConversation", NoFault); err != nil {
+		var r0 *acteon.BusConversation
+		return r0, err
+	}
+	return c.next.CreateBusConversation(ctx, req)
+}
+
+// CreateBusSubscription delegates to the wrapped client after injecting any fault.
+func (c *Client) CreateBusSubscription(ctx context.Context, req *acteon.CreateBusSubscription) (*acteon.BusSubscription, error) {
+	if _, err := c.before(ctx, "CreateBusSubscription", NoFault); err != nil {
+		var r0 *acteon.BusSubscription
+		return r0, err
+	}
+	return c.next.CreateBusSubscription(ctx, req)
+}
+
+// CreateBusTopic delegates to the wrapped client after injecting any fault.
+func (c *Client) CreateBusTopic(ctx context.Context, req *acteon.CreateBusTopic) (*acteon.BusTopic, error) {
+	if _, err := c.before(ctx, "CreateBusTopic", NoFault); err != nil {
+		var r0 *acteon.BusTopic
+		return r0, err
+	}
+	return c.next.CreateBusTopic(ctx, req)
+}
+
+// CreateEscalationPolicy delegates to the wrapped client after injecting any fault.
+func (c *Client) CreateEscalationPolicy(ctx context.Context, req *acteon.CreateEscalationPolicyRequest) (*acteon.EscalationPolicy, error) {
+	if _, err := c.before(ctx, "CreateEscalationPolicy", NoFault); err != nil {
+		var r0 *acteon.EscalationPolicy
+		return r0, err
+	}
+	return c.next.CreateEscalationPolicy(ctx, req)
+}
+
+// CreateProfile delegates to the wrapped client after injecting any fault.
+func (c *Client) CreateProfile(ctx context.Context, req *acteon.CreateProfileRequest) (*acteon.TemplateProfileInfo, error) {
+	if _, err := c.before(ctx, "CreateProfile", NoFault); err != nil {
+		var r0 *acteon.TemplateProfileInfo
+		return r0, err
+	}
+	return c.next.CreateProfile(ctx, req)
+}
+
+// CreateProviderConfig delegates to the wrapped client after injecting any fault.
+func (c *Client) CreateProviderConfig(ctx context.Context, req *acteon.CreateProviderConfigRequest) (*acteon.ProviderConfig, error) {
+	if _, err := c.before(ctx, "CreateProviderConfig", NoFault); err != nil {
+		var r0 *acteon.ProviderConfig
+		return r0, err
+	}
+	return c.next.CreateProviderConfig(ctx, req)
+}
+
+// CreateQuota delegates to the wrapped client after injecting any fault.
+func (c *Client) CreateQuota(ctx context.Context, req *acteon.CreateQuotaRequest) (*acteon.QuotaPolicy, error) {
+	if _, err := c.before(ctx, "CreateQuota", NoFault); err != nil {
+		var r0 *acteon.QuotaPolicy
+		return r0, err
+	}
+	return c.next.CreateQuota(ctx, req)
+}
+
+// CreateRecurring delegates to the wrapped client after injecting any fault.
+func (c *Client) CreateRecurring(ctx context.Context, recurring *acteon.CreateRecurringAction) (*acteon.CreateRecurringResponse, error) {
+	if _, err := c.before(ctx, "CreateRecurring", NoFault); err != nil {
+		var r0 *acteon.CreateRecurringResponse
+		return r0, err
+	}
+	return c.next.CreateRecurring(ctx, recurring)
+}
+
+// CreateRetention delegates to the wrapped client after injecting any fault.
+func (c *Client) CreateRetention(ctx context.Context, req *acteon.CreateRetentionRequest) (*acteon.RetentionPolicy, error) {
+	if _, err := c.before(ctx, "CreateRetention", NoFault); err != nil {
+		var r0 *acteon.RetentionPolicy
+		return r0, err
+	}
+	return c.next.CreateRetention(ctx, req)
+}
+
+// CreateRoleBinding delegates to the wrapped client after injecting any fault.
+func (c *Client) CreateRoleBinding(ctx context.Context, req *acteon.CreateRoleBindingRequest) (*acteon.RoleBinding, error) {
+	if _, err := c.before(ctx, "CreateRoleBinding", NoFault); err != nil {
+		var r0 *acteon.RoleBinding
+		return r0, err
+	}
+	return c.next.CreateRoleBinding(ctx, req)
+}
+
+// CreateSilence delegates to the wrapped client after injecting any fault.
+func (c *Client) CreateSilence(ctx context.Context, req *acteon.CreateSilenceRequest) (*acteon.Silence, error) {
+	if _, err := c.before(ctx, "CreateSilence", NoFault); err != nil {
+		var r0 *acteon.Silence
+		return r0, err
+	}
+	return c.next.CreateSilence(ctx, req)
+}
+
+// CreateTemplate delegates to the wrapped client after injecting any fault.
+func (c *Client) CreateTemplate(ctx context.Context, req *acteon.CreateTemplateRequest) (*acteon.TemplateInfo, error) {
+	if _, err := c.before(ctx, "CreateTemplate", NoFault); err != nil {
+		var r0 *acteon.TemplateInfo
+		return r0, err
+	}
+	return c.next.CreateTemplate(ctx, req)
+}
+
+// CreateThrottle delegates to the wrapped client after injecting any fault.
+func (c *Client) CreateThrottle(ctx context.Context, req *acteon.CreateThrottleRequest) (*acteon.ThrottlePolicy, error) {
+	if _, err := c.before(ctx, "CreateThrottle", NoFault); err != nil {
+		var r0 *acteon.ThrottlePolicy
+		return r0, err
+	}
+	return c.next.CreateThrottle(ctx, req)
+}
+
+// CreateTimeInterval delegates to the wrapped client after injecting any fault.
+func (c *Client) CreateTimeInterval(ctx context.Context, req *acteon.CreateTimeIntervalRequest) (*acteon.TimeInterval, error) {
+	if _, err := c.before(ctx, "CreateTimeInterval", NoFault); err != nil {
+		var r0 *acteon.TimeInterval
+		return r0, err
+	}
+	return c.next.CreateTimeInterval(ctx, req)
+}
+
+// DeleteBusAgent delegates to the wrapped client after injecting any fault.
+func (c *Client) DeleteBusAgent(ctx context.Context, namespace, tenant, agentID string) error {
+	if _, err := c.before(ctx, "DeleteBusAgent", NoFault); err != nil {
+		return err
+	}
+	return c.next.DeleteBusAgent(ctx, namespace, tenant, agentID)
+}
+
+// DeleteBusConversation delegates to the wrapped client after injecting any fault.
+func (c *Client) DeleteBusConversation(ctx context.Context, namespace, tenant, conversationID string) error {
+	if _, err := c.before(ctx, "DeleteBusConversation", NoFault); err != nil {
+		return err
+	}
+	return c.next.DeleteBusConversation(ctx, namespace, tenant, conversationID)
+}
+
+// DeleteBusSchema delegates to the wrapped client after injecting any fault.
+func (c *Client) DeleteBusSchema(ctx context.Context, namespace, tenant, subject string, version int) error {
+	if _, err := c.before(ctx, "DeleteBusSchema", NoFault); err != nil {
+		return err
+	}
+	return c.next.DeleteBusSchema(ctx, namespace, tenant, subject, version)
+}
+
+// DeleteBusSubscription delegates to the wrapped client after injecting any fault.
+func (c *Client) DeleteBusSubscription(ctx context.Context, namespace, tenant, subID string) error {
+	if _, err := c.before(ctx, "DeleteBusSubscription", NoFault); err != nil {
+		return err
+	}
+	return c.next.DeleteBusSubscription(ctx, namespace, tenant, subID)
+}
+
+// DeleteBusTopic delegates to the wrapped client after injecting any fault.
+func (c *Client) DeleteBusTopic(ctx context.Context, namespace, tenant, name string) error {
+	if _, err := c.before(ctx, "DeleteBusTopic", NoFault); err != nil {
+		return err
+	}
+	return c.next.DeleteBusTopic(ctx, namespace, tenant, name)
+}
+
+// DeleteDlqEntry delegates to the wrapped client after injecting any fault.
+func (c *Client) DeleteDlqEntry(ctx context.Context, actionID string) error {
+	if _, err := c.before(ctx, "DeleteDlqEntry", NoFault); err != nil {
+		return err
+	}
+	return c.next.DeleteDlqEntry(ctx, actionID)
+}
+
+// DeleteEscalationPolicy delegates to the wrapped client after injecting any fault.
+func (c *Client) DeleteEscalationPolicy(ctx context.Context, policyID string) error {
+	if _, err := c.before(ctx, "DeleteEscalationPolicy", NoFault); err != nil {
+		return err
+	}
+	return c.next.DeleteEscalationPolicy(ctx, policyID)
+}
+
+// DeleteNotificationPreferences delegates to the wrapped client after injecting any fault.
+func (c *Client) DeleteNotificationPreferences(ctx context.Context, tenant string) error {
+	if _, err := c.before(ctx, "DeleteNotificationPreferences", NoFault); err != nil {
+		return err
+	}
+	return c.next.DeleteNotificationPreferences(ctx, tenant)
+}
+
+// DeletePlugin delegates to the wrapped client after injecting any fault.
+func (c *Client) DeletePlugin(ctx context.Context, name string) error {
+	if _, err := c.before(ctx, "DeletePlugin", NoFault); err != nil {
+		return err
+	}
+	return c.next.DeletePlugin(ctx, name)
+}
+
+// DeleteProfile delegates to the wrapped client after injecting any fault.
+func (c *Client) DeleteProfile(ctx context.Context, profileID string) error {
+	if _, err := c.before(ctx, "DeleteProfile", NoFault); err != nil {
+		return err
+	}
+	return c.next.DeleteProfile(ctx, profileID)
+}
+
+// DeleteProviderConfig delegates to the wrapped client after injecting any fault.
+func (c *Client) DeleteProviderConfig(ctx context.Context, name string) error {
+	if _, err := c.before(ctx, "DeleteProviderConfig", NoFault); err != nil {
+		return err
+	}
+	return c.next.DeleteProviderConfig(ctx, name)
+}
+
+// DeleteQuota delegates to the wrapped client after injecting any fault.
+func (c *Client) DeleteQuota(ctx context.Context, quotaID, namespace, tenant string) error {
+	if _, err := c.before(ctx, "DeleteQuota", NoFault); err != nil {
+		return err
+	}
+	return c.next.DeleteQuota(ctx, quotaID, namespace, tenant)
+}
+
+// DeleteRecurring delegates to the wrapped client after injecting any fault.
+func (c *Client) DeleteRecurring(ctx context.Context, recurringID, namespace, tenant string) error {
+	if _, err := c.before(ctx, "DeleteRecurring", NoFault); err != nil {
+		return err
+	}
+	return c.next.DeleteRecurring(ctx, recurringID, namespace, tenant)
+}
+
+// DeleteRetention delegates to the wrapped client after injecting any fault.
+func (c *Client) DeleteRetention(ctx context.Context, retentionID string) error {
+	if _, err := c.before(ctx, "DeleteRetention", NoFault); err != nil {
+		return err
+	}
+	return c.next.DeleteRetention(ctx, retentionID)
+}
+
+// DeleteRoleBinding delegates to the wrapped client after injecting any fault.
+func (c *Client) DeleteRoleBinding(ctx context.Context, bindingID string) error {
+	if _, err := c.before(ctx, "DeleteRoleBinding", NoFault); err != nil {
+		return err
+	}
+	return c.next.DeleteRoleBinding(ctx, bindingID)
+}
+
+// DeleteSecret delegates to the wrapped client after injecting any fault.
+func (c *Client) DeleteSecret(ctx context.Context, scope, name string) error {
+	if _, err := c.before(ctx, "DeleteSecret", NoFault); err != nil {
+		return err
+	}
+	return c.next.DeleteSecret(ctx, scope, name)
+}
+
+// DeleteSilence delegates to the wrapped client after injecting any fault.
+func (c *Client) DeleteSilence(ctx context.Context, silenceID string) error {
+	if _, err := c.before(ctx, "DeleteSilence", NoFault); err != nil {
+		return err
+	}
+	return c.next.DeleteSilence(ctx, silenceID)
+}
+
+// DeleteTemplate delegates to the wrapped client after injecting any fault.
+func (c *Client) DeleteTemplate(ctx context.Context, templateID string) error {
+	if _, err := c.before(ctx, "DeleteTemplate", NoFault); err != nil {
+		return err
+	}
+	return c.next.DeleteTemplate(ctx, templateID)
+}
+
+// DeleteThrottle delegates to the wrapped client after injecting any fault.
+func (c *Client) DeleteThrottle(ctx context.Context, throttleID, namespace, tenant string) error {
+	if _, err := c.before(ctx, "DeleteThrottle", NoFault); err != nil {
+		return err
+	}
+	return c.next.DeleteThrottle(ctx, throttleID, namespace, tenant)
+}
+
+// DeleteTimeInterval delegates to the wrapped client after injecting any fault.
+func (c *Client) DeleteTimeInterval(ctx context.Context, namespace, tenant, name string) error {
+	if _, err := c.before(ctx, "DeleteTimeInterval", NoFault); err != nil {
+		return err
+	}
+	return c.next.DeleteTimeInterval(ctx, namespace, tenant, name)
+}
+
+// Dispatch delegates to the wrapped client after injecting any fault.
+func (c *Client) Dispatch(ctx context.Context, action *acteon.Action) (*acteon.ActionOutcome, error) {
+	fault, err := c.before(ctx, "Dispatch", MalformedOutcome)
+	if err != nil {
+		var r0 *acteon.ActionOutcome
+		return r0, err
+	}
+	r0, err := c.next.Dispatch(ctx, action)
+	return malformOutcome(fault, r0), err
+}
+
+// DispatchBatch delegates to the wrapped client after injecting any fault.
+func (c *Client) DispatchBatch(ctx context.Context, actions []*acteon.Action) (acteon.BatchResults, error) {
+	fault, err := c.before(ctx, "DispatchBatch", MalformedOutcome)
+	if err != nil {
+		var r0 acteon.BatchResults
+		return r0, err
+	}
+	r0, err := c.next.DispatchBatch(ctx, actions)
+	return c.malformBatch(fault, r0), err
+}
+
+// DispatchBatchAtomic delegates to the wrapped client after injecting any fault.
+func (c *Client) DispatchBatchAtomic(ctx context.Context, actions []*acteon.Action) (acteon.BatchResults, error) {
+	fault, err := c.before(ctx, "DispatchBatchAtomic", MalformedOutcome)
+	if err != nil {
+		var r0 acteon.BatchResults
+		return r0, err
+	}
+	r0, err := c.next.DispatchBatchAtomic(ctx, actions)
+	return c.malformBatch(fault, r0), err
+}
+
+// DispatchBatchDryRun delegates to the wrapped client after injecting any fault.
+func (c *Client) DispatchBatchDryRun(ctx context.Context, actions []*acteon.Action) (acteon.BatchResults, error) {
+	fault, err := c.before(ctx, "DispatchBatchDryRun", MalformedOutcome)
+	if err != nil {
+		var r0 acteon.BatchResults
+		return r0, err
+	}
+	r0, err := c.next.DispatchBatchDryRun(ctx, actions)
+	return c.malformBatch(fault, r0), err
+}
+
+// DispatchDryRun delegates to the wrapped client after injecting any fault.
+func (c *Client) DispatchDryRun(ctx context.Context, action *acteon.Action) (*acteon.ActionOutcome, error) {
+	fault, err := c.before(ctx, "DispatchDryRun", MalformedOutcome)
+	if err != nil {
+		var r0 *acteon.ActionOutcome
+		return r0, err
+	}
+	r0, err := c.next.DispatchDryRun(ctx, action)
+	return malformOutcome(fault, r0), err
+}
+
+// DlqDrain delegates to the wrapped client after injecting any fault.
+func (c *Client) DlqDrain(ctx context.Context) (*acteon.DlqDrainResponse, error) {
+	if _, err := c.before(ctx, "DlqDrain", NoFault); err != nil {
+		var r0 *acteon.DlqDrainResponse
+		return r0, err
+	}
+	return c.next.DlqDrain(ctx)
+}
+
+// DlqStats delegates to the wrapped client after injecting any fault.
+func (c *Client) DlqStats(ctx context.Context) (*acteon.DlqStatsResponse, error) {
+	if _, err := c.before(ctx, "DlqStats", NoFault); err != nil {
+		var r0 *acteon.DlqStatsResponse
+		return r0, err
+	}
+	return c.next.DlqStats(ctx)
+}
+
+// DlqStatsDetailed delegates to the wrapped client after injecting any fault.
+func (c *Client) DlqStatsDetailed(ctx context.Context) (*acteon.DlqStatsDetailedResponse, error) {
+	if _, err := c.before(ctx, "DlqStatsDetailed", NoFault); err != nil {
+		var r0 *acteon.DlqStatsDetailedResponse
+		return r0, err
+	}
+	return c.next.DlqStatsDetailed(ctx)
+}
+
+// DumpAction delegates to the wrapped client without injecting faults.
+func (c *Client) DumpAction(action *acteon.Action) ([]byte, error) {
+	return c.next.DumpAction(action)
+}
+
+// Endpoint delegates to the wrapped client without injecting faults.
+func (c *Client) Endpoint(namespace, tenant string) string {
+	return c.next.Endpoint(namespace, tenant)
+}
+
+// EnqueueTask delegates to the wrapped client after injecting any fault.
+func (c *Client) EnqueueTask(ctx context.Context, queue string, req *acteon.EnqueueTaskRequest) (*acteon.WorkerTask, error) {
+	if _, err := c.before(ctx, "EnqueueTask", NoFault); err != nil {
+		var r0 *acteon.WorkerTask
+		return r0, err
+	}
+	return c.next.EnqueueTask(ctx, queue, req)
+}
+
+// EnsureQuota delegates to the wrapped client after injecting any fault.
+func (c *Client) EnsureQuota(ctx context.Context, req *acteon.CreateQuotaRequest) (bool, error) {
+	if _, err := c.before(ctx, "EnsureQuota", NoFault); err != nil {
+		var r0 bool
+		return r0, err
+	}
+	return c.next.EnsureQuota(ctx, req)
+}
+
+// EnsureRecurring delegates to the wrapped client after injecting any fault.
+func (c *Client) EnsureRecurring(ctx context.Context, req *acteon.CreateRecurringAction) (bool, error) {
+	if _, err := c.before(ctx, "EnsureRecurring", NoFault); err != nil {
+		var r0 bool
+		return r0, err
+	}
+	return c.next.EnsureRecurring(ctx, req)
+}
+
+// EnsureRetention delegates to the wrapped client after injecting any fault.
+func (c *Client) EnsureRetention(ctx context.Context, req *acteon.CreateRetentionRequest) (bool, error) {
+	if _, err := c.before(ctx, "EnsureRetention", NoFault); err != nil {
+		var r0 bool
+		return r0, err
+	}
+	return c.next.EnsureRetention(ctx, req)
+}
+
+// EnsureTemplate delegates to the wrapped client after injecting any fault.
+func (c *Client) EnsureTemplate(ctx context.Context, req *acteon.CreateTemplateRequest) (bool, error) {
+	if _, err := c.before(ctx, "EnsureTemplate", NoFault); err != nil {
+		var r0 bool
+		return r0, err
+	}
+	return c.next.EnsureTemplate(ctx, req)
+}
+
+// EraseSubjectData delegates to the wrapped client after injecting any fault.
+func (c *Client) EraseSubjectData(ctx context.Context, query *acteon.SubjectQuery) (*acteon.SubjectErasureReport, error) {
+	if _, err := c.before(ctx, "EraseSubjectData", NoFault); err != nil {
+		var r0 *acteon.SubjectErasureReport
+		return r0, err
+	}
+	return c.next.EraseSubjectData(ctx, query)
+}
+
+// EvaluateGuardrail delegates to the wrapped client after injecting any fault.
+func (c *Client) EvaluateGuardrail(ctx context.Context, text string) (*acteon.GuardrailEvaluation, error) {
+	if _, err := c.before(ctx, "EvaluateGuardrail", NoFault); err != nil {
+		var r0 *acteon.GuardrailEvaluation
+		return r0, err
+	}
+	return c.next.EvaluateGuardrail(ctx, text)
+}
+
+// EvaluateRules delegates to the wrapped client after injecting any fault.
+func (c *Client) EvaluateRules(ctx context.Context, req acteon.EvaluateRulesRequest) (*acteon.EvaluateRulesResponse, error) {
+	if _, err := c.before(ctx, "EvaluateRules", NoFault); err != nil {
+		var r0 *acteon.EvaluateRulesResponse
+		return r0, err
+	}
+	return c.next.EvaluateRules(ctx, req)
+}
+
+// ExportSubjectData delegates to the wrapped client after injecting any fault.
+func (c *Client) ExportSubjectData(ctx context.Context, query *acteon.SubjectQuery) (*acteon.SubjectDataExport, error) {
+	if _, err := c.before(ctx, "ExportSubjectData", NoFault); err != nil {
+		var r0 *acteon.SubjectDataExport
+		return r0, err
+	}
+	return c.next.ExportSubjectData(ctx, query)
+}
+
+// ExportTemplates delegates to the wrapped client after injecting any fault.
+func (c *Client) ExportTemplates(ctx context.Context, filter *acteon.TemplateBundleFilter, w io.Writer) error {
+	if _, err := c.before(ctx, "ExportTemplates", NoFault); err != nil {
+		return err
+	}
+	return c.next.ExportTemplates(ctx, filter, w)
+}
+
+// FailTask delegates to the wrapped client after injecting any fault.
+func (c *Client) FailTask(ctx context.Context, taskID string, req *acteon.FailTaskRequest) (*acteon.WorkerTask, error) {
+	if _, err := c.before(ctx, "FailTask", NoFault); err != nil {
+		var r0 *acteon.WorkerTask
+		return r0, err
+	}
+	return c.next.FailTask(ctx, taskID, req)
+}
+
+// FetchSigningKeys delegates to the wrapped client after injecting any fault.
+func (c *Client) FetchSigningKeys(ctx context.Context) (*acteon.SigningKeysResponse, error) {
+	if _, err := c.before(ctx, "FetchSigningKeys", NoFault); err != nil {
+		var r0 *acteon.SigningKeysResponse
+		return r0, err
+	}
+	return c.next.FetchSigningKeys(ctx)
+}
+
+// FlushGroup delegates to the wrapped client after injecting any fault.
+func (c *Client) FlushGroup(ctx context.Context, groupKey string) (*acteon.FlushGroupResponse, error) {
+	if _, err := c.before(ctx, "FlushGroup", NoFault); err != nil {
+		var r0 *acteon.FlushGroupResponse
+		return r0, err
+	}
+	return c.next.FlushGroup(ctx, groupKey)
+}
+
+// GetApproval delegates to the wrapped client after injecting any fault.
+func (c *Client) GetApproval(ctx context.Context, namespace, tenant, id, sig string, expiresAt int64, kid string) (*acteon.ApprovalStatus, error) {
+	if _, err := c.before(ctx, "GetApproval", NoFault); err != nil {
+		var r0 *acteon.ApprovalStatus
+		return r0, err
+	}
+	return c.next.GetApproval(ctx, namespace, tenant, id, sig, expiresAt, kid)
+}
+
+// GetAuditRecord delegates to the wrapped client after injecting any fault.
+func (c *Client) GetAuditRecord(ctx context.Context, actionID string) (*acteon.AuditRecord, error) {
+	if _, err := c.before(ctx, "GetAuditRecord", NoFault); err != nil {
+		var r0 *acteon.AuditRecord
+		return r0, err
+	}
+	return c.next.GetAuditRecord(ctx, actionID)
+}
+
+// GetAuditRecords delegates to the wrapped client after injecting any fault.
+func (c *Client) GetAuditRecords(ctx context.Context, actionIDs []string) (*acteon.AuditRecordsResult, error) {
+	if _, err := c.before(ctx, "GetAuditRecords", NoFault); err != nil {
+		var r0 *acteon.AuditRecordsResult
+		return r0, err
+	}
+	return c.next.GetAuditRecords(ctx, actionIDs)
+}
+
+// GetBackupStatus delegates to the wrapped client after injecting any fault.
+func (c *Client) GetBackupStatus(ctx context.Context, backupID string) (*acteon.Backup, error) {
+	if _, err := c.before(ctx, "GetBackupStatus", NoFault); err != nil {
+		var r0 *acteon.Backup
+		return r0, err
+	}
+	return c.next.GetBackupStatus(ctx, backupID)
+}
+
+// GetBusAgent delegates to the wrapped client after injecting any fault.
+func (c *Client) GetBusAgent(ctx context.Context, namespace, tenant, agentID string) (*acteon.BusAgent, error) {
+	if _, err := c.before(ctx, "GetBusAgent", NoFault); err != nil {
+		var r0 *acteon.BusAgent
+		return r0, err
+	}
+	return c.next.GetBusAgent(ctx, namespace, tenant, agentID)
+}
+
+// GetBusApproval delegates to the wrapped client after injecting any fault.
+func (c *Client) GetBusApproval(ctx context.Context, namespace, tenant, approvalID string) (*acteon.BusApprovalView, error) {
+	if _, err := c.before(ctx, "GetBusApproval", NoFault); err != nil {
+		var r0 *acteon.BusApprovalView
+		return r0, err
+	}
+	return c.next.GetBusApproval(ctx, namespace, tenant, approvalID)
+}
+
+// GetBusConversation delegates to the wrapped client after injecting any fault.
+func (c *Client) GetBusConversation(ctx context.Context, namespace, tenant, conversationID string) (*acteon.BusConversation, error) {
+	if _, err := c.before(ctx, "GetBusConversation", NoFault); err != nil {
+		var r0 *acteon.BusConversation
+		return r0, err
+	}
+	return c.next.GetBusConversation(ctx, namespace, tenant, conversationID)
+}
+
+// GetBusSchema delegates to the wrapped client after injecting any fault.
+func (c *Client) GetBusSchema(ctx context.Context, namespace, tenant, subject string, version int) (*acteon.BusSchema, error) {
+	if _, err := c.before(ctx, "GetBusSchema", NoFault); err != nil {
+		var r0 *acteon.BusSchema
+		return r0, err
+	}
+	return c.next.GetBusSchema(ctx, namespace, tenant, subject, version)
+}
+
+// GetBusSubscription delegates to the wrapped client after injecting any fault.
+func (c *Client) GetBusSubscription(ctx context.Context, namespace, tenant, subID string) (*acteon.BusSubscription, error) {
+	if _, err := c.before(ctx, "GetBusSubscription", NoFault); err != nil {
+		var r0 *acteon.BusSubscription
+		return r0, err
+	}
+	return c.next.GetBusSubscription(ctx, namespace, tenant, subID)
+}
+
+// GetBusSubscriptionLag delegates to the wrapped client after injecting any fault.
+func (c *Client) GetBusSubscriptionLag(ctx context.Context, namespace, tenant, subID string) (*acteon.BusLag, error) {
+	if _, err := c.before(ctx, "GetBusSubscriptionLag", NoFault); err != nil {
+		var r0 *acteon.BusLag
+		return r0, err
+	}
+	return c.next.GetBusSubscriptionLag(ctx, namespace, tenant, subID)
+}
+
+// GetBusTopic delegates to the wrapped client after injecting any fault.
+func (c *Client) GetBusTopic(ctx context.Context, namespace, tenant, name string) (*acteon.BusTopic, error) {
+	if _, err := c.before(ctx, "GetBusTopic", NoFault); err != nil {
+		var r0 *acteon.BusTopic
+		return r0, err
+	}
+	return c.next.GetBusTopic(ctx, namespace, tenant, name)
+}
+
+// GetChain delegates to the wrapped client after injecting any fault.
+func (c *Client) GetChain(ctx context.Context, chainID, namespace, tenant string) (*acteon.ChainDetailResponse, error) {
+	if _, err := c.before(ctx, "GetChain", NoFault); err != nil {
+		var r0 *acteon.ChainDetailResponse
+		return r0, err
+	}
+	return c.next.GetChain(ctx, chainID, namespace, tenant)
+}
+
+// GetChainDag delegates to the wrapped client after injecting any fault.
+func (c *Client) GetChainDag(ctx context.Context, chainID, namespace, tenant string) (*acteon.DagResponse, error) {
+	if _, err := c.before(ctx, "GetChainDag", NoFault); err != nil {
+		var r0 *acteon.DagResponse
+		return r0, err
+	}
+	return c.next.GetChainDag(ctx, chainID, namespace, tenant)
+}
+
+// GetChainDefinitionDag delegates to the wrapped client after injecting any fault.
+func (c *Client) GetChainDefinitionDag(ctx context.Context, name string) (*acteon.DagResponse, error) {
+	if _, err := c.before(ctx, "GetChainDefinitionDag", NoFault); err != nil {
+		var r0 *acteon.DagResponse
+		return r0, err
+	}
+	return c.next.GetChainDefinitionDag(ctx, name)
+}
+
+// GetChainHistory delegates to the wrapped client after injecting any fault.
+func (c *Client) GetChainHistory(ctx context.Context, chainID, namespace, tenant string) (*acteon.ChainHistoryResponse, error) {
+	if _, err := c.before(ctx, "GetChainHistory", NoFault); err != nil {
+		var r0 *acteon.ChainHistoryResponse
+		return r0, err
+	}
+	return c.next.GetChainHistory(ctx, chainID, namespace, tenant)
+}
+
+// GetChainMetrics delegates to the wrapped client after injecting any fault.
+func (c *Client) GetChainMetrics(ctx context.Context, chainName string, window time.Duration) (*acteon.ChainMetricsResponse, error) {
+	if _, err := c.before(ctx, "GetChainMetrics", NoFault); err != nil {
+		var r0 *acteon.ChainMetricsResponse
+		return r0, err
+	}
+	return c.next.GetChainMetrics(ctx, chainName, window)
+}
+
+// GetComplianceStatus delegates to the wrapped client after injecting any fault.
+func (c *Client) GetComplianceStatus(ctx context.Context) (*acteon.ComplianceStatus, error) {
+	if _, err := c.before(ctx, "GetComplianceStatus", NoFault); err != nil {
+		var r0 *acteon.ComplianceStatus
+		return r0, err
+	}
+	return c.next.GetComplianceStatus(ctx)
+}
+
+// GetConfig delegates to the wrapped client after injecting any fault.
+func (c *Client) GetConfig(ctx context.Context) (*acteon.GatewayConfig, error) {
+	if _, err := c.before(ctx, "GetConfig", NoFault); err != nil {
+		var r0 *acteon.GatewayConfig
+		return r0, err
+	}
+	return c.next.GetConfig(ctx)
+}
+
+// GetDlqEntry delegates to the wrapped client after injecting any fault.
+func (c *Client) GetDlqEntry(ctx context.Context, actionID string) (*acteon.DlqEntryDetail, error) {
+	if _, err := c.before(ctx, "GetDlqEntry", NoFault); err != nil {
+		var r0 *acteon.DlqEntryDetail
+		return r0, err
+	}
+	return c.next.GetDlqEntry(ctx, actionID)
+}
+
+// GetDlqPolicy delegates to the wrapped client after injecting any fault.
+func (c *Client) GetDlqPolicy(ctx context.Context) (*acteon.DlqPolicy, error) {
+	if _, err := c.before(ctx, "GetDlqPolicy", NoFault); err != nil {
+		var r0 *acteon.DlqPolicy
+		return r0, err
+	}
+	return c.next.GetDlqPolicy(ctx)
+}
+
+// GetEscalationPolicy delegates to the wrapped client after injecting any fault.
+func (c *Client) GetEscalationPolicy(ctx context.Context, policyID string) (*acteon.EscalationPolicy, error) {
+	if _, err := c.before(ctx, "GetEscalationPolicy", NoFault); err != nil {
+		var r0 *acteon.EscalationPolicy
+		return r0, err
+	}
+	return c.next.GetEscalationPolicy(ctx, policyID)
+}
+
+// GetEscalationState delegates to the wrapped client after injecting any fault.
+func (c *Client) GetEscalationState(ctx context.Context, eventFingerprint string) (*acteon.EscalationState, error) {
+	if _, err := c.before(ctx, "GetEscalationState", NoFault); err != nil {
+		var r0 *acteon.EscalationState
+		return r0, err
+	}
+	return c.next.GetEscalationState(ctx, eventFingerprint)
+}
+
+// GetEvent delegates to the wrapped client after injecting any fault.
+func (c *Client) GetEvent(ctx context.Context, fingerprint, namespace, tenant string) (*acteon.EventState, error) {
+	if _, err := c.before(ctx, "GetEvent", NoFault); err != nil {
+		var r0 *acteon.EventState
+		return r0, err
+	}
+	return c.next.GetEvent(ctx, fingerprint, namespace, tenant)
+}
+
+// GetGroup delegates to the wrapped client after injecting any fault.
+func (c *Client) GetGroup(ctx context.Context, groupKey string) (*acteon.GroupDetail, error) {
+	if _, err := c.before(ctx, "GetGroup", NoFault); err != nil {
+		var r0 *acteon.GroupDetail
+		return r0, err
+	}
+	return c.next.GetGroup(ctx, groupKey)
+}
+
+// GetGuardrailConfig delegates to the wrapped client after injecting any fault.
+func (c *Client) GetGuardrailConfig(ctx context.Context) (*acteon.GuardrailConfig, error) {
+	if _, err := c.before(ctx, "GetGuardrailConfig", NoFault); err != nil {
+		var r0 *acteon.GuardrailConfig
+		return r0, err
+	}
+	return c.next.GetGuardrailConfig(ctx)
+}
+
+// GetHealthDetail delegates to the wrapped client after injecting any fault.
+func (c *Client) GetHealthDetail(ctx context.Context) (*acteon.HealthDetail, error) {
+	if _, err := c.before(ctx, "GetHealthDetail", NoFault); err != nil {
+		var r0 *acteon.HealthDetail
+		return r0, err
+	}
+	return c.next.GetHealthDetail(ctx)
+}
+
+// GetMaintenanceStatus delegates to the wrapped client after injecting any fault.
+func (c *Client) GetMaintenanceStatus(ctx context.Context) (*acteon.MaintenanceStatus, error) {
+	if _, err := c.before(ctx, "GetMaintenanceStatus", NoFault); err != nil {
+		var r0 *acteon.MaintenanceStatus
+		return r0, err
+	}
+	return c.next.GetMaintenanceStatus(ctx)
+}
+
+// GetMetrics delegates to the wrapped client after injecting any fault.
+func (c *Client) GetMetrics(ctx context.Context) (*acteon.GatewayMetrics, error) {
+	if _, err := c.before(ctx, "GetMetrics", NoFault); err != nil {
+		var r0 *acteon.GatewayMetrics
+		return r0, err
+	}
+	return c.next.GetMetrics(ctx)
+}
+
+// GetNotificationPreferences delegates to the wrapped client after injecting any fault.
+func (c *Client) GetNotificationPreferences(ctx context.Context, tenant string) (*acteon.NotificationPreferences, error) {
+	if _, err := c.before(ctx, "GetNotificationPreferences", NoFault); err != nil {
+		var r0 *acteon.NotificationPreferences
+		return r0, err
+	}
+	return c.next.GetNotificationPreferences(ctx, tenant)
+}
+
+// GetPlugin delegates to the wrapped client after injecting any fault.
+func (c *Client) GetPlugin(ctx context.Context, name string) (*acteon.WasmPlugin, error) {
+	if _, err := c.before(ctx, "GetPlugin", NoFault); err != nil {
+		var r0 *acteon.WasmPlugin
+		return r0, err
+	}
+	return c.next.GetPlugin(ctx, name)
+}
+
+// GetProfile delegates to the wrapped client after injecting any fault.
+func (c *Client) GetProfile(ctx context.Context, profileID string) (*acteon.TemplateProfileInfo, error) {
+	if _, err := c.before(ctx, "GetProfile", NoFault); err != nil {
+		var r0 *acteon.TemplateProfileInfo
+		return r0, err
+	}
+	return c.next.GetProfile(ctx, profileID)
+}
+
+// GetQuota delegates to the wrapped client after injecting any fault.
+func (c *Client) GetQuota(ctx context.Context, quotaID string) (*acteon.QuotaPolicy, error) {
+	if _, err := c.before(ctx, "GetQuota", NoFault); err != nil {
+		var r0 *acteon.QuotaPolicy
+		return r0, err
+	}
+	return c.next.GetQuota(ctx, quotaID)
+}
+
+// GetQuotaUsage delegates to the wrapped client after injecting any fault.
+func (c *Client) GetQuotaUsage(ctx context.Context, quotaID string) (*acteon.QuotaUsage, error) {
+	if _, err := c.before(ctx, "GetQuotaUsage", NoFault); err != nil {
+		var r0 *acteon.QuotaUsage
+		return r0, err
+	}
+	return c.next.GetQuotaUsage(ctx, quotaID)
+}
+
+// GetRecurring delegates to the wrapped client after injecting any fault.
+func (c *Client) GetRecurring(ctx context.Context, recurringID, namespace, tenant string) (*acteon.RecurringDetail, error) {
+	if _, err := c.before(ctx, "GetRecurring", NoFault); err != nil {
+		var r0 *acteon.RecurringDetail
+		return r0, err
+	}
+	return c.next.GetRecurring(ctx, recurringID, namespace, tenant)
+}
+
+// GetRetention delegates to the wrapped client after injecting any fault.
+func (c *Client) GetRetention(ctx context.Context, retentionID string) (*acteon.RetentionPolicy, error) {
+	if _, err := c.before(ctx, "GetRetention", NoFault); err != nil {
+		var r0 *acteon.RetentionPolicy
+		return r0, err
+	}
+	return c.next.GetRetention(ctx, retentionID)
+}
+
+// GetRetentionArchiveStatus delegates to the wrapped client after injecting any fault.
+func (c *Client) GetRetentionArchiveStatus(ctx context.Context, retentionID string) (*acteon.RetentionArchiveStatus, error) {
+	if _, err := c.before(ctx, "GetRetentionArchiveStatus", NoFault); err != nil {
+		var r0 *acteon.RetentionArchiveStatus
+		return r0, err
+	}
+	return c.next.GetRetentionArchiveStatus(ctx, retentionID)
+}
+
+// GetServerInfo delegates to the wrapped client after injecting any fault.
+func (c *Client) GetServerInfo(ctx context.Context) (*acteon.ServerInfo, error) {
+	if _, err := c.before(ctx, "GetServerInfo", NoFault); err != nil {
+		var r0 *acteon.ServerInfo
+		return r0, err
+	}
+	return c.next.GetServerInfo(ctx)
+}
+
+// GetSilence delegates to the wrapped client after injecting any fault.
+func (c *Client) GetSilence(ctx context.Context, silenceID string) (*acteon.Silence, error) {
+	if _, err := c.before(ctx, "GetSilence", NoFault); err != nil {
+		var r0 *acteon.Silence
+		return r0, err
+	}
+	return c.next.GetSilence(ctx, silenceID)
+}
+
+// GetSwarmRun delegates to the wrapped client after injecting any fault.
+func (c *Client) GetSwarmRun(ctx context.Context, runID string) (*acteon.SwarmRunSnapshot, error) {
+	if _, err := c.before(ctx, "GetSwarmRun", NoFault); err != nil {
+		var r0 *acteon.SwarmRunSnapshot
+		return r0, err
+	}
+	return c.next.GetSwarmRun(ctx, runID)
+}
+
+// GetTask delegates to the wrapped client after injecting any fault.
+func (c *Client) GetTask(ctx context.Context, taskID, namespace, tenant string) (*acteon.WorkerTask, error) {
+	if _, err := c.before(ctx, "GetTask", NoFault); err != nil {
+		var r0 *acteon.WorkerTask
+		return r0, err
+	}
+	return c.next.GetTask(ctx, taskID, namespace, tenant)
+}
+
+// GetTemplate delegates to the wrapped client after injecting any fault.
+func (c *Client) GetTemplate(ctx context.Context, templateID string) (*acteon.TemplateInfo, error) {
+	if _, err := c.before(ctx, "GetTemplate", NoFault); err != nil {
+		var r0 *acteon.TemplateInfo
+		return r0, err
+	}
+	return c.next.GetTemplate(ctx, templateID)
+}
+
+// GetTemplateUsage delegates to the wrapped client after injecting any fault.
+func (c *Client) GetTemplateUsage(ctx context.Context, templateID string, window time.Duration) (*acteon.TemplateUsageResponse, error) {
+	if _, err := c.before(ctx, "GetTemplateUsage", NoFault); err != nil {
+		var r0 *acteon.TemplateUsageResponse
+		return r0, err
+	}
+	return c.next.GetTemplateUsage(ctx, templateID, window)
+}
+
+// GetThrottle delegates to the wrapped client after injecting any fault.
+func (c *Client) GetThrottle(ctx context.Context, throttleID string) (*acteon.ThrottlePolicy, error) {
+	if _, err := c.before(ctx, "GetThrottle", NoFault); err != nil {
+		var r0 *acteon.ThrottlePolicy
+		return r0, err
+	}
+	return c.next.GetThrottle(ctx, throttleID)
+}
+
+// GetTimeInterval delegates to the wrapped client after injecting any fault.
+func (c *Client) GetTimeInterval(ctx context.Context, namespace, tenant, name string) (*acteon.TimeInterval, error) {
+	if _, err := c.before(ctx, "GetTimeInterval", NoFault); err != nil {
+		var r0 *acteon.TimeInterval
+		return r0, err
+	}
+	return c.next.GetTimeInterval(ctx, namespace, tenant, name)
+}
+
+// Health delegates to the wrapped client after injecting any fault.
+func (c *Client) Health(ctx context.Context) (bool, error) {
+	if _, err := c.before(ctx, "Health", NoFault); err != nil {
+		var r0 bool
+		return r0, err
+	}
+	return c.next.Health(ctx)
+}
+
+// HeartbeatBusAgent delegates to the wrapped client after injecting any fault.
+func (c *Client) HeartbeatBusAgent(ctx context.Context, namespace, tenant, agentID string) (*acteon.BusAgent, error) {
+	if _, err := c.before(ctx, "HeartbeatBusAgent", NoFault); err != nil {
+		var r0 *acteon.BusAgent
+		return r0, err
+	}
+	return c.next.HeartbeatBusAgent(ctx, namespace, tenant, agentID)
+}
+
+// HeartbeatTask delegates to the wrapped client after injecting any fault.
+func (c *Client) HeartbeatTask(ctx context.Context, taskID string, req *acteon.HeartbeatTaskRequest) (*acteon.WorkerTask, error) {
+	if _, err := c.before(ctx, "HeartbeatTask", NoFault); err != nil {
+		var r0 *acteon.WorkerTask
+		return r0, err
+	}
+	return c.next.HeartbeatTask(ctx, taskID, req)
+}
+
+// ImportTemplates delegates to the wrapped client after injecting any fault.
+func (c *Client) ImportTemplates(ctx context.Context, r io.Reader, opts acteon.ImportOptions) (*acteon.ImportResult, error) {
+	if _, err := c.before(ctx, "ImportTemplates", NoFault); err != nil {
+		var r0 *acteon.ImportResult
+		return r0, err
+	}
+	return c.next.ImportTemplates(ctx, r, opts)
+}
+
+// InspectPlugin delegates to the wrapped client after injecting any fault.
+func (c *Client) InspectPlugin(ctx context.Context, name string) (*acteon.PluginInspection, error) {
+	if _, err := c.before(ctx, "InspectPlugin", NoFault); err != nil {
+		var r0 *acteon.PluginInspection
+		return r0, err
+	}
+	return c.next.InspectPlugin(ctx, name)
+}
+
+// InvokePlugin delegates to the wrapped client after injecting any fault.
+func (c *Client) InvokePlugin(ctx context.Context, name string, req *acteon.PluginInvocationRequest) (*acteon.PluginInvocationResponse, error) {
+	if _, err := c.before(ctx, "InvokePlugin", NoFault); err != nil {
+		var r0 *acteon.PluginInvocationResponse
+		return r0, err
+	}
+	return c.next.InvokePlugin(ctx, name, req)
+}
+
+// InvokePluginBatch delegates to the wrapped client after injecting any fault.
+func (c *Client) InvokePluginBatch(ctx context.Context, name string, reqs []acteon.PluginInvocationRequest) (*acteon.PluginBatchInvocationResponse, error) {
+	if _, err := c.before(ctx, "InvokePluginBatch", NoFault); err != nil {
+		var r0 *acteon.PluginBatchInvocationResponse
+		return r0, err
+	}
+	return c.next.InvokePluginBatch(ctx, name, reqs)
+}
+
+// ListApprovals delegates to the wrapped client after injecting any fault.
+func (c *Client) ListApprovals(ctx context.Context, namespace, tenant string) (*acteon.ApprovalListResponse, error) {
+	if _, err := c.before(ctx, "ListApprovals", NoFault); err != nil {
+		var r0 *acteon.ApprovalListResponse
+		return r0, err
+	}
+	return c.next.ListApprovals(ctx, namespace, tenant)
+}
+
+// ListBackups delegates to the wrapped client after injecting any fault.
+func (c *Client) ListBackups(ctx context.Context) (*acteon.ListBackupsResponse, error) {
+	if _, err := c.before(ctx, "ListBackups", NoFault); err != nil {
+		var r0 *acteon.ListBackupsResponse
+		return r0, err
+	}
+	return c.next.ListBackups(ctx)
+}
+
+// ListBusAgents delegates to the wrapped client after injecting any fault.
+func (c *Client) ListBusAgents(ctx context.Context, filter *acteon.ListBusAgentsFilter) ([]acteon.BusAgent, error) {
+	if _, err := c.before(ctx, "ListBusAgents", NoFault); err != nil {
+		var r0 []acteon.BusAgent
+		return r0, err
+	}
+	return c.next.ListBusAgents(ctx, filter)
+}
+
+// ListBusApprovals delegates to the wrapped client after injecting any fault.
+func (c *Client) ListBusApprovals(ctx context.Context, namespace, tenant string, filter *acteon.ListBusApprovalsFilter) ([]acteon.BusApprovalView, error) {
+	if _, err := c.before(ctx, "ListBusApprovals", NoFault); err != nil {
+		var r0 []acteon.BusApprovalView
+		return r0, err
+	}
+	return c.next.ListBusApprovals(ctx, namespace, tenant, filter)
+}
+
+// ListBusConversations delegates to the wrapped client after injecting any fault.
+func (c *Client) ListBusConversations(ctx context.Context, filter *acteon.ListBusConversationsFilter) ([]acteon.BusConversation, error) {
+	if _, err := c.before(ctx, "ListBusConversations", NoFault); err != nil {
+		var r0 []acteon.BusConversation
+		return r0, err
+	}
+	return c.next.ListBusConversations(ctx, filter)
+}
+
+// ListBusSchemas delegates to the wrapped client after injecting any fault.
+func (c *Client) ListBusSchemas(ctx context.Context, filter *acteon.ListBusSchemasFilter) ([]acteon.BusSchema, error) {
+	if _, err := c.before(ctx, "ListBusSchemas", NoFault); err != nil {
+		var r0 []acteon.BusSchema
+		return r0, err
+	}
+	return c.next.ListBusSchemas(ctx, filter)
+}
+
+// ListBusSubscriptions delegates to the wrapped client after injecting any fault.
+func (c *Client) ListBusSubscriptions(ctx context.Context, filter *acteon.ListBusSubscriptionsFilter) ([]acteon.BusSubscription, error) {
+	if _, err := c.before(ctx, "ListBusSubscriptions", NoFault); err != nil {
+		var r0 []acteon.BusSubscription
+		return r0, err
+	}
+	return c.next.ListBusSubscriptions(ctx, filter)
+}
+
+// ListBusTopics delegates to the wrapped client after injecting any fault.
+func (c *Client) ListBusTopics(ctx context.Context, filter *acteon.ListBusTopicsFilter) ([]acteon.BusTopic, error) {
+	if _, err := c.before(ctx, "ListBusTopics", NoFault); err != nil {
+		var r0 []acteon.BusTopic
+		return r0, err
+	}
+	return c.next.ListBusTopics(ctx, filter)
+}
+
+// ListChains delegates to the wrapped client after injecting any fault.
+func (c *Client) ListChains(ctx context.Context, namespace, tenant string, status *string) (*acteon.ListChainsResponse, error) {
+	if _, err := c.before(ctx, "ListChains", NoFault); err != nil {
+		var r0 *acteon.ListChainsResponse
+		return r0, err
+	}
+	return c.next.ListChains(ctx, namespace, tenant, status)
+}
+
+// ListDlq delegates to the wrapped client after injecting any fault.
+func (c *Client) ListDlq(ctx context.Context, filter acteon.DlqFilter) (*acteon.DlqListResponse, error) {
+	if _, err := c.before(ctx, "ListDlq", NoFault); err != nil {
+		var r0 *acteon.DlqListResponse
+		return r0, err
+	}
+	return c.next.ListDlq(ctx, filter)
+}
+
+// ListEscalationPolicies delegates to the wrapped client after injecting any fault.
+func (c *Client) ListEscalationPolicies(ctx context.Context, namespace, tenant *string) (*acteon.ListEscalationPoliciesResponse, error) {
+	if _, err := c.before(ctx, "ListEscalationPolicies", NoFault); err != nil {
+		var r0 *acteon.ListEscalationPoliciesResponse
+		return r0, err
+	}
+	return c.next.ListEscalationPolicies(ctx, namespace, tenant)
+}
+
+// ListEvents delegates to the wrapped client after injecting any fault.
+func (c *Client) ListEvents(ctx context.Context, query *acteon.EventQuery) (*acteon.EventListResponse, error) {
+	if _, err := c.before(ctx, "ListEvents", NoFault); err != nil {
+		var r0 *acteon.EventListResponse
+		return r0, err
+	}
+	return c.next.ListEvents(ctx, query)
+}
+
+// ListGroups delegates to the wrapped client after injecting any fault.
+func (c *Client) ListGroups(ctx context.Context) (*acteon.GroupListResponse, error) {
+	if _, err := c.before(ctx, "ListGroups", NoFault); err != nil {
+		var r0 *acteon.GroupListResponse
+		return r0, err
+	}
+	return c.next.ListGroups(ctx)
+}
+
+// ListPluginVersions delegates to the wrapped client after injecting any fault.
+func (c *Client) ListPluginVersions(ctx context.Context, name string) (*acteon.ListPluginVersionsResponse, error) {
+	if _, err := c.before(ctx, "ListPluginVersions", NoFault); err != nil {
+		var r0 *acteon.ListPluginVersionsResponse
+		return r0, err
+	}
+	return c.next.ListPluginVersions(ctx, name)
+}
+
+// ListPlugins delegates to the wrapped client after injecting any fault.
+func (c *Client) ListPlugins(ctx context.Context) (*acteon.ListPluginsResponse, error) {
+	if _, err := c.before(ctx, "ListPlugins", NoFault); err != nil {
+		var r0 *acteon.ListPluginsResponse
+		return r0, err
+	}
+	return c.next.ListPlugins(ctx)
+}
+
+// ListProfiles delegates to the wrapped client after injecting any fault.
+func (c *Client) ListProfiles(ctx context.Context, namespace, tenant *string) (*acteon.ListProfilesResponse, error) {
+	if _, err := c.before(ctx, "ListProfiles", NoFault); err != nil {
+		var r0 *acteon.ListProfilesResponse
+		return r0, err
+	}
+	return c.next.ListProfiles(ctx, namespace, tenant)
+}
+
+// ListProviderConfigs delegates to the wrapped client after injecting any fault.
+func (c *Client) ListProviderConfigs(ctx context.Context, tenant *string) (*acteon.ListProviderConfigsResponse, error) {
+	if _, err := c.before(ctx, "ListProviderConfigs", NoFault); err != nil {
+		var r0 *acteon.ListProviderConfigsResponse
+		return r0, err
+	}
+	return c.next.ListProviderConfigs(ctx, tenant)
+}
+
+// ListProviderHealth delegates to the wrapped client after injecting any fault.
+func (c *Client) ListProviderHealth(ctx context.Context) (*acteon.ListProviderHealthResponse, error) {
+	if _, err := c.before(ctx, "ListProviderHealth", NoFault); err != nil {
+		var r0 *acteon.ListProviderHealthResponse
+		return r0, err
+	}
+	return c.next.ListProviderHealth(ctx)
+}
+
+// ListProviders delegates to the wrapped client after injecting any fault.
+func (c *Client) ListProviders(ctx context.Context) (*acteon.ListProvidersResponse, error) {
+	if _, err := c.before(ctx, "ListProviders", NoFault); err != nil {
+		var r0 *acteon.ListProvidersResponse
+		return r0, err
+	}
+	return c.next.ListProviders(ctx)
+}
+
+// ListQuotas delegates to the wrapped client after injecting any fault.
+func (c *Client) ListQuotas(ctx context.Context, namespace, tenant, provider, principal *string) (*acteon.ListQuotasResponse, error) {
+	if _, err := c.before(ctx, "ListQuotas", NoFault); err != nil {
+		var r0 *acteon.ListQuotasResponse
+		return r0, err
+	}
+	return c.next.ListQuotas(ctx, namespace, tenant, provider, principal)
+}
+
+// ListRecurring delegates to the wrapped client after injecting any fault.
+func (c *Client) ListRecurring(ctx context.Context, filter *acteon.RecurringFilter) (*acteon.ListRecurringResponse, error) {
+	if _, err := c.before(ctx, "ListRecurring", NoFault); err != nil {
+		var r0 *acteon.ListRecurringResponse
+		return r0, err
+	}
+	return c.next.ListRecurring(ctx, filter)
+}
+
+// ListRetention delegates to the wrapped client after injecting any fault.
+func (c *Client) ListRetention(ctx context.Context, namespace, tenant *string, limit, offset *int) (*acteon.ListRetentionResponse, error) {
+	if _, err := c.before(ctx, "ListRetention", NoFault); err != nil {
+		var r0 *acteon.ListRetentionResponse
+		return r0, err
+	}
+	return c.next.ListRetention(ctx, namespace, tenant, limit, offset)
+}
+
+// ListRoleBindings delegates to the wrapped client after injecting any fault.
+func (c *Client) ListRoleBindings(ctx context.Context, namespace, tenant *string) (*acteon.ListRoleBindingsResponse, error) {
+	if _, err := c.before(ctx, "ListRoleBindings", NoFault); err != nil {
+		var r0 *acteon.ListRoleBindingsResponse
+		return r0, err
+	}
+	return c.next.ListRoleBindings(ctx, namespace, tenant)
+}
+
+// ListRoles delegates to the wrapped client after injecting any fault.
+func (c *Client) ListRoles(ctx context.Context) ([]acteon.RoleInfo, error) {
+	if _, err := c.before(ctx, "ListRoles", NoFault); err != nil {
+		var r0 []acteon.RoleInfo
+		return r0, err
+	}
+	return c.next.ListRoles(ctx)
+}
+
+// ListRules delegates to the wrapped client after injecting any fault.
+func (c *Client) ListRules(ctx context.Context) ([]acteon.RuleInfo, error) {
+	if _, err := c.before(ctx, "ListRules", NoFault); err != nil {
+		var r0 []acteon.RuleInfo
+		return r0, err
+	}
+	return c.next.ListRules(ctx)
+}
+
+// ListSecrets delegates to the wrapped client after injecting any fault.
+func (c *Client) ListSecrets(ctx context.Context, scope string) (*acteon.ListSecretsResponse, error) {
+	if _, err := c.before(ctx, "ListSecrets", NoFault); err != nil {
+		var r0 *acteon.ListSecretsResponse
+		return r0, err
+	}
+	return c.next.ListSecrets(ctx, scope)
+}
+
+// ListSilences delegates to the wrapped client after injecting any fault.
+func (c *Client) ListSilences(ctx context.Context, namespace, tenant *string, includeExpired bool) (*acteon.ListSilencesResponse, error) {
+	if _, err := c.before(ctx, "ListSilences", NoFault); err != nil {
+		var r0 *acteon.ListSilencesResponse
+		return r0, err
+	}
+	return c.next.ListSilences(ctx, namespace, tenant, includeExpired)
+}
+
+// ListSwarmRuns delegates to the wrapped client after injecting any fault.
+func (c *Client) ListSwarmRuns(ctx context.Context, filter *acteon.SwarmRunFilter) (*acteon.ListSwarmRunsResponse, error) {
+	if _, err := c.before(ctx, "ListSwarmRuns", NoFault); err != nil {
+		var r0 *acteon.ListSwarmRunsResponse
+		return r0, err
+	}
+	return c.next.ListSwarmRuns(ctx, filter)
+}
+
+// ListTasks delegates to the wrapped client after injecting any fault.
+func (c *Client) ListTasks(ctx context.Context, queue, namespace, tenant, status string) ([]acteon.WorkerTask, error) {
+	if _, err := c.before(ctx, "ListTasks", NoFault); err != nil {
+		var r0 []acteon.WorkerTask
+		return r0, err
+	}
+	return c.next.ListTasks(ctx, queue, namespace, tenant, status)
+}
+
+// ListTemplates delegates to the wrapped client after injecting any fault.
+func (c *Client) ListTemplates(ctx context.Context, namespace, tenant *string) (*acteon.ListTemplatesResponse, error) {
+	if _, err := c.before(ctx, "ListTemplates", NoFault); err != nil {
+		var r0 *acteon.ListTemplatesResponse
+		return r0, err
+	}
+	return c.next.ListTemplates(ctx, namespace, tenant)
+}
+
+// ListThrottles delegates to the wrapped client after injecting any fault.
+func (c *Client) ListThrottles(ctx context.Context, namespace, tenant, provider, actionType *string) (*acteon.ListThrottlesResponse, error) {
+	if _, err := c.before(ctx, "ListThrottles", NoFault); err != nil {
+		var r0 *acteon.ListThrottlesResponse
+		return r0, err
+	}
+	return c.next.ListThrottles(ctx, namespace, tenant, provider, actionType)
+}
+
+// ListTimeIntervals delegates to the wrapped client after injecting any fault.
+func (c *Client) ListTimeIntervals(ctx context.Context, namespace, tenant *string) (*acteon.ListTimeIntervalsResponse, error) {
+	if _, err := c.before(ctx, "ListTimeIntervals", NoFault); err != nil {
+		var r0 *acteon.ListTimeIntervalsResponse
+		return r0, err
+	}
+	return c.next.ListTimeIntervals(ctx, namespace, tenant)
+}
+
+// LookupBusToolResult delegates to the wrapped client after injecting any fault.
+func (c *Client) LookupBusToolResult(ctx context.Context, namespace, tenant, callID string, params *acteon.BusToolResultLookupParams) (*acteon.BusToolResultLookup, error) {
+	if _, err := c.before(ctx, "LookupBusToolResult", NoFault); err != nil {
+		var r0 *acteon.BusToolResultLookup
+		return r0, err
+	}
+	return c.next.LookupBusToolResult(ctx, namespace, tenant, callID, params)
+}
+
+// PauseRecurring delegates to the wrapped client after injecting any fault.
+func (c *Client) PauseRecurring(ctx context.Context, recurringID, namespace, tenant string) (*acteon.RecurringDetail, error) {
+	if _, err := c.before(ctx, "PauseRecurring", NoFault); err != nil {
+		var r0 *acteon.RecurringDetail
+		return r0, err
+	}
+	return c.next.PauseRecurring(ctx, recurringID, namespace, tenant)
+}
+
+// PollTasks delegates to the wrapped client after injecting any fault.
+func (c *Client) PollTasks(ctx context.Context, queue string, req *acteon.PollTasksRequest) ([]acteon.WorkerTask, error) {
+	if _, err := c.before(ctx, "PollTasks", NoFault); err != nil {
+		var r0 []acteon.WorkerTask
+		return r0, err
+	}
+	return c.next.PollTasks(ctx, queue, req)
+}
+
+// PostBusStreamChunk delegates to the wrapped client after injecting any fault.
+func (c *Client) PostBusStreamChunk(ctx context.Context, namespace, tenant, conversationID string, req *acteon.PostBusStreamChunk) (*acteon.BusStreamEnvelopeReceipt, error) {
+	if _, err := c.before(ctx, "PostBusStreamChunk", NoFault); err != nil {
+		var r0 *acteon.BusStreamEnvelopeReceipt
+		return r0, err
+	}
+	return c.next.PostBusStreamChunk(ctx, namespace, tenant, conversationID, req)
+}
+
+// PostBusStreamEnd delegates to the wrapped client after injecting any fault.
+func (c *Client) PostBusStreamEnd(ctx context.Context, namespace, tenant, conversationID string, req *acteon.PostBusStreamEnd) (*acteon.BusStreamEnvelopeReceipt, error) {
+	if _, err := c.before(ctx, "PostBusStreamEnd", NoFault); err != nil {
+		var r0 *acteon.BusStreamEnvelopeReceipt
+		return r0, err
+	}
+	return c.next.PostBusStreamEnd(ctx, namespace, tenant, conversationID, req)
+}
+
+// PostBusToolCall delegates to the wrapped client after injecting any fault.
+func (c *Client) PostBusToolCall(ctx context.Context, namespace, tenant, conversationID string, req *acteon.PostBusToolCall) (*acteon.PostBusToolCallOutcome, error) {
+	if _, err := c.before(ctx, "PostBusToolCall", NoFault); err != nil {
+		var r0 *acteon.PostBusToolCallOutcome
+		return r0, err
+	}
+	return c.next.PostBusToolCall(ctx, namespace, tenant, conversationID, req)
+}
+
+// PostBusToolResult delegates to the wrapped client after injecting any fault.
+func (c *Client) PostBusToolResult(ctx context.Context, namespace, tenant, conversationID string, req *acteon.PostBusToolResult) (*acteon.BusToolEnvelopeReceipt, error) {
+	if _, err := c.before(ctx, "PostBusToolResult", NoFault); err != nil {
+		var r0 *acteon.BusToolEnvelopeReceipt
+		return r0, err
+	}
+	return c.next.PostBusToolResult(ctx, namespace, tenant, conversationID, req)
+}
+
+// ProvideChainStepInput delegates to the wrapped client after injecting any fault.
+func (c *Client) ProvideChainStepInput(ctx context.Context, chainID, stepName string, req *acteon.ProvideStepInputRequest) (*acteon.ChainDetailResponse, error) {
+	if _, err := c.before(ctx, "ProvideChainStepInput", NoFault); err != nil {
+		var r0 *acteon.ChainDetailResponse
+		return r0, err
+	}
+	return c.next.ProvideChainStepInput(ctx, chainID, stepName, req)
+}
+
+// PublishBusMessage delegates to the wrapped client after injecting any fault.
+func (c *Client) PublishBusMessage(ctx context.Context, req *acteon.PublishBusMessage) (*acteon.PublishReceipt, error) {
+	if _, err := c.before(ctx, "PublishBusMessage", NoFault); err != nil {
+		var r0 *acteon.PublishReceipt
+		return r0, err
+	}
+	return c.next.PublishBusMessage(ctx, req)
+}
+
+// PurgeCache delegates to the wrapped client without injecting faults.
+func (c *Client) PurgeCache() {
+	c.next.PurgeCache()
+}
+
+// PurgeDlq delegates to the wrapped client after injecting any fault.
+func (c *Client) PurgeDlq(ctx context.Context, filter acteon.DlqPurgeFilter) (int, error) {
+	if _, err := c.before(ctx, "PurgeDlq", NoFault); err != nil {
+		var r0 int
+		return r0, err
+	}
+	return c.next.PurgeDlq(ctx, filter)
+}
+
+// PutChainDefinition delegates to the wrapped client after injecting any fault.
+func (c *Client) PutChainDefinition(ctx context.Context, def *acteon.ChainDefinition) (*acteon.ChainDefinition, error) {
+	if _, err := c.before(ctx, "PutChainDefinition", NoFault); err != nil {
+		var r0 *acteon.ChainDefinition
+		return r0, err
+	}
+	return c.next.PutChainDefinition(ctx, def)
+}
+
+// PutNotificationPreferences delegates to the wrapped client after injecting any fault.
+func (c *Client) PutNotificationPreferences(ctx context.Context, tenant string, prefs *acteon.NotificationPreferences) (*acteon.NotificationPreferences, error) {
+	if _, err := c.before(ctx, "PutNotificationPreferences", NoFault); err != nil {
+		var r0 *acteon.NotificationPreferences
+		return r0, err
+	}
+	return c.next.PutNotificationPreferences(ctx, tenant, prefs)
+}
+
+// PutSecret delegates to the wrapped client after injecting any fault.
+func (c *Client) PutSecret(ctx context.Context, scope, name, value string) (*acteon.SecretInfo, error) {
+	if _, err := c.before(ctx, "PutSecret", NoFault); err != nil {
+		var r0 *acteon.SecretInfo
+		return r0, err
+	}
+	return c.next.PutSecret(ctx, scope, name, value)
+}
+
+// QueryAnalytics delegates to the wrapped client after injecting any fault.
+func (c *Client) QueryAnalytics(ctx context.Context, query *acteon.AnalyticsQuery) (*acteon.AnalyticsResponse, error) {
+	if _, err := c.before(ctx, "QueryAnalytics", NoFault); err != nil {
+		var r0 *acteon.AnalyticsResponse
+		return r0, err
+	}
+	return c.next.QueryAnalytics(ctx, query)
+}
+
+// QueryAudit delegates to the wrapped client after injecting any fault.
+func (c *Client) QueryAudit(ctx context.Context, query *acteon.AuditQuery) (*acteon.AuditPage, error) {
+	if _, err := c.before(ctx, "QueryAudit", NoFault); err != nil {
+		var r0 *acteon.AuditPage
+		return r0, err
+	}
+	return c.next.QueryAudit(ctx, query)
+}
+
+// Ready delegates to the wrapped client after injecting any fault.
+func (c *Client) Ready(ctx context.Context) (*acteon.Readiness, error) {
+	if _, err := c.before(ctx, "Ready", NoFault); err != nil {
+		var r0 *acteon.Readiness
+		return r0, err
+	}
+	return c.next.Ready(ctx)
+}
+
+// RegisterBusAgent delegates to the wrapped client after injecting any fault.
+func (c *Client) RegisterBusAgent(ctx context.Context, req *acteon.RegisterBusAgent) (*acteon.BusAgent, error) {
+	if _, err := c.before(ctx, "RegisterBusAgent", NoFault); err != nil {
+		var r0 *acteon.BusAgent
+		return r0, err
+	}
+	return c.next.RegisterBusAgent(ctx, req)
+}
+
+// RegisterBusSchema delegates to the wrapped client after injecting any fault.
+func (c *Client) RegisterBusSchema(ctx context.Context, req *acteon.RegisterBusSchema) (*acteon.BusSchema, error) {
+	if _, err := c.before(ctx, "RegisterBusSchema", NoFault); err != nil {
+		var r0 *acteon.BusSchema
+		return r0, err
+	}
+	return c.next.RegisterBusSchema(ctx, req)
+}
+
+// RegisterPlugin delegates to the wrapped client after injecting any fault.
+func (c *Client) RegisterPlugin(ctx context.Context, req *acteon.RegisterPluginRequest) (*acteon.WasmPlugin, error) {
+	if _, err := c.before(ctx, "RegisterPlugin", NoFault); err != nil {
+		var r0 *acteon.WasmPlugin
+		return r0, err
+	}
+	return c.next.RegisterPlugin(ctx, req)
+}
+
+// RegisterPluginFromFile delegates to the wrapped client after injecting any fault.
+func (c *Client) RegisterPluginFromFile(ctx context.Context, name, path string, opts *acteon.PluginUploadOptions) (*acteon.WasmPlugin, error) {
+	if _, err := c.before(ctx, "RegisterPluginFromFile", NoFault); err != nil {
+		var r0 *acteon.WasmPlugin
+		return r0, err
+	}
+	return c.next.RegisterPluginFromFile(ctx, name, path, opts)
+}
+
+// RegisterPluginFromReader delegates to the wrapped client after injecting any fault.
+func (c *Client) RegisterPluginFromReader(ctx context.Context, name string, r io.Reader, size int64, opts *acteon.PluginUploadOptions) (*acteon.WasmPlugin, error) {
+	if _, err := c.before(ctx, "RegisterPluginFromReader", NoFault); err != nil {
+		var r0 *acteon.WasmPlugin
+		return r0, err
+	}
+	return c.next.RegisterPluginFromReader(ctx, name, r, size, opts)
+}
+
+// RegisterPluginVersion delegates to the wrapped client after injecting any fault.
+func (c *Client) RegisterPluginVersion(ctx context.Context, name string, req *acteon.RegisterPluginVersionRequest) (*acteon.PluginVersion, error) {
+	if _, err := c.before(ctx, "RegisterPluginVersion", NoFault); err != nil {
+		var r0 *acteon.PluginVersion
+		return r0, err
+	}
+	return c.next.RegisterPluginVersion(ctx, name, req)
+}
+
+// Reject delegates to the wrapped client after injecting any fault.
+func (c *Client) Reject(ctx context.Context, namespace, tenant, id, sig string, expiresAt int64, kid string) (*acteon.ApprovalActionResponse, error) {
+	if _, err := c.before(ctx, "Reject", NoFault); err != nil {
+		var r0 *acteon.ApprovalActionResponse
+		return r0, err
+	}
+	return c.next.Reject(ctx, namespace, tenant, id, sig, expiresAt, kid)
+}
+
+// RejectBusApproval delegates to the wrapped client after injecting any fault.
+func (c *Client) RejectBusApproval(ctx context.Context, namespace, tenant, approvalID string, decision *acteon.BusApprovalDecision) (*acteon.BusApprovalDecisionResponse, error) {
+	if _, err := c.before(ctx, "RejectBusApproval", NoFault); err != nil {
+		var r0 *acteon.BusApprovalDecisionResponse
+		return r0, err
+	}
+	return c.next.RejectBusApproval(ctx, namespace, tenant, approvalID, decision)
+}
+
+// ReloadConfig delegates to the wrapped client after injecting any fault.
+func (c *Client) ReloadConfig(ctx context.Context) (*acteon.ConfigReloadResult, error) {
+	if _, err := c.before(ctx, "ReloadConfig", NoFault); err != nil {
+		var r0 *acteon.ConfigReloadResult
+		return r0, err
+	}
+	return c.next.ReloadConfig(ctx)
+}
+
+// ReloadRules delegates to the wrapped client after injecting any fault.
+func (c *Client) ReloadRules(ctx context.Context) (*acteon.ReloadResult, error) {
+	if _, err := c.before(ctx, "ReloadRules", NoFault); err != nil {
+		var r0 *acteon.ReloadResult
+		return r0, err
+	}
+	return c.next.ReloadRules(ctx)
+}
+
+// RenderPreview delegates to the wrapped client after injecting any fault.
+func (c *Client) RenderPreview(ctx context.Context, req *acteon.RenderPreviewRequest) (*acteon.RenderPreviewResponse, error) {
+	if _, err := c.before(ctx, "RenderPreview", NoFault); err != nil {
+		var r0 *acteon.RenderPreviewResponse
+		return r0, err
+	}
+	return c.next.RenderPreview(ctx, req)
+}
+
+// ReplayAction delegates to the wrapped client after injecting any fault.
+func (c *Client) ReplayAction(ctx context.Context, actionID string) (*acteon.ReplayResult, error) {
+	if _, err := c.before(ctx, "ReplayAction", NoFault); err != nil {
+		var r0 *acteon.ReplayResult
+		return r0, err
+	}
+	return c.next.ReplayAction(ctx, actionID)
+}
+
+// ReplayAudit delegates to the wrapped client after injecting any fault.
+func (c *Client) ReplayAudit(ctx context.Context, query *acteon.ReplayQuery) (*acteon.ReplaySummary, error) {
+	if _, err := c.before(ctx, "ReplayAudit", NoFault); err != nil {
+		var r0 *acteon.ReplaySummary
+		return r0, err
+	}
+	return c.next.ReplayAudit(ctx, query)
+}
+
+// ReplayBusConversationMessages delegates to the wrapped client after injecting any fault.
+func (c *Client) ReplayBusConversationMessages(ctx context.Context, namespace, tenant, conversationID string, params *acteon.ReplayBusConversationParams) (*acteon.BusReplayResponse, error) {
+	if _, err := c.before(ctx, "ReplayBusConversationMessages", NoFault); err != nil {
+		var r0 *acteon.BusReplayResponse
+		return r0, err
+	}
+	return c.next.ReplayBusConversationMessages(ctx, namespace, tenant, conversationID, params)
+}
+
+// ResolveProfile delegates to the wrapped client after injecting any fault.
+func (c *Client) ResolveProfile(ctx context.Context, profileID string) (*acteon.ResolvedProfile, error) {
+	if _, err := c.before(ctx, "ResolveProfile", NoFault); err != nil {
+		var r0 *acteon.ResolvedProfile
+		return r0, err
+	}
+	return c.next.ResolveProfile(ctx, profileID)
+}
+
+// RestoreBackup delegates to the wrapped client after injecting any fault.
+func (c *Client) RestoreBackup(ctx context.Context, backupID string, opts acteon.RestoreOptions) (*acteon.RestoreResult, error) {
+	if _, err := c.before(ctx, "RestoreBackup", NoFault); err != nil {
+		var r0 *acteon.RestoreResult
+		return r0, err
+	}
+	return c.next.RestoreBackup(ctx, backupID, opts)
+}
+
+// ResumeRecurring delegates to the wrapped client after injecting any fault.
+func (c *Client) ResumeRecurring(ctx context.Context, recurringID, namespace, tenant string) (*acteon.RecurringDetail, error) {
+	if _, err := c.before(ctx, "ResumeRecurring", NoFault); err != nil {
+		var r0 *acteon.RecurringDetail
+		return r0, err
+	}
+	return c.next.ResumeRecurring(ctx, recurringID, namespace, tenant)
+}
+
+// RetryChain delegates to the wrapped client after injecting any fault.
+func (c *Client) RetryChain(ctx context.Context, chainID string, opts acteon.RetryOptions) (*acteon.ChainDetailResponse, error) {
+	if _, err := c.before(ctx, "RetryChain", NoFault); err != nil {
+		var r0 *acteon.ChainDetailResponse
+		return r0, err
+	}
+	return c.next.RetryChain(ctx, chainID, opts)
+}
+
+// RetryDlq delegates to the wrapped client after injecting any fault.
+func (c *Client) RetryDlq(ctx context.Context, filter acteon.DlqFilter, opts acteon.DlqRetryOptions) (*acteon.DlqRetryReport, error) {
+	if _, err := c.before(ctx, "RetryDlq", NoFault); err != nil {
+		var r0 *acteon.DlqRetryReport
+		return r0, err
+	}
+	return c.next.RetryDlq(ctx, filter, opts)
+}
+
+// RetryDlqEntry delegates to the wrapped client after injecting any fault.
+func (c *Client) RetryDlqEntry(ctx context.Context, actionID string) (*acteon.DlqRetryResult, error) {
+	if _, err := c.before(ctx, "RetryDlqEntry", NoFault); err != nil {
+		var r0 *acteon.DlqRetryResult
+		return r0, err
+	}
+	return c.next.RetryDlqEntry(ctx, actionID)
+}
+
+// RollbackPlugin delegates to the wrapped client after injecting any fault.
+func (c *Client) RollbackPlugin(ctx context.Context, name string, version int) (*acteon.WasmPlugin, error) {
+	if _, err := c.before(ctx, "RollbackPlugin", NoFault); err != nil {
+		var r0 *acteon.WasmPlugin
+		return r0, err
+	}
+	return c.next.RollbackPlugin(ctx, name, version)
+}
+
+// RulesCoverage delegates to the wrapped client after injecting any fault.
+func (c *Client) RulesCoverage(ctx context.Context, query *acteon.CoverageQuery) (*acteon.CoverageReport, error) {
+	if _, err := c.before(ctx, "RulesCoverage", NoFault); err != nil {
+		var r0 *acteon.CoverageReport
+		return r0, err
+	}
+	return c.next.RulesCoverage(ctx, query)
+}
+
+// SetBusAgentAdminState delegates to the wrapped client after injecting any fault.
+func (c *Client) SetBusAgentAdminState(ctx context.Context, namespace, tenant, agentID string, req *acteon.SetBusAgentAdminState) (*acteon.BusAgent, error) {
+	if _, err := c.before(ctx, "SetBusAgentAdminState", NoFault); err != nil {
+		var r0 *acteon.BusAgent
+		return r0, err
+	}
+	return c.next.SetBusAgentAdminState(ctx, namespace, tenant, agentID, req)
+}
+
+// SetDlqPolicy delegates to the wrapped client after injecting any fault.
+func (c *Client) SetDlqPolicy(ctx context.Context, policy acteon.DlqPolicy) (*acteon.DlqPolicy, error) {
+	if _, err := c.before(ctx, "SetDlqPolicy", NoFault); err != nil {
+		var r0 *acteon.DlqPolicy
+		return r0, err
+	}
+	return c.next.SetDlqPolicy(ctx, policy)
+}
+
+// SetMaintenanceMode delegates to the wrapped client after injecting any fault.
+func (c *Client) SetMaintenanceMode(ctx context.Context, enabled bool, opts acteon.MaintenanceOptions) (*acteon.MaintenanceStatus, error) {
+	if _, err := c.before(ctx, "SetMaintenanceMode", NoFault); err != nil {
+		var r0 *acteon.MaintenanceStatus
+		return r0, err
+	}
+	return c.next.SetMaintenanceMode(ctx, enabled, opts)
+}
+
+// SetPluginEnabled delegates to the wrapped client after injecting any fault.
+func (c *Client) SetPluginEnabled(ctx context.Context, name string, enabled bool) (*acteon.WasmPlugin, error) {
+	if _, err := c.before(ctx, "SetPluginEnabled", NoFault); err != nil {
+		var r0 *acteon.WasmPlugin
+		return r0, err
+	}
+	return c.next.SetPluginEnabled(ctx, name, enabled)
+}
+
+// SetRuleEnabled delegates to the wrapped client after injecting any fault.
+func (c *Client) SetRuleEnabled(ctx context.Context, ruleName string, enabled bool) error {
+	if _, err := c.before(ctx, "SetRuleEnabled", NoFault); err != nil {
+		return err
+	}
+	return c.next.SetRuleEnabled(ctx, ruleName, enabled)
+}
+
+// StartChain delegates to the wrapped client after injecting any fault.
+func (c *Client) StartChain(ctx context.Context, req acteon.StartChainRequest) (*acteon.StartChainResponse, error) {
+	if _, err := c.before(ctx, "StartChain", NoFault); err != nil {
+		var r0 *acteon.StartChainResponse
+		return r0, err
+	}
+	return c.next.StartChain(ctx, req)
+}
+
+// Stream delegates to the wrapped client after injecting any fault.
+func (c *Client) Stream(ctx context.Context, opts *acteon.StreamOptions) (<-chan *acteon.SseEvent, error) {
+	fault, err := c.before(ctx, "Stream", Disconnect)
+	if err != nil {
+		var r0 <-chan *acteon.SseEvent
+		return r0, err
+	}
+	ctx, stop := streamContext(ctx, fault)
+	r0, err := c.next.Stream(ctx, opts)
+	return disconnect(ctx, stop, c.cfg.DisconnectAfter, r0, err)
+}
+
+// Subscribe delegates to the wrapped client after injecting any fault.
+func (c *Client) Subscribe(ctx context.Context, entityType, entityID string, opts *acteon.SubscribeOptions) (<-chan *acteon.SseEvent, error) {
+	fault, err := c.before(ctx, "Subscribe", Disconnect)
+	if err != nil {
+		var r0 <-chan *acteon.SseEvent
+		return r0, err
+	}
+	ctx, stop := streamContext(ctx, fault)
+	r0, err := c.next.Subscribe(ctx, entityType, entityID, opts)
+	return disconnect(ctx, stop, c.cfg.DisconnectAfter, r0, err)
+}
+
+// SubscribeChain delegates to the wrapped client after injecting any fault.
+func (c *Client) SubscribeChain(ctx context.Context, chainID string, opts *acteon.SubscribeOptions) (<-chan *acteon.ChainEvent, error) {
+	fault, err := c.before(ctx, "SubscribeChain", Disconnect)
+	if err != nil {
+		var r0 <-chan *acteon.ChainEvent
+		return r0, err
+	}
+	ctx, stop := streamContext(ctx, fault)
+	r0, err := c.next.SubscribeChain(ctx, chainID, opts)
+	return disconnect(ctx, stop, c.cfg.DisconnectAfter, r0, err)
+}
+
+// SyncPluginFromRegistry delegates to the wrapped client after injecting any fault.
+func (c *Client) SyncPluginFromRegistry(ctx context.Context, ref acteon.PluginRef) (*acteon.WasmPlugin, error) {
+	if _, err := c.before(ctx, "SyncPluginFromRegistry", NoFault); err != nil {
+		var r0 *acteon.WasmPlugin
+		return r0, err
+	}
+	return c.next.SyncPluginFromRegistry(ctx, ref)
+}
+
+// TestProvider delegates to the wrapped client after injecting any fault.
+func (c *Client) TestProvider(ctx context.Context, provider string, sampleAction *acteon.Action) (*acteon.ProviderTestResult, error) {
+	if _, err := c.before(ctx, "TestProvider", NoFault); err != nil {
+		var r0 *acteon.ProviderTestResult
+		return r0, err
+	}
+	return c.next.TestProvider(ctx, provider, sampleAction)
+}
+
+// TransitionBusConversation delegates to the wrapped client after injecting any fault.
+func (c *Client) TransitionBusConversation(ctx context.Context, namespace, tenant, conversationID, targetState string) (*acteon.BusConversation, error) {
+	if _, err := c.before(ctx, "TransitionBusConversation", NoFault); err != nil {
+		var r0 *acteon.BusConversation
+		return r0, err
+	}
+	return c.next.TransitionBusConversation(ctx, namespace, tenant, conversationID, targetState)
+}
+
+// TransitionEvent delegates to the wrapped client after injecting any fault.
+func (c *Client) TransitionEvent(ctx context.Context, fingerprint, toState, namespace, tenant string) (*acteon.TransitionResponse, error) {
+	if _, err := c.before(ctx, "TransitionEvent", NoFault); err != nil {
+		var r0 *acteon.TransitionResponse
+		return r0, err
+	}
+	return c.next.TransitionEvent(ctx, fingerprint, toState, namespace, tenant)
+}
+
+// UpdateEscalationPolicy delegates to the wrapped client after injecting any fault.
+func (c *Client) UpdateEscalationPolicy(ctx context.Context, policyID string, update *acteon.UpdateEscalationPolicyRequest) (*acteon.EscalationPolicy, error) {
+	if _, err := c.before(ctx, "UpdateEscalationPolicy", NoFault); err != nil {
+		var r0 *acteon.EscalationPolicy
+		return r0, err
+	}
+	return c.next.UpdateEscalationPolicy(ctx, policyID, update)
+}
+
+// UpdateGuardrailConfig delegates to the wrapped client after injecting any fault.
+func (c *Client) UpdateGuardrailConfig(ctx context.Context, update *acteon.UpdateGuardrailConfigRequest) (*acteon.GuardrailConfig, error) {
+	if _, err := c.before(ctx, "UpdateGuardrailConfig", NoFault); err != nil {
+		var r0 *acteon.GuardrailConfig
+		return r0, err
+	}
+	return c.next.UpdateGuardrailConfig(ctx, update)
+}
+
+// UpdatePluginConfig delegates to the wrapped client after injecting any fault.
+func (c *Client) UpdatePluginConfig(ctx context.Context, name string, cfg *acteon.WasmPluginConfig) (*acteon.WasmPlugin, error) {
+	if _, err := c.before(ctx, "UpdatePluginConfig", NoFault); err != nil {
+		var r0 *acteon.WasmPlugin
+		return r0, err
+	}
+	return c.next.UpdatePluginConfig(ctx, name, cfg)
+}
+
+// UpdateProfile delegates to the wrapped client after injecting any fault.
+func (c *Client) UpdateProfile(ctx context.Context, profileID string, update *acteon.UpdateProfileRequest) (*acteon.TemplateProfileInfo, error) {
+	if _, err := c.before(ctx, "UpdateProfile", NoFault); err != nil {
+		var r0 *acteon.TemplateProfileInfo
+		return r0, err
+	}
+	return c.next.UpdateProfile(ctx, profileID, update)
+}
+
+// UpdateProviderConfig delegates to the wrapped client after injecting any fault.
+func (c *Client) UpdateProviderConfig(ctx context.Context, name string, update *acteon.UpdateProviderConfigRequest) (*acteon.ProviderConfig, error) {
+	if _, err := c.before(ctx, "UpdateProviderConfig", NoFault); err != nil {
+		var r0 *acteon.ProviderConfig
+		return r0, err
+	}
+	return c.next.UpdateProviderConfig(ctx, name, update)
+}
+
+// UpdateQuota delegates to the wrapped client after injecting any fault.
+func (c *Client) UpdateQuota(ctx context.Context, quotaID string, update *acteon.UpdateQuotaRequest) (*acteon.QuotaPolicy, error) {
+	if _, err := c.before(ctx, "UpdateQuota", NoFault); err != nil {
+		var r0 *acteon.QuotaPolicy
+		return r0, err
+	}
+	return c.next.UpdateQuota(ctx, quotaID, update)
+}
+
+// UpdateRecurring delegates to the wrapped client after injecting any fault.
+func (c *Client) UpdateRecurring(ctx context.Context, recurringID string, update *acteon.UpdateRecurringAction) (*acteon.RecurringDetail, error) {
+	if _, err := c.before(ctx, "UpdateRecurring", NoFault); err != nil {
+		var r0 *acteon.RecurringDetail
+		return r0, err
+	}
+	return c.next.UpdateRecurring(ctx, recurringID, update)
+}
+
+// UpdateRetention delegates to the wrapped client after injecting any fault.
+func (c *Client) UpdateRetention(ctx context.Context, retentionID string, update *acteon.UpdateRetentionRequest) (*acteon.RetentionPolicy, error) {
+	if _, err := c.before(ctx, "UpdateRetention", NoFault); err != nil {
+		var r0 *acteon.RetentionPolicy
+		return r0, err
+	}
+	return c.next.UpdateRetention(ctx, retentionID, update)
+}
+
+// UpdateRoleBinding delegates to the wrapped client after injecting any fault.
+func (c *Client) UpdateRoleBinding(ctx context.Context, bindingID string, update *acteon.UpdateRoleBindingRequest) (*acteon.RoleBinding, error) {
+	if _, err := c.before(ctx, "UpdateRoleBinding", NoFault); err != nil {
+		var r0 *acteon.RoleBinding
+		return r0, err
+	}
+	return c.next.UpdateRoleBinding(ctx, bindingID, update)
+}
+
+// UpdateSilence delegates to the wrapped client after injecting any fault.
+func (c *Client) UpdateSilence(ctx context.Context, silenceID string, update *acteon.UpdateSilenceRequest) (*acteon.Silence, error) {
+	if _, err := c.before(ctx, "UpdateSilence", NoFault); err != nil {
+		var r0 *acteon.Silence
+		return r0, err
+	}
+	return c.next.UpdateSilence(ctx, silenceID, update)
+}
+
+// UpdateTemplate delegates to the wrapped client after injecting any fault.
+func (c *Client) UpdateTemplate(ctx context.Context, templateID string, update *acteon.UpdateTemplateRequest) (*acteon.TemplateInfo, error) {
+	if _, err := c.before(ctx, "UpdateTemplate", NoFault); err != nil {
+		var r0 *acteon.TemplateInfo
+		return r0, err
+	}
+	return c.next.UpdateTemplate(ctx, templateID, update)
+}
+
+// UpdateThrottle delegates to the wrapped client after injecting any fault.
+func (c *Client) UpdateThrottle(ctx context.Context, throttleID string, update *acteon.UpdateThrottleRequest) (*acteon.ThrottlePolicy, error) {
+	if _, err := c.before(ctx, "UpdateThrottle", NoFault); err != nil {
+		var r0 *acteon.ThrottlePolicy
+		return r0, err
+	}
+	return c.next.UpdateThrottle(ctx, throttleID, update)
+}
+
+// UpdateTimeInterval delegates to the wrapped client after injecting any fault.
+func (c *Client) UpdateTimeInterval(ctx context.Context, namespace, tenant, name string, update *acteon.UpdateTimeIntervalRequest) (*acteon.TimeInterval, error) {
+	if _, err := c.before(ctx, "UpdateTimeInterval", NoFault); err != nil {
+		var r0 *acteon.TimeInterval
+		return r0, err
+	}
+	return c.next.UpdateTimeInterval(ctx, namespace, tenant, name, update)
+}
+
+// ValidateChainDefinition delegates to the wrapped client after injecting any fault.
+func (c *Client) ValidateChainDefinition(ctx context.Context, def *acteon.ChainDefinition) (*acteon.ChainValidationResult, error) {
+	if _, err := c.before(ctx, "ValidateChainDefinition", NoFault); err != nil {
+		var r0 *acteon.ChainValidationResult
+		return r0, err
+	}
+	return c.next.ValidateChainDefinition(ctx, def)
+}
+
+// ValidateTemplate delegates to the wrapped client after injecting any fault.
+func (c *Client) ValidateTemplate(ctx context.Context, content string) (*acteon.TemplateValidationResult, error) {
+	if _, err := c.before(ctx, "ValidateTemplate", NoFault); err != nil {
+		var r0 *acteon.TemplateValidationResult
+		return r0, err
+	}
+	return c.next.ValidateTemplate(ctx, content)
+}
+
+// VerifyAuditChain delegates to the wrapped client after injecting any fault.
+func (c *Client) VerifyAuditChain(ctx context.Context, req *acteon.VerifyHashChainRequest) (*acteon.HashChainVerification, error) {
+	if _, err := c.before(ctx, "VerifyAuditChain", NoFault); err != nil {
+		var r0 *acteon.HashChainVerification
+		return r0, err
+	}
+	return c.next.VerifyAuditChain(ctx, req)
+}
+
+// VerifyPlugin delegates to the wrapped client after injecting any fault.
+func (c *Client) VerifyPlugin(ctx context.Context, name string) (*acteon.PluginVerification, error) {
+	if _, err := c.before(ctx, "VerifyPlugin", NoFault); err != nil {
+		var r0 *acteon.PluginVerification
+		return r0, err
+	}
+	return c.next.VerifyPlugin(ctx, name)
+}
+
+// WhoAmI delegates to the wrapped client after injecting any fault.
+func (c *Client) WhoAmI(ctx context.Context) (*acteon.CallerIdentity, error) {
+	if _, err := c.before(ctx, "WhoAmI", NoFault); err != nil {
+		var r0 *acteon.CallerIdentity
+		return r0, err
+	}
+	return c.next.WhoAmI(ctx)
+}
@@ -0,0 +1,98 @@
+package acteonchaos
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+	"github.com/penserai/acteon/clients/go/acteon/acteontest"
+)
+
+func TestScriptedFaults(t *testing.T) {
+	srv := acteontest.NewServer()
+	defer srv.Close()
+	chaos := New(srv.Client(), Config{})
+	chaos.Script("Dispatch", ConnectionError, ServerError, MalformedOutcome, Disconnect)
+	ctx := context.Background()
+	action := acteon.NewAction("alerts", "acme", "email", "send", nil)
+
+	_, err := chaos.Dispatch(ctx, action)
+	var connErr *acteon.ConnectionError
+	if !errors.As(err, &connErr) || !connErr.IsRetryable() {
+		t.Errorf("first call = %v", err)
+	}
+	_, err = chaos.Dispatch(ctx, action)
+	var httpErr *acteon.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.Status != 503 {
+		t.Errorf("second call = %v", err)
+	}
+	if outcome, err := chaos.Dispatch(ctx, action); err != nil || !outcome.IsUnknown() {
+		t.Errorf("third call = %+v, %v", outcome, err)
+	}
+	if outcome, err := chaos.Dispatch(ctx, action); err != nil || !outcome.IsExecuted() {
+		t.Errorf("Disconnect does not apply to Dispatch: %+v, %v", outcome, err)
+	}
+	if got := len(srv.Dispatched()); got != 2 {
+		t.Errorf("forwarded %d dispatches, want 2", got)
+	}
+
+	want := []Injection{{"Dispatch", ConnectionError}, {"Dispatch", ServerError}, {"Dispatch", MalformedOutcome}}
+	if got := chaos.Injected(); !slices.Equal(got, want) {
+		t.Errorf("Injected = %v", got)
+	}
+}
+
+func TestSeededRatesAreDeterministic(t *testing.T) {
+	srv := acteontest.NewServer()
+	defer srv.Close()
+	cfg := Config{Seed: 42, ServerErrorRate: 0.5, Methods: []string{"Health"}}
+
+	run := func() []Injection {
+		chaos := New(srv.Client(), cfg)
+		for range 20 {
+			_, _ = chaos.Health(context.Background())
+			if _, err := chaos.QueryAudit(context.Background(), nil); err != nil {
+				t.Fatalf("QueryAudit is not in Methods: %v", err)
+			}
+		}
+		return chaos.Injected()
+	}
+	first, second := run(), run()
+	if len(first) == 0 || len(first) == 20 || !slices.Equal(first, second) {
+		t.Errorf("runs injected %v and %v", first, second)
+	}
+}
+
+func TestStreamDisconnect(t *testing.T) {
+	srv := acteontest.NewServer()
+	defer srv.Close()
+	for range 3 {
+		srv.Publish("chain_completed", map[string]any{"namespace": "alerts"})
+	}
+	chaos := New(srv.Client(), Config{DisconnectAfter: 1})
+	chaos.Script("Stream", Disconnect)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := chaos.Stream(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ev := <-events; ev == nil || ev.ID != "1" {
+		t.Errorf("first event = %+v", ev)
+	}
+	if ev, ok := <-events; ok {
+		t.Errorf("stream still open, got %+v", ev)
+	}
+
+	last := "1"
+	resumed, err := chaos.Stream(ctx, &acteon.StreamOptions{LastEventID: &last})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ev := <-resumed; ev.ID != "2" {
+		t.Errorf("resumed event = %+v", ev)
+	}
+}
@@ -0,0 +1,83 @@
+// Package acteonmock provides a stub implementation of
+// acteon.ActeonAPI for unit tests of code that depends on the client.
+//
+// Code under test takes an acteon.ActeonAPI; tests pass a *Client with
+// the Func fields they need and inspect the recorded calls afterwards:
+//
+//	mock := &acteonmock.Client{
+//		DispatchFunc: func(ctx context.Context, a *acteon.Action) (*acteon.ActionOutcome, error) {
+//			return &acteon.ActionOutcome{Type: acteon.OutcomeExecuted}, nil
+//		},
+//	}
+//	notifier := NewNotifier(mock)
+//	notifier.Send(ctx, "hello")
+//	if calls := mock.CallsTo("Dispatch"); len(calls) != 1 { ... }
+//
+// A method whose Func field is nil returns zero values and an error
+// wrapping ErrNotStubbed, so an unexpected call fails loudly without a
+// panic. The Client type is generated from *acteon.Client by
+// acteon/internal/apigen; run `go generate ./acteon` after changing
+// the client's methods.
+package acteonmock
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNotStubbed is wrapped by the error a Client method returns when
+// its Func field is nil.
+var ErrNotStubbed = errors.New("acteonmock: method not stubbed")
+
+func notStubbed(method string) error {
+	return fmt.Errorf("%w: %s", ErrNotStubbed, method)
+}
+
+// Call is one recorded method call.
+type Call struct {
+	Method string
+	// Args holds the arguments other than the context, in order. A
+	// variadic parameter is recorded as its slice.
+	Args []any
+}
+
+// recorder keeps the calls made on a Client. It is safe for
+// concurrent use.
+type recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *recorder) record(method string, args ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns every call made so far, oldest first.
+func (r *recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the calls made so far to method, oldest first.
+func (r *recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []Call
+	for _, c := range r.calls {
+		if c.Method == method {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// Reset forgets the recorded calls.
+func (r *recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
@@ -0,0 +1,2560 @@
+// Code generated by apigen; DO NOT EDIT.
+
+package acteonmock
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+// Client is a stub acteon.ActeonAPI. Each method records its call
+// and delegates to the matching Func field; methods whose field is
+// nil return zero values and an error wrapping ErrNotStubbed.
+type Client struct {
+	recorder
+
+	A2ACancelTaskFunc                   func(ctx context.Context, namespace, tenant, taskID string) (map[string]any, error)
+	A2ADeletePushConfigFunc             func(ctx context.Context, namespace, tenant, taskID, configID string) error
+	A2ADiscoverAgentFunc                func(ctx context.Context, namespace, tenant string) (map[string]any, error)
+	A2AGetAuthenticatedExtendedCardFunc func(ctx context.Context, namespace, tenant string) (map[string]any, error)
+	A2AGetPushConfigFunc                func(ctx context.Context, namespace, tenant, taskID, configID string) (map[string]any, error)
+	A2AGetTaskFunc                      func(ctx context.Context, namespace, tenant, taskID string) (map[string]any, error)
+	A2AListPushConfigsFunc              func(ctx context.Context, namespace, tenant, taskID string) ([]map[string]any, error)
+	A2ASendMessageFunc                  func(ctx context.Context, namespace, tenant string, message map[string]any) (map[string]any, error)
+	A2ASetPushConfigFunc                func(ctx context.Context, namespace, tenant, taskID string, config map[string]any) (map[string]any, error)
+	AppendBusConversationMessageFunc    func(ctx context.Context, namespace, tenant, conversationID string, req *acteon.AppendBusConversationMessage) (map[string]any, error)
+	ApplyFunc                           func(ctx context.Context, manifest *acteon.Manifest, opts acteon.ApplyOptions) (*acteon.ApplyResult, error)
+	ApproveFunc                         func(ctx context.Context, namespace, tenant, id, sig string, expiresAt int64, kid string) (*acteon.ApprovalActionResponse, error)
+	ApproveBusApprovalFunc              func(ctx context.Context, namespace, tenant, approvalID string, decision *acteon.BusApprovalDecision) (*acteon.BusApprovalDecisionResponse, error)
+	AwaitOutcomeFunc                    func(ctx context.Context, actionID string, timeout time.Duration) (*acteon.AuditRecord, error)
+	BusStreamConsumeURLFunc             func(namespace, tenant, conversationID, streamID string) string
+	CacheStatsFunc                      func() acteon.CacheStats
+	CancelChainFunc                     func(ctx context.Context, chainID string, req *acteon.CancelChainRequest) (*acteon.ChainDetailResponse, error)
+	CancelSwarmRunFunc                  func(ctx context.Context, runID string) (*acteon.SwarmRunSnapshot, error)
+	CheckQuotaFunc                      func(ctx context.Context, namespace, tenant string, n int) (*acteon.QuotaCheckResult, error)
+	CompleteTaskFunc                    func(ctx context.Context, taskID string, req *acteon.CompleteTaskRequest) (*acteon.WorkerTask, error)
+	ConsumeBusStreamFunc                func(ctx context.Context, namespace, tenant, conversationID, streamID string) (<-chan *acteon.BusStreamItem, error)
+	ConsumeBusSubscriptionFunc          func(ctx context.Context, subscriptionID string, opts *acteon.ConsumeBusSubscriptionOptions) (<-chan *acteon.BusConsumeItem, error)
+	CreateBackupFunc                    func(ctx context.Context, req *acteon.BackupRequest) (*acteon.Backup, error)
+	CreateBusConversationFunc           func(ctx context.Context, req *acteon.CreateBusConversation) (*acteon.BusConversation, error)
+	CreateBusSubscriptionFunc           func(ctx context.Context, req *acteon.CreateBusSubscription) (*acteon.BusSubscription, error)
+	CreateBusTopicFunc                  func(ctx context.Context, req *acteon.CreateBusTopic) (*acteon.BusTopic, error)
+	CreateEscalationPolicyFunc          func(ctx context.Context, req *acteon.CreateEscalationPolicyRequest) (*acteon.EscalationPolicy, error)
+	CreateProfileFunc                   func(ctx context.Context, req *acteon.CreateProfileRequest) (*acteon.TemplateProfileInfo, error)
+	CreateProviderConfigFunc            func(ctx context.Context, req *acteon.CreateProviderConfigRequest) (*acteon.ProviderConfig, error)
+	CreateQuotaFunc                     func(ctx context.Context, req *acteon.CreateQuotaRequest) (*acteon.QuotaPolicy, error)
+	CreateRecurringFunc                 func(ctx context.Context, recurring *acteon.CreateRecurringAction) (*acteon.CreateRecurringResponse, error)
+	CreateRetentionFunc                 func(ctx context.Context, req *acteon.CreateRetentionRequest) (*acteon.RetentionPolicy, error)
+	CreateRoleBindingFunc               func(ctx context.Context, req *acteon.CreateRoleBindingRequest) (*acteon.RoleBinding, error)
+	CreateSilenceFunc                   func(ctx context.Context, req *acteon.CreateSilenceRequest) (*acteon.Silence, error)
+	CreateTemplateFunc                  func(ctx context.Context, req *acteon.CreateTemplateRequest) (*acteon.TemplateInfo, error)
+	CreateThrottleFunc                  func(ctx context.Context, req *acteon.CreateThrottleRequest) (*acteon.ThrottlePolicy, error)
+	CreateTimeIntervalFunc              func(ctx context.Context, req *acteon.CreateTimeIntervalRequest) (*acteon.TimeInterval, error)
+	DeleteBusAgentFunc                  func(ctx context.Context, namespace, tenant, agentID string) error
+	DeleteBusConversationFunc           func(ctx context.Context, namespace, tenant, conversationID string) error
+	DeleteBusSchemaFunc                 func(ctx context.Context, namespace, tenant, subject string, version int) error
+	DeleteBusSubscriptionFunc           func(ctx context.Context, namespace, tenant, subID string) error
+	DeleteBusTopicFunc                  func(ctx context.Context, namespace, tenant, name string) error
+	DeleteDlqEntryFunc                  func(ctx context.Context, actionID string) error
+	DeleteEscalationPolicyFunc          func(ctx context.Context, policyID string) error
+	DeleteNotificationPreferencesFunc   func(ctx context.Context, tenant string) error
+	DeletePluginFunc                    func(ctx context.Context, name string) error
+	DeleteProfileFunc                   func(ctx context.Context, profileID string) error
+	DeleteProviderConfigFunc            func(ctx context.Context, name string) error
+	DeleteQuotaFunc                     func(ctx context.Context, quotaID, namespace, tenant string) error
+	DeleteRecurringFunc                 func(ctx context.Context, recurringID, namespace, tenant string) error
+	DeleteRetentionFunc                 func(ctx context.Context, retentionID string) error
+	DeleteRoleBindingFunc               func(ctx context.Context, bindingID string) error
+	DeleteSecretFunc                    func(ctx context.Context, scope, name string) error
+	DeleteSilenceFunc                   func(ctx context.Context, silenceID string) error
+	DeleteTemplateFunc                  func(ctx context.Context, templateID string) error
+	DeleteThrottleFunc                  func(ctx context.Context, throttleID, namespace, tenant string) error
+	DeleteTimeIntervalFunc              func(ctx context.Context, namespace, tenant, name string) error
+	DispatchFunc                        func(ctx context.Context, action *acteon.Action) (*acteon.ActionOutcome, error)
+	DispatchBatchFunc                   func(ctx context.Context, actions []*acteon.Action) (acteon.BatchResults, error)
+	DispatchBatchAtomicFunc             func(ctx context.Context, actions []*acteon.Action) (acteon.BatchResults, error)
+	DispatchBatchDryRunFunc             func(ctx context.Context, actions []*acteon.Action) (acteon.BatchResults, error)
+	DispatchDryRunFunc                  func(ctx context.Context, action *acteon.Action) (*acteon.ActionOutcome, error)
+	DlqDrainFunc                        func(ctx context.Context) (*acteon.DlqDrainResponse, error)
+	DlqStatsFunc                        func(ctx context.Context) (*acteon.DlqStatsResponse, error)
+	DlqStatsDetailedFunc                func(ctx context.Context) (*acteon.DlqStatsDetailedResponse, error)
+	DumpActionFunc                      func(action *acteon.Action) ([]byte, error)
+	EndpointFunc                        func(namespace, tenant string) string
+	EnqueueTaskFunc                     func(ctx context.Context, queue string, req *acteon.EnqueueTaskRequest) (*acteon.WorkerTask, error)
+	EnsureQuotaFunc                     func(ctx context.Context, req *acteon.CreateQuotaRequest) (bool, error)
+	EnsureRecurringFunc                 func(ctx context.Context, req *acteon.CreateRecurringAction) (bool, error)
+	EnsureRetentionFunc                 func(ctx context.Context, req *acteon.CreateRetentionRequest) (bool, error)
+	EnsureTemplateFunc                  func(ctx context.Context, req *acteon.CreateTemplateRequest) (bool, error)
+	EraseSubjectDataFunc                func(ctx context.Context, query *acteon.SubjectQuery) (*acteon.SubjectErasureReport, error)
+	EvaluateGuardrailFunc               func(ctx context.Context, text string) (*acteon.GuardrailEvaluation, error)
+	EvaluateRulesFunc                   func(ctx context.Context, req acteon.EvaluateRulesRequest) (*acteon.EvaluateRulesResponse, error)
+	ExportSubjectDataFunc               func(ctx context.Context, query *acteon.SubjectQuery) (*acteon.SubjectDataExport, error)
+	ExportTemplatesFunc                 func(ctx context.Context, filter *acteon.TemplateBundleFilter, w io.Writer) error
+	FailTaskFunc                        func(ctx context.Context, taskID string, req *acteon.FailTaskRequest) (*acteon.WorkerTask, error)
+	FetchSigningKeysFunc                func(ctx context.Context) (*acteon.SigningKeysResponse, error)
+	FlushGroupFunc                      func(ctx context.Context, groupKey string) (*acteon.FlushGroupResponse, error)
+	GetApprovalFunc                     func(ctx context.Context, namespace, tenant, id, sig string, expiresAt int64, kid string) (*acteon.ApprovalStatus, error)
+	GetAuditRecordFunc                  func(ctx context.Context, actionID string) (*acteon.AuditRecord, error)
+	GetAuditRecordsFunc                 func(ctx context.Context, actionIDs []string) (*acteon.AuditRecordsResult, error)
+	GetBackupStatusFunc                 func(ctx context.Context, backupID string) (*acteon.Backup, error)
+	GetBusAgentFunc                     func(ctx context.Context, namespace, tenant, agentID string) (*acteon.BusAgent, error)
+	GetBusApprovalFunc                  func(ctx context.Context, namespace, tenant, approvalID string) (*acteon.BusApprovalView, error)
+	GetBusConversationFunc              func(ctx context.Context, namespace, tenant, conversationID string) (*acteon.BusConversation, error)
+	GetBusSchemaFunc                    func(ctx context.Context, namespace, tenant, subject string, version int) (*acteon.BusSchema, error)
+	GetBusSubscriptionFunc              func(ctx context.Context, namespace, tenant, subID string) (*acteon.BusSubscription, error)
+	GetBusSubscriptionLagFunc           func(ctx context.Context, namespace, tenant, subID string) (*acteon.BusLag, error)
+	GetBusTopicFunc                     func(ctx context.Context, namespace, tenant, name string) (*acteon.BusTopic, error)
+	GetChainFunc                        func(ctx context.Context, chainID, namespace, tenant string) (*acteon.ChainDetailResponse, error)
+	GetChainDagFunc                     func(ctx context.Context, chainID, namespace, tenant string) (*acteon.DagResponse, error)
+	GetChainDefinitionDagFunc           func(ctx context.Context, name string) (*acteon.DagResponse, error)
+	GetChainHistoryFunc                 func(ctx context.Context, chainID, namespace, tenant string) (*acteon.ChainHistoryResponse, error)
+	GetChainMetricsFunc                 func(ctx context.Context, chainName string, window time.Duration) (*acteon.ChainMetricsResponse, error)
+	GetComplianceStatusFunc             func(ctx context.Context) (*acteon.ComplianceStatus, error)
+	GetConfigFunc                       func(ctx context.Context) (*acteon.GatewayConfig, error)
+	GetDlqEntryFunc                     func(ctx context.Context, actionID string) (*acteon.DlqEntryDetail, error)
+	GetDlqPolicyFunc                    func(ctx context.Context) (*acteon.DlqPolicy, error)
+	GetEscalationPolicyFunc             func(ctx context.Context, policyID string) (*acteon.EscalationPolicy, error)
+	GetEscalationStateFunc              func(ctx context.Context, eventFingerprint string) (*acteon.EscalationState, error)
+	GetEventFunc                        func(ctx context.Context, fingerprint, namespace, tenant string) (*acteon.EventState, error)
+	GetGroupFunc                        func(ctx context.Context, groupKey string) (*acteon.GroupDetail, error)
+	GetGuardrailConfigFunc              func(ctx context.Context) (*acteon.GuardrailConfig, error)
+	GetHealthDetailFunc                 func(ctx context.Context) (*acteon.HealthDetail, error)
+	GetMaintenanceStatusFunc            func(ctx context.Context) (*acteon.MaintenanceStatus, error)
+	GetMetricsFunc                      func(ctx context.Context) (*acteon.GatewayMetrics, error)
+	GetNotificationPreferencesFunc      func(ctx context.Context, tenant string) (*acteon.NotificationPreferences, error)
+	GetPluginFunc                       func(ctx context.Context, name string) (*acteon.WasmPlugin, error)
+	GetProfileFunc                      func(ctx context.Context, profileID string) (*acteon.TemplateProfileInfo, error)
+	GetQuotaFunc                        func(ctx context.Context, quotaID string) (*acteon.QuotaPolicy, error)
+	GetQuotaUsageFunc                   func(ctx context.Context, quotaID string) (*acteon.QuotaUsage, error)
+	GetRecurringFunc                    func(ctx context.Context, recurringID, namespace, tenant string) (*acteon.RecurringDetail, error)
+	GetRetentionFunc                    func(ctx context.Context, retentionID string) (*acteon.RetentionPolicy, error)
+	GetRetentionArchiveStatusFunc       func(ctx context.Context, retentionID string) (*acteon.RetentionArchiveStatus, error)
+	GetServerInfoFunc                   func(ctx context.Context) (*acteon.ServerInfo, error)
+	GetSilenceFunc                      func(ctx context.Context, silenceID string) (*acteon.Silence, error)
+	GetSwarmRunFunc                     func(ctx context.Context, runID string) (*acteon.SwarmRunSnapshot, error)
+	GetTaskFunc                         func(ctx context.Context, taskID, namespace, tenant string) (*acteon.WorkerTask, error)
+	GetTemplateFunc                     func(ctx context.Context, templateID string) (*acteon.TemplateInfo, error)
+	GetTemplateUsageFunc                func(ctx context.Context, templateID string, window time.Duration) (*acteon.TemplateUsageResponse, error)
+	GetThrottleFunc                     func(ctx context.Context, throttleID string) (*acteon.ThrottlePolicy, error)
+	GetTimeIntervalFunc                 func(ctx context.Context, namespace, tenant, name string) (*acteon.TimeInterval, error)
+	HealthFunc                          func(ctx context.Context) (bool, error)
+	HeartbeatBusAgentFunc               func(ctx context.Context, namespace, tenant, agentID string) (*acteon.BusAgent, error)
+	HeartbeatTaskFunc                   func(ctx context.Context, taskID string, req *acteon.HeartbeatTaskRequest) (*acteon.WorkerTask, error)
+	ImportTemplatesFunc                 func(ctx context.Context, r io.Reader, opts acteon.ImportOptions) (*acteon.ImportResult, error)
+	InspectPluginFunc                   func(ctx context.Context, name string) (*acteon.PluginInspection, error)
+	InvokePluginFunc                    func(ctx context.Context, name string, req *acteon.PluginInvocationRequest) (*acteon.PluginInvocationResponse, error)
+	InvokePluginBatchFunc               func(ctx context.Context, name string, reqs []acteon.PluginInvocationRequest) (*acteon.PluginBatchInvocationResponse, error)
+	ListApprovalsFunc                   func(ctx context.Context, namespace, tenant string) (*acteon.ApprovalListResponse, error)
+	ListBackupsFunc                     func(ctx context.Context) (*acteon.ListBackupsResponse, error)
+	ListBusAgentsFunc                   func(ctx context.Context, filter *acteon.ListBusAgentsFilter) ([]acteon.BusAgent, error)
+	ListBusApprovalsFunc                func(ctx context.Context, namespace, tenant string, filter *acteon.ListBusApprovalsFilter) ([]acteon.BusApprovalView, error)
+	ListBusConversationsFunc            func(ctx context.Context, filter *acteon.ListBusConversationsFilter) ([]acteon.BusConversation, error)
+	ListBusSchemasFunc                  func(ctx context.Context, filter *acteon.ListBusSchemasFilter) ([]acteon.BusSchema, error)
+	ListBusSubscriptionsFunc            func(ctx context.Context, filter *acteon.ListBusSubscriptionsFilter) ([]acteon.BusSubscription, error)
+	ListBusTopicsFunc                   func(ctx context.Context, filter *acteon.ListBusTopicsFilter) ([]acteon.BusTopic, error)
+	ListChainsFunc                      func(ctx context.Context, namespace, tenant string, status *string) (*acteon.ListChainsResponse, error)
+	ListDlqFunc                         func(ctx context.Context, filter acteon.DlqFilter) (*acteon.DlqListResponse, error)
+	ListEscalationPoliciesFunc          func(ctx context.Context, namespace, tenant *string) (*acteon.ListEscalationPoliciesResponse, error)
+	ListEventsFunc                      func(ctx context.Context, query *acteon.EventQuery) (*acteon.EventListResponse, error)
+	ListGroupsFunc                      func(ctx context.Context) (*acteon.GroupListResponse, error)
+	ListPluginVersionsFunc              func(ctx context.Context, name string) (*acteon.ListPluginVersionsResponse, error)
+	ListPluginsFunc                     func(ctx context.Context) (*acteon.ListPluginsResponse, error)
+	ListProfilesFunc                    func(ctx context.Context, namespace, tenant *string) (*acteon.ListProfilesResponse, error)
+	ListProviderConfigsFunc             func(ctx context.Context, tenant *string) (*acteon.ListProviderConfigsResponse, error)
+	ListProviderHealthFunc              func(ctx context.Context) (*acteon.ListProviderHealthResponse, error)
+	ListProvidersFunc                   func(ctx context.Context) (*acteon.ListProvidersResponse, error)
+	ListQuotasFunc                      func(ctx context.Context, namespace, tenant, provider, principal *string) (*acteon.ListQuotasResponse, error)
+	ListRecurringFunc                   func(ctx context.Context, filter *acteon.RecurringFilter) (*acteon.ListRecurringResponse, error)
+	ListRetentionFunc                   func(ctx context.Context, namespace, tenant *string, limit, offset *int) (*acteon.ListRetentionResponse, error)
+	ListRoleBindingsFunc                func(ctx context.Context, namespace, tenant *string) (*acteon.ListRoleBindingsResponse, error)
+	ListRolesFunc                       func(ctx context.Context) ([]acteon.RoleInfo, error)
+	ListRulesFunc                       func(ctx context.Context) ([]acteon.RuleInfo, error)
+	ListSecretsFunc                     func(ctx context.Context, scope string) (*acteon.ListSecretsResponse, error)
+	ListSilencesFunc                    func(ctx context.Context, namespace, tenant *string, includeExpired bool) (*acteon.ListSilencesResponse, error)
+	ListSwarmRunsFunc                   func(ctx context.Context, filter *acteon.SwarmRunFilter) (*acteon.ListSwarmRunsResponse, error)
+	ListTasksFunc                       func(ctx context.Context, queue, namespace, tenant, status string) ([]acteon.WorkerTask, error)
+	ListTemplatesFunc                   func(ctx context.Context, namespace, tenant *string) (*acteon.ListTemplatesResponse, error)
+	ListThrottlesFunc                   func(ctx context.Context, namespace, tenant, provider, actionType *string) (*acteon.ListThrottlesResponse, error)
+	ListTimeIntervalsFunc               func(ctx context.Context, namespace, tenant *string) (*acteon.ListTimeIntervalsResponse, error)
+	LookupBusToolResultFunc             func(ctx context.Context, namespace, tenant, callID string, params *acteon.BusToolResultLookupParams) (*acteon.BusToolResultLookup, error)
+	PauseRecurringFunc                  func(ctx context.Context, recurringID, namespace, tenant string) (*acteon.RecurringDetail, error)
+	PollTasksFunc                       func(ctx context.Context, queue string, req *acteon.PollTasksRequest) ([]acteon.WorkerTask, error)
+	PostBusStreamChunkFunc              func(ctx context.Context, namespace, tenant, conversationID string, req *acteon.PostBusStreamChunk) (*acteon.BusStreamEnvelopeReceipt, error)
+	PostBusStreamEndFunc                func(ctx context.Context, namespace, tenant, conversationID string, req *acteon.PostBusStreamEnd) (*acteon.BusStreamEnvelopeReceipt, error)
+	PostBusToolCallFunc                 func(ctx context.Context, namespace, tenant, conversationID string, req *acteon.PostBusToolCall) (*acteon.PostBusToolCallOutcome, error)
+	PostBusToolResultFunc               func(ctx context.Context, namespace, tenant, conversationID string, req *acteon.PostBusToolResult) (*acteon.BusToolEnvelopeReceipt, error)
+	ProvideChainStepInputFunc           func(ctx context.Context, chainID, stepName string, req *acteon.ProvideStepInputRequest) (*acteon.ChainDetailResponse, error)
+	PublishBusMessageFunc               func(ctx context.Context, req *acteon.PublishBusMessage) (*acteon.PublishReceipt, error)
+	PurgeCacheFunc                      func()
+	PurgeDlqFunc                        func(ctx context.Context, filter acteon.DlqPurgeFilter) (int, error)
+	PutChainDefinitionFunc              func(ctx context.Context, def *acteon.ChainDefinition) (*acteon.ChainDefinition, error)
+	PutNotificationPreferencesFunc      func(ctx context.Context, tenant string, prefs *acteon.NotificationPreferences) (*acteon.NotificationPreferences, error)
+	PutSecretFunc                       func(ctx context.Context, scope, name, value string) (*acteon.SecretInfo, error)
+	QueryAnalyticsFunc                  func(ctx context.Context, query *acteon.AnalyticsQuery) (*acteon.AnalyticsResponse, error)
+	QueryAuditFunc                      func(ctx context.Context, query *acteon.AuditQuery) (*acteon.AuditPage, error)
+	ReadyFunc                           func(ctx context.Context) (*acteon.Readiness, error)
+	RegisterBusAgentFunc                func(ctx context.Context, req *acteon.RegisterBusAgent) (*acteon.BusAgent, error)
+	RegisterBusSchemaFunc               func(ctx context.Context, req *acteon.RegisterBusSchema) (*acteon.BusSchema, error)
+	RegisterPluginFunc                  func(ctx context.Context, req *acteon.RegisterPluginRequest) (*acteon.WasmPlugin, error)
+	RegisterPluginFromFileFunc          func(ctx context.Context, name, path string, opts *acteon.PluginUploadOptions) (*acteon.WasmPlugin, error)
+	RegisterPluginFromReaderFunc        func(ctx context.Context, name string, r io.Reader, size int64, opts *acteon.PluginUploadOptions) (*acteon.WasmPlugin, error)
+	RegisterPluginVersionFunc           func(ctx context.Context, name string, req *acteon.RegisterPluginVersionRequest) (*acteon.PluginVersion, error)
+	RejectFunc                          func(ctx context.Context, namespace, tenant, id, sig string, expiresAt int64, kid string) (*acteon.ApprovalActionResponse, error)
+	RejectBusApprovalFunc               func(ctx context.Context, namespace, tenant, approvalID string, decision *acteon.BusApprovalDecision) (*acteon.BusApprovalDecisionResponse, error)
+	ReloadConfigFunc                    func(ctx context.Context) (*acteon.ConfigReloadResult, error)
+	ReloadRulesFunc                     func(ctx context.Context) (*acteon.ReloadResult, error)
+	RenderPreviewFunc                   func(ctx context.Context, req *acteon.RenderPreviewRequest) (*acteon.RenderPreviewResponse, error)
+	ReplayActionFunc                    func(ctx context.Context, actionID string) (*acteon.ReplayResult, error)
+	ReplayAuditFunc                     func(ctx context.Context, query *acteon.ReplayQuery) (*acteon.ReplaySummary, error)
+	ReplayBusConversationMessagesFunc   func(ctx context.Context, namespace, tenant, conversationID string, params *acteon.ReplayBusConversationParams) (*acteon.BusReplayResponse, error)
+	ResolveProfileFunc                  func(ctx context.Context, profileID string) (*acteon.ResolvedProfile, error)
+	RestoreBackupFunc                   func(ctx context.Context, backupID string, opts acteon.RestoreOptions) (*acteon.RestoreResult, error)
+	ResumeRecurringFunc                 func(ctx context.Context, recurringID, namespace, tenant string) (*acteon.RecurringDetail, error)
+	RetryChainFunc                      func(ctx context.Context, chainID string, opts acteon.RetryOptions) (*acteon.ChainDetailResponse, error)
+	RetryDlqFunc                        func(ctx context.Context, filter acteon.DlqFilter, opts acteon.DlqRetryOptions) (*acteon.DlqRetryReport, error)
+	RetryDlqEntryFunc                   func(ctx context.Context, actionID string) (*acteon.DlqRetryResult, error)
+	RollbackPluginFunc                  func(ctx context.Context, name string, version int) (*acteon.WasmPlugin, error)
+	RulesCoverageFunc                   func(ctx context.Context, query *acteon.CoverageQuery) (*acteon.CoverageReport, error)
+	SetBusAgentAdminStateFunc           func(ctx context.Context, namespace, tenant, agentID string, req *acteon.SetBusAgentAdminState) (*acteon.BusAgent, error)
+	SetDlqPolicyFunc                    func(ctx context.Context, policy acteon.DlqPolicy) (*acteon.DlqPolicy, error)
+	SetMaintenanceModeFunc              func(ctx context.Context, enabled bool, opts acteon.MaintenanceOptions) (*acteon.MaintenanceStatus, error)
+	SetPluginEnabledFunc                func(ctx context.Context, name string, enabled bool) (*acteon.WasmPlugin, error)
+	SetRuleEnabledFunc                  func(ctx context.Context, ruleName string, enabled bool) error
+	StartChainFunc                      func(ctx context.Context, req acteon.StartChainRequest) (*acteon.StartChainResponse, error)
+	StreamFunc                          func(ctx context.Context, opts *acteon.StreamOptions) (<-chan *acteon.SseEvent, error)
+	SubscribeFunc                       func(ctx context.Context, entityType, entityID string, opts *acteon.SubscribeOptions) (<-chan *acteon.SseEvent, error)
+	SubscribeChainFunc                  func(ctx context.Context, chainID string, opts *acteon.SubscribeOptions) (<-chan *acteon.ChainEvent, error)
+	SyncPluginFromRegistryFunc          func(ctx context.Context, ref acteon.PluginRef) (*acteon.WasmPlugin, error)
+	TestProviderFunc                    func(ctx context.Context, provider string, sampleAction *acteon.Action) (*acteon.ProviderTestResult, error)
+	TransitionBusConversationFunc       func(ctx context.Context, namespace, tenant, conversationID, targetState string) (*acteon.BusConversation, error)
+	TransitionEventFunc                 func(ctx context.Context, fingerprint, toState, namespace, tenant string) (*acteon.TransitionResponse, error)
+	UpdateEscalationPolicyFunc          func(ctx context.Context, policyID string, update *acteon.UpdateEscalationPolicyRequest) (*acteon.EscalationPolicy, error)
+	UpdateGuardrailConfigFunc           func(ctx context.Context, update *acteon.UpdateGuardrailConfigRequest) (*acteon.GuardrailConfig, error)
+	UpdatePluginConfigFunc              func(ctx context.Context, name string, cfg *acteon.WasmPluginConfig) (*acteon.WasmPlugin, error)
+	UpdateProfileFunc                   func(ctx context.Context, profileID string, update *acteon.UpdateProfileRequest) (*acteon.TemplateProfileInfo, error)
+	UpdateProviderConfigFunc            func(ctx context.Context, name string, update *acteon.UpdateProviderConfigRequest) (*acteon.ProviderConfig, error)
+	UpdateQuotaFunc                     func(ctx context.Context, quotaID string, update *acteon.UpdateQuotaRequest) (*acteon.QuotaPolicy, error)
+	UpdateRecurringFunc                 func(ctx context.Context, recurringID string, update *acteon.UpdateRecurringAction) (*acteon.RecurringDetail, error)
+	UpdateRetentionFunc                 func(ctx context.Context, retentionID string, update *acteon.UpdateRetentionRequest) (*acteon.RetentionPolicy, error)
+	UpdateRoleBindingFunc               func(ctx context.Context, bindingID string, update *acteon.UpdateRoleBindingRequest) (*acteon.RoleBinding, error)
+	UpdateSilenceFunc                   func(ctx context.Context, silenceID string, update *acteon.UpdateSilenceRequest) (*acteon.Silence, error)
+	UpdateTemplateFunc                  func(ctx context.Context, templateID string, update *acteon.UpdateTemplateRequest) (*acteon.TemplateInfo, error)
+	UpdateThrottleFunc                  func(ctx context.Context, throttleID string, update *acteon.UpdateThrottleRequest) (*acteon.ThrottlePolicy, error)
+	UpdateTimeIntervalFunc              func(ctx context.Context, namespace, tenant, name string, update *acteon.UpdateTimeIntervalRequest) (*acteon.TimeInterval, error)
+	ValidateChainDefinitionFunc         func(ctx context.Context, def *acteon.ChainDefinition) (*acteon.ChainValidationResult, error)
+	ValidateTemplateFunc                func(ctx context.Context, content string) (*acteon.TemplateValidationResult, error)
+	VerifyAuditChainFunc                func(ctx context.Context, req *acteon.VerifyHashChainRequest) (*acteon.HashChainVerification, error)
+	VerifyPluginFunc                    func(ctx context.Context, name string) (*acteon.PluginVerification, error)
+	WhoAmIFunc                          func(ctx context.Context) (*acteon.CallerIdentity, error)
+}
+
+var _ acteon.ActeonAPI = (*Client)(nil)
+
+// A2ACancelTask calls A2ACancelTaskFunc.
+func (m *Client) A2ACancelTask(ctx context.Context, namespace, tenant, taskID string) (map[string]any, error) {
+	m.record("A2ACancelTask", namespace, tenant, taskID)
+	if m.A2ACancelTaskFunc == nil {
+		var r0 map[string]any
+		return r0, notStubbed("A2ACancelTask")
+	}
+	return m.A2ACancelTaskFunc(ctx, namespace, tenant, taskID)
+}
+
+// A2ADeletePushConfig calls A2ADeletePushConfigFunc.
+func (m *Client) A2ADeletePushConfig(ctx context.Context, namespace, tenant, taskID, configID string) error {
+	m.record("A2ADeletePushConfig", namespace, tenant, taskID, configID)
+	if m.A2ADeletePushConfigFunc == nil {
+		return notStubbed("A2ADeletePushConfig")
+	}
+	return m.A2ADeletePushConfigFunc(ctx, namespace, tenant, taskID, configID)
+}
+
+// A2ADiscoverAgent calls A2ADiscoverAgentFunc.
+func (m *Client) A2ADiscoverAgent(ctx context.Context, namespace, tenant string) (map[string]any, error) {
+	m.record("A2ADiscoverAgent", namespace, tenant)
+	if m.A2ADiscoverAgentFunc == nil {
+		var r0 map[string]any
+		return r0, notStubbed("A2ADiscoverAgent")
+	}
+	return m.A2ADiscoverAgentFunc(ctx, namespace, tenant)
+}
+
+// A2AGetAuthenticatedExtendedCard calls A2AGetAuthenticatedExtendedCardFunc.
+func (m *Client) A2AGetAuthenticatedExtendedCard(ctx context.Context, namespace, tenant string) (map[string]any, error) {
+	m.record("A2AGetAuthenticatedExtendedCard", namespace, tenant)
+	if m.A2AGetAuthenticatedExtendedCardFunc == nil {
+		var r0 map[string]any
+		return r0, notStubbed("A2AGetAuthenticatedExtendedCard")
+	}
+	return m.A2AGetAuthenticatedExtendedCardFunc(ctx, namespace, tenant)
+}
+
+// A2AGetPushConfig calls A2AGetPushConfigFunc.
+func (m *Client) A2AGetPushConfig(ctx context.Context, namespace, tenant, taskID, configID string) (map[string]any, error) {
+	m.record("A2AGetPushConfig", namespace, tenant, taskID, configID)
+	if m.A2AGetPushConfigFunc == nil {
+		var r0 map[string]any
+		return r0, notStubbed("A2AGetPushConfig")
+	}
+	return m.A2AGetPushConfigFunc(ctx, namespace, tenant, taskID, configID)
+}
+
+// A2AGetTask calls A2AGetTaskFunc.
+func (m *Client) A2AGetTask(ctx context.Context, namespace, tenant, taskID string) (map[string]any, error) {
+	m.record("A2AGetTask", namespace, tenant, taskID)
+	if m.A2AGetTaskFunc == nil {
+		var r0 map[string]any
+		return r0, notStubbed("A2AGetTask")
+	}
+	return m.A2AGetTaskFunc(ctx, namespace, tenant, taskID)
+}
+
+// A2AListPushConfigs calls A2AListPushConfigsFunc.
+func (m *Client) A2AListPushConfigs(ctx context.Context, namespace, tenant, taskID string) ([]map[string]any, error) {
+	m.record("A2AListPushConfigs", namespace, tenant, taskID)
+	if m.A2AListPushConfigsFunc == nil {
+		var r0 []map[string]any
+		return r0, notStubbed("A2AListPushConfigs")
+	}
+	return m.A2AListPushConfigsFunc(ctx, namespace, tenant, taskID)
+}
+
+// A2ASendMessage calls A2ASendMessageFunc.
+func (m *Client) A2ASendMessage(ctx context.Context, namespace, tenant string, message map[string]any) (map[string]any, error) {
+	m.record("A2ASendMessage", namespace, tenant, message)
+	if m.A2ASendMessageFunc == nil {
+		var r0 map[string]any
+		return r0, notStubbed("A2ASendMessage")
+	}
+	return m.A2ASendMessageFunc(ctx, namespace, tenant, message)
+}
+
+// A2ASetPushConfig calls A2ASetPushConfigFunc.
+func (m *Client) A2ASetPushConfig(ctx context.Context, namespace, tenant, taskID string, config map[string]any) (map[string]any, error) {
+	m.record("A2ASetPushConfig", namespace, tenant, taskID, config)
+	if m.A2ASetPushConfigFunc == nil {
+		var r0 map[string]any
+		return r0, notStubbed("A2ASetPushConfig")
+	}
+	return m.A2ASetPushConfigFunc(ctx, namespace, tenant, taskID, config)
+}
+
+// AppendBusConversationMessage calls AppendBusConversationMessageFunc.
+func (m *Client) AppendBusConversationMessage(ctx context.Context, namespace, tenant, conversationID string, req *acteon.AppendBusConversationMessage) (map[string]any, error) {
+	m.record("AppendBusConversationMessage", namespace, tenant, conversationID, req)
+	if m.AppendBusConversationMessageFunc == nil {
+		var r0 map[string]any
+		return r0, notStubbed("AppendBusConversationMessage")
+	}
+	return m.AppendBusConversationMessageFunc(ctx, namespace, tenant, conversationID, req)
+}
+
+// Apply calls ApplyFunc.
+func (m *Client) Apply(ctx context.Context, manifest *acteon.Manifest, opts acteon.ApplyOptions) (*acteon.ApplyResult, error) {
+	m.record("Apply", manifest, opts)
+	if m.ApplyFunc == nil {
+		var r0 *acteon.ApplyResult
+		return r0, notStubbed("Apply")
+	}
+	return m.ApplyFunc(ctx, manifest, opts)
+}
+
+// Approve calls ApproveFunc.
+func (m *Client) Approve(ctx context.Context, namespace, tenant, id, sig string, expiresAt int64, kid string) (*acteon.ApprovalActionResponse, error) {
+	m.record("Approve", namespace, tenant, id, sig, expiresAt, kid)
+	if m.ApproveFunc == nil {
+		var r0 *acteon.ApprovalActionResponse
+		return r0, notStubbed("Approve")
+	}
+	return m.ApproveFunc(ctx, namespace, tenant, id, sig, expiresAt, kid)
+}
+
+// ApproveBusApproval calls ApproveBusApprovalFunc.
+func (m *Client) ApproveBusApproval(ctx context.Context, namespace, tenant, approvalID string, decision *acteon.BusApprovalDecision) (*acteon.BusApprovalDecisionResponse, error) {
+	m.record("ApproveBusApproval", namespace, tenant, approvalID, decision)
+	if m.ApproveBusApprovalFunc == nil {
+		var r0 *acteon.BusApprovalDecisionResponse
+		return r0, notStubbed("ApproveBusApproval")
+	}
+	return m.ApproveBusApprovalFunc(ctx, namespace, tenant, approvalID, decision)
+}
+
+// AwaitOutcome calls AwaitOutcomeFunc.
+func (m *Client) AwaitOutcome(ctx context.Context, actionID string, timeout time.Duration) (*acteon.AuditRecord, error) {
+	m.record("AwaitOutcome", actionID, timeout)
+	if m.AwaitOutcomeFunc == nil {
+		var r0 *acteon.AuditRecord
+		return r0, notStubbed("AwaitOutcome")
+	}
+	return m.AwaitOutcomeFunc(ctx, actionID, timeout)
+}
+
+// BusStreamConsumeURL calls BusStreamConsumeURLFunc.
+func (m *Client) BusStreamConsumeURL(namespace, tenant, conversationID, streamID string) string {
+	m.record("BusStreamConsumeURL", namespace, tenant, conversationID, streamID)
+	if m.BusStreamConsumeURLFunc == nil {
+		var r0 string
+		return r0
+	}
+	return m.BusStreamConsumeURLFunc(namespace, tenant, conversationID, streamID)
+}
+
+// CacheStats calls CacheStatsFunc.
+func (m *Client) CacheStats() acteon.CacheStats {
+	m.record("CacheStats")
+	if m.CacheStatsFunc == nil {
+		var r0 acteon.CacheStats
+		return r0
+	}
+	return m.CacheStatsFunc()
+}
+
+// CancelChain calls CancelChainFunc.
+func (m *Client) CancelChain(ctx context.Context, chainID string, req *acteon.CancelChainRequest) (*acteon.ChainDetailResponse, error) {
+	m.record("CancelChain", chainID, req)
+	if m.CancelChainFunc == nil {
+		var r0 *acteon.ChainDetailResponse
+		return r0, notStubbed("CancelChain")
+	}
+	return m.CancelChainFunc(ctx, chainID, req)
+}
+
+// CancelSwarmRun calls CancelSwarmRunFunc.
+func (m *Client) CancelSwarmRun(ctx context.Context, runID string) (*acteon.SwarmRunSnapshot, error) {
+	m.record("CancelSwarmRun", runID)
+	if m.CancelSwarmRunFunc == nil {
+		var r0 *acteon.SwarmRunSnapshot
+		return r0, notStubbed("CancelSwarmRun")
+	}
+	return m.CancelSwarmRunFunc(ctx, runID)
+}
+
+// CheckQuota calls CheckQuotaFunc.
+func (m *Client) CheckQuota(ctx context.Context, namespace, tenant string, n int) (*acteon.QuotaCheckResult, error) {
+	m.record("CheckQuota", namespace, tenant, n)
+	if m.CheckQuotaFunc == nil {
+		var r0 *acteon.QuotaCheckResult
+		return r0, notStubbed("CheckQuota")
+	}
+	return m.CheckQuotaFunc(ctx, namespace, tenant, n)
+}
+
+// CompleteTask calls CompleteTaskFunc.
+func (m *Client) CompleteTask(ctx context.Context, taskID string, req *acteon.CompleteTaskRequest) (*acteon.WorkerTask, error) {
+	m.record("CompleteTask", taskID, req)
+	if m.CompleteTaskFunc == nil {
+		var r0 *acteon.WorkerTask
+		return r0, notStubbed("CompleteTask")
+	}
+	return m.CompleteTaskFunc(ctx, taskID, req)
+}
+
+// ConsumeBusStream calls ConsumeBusStreamFunc.
+func (m *Client) ConsumeBusStream(ctx context.Context, namespace, tenant, conversationID, streamID string) (<-chan *acteon.BusStreamItem, error) {
+	m.record("ConsumeBusStream", namespace, tenant, conversationID, streamID)
+	if m.ConsumeBusStreamFunc == nil {
+		var r0 <-chan *acteon.BusStreamItem
+		return r0, notStubbed("ConsumeBusStream")
+	}
+	return m.ConsumeBusStreamFunc(ctx, namespace, tenant, conversationID, streamID)
+}
+
+// ConsumeBusSubscription calls ConsumeBusSubscriptionFunc.
+func (m *Client) ConsumeBusSubscription(ctx context.Context, subscriptionID string, opts *acteon.ConsumeBusSubscriptionOptions) (<-chan *acteon.BusConsumeItem, error) {
+	m.record("ConsumeBusSubscription", subscriptionID, opts)
+	if m.ConsumeBusSubscriptionFunc == nil {
+		var r0 <-chan *acteon.BusConsumeItem
+		return r0, notStubbed("ConsumeBusSubscription")
+	}
+	return m.ConsumeBusSubscriptionFunc(ctx, subscriptionID, opts)
+}
+
+// CreateBackup calls CreateBackupFunc.
+func (m *Client) CreateBackup(ctx context.Context, req *acteon.BackupRequest) (*acteon.Backup, error) {
+	m.record("CreateBackup", req)
+	if m.CreateBackupFunc == nil {
+		var r0 *acteon.Backup
+		return r0, notStubbed("CreateBackup")
+	}
+	return m.CreateBackupFunc(ctx, req)
+}
+
+// CreateBusConversation calls CreateBusConversationFunc.
+func (m *Client) CreateBusConversation(ctx context.Context, req *acteon.CreateBusConversation) (*acteon.BusConversation, error) {
+	m.record("CreateBusConversation", req)
+	if m.CreateBusConversationFunc == nil {
+		var r0 *acteon.BusConversation
+		return r0, notStubbed("CreateBusConversation")
+	}
+	return m.CreateBusConversationFunc(ctx, req)
+}
+
+// CreateBusSubscription calls CreateBusSubscriptionFunc.
+func (m *Client) CreateBusSubscription(ctx context.Context, req *acteon.CreateBusSubscription) (*acteon.BusSubscription, error) {
+	m.record("CreateBusSubscription", req)
+	if m.CreateBusSubscriptionFunc == nil {
+		var r0 *acteon.BusSubscription
+		return r0, notStubbed("CreateBusSubscription")
+	}
+	return m.CreateBusSubscriptionFunc(ctx, req)
+}
+
+// CreateBusTopic calls CreateBusTopicFunc.
+func (m *Client) CreateBusTopic(ctx context.Context, req *acteon.CreateBusTopic) (*acteon.BusTopic, error) {
+	m.record("CreateBusTopic", req)
+	if m.CreateBusTopicFunc == nil {
+		var r0 *acteon.BusTopic
+		return r0, notStubbed("CreateBusTopic")
+	}
+	return m.CreateBusTopicFunc(ctx, req)
+}
+
+// CreateEscalationPolicy calls CreateEscalationPolicyFunc.
+func (m *Client) CreateEscalationPolicy(ctx context.Context, req *acteon.CreateEscalationPolicyRequest) (*acteon.EscalationPolicy, error) {
+	m.record("CreateEscalationPolicy", req)
+	if m.CreateEscalationPolicyFunc == nil {
+		var r0 *acteon.EscalationPolicy
+		return r0, notStubbed("CreateEscalationPolicy")
+	}
+	return m.CreateEscalationPolicyFunc(ctx, req)
+}
+
+// CreateProfile calls CreateProfileFunc.
+func (m *Client) CreateProfile(ctx context.Context, req *acteon.CreateProfileRequest) (*acteon.TemplateProfileInfo, error) {
+	m.record("CreateProfile", req)
+	if m.CreateProfileFunc == nil {
+		var r0 *acteon.TemplateProfileInfo
+		return r0, notStubbed("CreateProfile")
+	}
+	return m.CreateProfileFunc(ctx, req)
+}
+
+// CreateProviderConfig calls CreateProviderConfigFunc.
+func (m *Client) CreateProviderConfig(ctx context.Context, req *acteon.CreateProviderConfigRequest) (*acteon.ProviderConfig, error) {
+	m.record("CreateProviderConfig", req)
+	if m.CreateProviderConfigFunc == nil {
+		var r0 *acteon.ProviderConfig
+		return r0, notStubbed("CreateProviderConfig")
+	}
+	return m.CreateProviderConfigFunc(ctx, req)
+}
+
+// CreateQuota calls CreateQuotaFunc.
+func (m *Client) CreateQuota(ctx context.Context, req *acteon.CreateQuotaRequest) (*acteon.QuotaPolicy, error) {
+	m.record("CreateQuota", req)
+	if m.CreateQuotaFunc == nil {
+		var r0 *acteon.QuotaPolicy
+		return r0, notStubbed("CreateQuota")
+	}
+	return m.CreateQuotaFunc(ctx, req)
+}
+
+// CreateRecurring calls CreateRecurringFunc.
+func (m *Client) CreateRecurring(ctx context.Context, recurring *acteon.CreateRecurringAction) (*acteon.CreateRecurringResponse, error) {
+	m.record("CreateRecurring", recurring)
+	if m.CreateRecurringFunc == nil {
+		var r0 *acteon.CreateRecurringResponse
+		return r0, notStubbed("CreateRecurring")
+	}
+	return m.CreateRecurringFunc(ctx, recurring)
+}
+
+// CreateRetention calls CreateRetentionFunc.
+func (m *Client) CreateRetention(ctx context.Context, req *acteon.CreateRetentionRequest) (*acteon.RetentionPolicy, error) {
+	m.record("CreateRetention", req)
+	if m.CreateRetentionFunc == nil {
+		var r0 *acteon.RetentionPolicy
+		return r0, notStubbed("CreateRetention")
+	}
+	return m.CreateRetentionFunc(ctx, req)
+}
+
+// CreateRoleBinding calls CreateRoleBindingFunc.
+func (m *Client) CreateRoleBinding(ctx context.Context, req *acteon.CreateRoleBindingRequest) (*acteon.RoleBinding, error) {
+	m.record("CreateRoleBinding", req)
+	if m.CreateRoleBindingFunc == nil {
+		var r0 *acteon.RoleBinding
+		return r0, notStubbed("CreateRoleBinding")
+	}
+	return m.CreateRoleBindingFunc(ctx, req)
+}
+
+// CreateSilence calls CreateSilenceFunc.
+func (m *Client) CreateSilence(ctx context.Context, req *acteon.CreateSilenceRequest) (*acteon.Silence, error) {
+	m.record("CreateSilence", req)
+	if m.CreateSilenceFunc == nil {
+		var r0 *acteon.Silence
+		return r0, notStubbed("CreateSilence")
+	}
+	return m.CreateSilenceFunc(ctx, req)
+}
+
+// CreateTemplate calls CreateTemplateFunc.
+func (m *Client) CreateTemplate(ctx context.Context, req *acteon.CreateTemplateRequest) (*acteon.TemplateInfo, error) {
+	m.record("CreateTemplate", req)
+	if m.CreateTemplateFunc == nil {
+		var r0 *acteon.TemplateInfo
+		return r0, notStubbed("CreateTemplate")
+	}
+	return m.CreateTemplateFunc(ctx, req)
+}
+
+// CreateThrottle calls CreateThrottleFunc.
+func (m *Client) CreateThrottle(ctx context.Context, req *acteon.CreateThrottleRequest) (*acteon.ThrottlePolicy, error) {
+	m.record("CreateThrottle", req)
+	if m.CreateThrottleFunc == nil {
+		var r0 *acteon.ThrottlePolicy
+		return r0, notStubbed("CreateThrottle")
+	}
+	return m.CreateThrottleFunc(ctx, req)
+}
+
+// CreateTimeInterval calls CreateTimeIntervalFunc.
+func (m *Client) CreateTimeInterval(ctx context.Context, req *acteon.CreateTimeIntervalRequest) (*acteon.TimeInterval, error) {
+	m.record("CreateTimeInterval", req)
+	if m.CreateTimeIntervalFunc == nil {
+		var r0 *acteon.TimeInterval
+		return r0, notStubbed("CreateTimeInterval")
+	}
+	return m.CreateTimeIntervalFunc(ctx, req)
+}
+
+// DeleteBusAgent calls DeleteBusAgentFunc.
+func (m *Client) DeleteBusAgent(ctx context.Context, namespace, tenant, agentID string) error {
+	m.record("DeleteBusAgent", namespace, tenant, agentID)
+	if m.DeleteBusAgentFunc == nil {
+		return notStubbed("DeleteBusAgent")
+	}
+	return m.DeleteBusAgentFunc(ctx, namespace, tenant, agentID)
+}
+
+// DeleteBusConversation calls DeleteBusConversationFunc.
+func (m *Client) DeleteBusConversation(ctx context.Context, namespace, tenant, conversationID string) error {
+	m.record("DeleteBusConversation", namespace, tenant, conversationID)
+	if m.DeleteBusConversationFunc == nil {
+		return notStubbed("DeleteBusConversation")
+	}
+	return m.DeleteBusConversationFunc(ctx, namespace, tenant, conversationID)
+}
+
+// DeleteBusSchema calls DeleteBusSchemaFunc.
+func (m *Client) DeleteBusSchema(ctx context.Context, namespace, tenant, subject string, version int) error {
+	m.record("DeleteBusSchema", namespace, tenant, subject, version)
+	if m.DeleteBusSchemaFunc == nil {
+		return notStubbed("DeleteBusSchema")
+	}
+	return m.DeleteBusSchemaFunc(ctx, namespace, tenant, subject, version)
+}
+
+// DeleteBusSubscription calls DeleteBusSubscriptionFunc.
+func (m *Client) DeleteBusSubscription(ctx context.Context, namespace, tenant, subID string) error {
+	m.record("DeleteBusSubscription", namespace, tenant, subID)
+	if m.DeleteBusSubscriptionFunc == nil {
+		return notStubbed("DeleteBusSubscription")
+	}
+	return m.DeleteBusSubscriptionFunc(ctx, namespace, tenant, subID)
+}
+
+// DeleteBusTopic calls DeleteBusTopicFunc.
+func (m *Client) DeleteBusTopic(ctx context.Context, namespace, tenant, name string) error {
+	m.record("DeleteBusTopic", namespace, tenant, name)
+	if m.DeleteBusTopicFunc == nil {
+		return notStubbed("DeleteBusTopic")
+	}
+	return m.DeleteBusTopicFunc(ctx, namespace, tenant, name)
+}
+
+// DeleteDlqEntry calls DeleteDlqEntryFunc.
+func (m *Client) DeleteDlqEntry(ctx context.Context, actionID string) error {
+	m.record("DeleteDlqEntry", actionID)
+	if m.DeleteDlqEntryFunc == nil {
+		return notStubbed("DeleteDlqEntry")
+	}
+	return m.DeleteDlqEntryFunc(ctx, actionID)
+}
+
+// DeleteEscalationPolicy calls DeleteEscalationPolicyFunc.
+func (m *Client) DeleteEscalationPolicy(ctx context.Context, policyID string) error {
+	m.record("DeleteEscalationPolicy", policyID)
+	if m.DeleteEscalationPolicyFunc == nil {
+		return notStubbed("DeleteEscalationPolicy")
+	}
+	return m.DeleteEscalationPolicyFunc(ctx, policyID)
+}
+
+// DeleteNotificationPreferences calls DeleteNotificationPreferencesFunc.
+func (m *Client) DeleteNotificationPreferences(ctx context.Context, tenant string) error {
+	m.record("DeleteNotificationPreferences", tenant)
+	if m.DeleteNotificationPreferencesFunc == nil {
+		return notStubbed("DeleteNotificationPreferences")
+	}
+	return m.DeleteNotificationPreferencesFunc(ctx, tenant)
+}
+
+// DeletePlugin calls DeletePluginFunc.
+func (m *Client) DeletePlugin(ctx context.Context, name string) error {
+	m.record("DeletePlugin", name)
+	if m.DeletePluginFunc == nil {
+		return notStubbed("DeletePlugin")
+	}
+	return m.DeletePluginFunc(ctx, name)
+}
+
+// DeleteProfile calls DeleteProfileFunc.
+func (m *Client) DeleteProfile(ctx context.Context, profileID string) error {
+	m.record("DeleteProfile", profileID)
+	if m.DeleteProfileFunc == nil {
+		return notStubbed("DeleteProfile")
+	}
+	return m.DeleteProfileFunc(ctx, profileID)
+}
+
+// DeleteProviderConfig calls DeleteProviderConfigFunc.
+func (m *Client) DeleteProviderConfig(ctx context.Context, name string) error {
+	m.record("DeleteProviderConfig", name)
+	if m.DeleteProviderConfigFunc == nil {
+		return notStubbed("DeleteProviderConfig")
+	}
+	return m.DeleteProviderConfigFunc(ctx, name)
+}
+
+// DeleteQuota calls DeleteQuotaFunc.
+func (m *Client) DeleteQuota(ctx context.Context, quotaID, namespace, tenant string) error {
+	m.record("DeleteQuota", quotaID, namespace, tenant)
+	if m.DeleteQuotaFunc == nil {
+		return notStubbed("DeleteQuota")
+	}
+	return m.DeleteQuotaFunc(ctx, quotaID, namespace, tenant)
+}
+
+// DeleteRecurring calls DeleteRecurringFunc.
+func (m *Client) DeleteRecurring(ctx context.Context, recurringID, namespace, tenant string) error {
+	m.record("DeleteRecurring", recurringID, namespace, tenant)
+	if m.DeleteRecurringFunc == nil {
+		return notStubbed("DeleteRecurring")
+	}
+	return m.DeleteRecurringFunc(ctx, recurringID, namespace, tenant)
+}
+
+// DeleteRetention calls DeleteRetentionFunc.
+func (m *Client) DeleteRetention(ctx context.Context, retentionID string) error {
+	m.record("DeleteRetention", retentionID)
+	if m.DeleteRetentionFunc == nil {
+		return notStubbed("DeleteRetention")
+	}
+	return m.DeleteRetentionFunc(ctx, retentionID)
+}
+
+// DeleteRoleBinding calls DeleteRoleBindingFunc.
+func (m *Client) DeleteRoleBinding(ctx context.Context, bindingID string) error {
+	m.record("DeleteRoleBinding", bindingID)
+	if m.DeleteRoleBindingFunc == nil {
+		return notStubbed("DeleteRoleBinding")
+	}
+	return m.DeleteRoleBindingFunc(ctx, bindingID)
+}
+
+// DeleteSecret calls DeleteSecretFunc.
+func (m *Client) DeleteSecret(ctx context.Context, scope, name string) error {
+	m.record("DeleteSecret", scope, name)
+	if m.DeleteSecretFunc == nil {
+		return notStubbed("DeleteSecret")
+	}
+	return m.DeleteSecretFunc(ctx, scope, name)
+}
+
+// DeleteSilence calls DeleteSilenceFunc.
+func (m *Client) DeleteSilence(ctx context.Context, silenceID string) error {
+	m.record("DeleteSilence", silenceID)
+	if m.DeleteSilenceFunc == nil {
+		return notStubbed("DeleteSilence")
+	}
+	return m.DeleteSilenceFunc(ctx, silenceID)
+}
+
+// DeleteTemplate calls DeleteTemplateFunc.
+func (m *Client) DeleteTemplate(ctx context.Context, templateID string) error {
+	m.record("DeleteTemplate", templateID)
+	if m.DeleteTemplateFunc == nil {
+		return notStubbed("DeleteTemplate")
+	}
+	return m.DeleteTemplateFunc(ctx, templateID)
+}
+
+// DeleteThrottle calls DeleteThrottleFunc.
+func (m *Client) DeleteThrottle(ctx context.Context, throttleID, namespace, tenant string) error {
+	m.record("DeleteThrottle", throttleID, namespace, tenant)
+	if m.DeleteThrottleFunc == nil {
+		return notStubbed("DeleteThrottle")
+	}
+	return m.DeleteThrottleFunc(ctx, throttleID, namespace, tenant)
+}
+
+// DeleteTimeInterval calls DeleteTimeIntervalFunc.
+func (m *Client) DeleteTimeInterval(ctx context.Context, namespace, tenant, name string) error {
+	m.record("DeleteTimeInterval", namespace, tenant, name)
+	if m.DeleteTimeIntervalFunc == nil {
+		return notStubbed("DeleteTimeInterval")
+	}
+	return m.DeleteTimeIntervalFunc(ctx, namespace, tenant, name)
+}
+
+// Dispatch calls DispatchFunc.
+func (m *Client) Dispatch(ctx context.Context, action *acteon.Action) (*acteon.ActionOutcome, error) {
+	m.record("Dispatch", action)
+	if m.DispatchFunc == nil {
+		var r0 *acteon.ActionOutcome
+		return r0, notStubbed("Dispatch")
+	}
+	return m.DispatchFunc(ctx, action)
+}
+
+// DispatchBatch calls DispatchBatchFunc.
+func (m *Client) DispatchBatch(ctx context.Context, actions []*acteon.Action) (acteon.BatchResults, error) {
+	m.record("DispatchBatch", actions)
+	if m.DispatchBatchFunc == nil {
+		var r0 acteon.BatchResults
+		return r0, notStubbed("DispatchBatch")
+	}
+	return m.DispatchBatchFunc(ctx, actions)
+}
+
+// DispatchBatchAtomic calls DispatchBatchAtomicFunc.
+func (m *Client) DispatchBatchAtomic(ctx context.Context, actions []*acteon.Action) (acteon.BatchResults, error) {
+	m.record("DispatchBatchAtomic", actions)
+	if m.DispatchBatchAtomicFunc == nil {
+		var r0 acteon.BatchResults
+		return r0, notStubbed("DispatchBatchAtomic")
+	}
+	return m.DispatchBatchAtomicFunc(ctx, actions)
+}
+
+// DispatchBatchDryRun calls DispatchBatchDryRunFunc.
+func (m *Client) DispatchBatchDryRun(ctx context.Context, actions []*acteon.Action) (acteon.BatchResults, error) {
+	m.record("DispatchBatchDryRun", actions)
+	if m.DispatchBatchDryRunFunc == nil {
+		var r0 acteon.BatchResults
+		return r0, notStubbed("DispatchBatchDryRun")
+	}
+	return m.DispatchBatchDryRunFunc(ctx, actions)
+}
+
+// DispatchDryRun calls DispatchDryRunFunc.
+func (m *Client) DispatchDryRun(ctx context.Context, action *acteon.Action) (*acteon.ActionOutcome, error) {
+	m.record("DispatchDryRun", action)
+	if m.DispatchDryRunFunc == nil {
+		var r0 *acteon.ActionOutcome
+		return r0, notStubbed("DispatchDryRun")
+	}
+	return m.DispatchDryRunFunc(ctx, action)
+}
+
+// DlqDrain calls DlqDrainFunc.
+func (m *Client) DlqDrain(ctx context.Context) (*acteon.DlqDrainResponse, error) {
+	m.record("DlqDrain")
+	if m.DlqDrainFunc == nil {
+		var r0 *acteon.DlqDrainResponse
+		return r0, notStubbed("DlqDrain")
+	}
+	return m.DlqDrainFunc(ctx)
+}
+
+// DlqStats calls DlqStatsFunc.
+func (m *Client) DlqStats(ctx context.Context) (*acteon.DlqStatsResponse, error) {
+	m.record("DlqStats")
+	if m.DlqStatsFunc == nil {
+		var r0 *acteon.DlqStatsResponse
+		return r0, notStubbed("DlqStats")
+	}
+	return m.DlqStatsFunc(ctx)
+}
+
+// DlqStatsDetailed calls DlqStatsDetailedFunc.
+func (m *Client) DlqStatsDetailed(ctx context.Context) (*acteon.DlqStatsDetailedResponse, error) {
+	m.record("DlqStatsDetailed")
+	if m.DlqStatsDetailedFunc == nil {
+		var r0 *acteon.DlqStatsDetailedResponse
+		return r0, notStubbed("DlqStatsDetailed")
+	}
+	return m.DlqStatsDetailedFunc(ctx)
+}
+
+// DumpAction calls DumpActionFunc.
+func (m *Client) DumpAction(action *acteon.Action) ([]byte, error) {
+	m.record("DumpAction", action)
+	if m.DumpActionFunc == nil {
+		var r0 []byte
+		return r0, notStubbed("DumpAction")
+	}
+	return m.DumpActionFunc(action)
+}
+
+// Endpoint calls EndpointFunc.
+func (m *Client) Endpoint(namespace, tenant string) string {
+	m.record("Endpoint", namespace, tenant)
+	if m.EndpointFunc == nil {
+		var r0 string
+		return r0
+	}
+	return m.EndpointFunc(namespace, tenant)
+}
+
+// EnqueueTask calls EnqueueTaskFunc.
+func (m *Client) EnqueueTask(ctx context.Context, queue string, req *acteon.EnqueueTaskRequest) (*acteon.WorkerTask, error) {
+	m.record("EnqueueTask", queue, req)
+	if m.EnqueueTaskFunc == nil {
+		var r0 *acteon.WorkerTask
+		return r0, notStubbed("EnqueueTask")
+	}
+	return m.EnqueueTaskFunc(ctx, queue, req)
+}
+
+// EnsureQuota calls EnsureQuotaFunc.
+func (m *Client) EnsureQuota(ctx context.Context, req *acteon.CreateQuotaRequest) (bool, error) {
+	m.record("EnsureQuota", req)
+	if m.EnsureQuotaFunc == nil {
+		var r0 bool
+		return r0, notStubbed("EnsureQuota")
+	}
+	return m.EnsureQuotaFunc(ctx, req)
+}
+
+// EnsureRecurring calls EnsureRecurringFunc.
+func (m *Client) EnsureRecurring(ctx context.Context, req *acteon.CreateRecurringAction) (bool, error) {
+	m.record("EnsureRecurring", req)
+	if m.EnsureRecurringFunc == nil {
+		var r0 bool
+		return r0, notStubbed("EnsureRecurring")
+	}
+	return m.EnsureRecurringFunc(ctx, req)
+}
+
+// EnsureRetention calls EnsureRetentionFunc.
+func (m *Client) EnsureRetention(ctx context.Context, req *acteon.CreateRetentionRequest) (bool, error) {
+	m.record("EnsureRetention", req)
+	if m.EnsureRetentionFunc == nil {
+		var r0 bool
+		return r0, notStubbed("EnsureRetention")
+	}
+	return m.EnsureRetentionFunc(ctx, req)
+}
+
+// EnsureTemplate calls EnsureTemplateFunc.
+func (m *Client) EnsureTemplate(ctx context.Context, req *acteon.CreateTemplateRequest) (bool, error) {
+	m.record("EnsureTemplate", req)
+	if m.EnsureTemplateFunc == nil {
+		var r0 bool
+		return r0, notStubbed("EnsureTemplate")
+	}
+	return m.EnsureTemplateFunc(ctx, req)
+}
+
+// EraseSubjectData calls EraseSubjectDataFunc.
+func (m *Client) EraseSubjectData(ctx context.Context, query *acteon.SubjectQuery) (*acteon.SubjectErasureReport, error) {
+	m.record("EraseSubjectData", query)
+	if m.EraseSubjectDataFunc == nil {
+		var r0 *acteon.SubjectErasureReport
+		return r0, notStubbed("EraseSubjectData")
+	}
+	return m.EraseSubjectDataFunc(ctx, query)
+}
+
+// EvaluateGuardrail calls EvaluateGuardrailFunc.
+func (m *Client) EvaluateGuardrail(ctx context.Context, text string) (*acteon.GuardrailEvaluation, error) {
+	m.record("EvaluateGuardrail", text)
+	if m.EvaluateGuardrailFunc == nil {
+		var r0 *acteon.GuardrailEvaluation
+		return r0, notStubbed("EvaluateGuardrail")
+	}
+	return m.EvaluateGuardrailFunc(ctx, text)
+}
+
+// EvaluateRules calls EvaluateRulesFunc.
+func (m *Client) EvaluateRules(ctx context.Context, req acteon.EvaluateRulesRequest) (*acteon.EvaluateRulesResponse, error) {
+	m.record("EvaluateRules", req)
+	if m.EvaluateRulesFunc == nil {
+		var r0 *acteon.EvaluateRulesResponse
+		return r0, notStubbed("EvaluateRules")
+	}
+	return m.EvaluateRulesFunc(ctx, req)
+}
+
+// ExportSubjectData calls ExportSubjectDataFunc.
+func (m *Client) ExportSubjectData(ctx context.Context, query *acteon.SubjectQuery) (*acteon.SubjectDataExport, error) {
+	m.record("ExportSubjectData", query)
+	if m.ExportSubjectDataFunc == nil {
+		var r0 *acteon.SubjectDataExport
+		return r0, notStubbed("ExportSubjectData")
+	}
+	return m.ExportSubjectDataFunc(ctx, query)
+}
+
+// ExportTemplates calls ExportTemplatesFunc.
+func (m *Client) ExportTemplates(ctx context.Context, filter *acteon.TemplateBundleFilter, w io.Writer) error {
+	m.record("ExportTemplates", filter, w)
+	if m.ExportTemplatesFunc == nil {
+		return notStubbed("ExportTemplates")
+	}
+	return m.ExportTemplatesFunc(ctx, filter, w)
+}
+
+// FailTask calls FailTaskFunc.
+func (m *Client) FailTask(ctx context.Context, taskID string, req *acteon.FailTaskRequest) (*acteon.WorkerTask, error) {
+	m.record("FailTask", taskID, req)
+	if m.FailTaskFunc == nil {
+		var r0 *acteon.WorkerTask
+		return r0, notStubbed("FailTask")
+	}
+	return m.FailTaskFunc(ctx, taskID, req)
+}
+
+// FetchSigningKeys calls FetchSigningKeysFunc.
+func (m *Client) FetchSigningKeys(ctx context.Context) (*acteon.SigningKeysResponse, error) {
+	m.record("FetchSigningKeys")
+	if m.FetchSigningKeysFunc == nil {
+		var r0 *acteon.SigningKeysResponse
+		return r0, notStubbed("FetchSigningKeys")
+	}
+	return m.FetchSigningKeysFunc(ctx)
+}
+
+// FlushGroup calls FlushGroupFunc.
+func (m *Client) FlushGroup(ctx context.Context, groupKey string) (*acteon.FlushGroupResponse, error) {
+	m.record("FlushGroup", groupKey)
+	if m.FlushGroupFunc == nil {
+		var r0 *acteon.FlushGroupResponse
+		return r0, notStubbed("FlushGroup")
+	}
+	return m.FlushGroupFunc(ctx, groupKey)
+}
+
+// GetApproval calls GetApprovalFunc.
+func (m *Client) GetApproval(ctx context.Context, namespace, tenant, id, sig string, expiresAt int64, kid string) (*acteon.ApprovalStatus, error) {
+	m.record("GetApproval", namespace, tenant, id, sig, expiresAt, kid)
+	if m.GetApprovalFunc == nil {
+		var r0 *acteon.ApprovalStatus
+		return r0, notStubbed("GetApproval")
+	}
+	return m.GetApprovalFunc(ctx, namespace, tenant, id, sig, expiresAt, kid)
+}
+
+// GetAuditRecord calls GetAuditRecordFunc.
+func (m *Client) GetAuditRecord(ctx context.Context, actionID string) (*acteon.AuditRecord, error) {
+	m.record("GetAuditRecord", actionID)
+	if m.GetAuditRecordFunc == nil {
+		var r0 *acteon.AuditRecord
+		return r0, notStubbed("GetAuditRecord")
+	}
+	return m.GetAuditRecordFunc(ctx, actionID)
+}
+
+// GetAuditRecords calls GetAuditRecordsFunc.
+func (m *Client) GetAuditRecords(ctx context.Context, actionIDs []string) (*acteon.AuditRecordsResult, error) {
+	m.record("GetAuditRecords", actionIDs)
+	if m.GetAuditRecordsFunc == nil {
+		var r0 *acteon.AuditRecordsResult
+		return r0, notStubbed("GetAuditRecords")
+	}
+	return m.GetAuditRecordsFunc(ctx, actionIDs)
+}
+
+// GetBackupStatus calls GetBackupStatusFunc.
+func (m *Client) GetBackupStatus(ctx context.Context, backupID string) (*acteon.Backup, error) {
+	m.record("GetBackupStatus", backupID)
+	if m.GetBackupStatusFunc == nil {
+		var r0 *acteon.Backup
+		return r0, notStubbed("GetBackupStatus")
+	}
+	return m.GetBackupStatusFunc(ctx, backupID)
+}
+
+// GetBusAgent calls GetBusAgentFunc.
+func (m *Client) GetBusAgent(ctx context.Context, namespace, tenant, agentID string) (*acteon.BusAgent, error) {
+	m.record("GetBusAgent", namespace, tenant, agentID)
+	if m.GetBusAgentFunc == nil {
+		var r0 *acteon.BusAgent
+		return r0, notStubbed("GetBusAgent")
+	}
+	return m.GetBusAgentFunc(ctx, namespace, tenant, agentID)
+}
+
+// GetBusApproval calls GetBusApprovalFunc.
+func (m *Client) GetBusApproval(ctx context.Context, namespace, tenant, approvalID string) (*acteon.BusApprovalView, error) {
+	m.record("GetBusApproval", namespace, tenant, approvalID)
+	if m.GetBusApprovalFunc == nil {
+		var r0 *acteon.BusApprovalView
+		return r0, notStubbed("GetBusApproval")
+	}
+	return m.GetBusApprovalFunc(ctx, namespace, tenant, approvalID)
+}
+
+// GetBusConversation calls GetBusConversationFunc.
+func (m *Client) GetBusConversation(ctx context.Context, namespace, tenant, conversationID string) (*acteon.BusConversation, error) {
+	m.record("GetBusConversation", namespace, tenant, conversationID)
+	if m.GetBusConversationFunc == nil {
+		var r0 *acteon.BusConversation
+		return r0, notStubbed("GetBusConversation")
+	}
+	return m.GetBusConversationFunc(ctx, namespace, tenant, conversationID)
+}
+
+// GetBusSchema calls GetBusSchemaFunc.
+func (m *Client) GetBusSchema(ctx context.Context, namespace, tenant, subject string, version int) (*acteon.BusSchema, error) {
+	m.record("GetBusSchema", namespace, tenant, subject, version)
+	if m.GetBusSchemaFunc == nil {
+		var r0 *acteon.BusSchema
+		return r0, notStubbed("GetBusSchema")
+	}
+	return m.GetBusSchemaFunc(ctx, namespace, tenant, subject, version)
+}
+
+// GetBusSubscription calls GetBusSubscriptionFunc.
+func (m *Client) GetBusSubscription(ctx context.Context, namespace, tenant, subID string) (*acteon.BusSubscription, error) {
+	m.record("GetBusSubscription", namespace, tenant, subID)
+	if m.GetBusSubscriptionFunc == nil {
+		var r0 *acteon.BusSubscription
+		return r0, notStubbed("GetBusSubscription")
+	}
+	return m.GetBusSubscriptionFunc(ctx, namespace, tenant, subID)
+}
+
+// GetBusSubscriptionLag calls GetBusSubscriptionLagFunc.
+func (m *Client) GetBusSubscriptionLag(ctx context.Context, namespace, tenant, subID string) (*acteon.BusLag, error) {
+	m.record("GetBusSubscriptionLag", namespace, tenant, subID)
+	if m.GetBusSubscriptionLagFunc == nil {
+		var r0 *acteon.BusLag
+		return r0, notStubbed("GetBusSubscriptionLag")
+	}
+	return m.GetBusSubscriptionLagFunc(ctx, namespace, tenant, subID)
+}
+
+// GetBusTopic calls GetBusTopicFunc.
+func (m *Client) GetBusTopic(ctx context.Context, namespace, tenant, name string) (*acteon.BusTopic, error) {
+	m.record("GetBusTopic", namespace, tenant, name)
+	if m.GetBusTopicFunc == nil {
+		var r0 *acteon.BusTopic
+		return r0, notStubbed("GetBusTopic")
+	}
+	return m.GetBusTopicFunc(ctx, namespace, tenant, name)
+}
+
+// GetChain calls GetChainFunc.
+func (m *Client) GetChain(ctx context.Context, chainID, namespace, tenant string) (*acteon.ChainDetailResponse, error) {
+	m.record("GetChain", chainID, namespace, tenant)
+	if m.GetChainFunc == nil {
+		var r0 *acteon.ChainDetailResponse
+		return r0, notStubbed("GetChain")
+	}
+	return m.GetChainFunc(ctx, chainID, namespace, tenant)
+}
+
+// GetChainDag calls GetChainDagFunc.
+func (m *Client) GetChainDag(ctx context.Context, chainID, namespace, tenant string) (*acteon.DagResponse, error) {
+	m.record("GetChainDag", chainID, namespace, tenant)
+	if m.GetChainDagFunc == nil {
+		var r0 *acteon.DagResponse
+		return r0, notStubbed("GetChainDag")
+	}
+	return m.GetChainDagFunc(ctx, chainID, namespace, tenant)
+}
+
+// GetChainDefinitionDag calls GetChainDefinitionDagFunc.
+func (m *Client) GetChainDefinitionDag(ctx context.Context, name string) (*acteon.DagResponse, error) {
+	m.record("GetChainDefinitionDag", name)
+	if m.GetChainDefinitionDagFunc == nil {
+		var r0 *acteon.DagResponse
+		return r0, notStubbed("GetChainDefinitionDag")
+	}
+	return m.GetChainDefinitionDagFunc(ctx, name)
+}
+
+// GetChainHistory calls GetChainHistoryFunc.
+func (m *Client) GetChainHistory(ctx context.Context, chainID, namespace, tenant string) (*acteon.ChainHistoryResponse, error) {
+	m.record("GetChainHistory", chainID, namespace, tenant)
+	if m.GetChainHistoryFunc == nil {
+		var r0 *acteon.ChainHistoryResponse
+		return r0, notStubbed("GetChainHistory")
+	}
+	return m.GetChainHistoryFunc(ctx, chainID, namespace, tenant)
+}
+
+// GetChainMetrics calls GetChainMetricsFunc.
+func (m *Client) GetChainMetrics(ctx context.Context, chainName string, window time.Duration) (*acteon.ChainMetricsResponse, error) {
+	m.record("GetChainMetrics", chainName, window)
+	if m.GetChainMetricsFunc == nil {
+		var r0 *acteon.ChainMetricsResponse
+		return r0, notStubbed("GetChainMetrics")
+	}
+	return m.GetChainMetricsFunc(ctx, chainName, window)
+}
+
+// GetComplianceStatus calls GetComplianceStatusFunc.
+func (m *Client) GetComplianceStatus(ctx context.Context) (*acteon.ComplianceStatus, error) {
+	m.record("GetComplianceStatus")
+	if m.GetComplianceStatusFunc == nil {
+		var r0 *acteon.ComplianceStatus
+		return r0, notStubbed("GetComplianceStatus")
+	}
+	return m.GetComplianceStatusFunc(ctx)
+}
+
+// GetConfig calls GetConfigFunc.
+func (m *Client) GetConfig(ctx context.Context) (*acteon.GatewayConfig, error) {
+	m.record("GetConfig")
+	if m.GetConfigFunc == nil {
+		var r0 *acteon.GatewayConfig
+		return r0, notStubbed("GetConfig")
+	}
+	return m.GetConfigFunc(ctx)
+}
+
+// GetDlqEntry calls GetDlqEntryFunc.
+func (m *Client) GetDlqEntry(ctx context.Context, actionID string) (*acteon.DlqEntryDetail, error) {
+	m.record("GetDlqEntry", actionID)
+	if m.GetDlqEntryFunc == nil {
+		var r0 *acteon.DlqEntryDetail
+		return r0, notStubbed("GetDlqEntry")
+	}
+	return m.GetDlqEntryFunc(ctx, actionID)
+}
+
+// GetDlqPolicy calls GetDlqPolicyFunc.
+func (m *Client) GetDlqPolicy(ctx context.Context) (*acteon.DlqPolicy, error) {
+	m.record("GetDlqPolicy")
+	if m.GetDlqPolicyFunc == nil {
+		var r0 *acteon.DlqPolicy
+		return r0, notStubbed("GetDlqPolicy")
+	}
+	return m.GetDlqPolicyFunc(ctx)
+}
+
+// GetEscalationPolicy calls GetEscalationPolicyFunc.
+func (m *Client) GetEscalationPolicy(ctx context.Context, policyID string) (*acteon.EscalationPolicy, error) {
+	m.record("GetEscalationPolicy", policyID)
+	if m.GetEscalationPolicyFunc == nil {
+		var r0 *acteon.EscalationPolicy
+		return r0, notStubbed("GetEscalationPolicy")
+	}
+	return m.GetEscalationPolicyFunc(ctx, policyID)
+}
+
+// GetEscalationState calls GetEscalationStateFunc.
+func (m *Client) GetEscalationState(ctx context.Context, eventFingerprint string) (*acteon.EscalationState, error) {
+	m.record("GetEscalationState", eventFingerprint)
+	if m.GetEscalationStateFunc == nil {
+		var r0 *acteon.EscalationState
+		return r0, notStubbed("GetEscalationState")
+	}
+	return m.GetEscalationStateFunc(ctx, eventFingerprint)
+}
+
+// GetEvent calls GetEventFunc.
+func (m *Client) GetEvent(ctx context.Context, fingerprint, namespace, tenant string) (*acteon.EventState, error) {
+	m.record("GetEvent", fingerprint, namespace, tenant)
+	if m.GetEventFunc == nil {
+		var r0 *acteon.EventState
+		return r0, notStubbed("GetEvent")
+	}
+	return m.GetEventFunc(ctx, fingerprint, namespace, tenant)
+}
+
+// GetGroup calls GetGroupFunc.
+func (m *Client) GetGroup(ctx context.Context, groupKey string) (*acteon.GroupDetail, error) {
+	m.record("GetGroup", groupKey)
+	if m.GetGroupFunc == nil {
+		var r0 *acteon.GroupDetail
+		return r0, notStubbed("GetGroup")
+	}
+	return m.GetGroupFunc(ctx, groupKey)
+}
+
+// GetGuardrailConfig calls GetGuardrailConfigFunc.
+func (m *Client) GetGuardrailConfig(ctx context.Context) (*acteon.GuardrailConfig, error) {
+	m.record("GetGuardrailConfig")
+	if m.GetGuardrailConfigFunc == nil {
+		var r0 *acteon.GuardrailConfig
+		return r0, notStubbed("GetGuardrailConfig")
+	}
+	return m.GetGuardrailConfigFunc(ctx)
+}
+
+// GetHealthDetail calls GetHealthDetailFunc.
+func (m *Client) GetHealthDetail(ctx context.Context) (*acteon.HealthDetail, error) {
+	m.record("GetHealthDetail")
+	if m.GetHealthDetailFunc == nil {
+		var r0 *acteon.HealthDetail
+		return r0, notStubbed("GetHealthDetail")
+	}
+	return m.GetHealthDetailFunc(ctx)
+}
+
+// GetMaintenanceStatus calls GetMaintenanceStatusFunc.
+func (m *Client) GetMaintenanceStatus(ctx context.Context) (*acteon.MaintenanceStatus, error) {
+	m.record("GetMaintenanceStatus")
+	if m.GetMaintenanceStatusFunc == nil {
+		var r0 *acteon.MaintenanceStatus
+		return r0, notStubbed("GetMaintenanceStatus")
+	}
+	return m.GetMaintenanceStatusFunc(ctx)
+}
+
+// GetMetrics calls GetMetricsFunc.
+func (m *Client) GetMetrics(ctx context.Context) (*acteon.GatewayMetrics, error) {
+	m.record("GetMetrics")
+	if m.GetMetricsFunc == nil {
+		var r0 *acteon.GatewayMetrics
+		return r0, notStubbed("GetMetrics")
+	}
+	return m.GetMetricsFunc(ctx)
+}
+
+// GetNotificationPreferences calls GetNotificationPreferencesFunc.
+func (m *Client) GetNotificationPreferences(ctx context.Context, tenant string) (*acteon.NotificationPreferences, error) {
+	m.record("GetNotificationPreferences", tenant)
+	if m.GetNotificationPreferencesFunc == nil {
+		var r0 *acteon.NotificationPreferences
+		return r0, notStubbed("GetNotificationPreferences")
+	}
+	return m.GetNotificationPreferencesFunc(ctx, tenant)
+}
+
+// GetPlugin calls GetPluginFunc.
+func (m *Client) GetPlugin(ctx context.Context, name string) (*acteon.WasmPlugin, error) {
+	m.record("GetPlugin", name)
+	if m.GetPluginFunc == nil {
+		var r0 *acteon.WasmPlugin
+		return r0, notStubbed("GetPlugin")
+	}
+	return m.GetPluginFunc(ctx, name)
+}
+
+// GetProfile calls GetProfileFunc.
+func (m *Client) GetProfile(ctx context.Context, profileID string) (*acteon.TemplateProfileInfo, error) {
+	m.record("GetProfile", profileID)
+	if m.GetProfileFunc == nil {
+		var r0 *acteon.TemplateProfileInfo
+		return r0, notStubbed("GetProfile")
+	}
+	return m.GetProfileFunc(ctx, profileID)
+}
+
+// GetQuota calls GetQuotaFunc.
+func (m *Client) GetQuota(ctx context.Context, quotaID string) (*acteon.QuotaPolicy, error) {
+	m.record("GetQuota", quotaID)
+	if m.GetQuotaFunc == nil {
+		var r0 *acteon.QuotaPolicy
+		return r0, notStubbed("GetQuota")
+	}
+	return m.GetQuotaFunc(ctx, quotaID)
+}
+
+// GetQuotaUsage calls GetQuotaUsageFunc.
+func (m *Client) GetQuotaUsage(ctx context.Context, quotaID string) (*acteon.QuotaUsage, error) {
+	m.record("GetQuotaUsage", quotaID)
+	if m.GetQuotaUsageFunc == nil {
+		var r0 *acteon.QuotaUsage
+		return r0, notStubbed("GetQuotaUsage")
+	}
+	return m.GetQuotaUsageFunc(ctx, quotaID)
+}
+
+// GetRecurring calls GetRecurringFunc.
+func (m *Client) GetRecurring(ctx context.Context, recurringID, namespace, tenant string) (*acteon.RecurringDetail, error) {
+	m.record("GetRecurring", recurringID, namespace, tenant)
+	if m.GetRecurringFunc == nil {
+		var r0 *acteon.RecurringDetail
+		return r0, notStubbed("GetRecurring")
+	}
+	return m.GetRecurringFunc(ctx, recurringID, namespace, tenant)
+}
+
+// GetRetention calls GetRetentionFunc.
+func (m *Client) GetRetention(ctx context.Context, retentionID string) (*acteon.RetentionPolicy, error) {
+	m.record("GetRetention", retentionID)
+	if m.GetRetentionFunc == nil {
+		var r0 *acteon.RetentionPolicy
+		return r0, notStubbed("GetRetention")
+	}
+	return m.GetRetentionFunc(ctx, retentionID)
+}
+
+// GetRetentionArchiveStatus calls GetRetentionArchiveStatusFunc.
+func (m *Client) GetRetentionArchiveStatus(ctx context.Context, retentionID string) (*acteon.RetentionArchiveStatus, error) {
+	m.record("GetRetentionArchiveStatus", retentionID)
+	if m.GetRetentionArchiveStatusFunc == nil {
+		var r0 *acteon.RetentionArchiveStatus
+		return r0, notStubbed("GetRetentionArchiveStatus")
+	}
+	return m.GetRetentionArchiveStatusFunc(ctx, retentionID)
+}
+
+// GetServerInfo calls GetServerInfoFunc.
+func (m *Client) GetServerInfo(ctx context.Context) (*acteon.ServerInfo, error) {
+	m.record("GetServerInfo")
+	if m.GetServerInfoFunc == nil {
+		var r0 *acteon.ServerInfo
+		return r0, notStubbed("GetServerInfo")
+	}
+	return m.GetServerInfoFunc(ctx)
+}
+
+// GetSilence calls GetSilenceFunc.
+func (m *Client) GetSilence(ctx context.Context, silenceID string) (*acteon.Silence, error) {
+	m.record("GetSilence", silenceID)
+	if m.GetSilenceFunc == nil {
+		var r0 *acteon.Silence
+		return r0, notStubbed("GetSilence")
+	}
+	return m.GetSilenceFunc(ctx, silenceID)
+}
+
+// GetSwarmRun calls GetSwarmRunFunc.
+func (m *Client) GetSwarmRun(ctx context.Context, runID string) (*acteon.SwarmRunSnapshot, error) {
+	m.record("GetSwarmRun", runID)
+	if m.GetSwarmRunFunc == nil {
+		var r0 *acteon.SwarmRunSnapshot
+		return r0, notStubbed("GetSwarmRun")
+	}
+	return m.GetSwarmRunFunc(ctx, runID)
+}
+
+// GetTask calls GetTaskFunc.
+func (m *Client) GetTask(ctx context.Context, taskID, namespace, tenant string) (*acteon.WorkerTask, error) {
+	m.record("GetTask", taskID, namespace, tenant)
+	if m.GetTaskFunc == nil {
+		var r0 *acteon.WorkerTask
+		return r0, notStubbed("GetTask")
+	}
+	return m.GetTaskFunc(ctx, taskID, namespace, tenant)
+}
+
+// GetTemplate calls GetTemplateFunc.
+func (m *Client) GetTemplate(ctx context.Context, templateID string) (*acteon.TemplateInfo, error) {
+	m.record("GetTemplate", templateID)
+	if m.GetTemplateFunc == nil {
+		var r0 *acteon.TemplateInfo
+		return r0, notStubbed("GetTemplate")
+	}
+	return m.GetTemplateFunc(ctx, templateID)
+}
+
+// GetTemplateUsage calls GetTemplateUsageFunc.
+func (m *Client) GetTemplateUsage(ctx context.Context, templateID string, window time.Duration) (*acteon.TemplateUsageResponse, error) {
+	m.record("GetTemplateUsage", templateID, window)
+	if m.GetTemplateUsageFunc == nil {
+		var r0 *acteon.TemplateUsageResponse
+		return r0, notStubbed("GetTemplateUsage")
+	}
+	return m.GetTemplateUsageFunc(ctx, templateID, window)
+}
+
+// GetThrottle calls GetThrottleFunc.
+func (m *Client) GetThrottle(ctx context.Context, throttleID string) (*acteon.ThrottlePolicy, error) {
+	m.record("GetThrottle", throttleID)
+	if m.GetThrottleFunc == nil {
+		var r0 *acteon.ThrottlePolicy
+		return r0, notStubbed("GetThrottle")
+	}
+	return m.GetThrottleFunc(ctx, throttleID)
+}
+
+// GetTimeInterval calls GetTimeIntervalFunc.
+func (m *Client) GetTimeInterval(ctx context.Context, namespace, tenant, name string) (*acteon.TimeInterval, error) {
+	m.record("GetTimeInterval", namespace, tenant, name)
+	if m.GetTimeIntervalFunc == nil {
+		var r0 *acteon.TimeInterval
+		return r0, notStubbed("GetTimeInterval")
+	}
+	return m.GetTimeIntervalFunc(ctx, namespace, tenant, name)
+}
+
+// Health calls HealthFunc.
+func (m *Client) Health(ctx context.Context) (bool, error) {
+	m.record("Health")
+	if m.HealthFunc == nil {
+		var r0 bool
+		return r0, notStubbed("Health")
+	}
+	return m.HealthFunc(ctx)
+}
+
+// HeartbeatBusAgent calls HeartbeatBusAgentFunc.
+func (m *Client) HeartbeatBusAgent(ctx context.Context, namespace, tenant, agentID string) (*acteon.BusAgent, error) {
+	m.record("HeartbeatBusAgent", namespace, tenant, agentID)
+	if m.HeartbeatBusAgentFunc == nil {
+		var r0 *acteon.BusAgent
+		return r0, notStubbed("HeartbeatBusAgent")
+	}
+	return m.HeartbeatBusAgentFunc(ctx, namespace, tenant, agentID)
+}
+
+// HeartbeatTask calls HeartbeatTaskFunc.
+func (m *Client) HeartbeatTask(ctx context.Context, taskID string, req *acteon.HeartbeatTaskRequest) (*acteon.WorkerTask, error) {
+	m.record("HeartbeatTask", taskID, req)
+	if m.HeartbeatTaskFunc == nil {
+		var r0 *acteon.WorkerTask
+		return r0, notStubbed("HeartbeatTask")
+	}
+	return m.HeartbeatTaskFunc(ctx, taskID, req)
+}
+
+// ImportTemplates calls ImportTemplatesFunc.
+func (m *Client) ImportTemplates(ctx context.Context, r io.Reader, opts acteon.ImportOptions) (*acteon.ImportResult, error) {
+	m.record("ImportTemplates", r, opts)
+	if m.ImportTemplatesFunc == nil {
+		var r0 *acteon.ImportResult
+		return r0, notStubbed("ImportTemplates")
+	}
+	return m.ImportTemplatesFunc(ctx, r, opts)
+}
+
+// InspectPlugin calls InspectPluginFunc.
+func (m *Client) InspectPlugin(ctx context.Context, name string) (*acteon.PluginInspection, error) {
+	m.record("InspectPlugin", name)
+	if m.InspectPluginFunc == nil {
+		var r0 *acteon.PluginInspection
+		return r0, notStubbed("InspectPlugin")
+	}
+	return m.InspectPluginFunc(ctx, name)
+}
+
+// InvokePlugin calls InvokePluginFunc.
+func (m *Client) InvokePlugin(ctx context.Context, name string, req *acteon.PluginInvocationRequest) (*acteon.PluginInvocationResponse, error) {
+	m.record("InvokePlugin", name, req)
+	if m.InvokePluginFunc == nil {
+		var r0 *acteon.PluginInvocationResponse
+		return r0, notStubbed("InvokePlugin")
+	}
+	return m.InvokePluginFunc(ctx, name, req)
+}
+
+// InvokePluginBatch calls InvokePluginBatchFunc.
+func (m *Client) InvokePluginBatch(ctx context.Context, name string, reqs []acteon.PluginInvocationRequest) (*acteon.PluginBatchInvocationResponse, error) {
+	m.record("InvokePluginBatch", name, reqs)
+	if m.InvokePluginBatchFunc == nil {
+		var r0 *acteon.PluginBatchInvocationResponse
+		return r0, notStubbed("InvokePluginBatch")
+	}
+	return m.InvokePluginBatchFunc(ctx, name, reqs)
+}
+
+// ListApprovals calls ListApprovalsFunc.
+func (m *Client) ListApprovals(ctx context.Context, namespace, tenant string) (*acteon.ApprovalListResponse, error) {
+	m.record("ListApprovals", namespace, tenant)
+	if m.ListApprovalsFunc == nil {
+		var r0 *acteon.ApprovalListResponse
+		return r0, notStubbed("ListApprovals")
+	}
+	return m.ListApprovalsFunc(ctx, namespace, tenant)
+}
+
+// ListBackups calls ListBackupsFunc.
+func (m *Client) ListBackups(ctx context.Context) (*acteon.ListBackupsResponse, error) {
+	m.record("ListBackups")
+	if m.ListBackupsFunc == nil {
+		var r0 *acteon.ListBackupsResponse
+		return r0, notStubbed("ListBackups")
+	}
+	return m.ListBackupsFunc(ctx)
+}
+
+// ListBusAgents calls ListBusAgentsFunc.
+func (m *Client) ListBusAgents(ctx context.Context, filter *acteon.ListBusAgentsFilter) ([]acteon.BusAgent, error) {
+	m.record("ListBusAgents", filter)
+	if m.ListBusAgentsFunc == nil {
+		var r0 []acteon.BusAgent
+		return r0, notStubbed("ListBusAgents")
+	}
+	return m.ListBusAgentsFunc(ctx, filter)
+}
+
+// ListBusApprovals calls ListBusApprovalsFunc.
+func (m *Client) ListBusApprovals(ctx context.Context, namespace, tenant string, filter *acteon.ListBusApprovalsFilter) ([]acteon.BusApprovalView, error) {
+	m.record("ListBusApprovals", namespace, tenant, filter)
+	if m.ListBusApprovalsFunc == nil {
+		var r0 []acteon.BusApprovalView
+		return r0, notStubbed("ListBusApprovals")
+	}
+	return m.ListBusApprovalsFunc(ctx, namespace, tenant, filter)
+}
+
+// ListBusConversations calls ListBusConversationsFunc.
+func (m *Client) ListBusConversations(ctx context.Context, filter *acteon.ListBusConversationsFilter) ([]acteon.BusConversation, error) {
+	m.record("ListBusConversations", filter)
+	if m.ListBusConversationsFunc == nil {
+		var r0 []acteon.BusConversation
+		return r0, notStubbed("ListBusConversations")
+	}
+	return m.ListBusConversationsFunc(ctx, filter)
+}
+
+// ListBusSchemas calls ListBusSchemasFunc.
+func (m *Client) ListBusSchemas(ctx context.Context, filter *acteon.ListBusSchemasFilter) ([]acteon.BusSchema, error) {
+	m.record("ListBusSchemas", filter)
+	if m.ListBusSchemasFunc == nil {
+		var r0 []acteon.BusSchema
+		return r0, notStubbed("ListBusSchemas")
+	}
+	return m.ListBusSchemasFunc(ctx, filter)
+}
+
+// ListBusSubscriptions calls ListBusSubscriptionsFunc.
+func (m *Client) ListBusSubscriptions(ctx context.Context, filter *acteon.ListBusSubscriptionsFilter) ([]acteon.BusSubscription, error) {
+	m.record("ListBusSubscriptions", filter)
+	if m.ListBusSubscriptionsFunc == nil {
+		var r0 []acteon.BusSubscription
+		return r0, notStubbed("ListBusSubscriptions")
+	}
+	return m.ListBusSubscriptionsFunc(ctx, filter)
+}
+
+// ListBusTopics calls ListBusTopicsFunc.
+func (m *Client) ListBusTopics(ctx context.Context, filter *acteon.ListBusTopicsFilter) ([]acteon.BusTopic, error) {
+	m.record("ListBusTopics", filter)
+	if m.ListBusTopicsFunc == nil {
+		var r0 []acteon.BusTopic
+		return r0, notStubbed("ListBusTopics")
+	}
+	return m.ListBusTopicsFunc(ctx, filter)
+}
+
+// ListChains calls ListChainsFunc.
+func (m *Client) ListChains(ctx context.Context, namespace, tenant string, status *string) (*acteon.ListChainsResponse, error) {
+	m.record("ListChains", namespace, tenant, status)
+	if m.ListChainsFunc == nil {
+		var r0 *acteon.ListChainsResponse
+		return r0, notStubbed("ListChains")
+	}
+	return m.ListChainsFunc(ctx, namespace, tenant, status)
+}
+
+// ListDlq calls ListDlqFunc.
+func (m *Client) ListDlq(ctx context.Context, filter acteon.DlqFilter) (*acteon.DlqListResponse, error) {
+	m.record("ListDlq", filter)
+	if m.ListDlqFunc == nil {
+		var r0 *acteon.DlqListResponse
+		return r0, notStubbed("ListDlq")
+	}
+	return m.ListDlqFunc(ctx, filter)
+}
+
+// ListEscalationPolicies calls ListEscalationPoliciesFunc.
+func (m *Client) ListEscalationPolicies(ctx context.Context, namespace, tenant *string) (*acteon.ListEscalationPoliciesResponse, error) {
+	m.record("ListEscalationPolicies", namespace, tenant)
+	if m.ListEscalationPoliciesFunc == nil {
+		var r0 *acteon.ListEscalationPoliciesResponse
+		return r0, notStubbed("ListEscalationPolicies")
+	}
+	return m.ListEscalationPoliciesFunc(ctx, namespace, tenant)
+}
+
+// ListEvents calls ListEventsFunc.
+func (m *Client) ListEvents(ctx context.Context, query *acteon.EventQuery) (*acteon.EventListResponse, error) {
+	m.record("ListEvents", query)
+	if m.ListEventsFunc == nil {
+		var r0 *acteon.EventListResponse
+		return r0, notStubbed("ListEvents")
+	}
+	return m.ListEventsFunc(ctx, query)
+}
+
+// ListGroups calls ListGroupsFunc.
+func (m *Client) ListGroups(ctx context.Context) (*acteon.GroupListResponse, error) {
+	m.record("ListGroups")
+	if m.ListGroupsFunc == nil {
+		var r0 *acteon.GroupListResponse
+		return r0, notStubbed("ListGroups")
+	}
+	return m.ListGroupsFunc(ctx)
+}
+
+// ListPluginVersions calls ListPluginVersionsFunc.
+func (m *Client) ListPluginVersions(ctx context.Context, name string) (*acteon.ListPluginVersionsResponse, error) {
+	m.record("ListPluginVersions", name)
+	if m.ListPluginVersionsFunc == nil {
+		var r0 *acteon.ListPluginVersionsResponse
+		return r0, notStubbed("ListPluginVersions")
+	}
+	return m.ListPluginVersionsFunc(ctx, name)
+}
+
+// ListPlugins calls ListPluginsFunc.
+func (m *Client) ListPlugins(ctx context.Context) (*acteon.ListPluginsResponse, error) {
+	m.record("ListPlugins")
+	if m.ListPluginsFunc == nil {
+		var r0 *acteon.ListPluginsResponse
+		return r0, notStubbed("ListPlugins")
+	}
+	return m.ListPluginsFunc(ctx)
+}
+
+// ListProfiles calls ListProfilesFunc.
+func (m *Client) ListProfiles(ctx context.Context, namespace, tenant *string) (*acteon.ListProfilesResponse, error) {
+	m.record("ListProfiles", namespace, tenant)
+	if m.ListProfilesFunc == nil {
+		var r0 *acteon.ListProfilesResponse
+		return r0, notStubbed("ListProfiles")
+	}
+	return m.ListProfilesFunc(ctx, namespace, tenant)
+}
+
+// ListProviderConfigs calls ListProviderConfigsFunc.
+func (m *Client) ListProviderConfigs(ctx context.Context, tenant *string) (*acteon.ListProviderConfigsResponse, error) {
+	m.record("ListProviderConfigs", tenant)
+	if m.ListProviderConfigsFunc == nil {
+		var r0 *acteon.ListProviderConfigsResponse
+		return r0, notStubbed("ListProviderConfigs")
+	}
+	return m.ListProviderConfigsFunc(ctx, tenant)
+}
+
+// ListProviderHealth calls ListProviderHealthFunc.
+func (m *Client) ListProviderHealth(ctx context.Context) (*acteon.ListProviderHealthResponse, error) {
+	m.record("ListProviderHealth")
+	if m.ListProviderHealthFunc == nil {
+		var r0 *acteon.ListProviderHealthResponse
+		return r0, notStubbed("ListProviderHealth")
+	}
+	return m.ListProviderHealthFunc(ctx)
+}
+
+// ListProviders calls ListProvidersFunc.
+func (m *Client) ListProviders(ctx context.Context) (*acteon.ListProvidersResponse, error) {
+	m.record("ListProviders")
+	if m.ListProvidersFunc == nil {
+		var r0 *acteon.ListProvidersResponse
+		return r0, notStubbed("ListProviders")
+	}
+	return m.ListProvidersFunc(ctx)
+}
+
+// ListQuotas calls ListQuotasFunc.
+func (m *Client) ListQuotas(ctx context.Context, namespace, tenant, provider, principal *string) (*acteon.ListQuotasResponse, error) {
+	m.record("ListQuotas", namespace, tenant, provider, principal)
+	if m.ListQuotasFunc == nil {
+		var r0 *acteon.ListQuotasResponse
+		return r0, notStubbed("ListQuotas")
+	}
+	return m.ListQuotasFunc(ctx, namespace, tenant, provider, principal)
+}
+
+// ListRecurring calls ListRecurringFunc.
+func (m *Client) ListRecurring(ctx context.Context, filter *acteon.RecurringFilter) (*acteon.ListRecurringResponse, error) {
+	m.record("ListRecurring", filter)
+	if m.ListRecurringFunc == nil {
+		var r0 *acteon.ListRecurringResponse
+		return r0, notStubbed("ListRecurring")
+	}
+	return m.ListRecurringFunc(ctx, filter)
+}
+
+// ListRetention calls ListRetentionFunc.
+func (m *Client) ListRetention(ctx context.Context, namespace, tenant *string, limit, offset *int) (*acteon.ListRetentionResponse, error) {
+	m.record("ListRetention", namespace, tenant, limit, offset)
+	if m.ListRetentionFunc == nil {
+		var r0 *acteon.ListRetentionResponse
+		return r0, notStubbed("ListRetention")
+	}
+	return m.ListRetentionFunc(ctx, namespace, tenant, limit, offset)
+}
+
+// ListRoleBindings calls ListRoleBindingsFunc.
+func (m *Client) ListRoleBindings(ctx context.Context, namespace, tenant *string) (*acteon.ListRoleBindingsResponse, error) {
+	m.record("ListRoleBindings", namespace, tenant)
+	if m.ListRoleBindingsFunc == nil {
+		var r0 *acteon.ListRoleBindingsResponse
+		return r0, notStubbed("ListRoleBindings")
+	}
+	return m.ListRoleBindingsFunc(ctx, namespace, tenant)
+}
+
+// ListRoles calls ListRolesFunc.
+func (m *Client) ListRoles(ctx context.Context) ([]acteon.RoleInfo, error) {
+	m.record("ListRoles")
+	if m.ListRolesFunc == nil {
+		var r0 []acteon.RoleInfo
+		return r0, notStubbed("ListRoles")
+	}
+	return m.ListRolesFunc(ctx)
+}
+
+// ListRules calls ListRulesFunc.
+func (m *Client) ListRules(ctx context.Context) ([]acteon.RuleInfo, error) {
+	m.record("ListRules")
+	if m.ListRulesFunc == nil {
+		var r0 []acteon.RuleInfo
+		return r0, notStubbed("ListRules")
+	}
+	return m.ListRulesFunc(ctx)
+}
+
+// ListSecrets calls ListSecretsFunc.
+func (m *Client) ListSecrets(ctx context.Context, scope string) (*acteon.ListSecretsResponse, error) {
+	m.record("ListSecrets", scope)
+	if m.ListSecretsFunc == nil {
+		var r0 *acteon.ListSecretsResponse
+		return r0, notStubbed("ListSecrets")
+	}
+	return m.ListSecretsFunc(ctx, scope)
+}
+
+// ListSilences calls ListSilencesFunc.
+func (m *Client) ListSilences(ctx context.Context, namespace, tenant *string, includeExpired bool) (*acteon.ListSilencesResponse, error) {
+	m.record("ListSilences", namespace, tenant, includeExpired)
+	if m.ListSilencesFunc == nil {
+		var r0 *acteon.ListSilencesResponse
+		return r0, notStubbed("ListSilences")
+	}
+	return m.ListSilencesFunc(ctx, namespace, tenant, includeExpired)
+}
+
+// ListSwarmRuns calls ListSwarmRunsFunc.
+func (m *Client) ListSwarmRuns(ctx context.Context, filter *acteon.SwarmRunFilter) (*acteon.ListSwarmRunsResponse, error) {
+	m.record("ListSwarmRuns", filter)
+	if m.ListSwarmRunsFunc == nil {
+		var r0 *acteon.ListSwarmRunsResponse
+		return r0, notStubbed("ListSwarmRuns")
+	}
+	return m.ListSwarmRunsFunc(ctx, filter)
+}
+
+// ListTasks calls ListTasksFunc.
+func (m *Client) ListTasks(ctx context.Context, queue, namespace, tenant, status string) ([]acteon.WorkerTask, error) {
+	m.record("ListTasks", queue, namespace, tenant, status)
+	if m.ListTasksFunc == nil {
+		var r0 []acteon.WorkerTask
+		return r0, notStubbed("ListTasks")
+	}
+	return m.ListTasksFunc(ctx, queue, namespace, tenant, status)
+}
+
+// ListTemplates calls ListTemplatesFunc.
+func (m *Client) ListTemplates(ctx context.Context, namespace, tenant *string) (*acteon.ListTemplatesResponse, error) {
+	m.record("ListTemplates", namespace, tenant)
+	if m.ListTemplatesFunc == nil {
+		var r0 *acteon.ListTemplatesResponse
+		return r0, notStubbed("ListTemplates")
+	}
+	return m.ListTemplatesFunc(ctx, namespace, tenant)
+}
+
+// ListThrottles calls ListThrottlesFunc.
+func (m *Client) ListThrottles(ctx context.Context, namespace, tenant, provider, actionType *string) (*acteon.ListThrottlesResponse, error) {
+	m.record("ListThrottles", namespace, tenant, provider, actionType)
+	if m.ListThrottlesFunc == nil {
+		var r0 *acteon.ListThrottlesResponse
+		return r0, notStubbed("ListThrottles")
+	}
+	return m.ListThrottlesFunc(ctx, namespace, tenant, provider, actionType)
+}
+
+// ListTimeIntervals calls ListTimeIntervalsFunc.
+func (m *Client) ListTimeIntervals(ctx context.Context, namespace, tenant *string) (*acteon.ListTimeIntervalsResponse, error) {
+	m.record("ListTimeIntervals", namespace, tenant)
+	if m.ListTimeIntervalsFunc == nil {
+		var r0 *acteon.ListTimeIntervalsResponse
+		return r0, notStubbed("ListTimeIntervals")
+	}
+	return m.ListTimeIntervalsFunc(ctx, namespace, tenant)
+}
+
+// LookupBusToolResult calls LookupBusToolResultFunc.
+func (m *Client) LookupBusToolResult(ctx context.Context, namespace, tenant, callID string, params *acteon.BusToolResultLookupParams) (*acteon.BusToolResultLookup, error) {
+	m.record("LookupBusToolResult", namespace, tenant, callID, params)
+	if m.LookupBusToolResultFunc == nil {
+		var r0 *acteon.BusToolResultLookup
+		return r0, notStubbed("LookupBusToolResult")
+	}
+	return m.LookupBusToolResultFunc(ctx, namespace, tenant, callID, params)
+}
+
+// PauseRecurring calls PauseRecurringFunc.
+func (m *Client) PauseRecurring(ctx context.Context, recurringID, namespace, tenant string) (*acteon.RecurringDetail, error) {
+	m.record("PauseRecurring", recurringID, namespace, tenant)
+	if m.PauseRecurringFunc == nil {
+		var r0 *acteon.RecurringDetail
+		return r0, notStubbed("PauseRecurring")
+	}
+	return m.PauseRecurringFunc(ctx, recurringID, namespace, tenant)
+}
+
+// PollTasks calls PollTasksFunc.
+func (m *Client) PollTasks(ctx context.Context, queue string, req *acteon.PollTasksRequest) ([]acteon.WorkerTask, error) {
+	m.record("PollTasks", queue, req)
+	if m.PollTasksFunc == nil {
+		var r0 []acteon.WorkerTask
+		return r0, notStubbed("PollTasks")
+	}
+	return m.PollTasksFunc(ctx, queue, req)
+}
+
+// PostBusStreamChunk calls PostBusStreamChunkFunc.
+func (m *Client) PostBusStreamChunk(ctx context.Context, namespace, tenant, conversationID string, req *acteon.PostBusStreamChunk) (*acteon.BusStreamEnvelopeReceipt, error) {
+	m.record("PostBusStreamChunk", namespace, tenant, conversationID, req)
+	if m.PostBusStreamChunkFunc == nil {
+		var r0 *acteon.BusStreamEnvelopeReceipt
+		return r0, notStubbed("PostBusStreamChunk")
+	}
+	return m.PostBusStreamChunkFunc(ctx, namespace, tenant, conversationID, req)
+}
+
+// PostBusStreamEnd calls PostBusStreamEndFunc.
+func (m *Client) PostBusStreamEnd(ctx context.Context, namespace, tenant, conversationID string, req *acteon.PostBusStreamEnd) (*acteon.BusStreamEnvelopeReceipt, error) {
+	m.record("PostBusStreamEnd", namespace, tenant, conversationID, req)
+	if m.PostBusStreamEndFunc == nil {
+		var r0 *acteon.BusStreamEnvelopeReceipt
+		return r0, notStubbed("PostBusStreamEnd")
+	}
+	return m.PostBusStreamEndFunc(ctx, namespace, tenant, conversationID, req)
+}
+
+// PostBusToolCall calls PostBusToolCallFunc.
+func (m *Client) PostBusToolCall(ctx context.Context, namespace, tenant, conversationID string, req *acteon.PostBusToolCall) (*acteon.PostBusToolCallOutcome, error) {
+	m.record("PostBusToolCall", namespace, tenant, conversationID, req)
+	if m.PostBusToolCallFunc == nil {
+		var r0 *acteon.PostBusToolCallOutcome
+		return r0, notStubbed("PostBusToolCall")
+	}
+	return m.PostBusToolCallFunc(ctx, namespace, tenant, conversationID, req)
+}
+
+// PostBusToolResult calls PostBusToolResultFunc.
+func (m *Client) PostBusToolResult(ctx context.Context, namespace, tenant, conversationID string, req *acteon.PostBusToolResult) (*acteon.BusToolEnvelopeReceipt, error) {
+	m.record("PostBusToolResult", namespace, tenant, conversationID, req)
+	if m.PostBusToolResultFunc == nil {
+		var r0 *acteon.BusToolEnvelopeReceipt
+		return r0, notStubbed("PostBusToolResult")
+	}
+	return m.PostBusToolResultFunc(ctx, namespace, tenant, conversationID, req)
+}
+
+// ProvideChainStepInput calls ProvideChainStepInputFunc.
+func (m *Client) ProvideChainStepInput(ctx context.Context, chainID, stepName string, req *acteon.ProvideStepInputRequest) (*acteon.ChainDetailResponse, error) {
+	m.record("ProvideChainStepInput", chainID, stepName, req)
+	if m.ProvideChainStepInputFunc == nil {
+		var r0 *acteon.ChainDetailResponse
+		return r0, notStubbed("ProvideChainStepInput")
+	}
+	return m.ProvideChainStepInputFunc(ctx, chainID, stepName, req)
+}
+
+// PublishBusMessage calls PublishBusMessageFunc.
+func (m *Client) PublishBusMessage(ctx context.Context, req *acteon.PublishBusMessage) (*acteon.PublishReceipt, error) {
+	m.record("PublishBusMessage", req)
+	if m.PublishBusMessageFunc == nil {
+		var r0 *acteon.PublishReceipt
+		return r0, notStubbed("PublishBusMessage")
+	}
+	return m.PublishBusMessageFunc(ctx, req)
+}
+
+// PurgeCache calls PurgeCacheFunc.
+func (m *Client) PurgeCache() {
+	m.record("PurgeCache")
+	if m.PurgeCacheFunc == nil {
+		return
+	}
+	m.PurgeCacheFunc()
+}
+
+// PurgeDlq calls PurgeDlqFunc.
+func (m *Client) PurgeDlq(ctx context.Context, filter acteon.DlqPurgeFilter) (int, error) {
+	m.record("PurgeDlq", filter)
+	if m.PurgeDlqFunc == nil {
+		var r0 int
+		return r0, notStubbed("PurgeDlq")
+	}
+	return m.PurgeDlqFunc(ctx, filter)
+}
+
+// PutChainDefinition calls PutChainDefinitionFunc.
+func (m *Client) PutChainDefinition(ctx context.Context, def *acteon.ChainDefinition) (*acteon.ChainDefinition, error) {
+	m.record("PutChainDefinition", def)
+	if m.PutChainDefinitionFunc == nil {
+		var r0 *acteon.ChainDefinition
+		return r0, notStubbed("PutChainDefinition")
+	}
+	return m.PutChainDefinitionFunc(ctx, def)
+}
+
+// PutNotificationPreferences calls PutNotificationPreferencesFunc.
+func (m *Client) PutNotificationPreferences(ctx context.Context, tenant string, prefs *acteon.NotificationPreferences) (*acteon.NotificationPreferences, error) {
+	m.record("PutNotificationPreferences", tenant, prefs)
+	if m.PutNotificationPreferencesFunc == nil {
+		var r0 *acteon.NotificationPreferences
+		return r0, notStubbed("PutNotificationPreferences")
+	}
+	return m.PutNotificationPreferencesFunc(ctx, tenant, prefs)
+}
+
+// PutSecret calls PutSecretFunc.
+func (m *Client) PutSecret(ctx context.Context, scope, name, value string) (*acteon.SecretInfo, error) {
+	m.record("PutSecret", scope, name, value)
+	if m.PutSecretFunc == nil {
+		var r0 *acteon.SecretInfo
+		return r0, notStubbed("PutSecret")
+	}
+	return m.PutSecretFunc(ctx, scope, name, value)
+}
+
+// QueryAnalytics calls QueryAnalyticsFunc.
+func (m *Client) QueryAnalytics(ctx context.Context, query *acteon.AnalyticsQuery) (*acteon.AnalyticsResponse, error) {
+	m.record("QueryAnalytics", query)
+	if m.QueryAnalyticsFunc == nil {
+		var r0 *acteon.AnalyticsResponse
+		return r0, notStubbed("QueryAnalytics")
+	}
+	return m.QueryAnalyticsFunc(ctx, query)
+}
+
+// QueryAudit calls QueryAuditFunc.
+func (m *Client) QueryAudit(ctx context.Context, query *acteon.AuditQuery) (*acteon.AuditPage, error) {
+	m.record("QueryAudit", query)
+	if m.QueryAuditFunc == nil {
+		var r0 *acteon.AuditPage
+		return r0, notStubbed("QueryAudit")
+	}
+	return m.QueryAuditFunc(ctx, query)
+}
+
+// Ready calls ReadyFunc.
+func (m *Client) Ready(ctx context.Context) (*acteon.Readiness, error) {
+	m.record("Ready")
+	if m.ReadyFunc == nil {
+		var r0 *acteon.Readiness
+		return r0, notStubbed("Ready")
+	}
+	return m.ReadyFunc(ctx)
+}
+
+// RegisterBusAgent calls RegisterBusAgentFunc.
+func (m *Client) RegisterBusAgent(ctx context.Context, req *acteon.RegisterBusAgent) (*acteon.BusAgent, error) {
+	m.record("RegisterBusAgent", req)
+	if m.RegisterBusAgentFunc == nil {
+		var r0 *acteon.BusAgent
+		return r0, notStubbed("RegisterBusAgent")
+	}
+	return m.RegisterBusAgentFunc(ctx, req)
+}
+
+// RegisterBusSchema calls RegisterBusSchemaFunc.
+func (m *Client) RegisterBusSchema(ctx context.Context, req *acteon.RegisterBusSchema) (*acteon.BusSchema, error) {
+	m.record("RegisterBusSchema", req)
+	if m.RegisterBusSchemaFunc == nil {
+		var r0 *acteon.BusSchema
+		return r0, notStubbed("RegisterBusSchema")
+	}
+	return m.RegisterBusSchemaFunc(ctx, req)
+}
+
+// RegisterPlugin calls RegisterPluginFunc.
+func (m *Client) RegisterPlugin(ctx context.Context, req *acteon.RegisterPluginRequest) (*acteon.WasmPlugin, error) {
+	m.record("RegisterPlugin", req)
+	if m.RegisterPluginFunc == nil {
+		var r0 *acteon.WasmPlugin
+		return r0, notStubbed("RegisterPlugin")
+	}
+	return m.RegisterPluginFunc(ctx, req)
+}
+
+// RegisterPluginFromFile calls RegisterPluginFromFileFunc.
+func (m *Client) RegisterPluginFromFile(ctx context.Context, name, path string, opts *acteon.PluginUploadOptions) (*acteon.WasmPlugin, error) {
+	m.record("RegisterPluginFromFile", name, path, opts)
+	if m.RegisterPluginFromFileFunc == nil {
+		var r0 *acteon.WasmPlugin
+		return r0, notStubbed("RegisterPluginFromFile")
+	}
+	return m.RegisterPluginFromFileFunc(ctx, name, path, opts)
+}
+
+// RegisterPluginFromReader calls RegisterPluginFromReaderFunc.
+func (m *Client) RegisterPluginFromReader(ctx context.Context, name string, r io.Reader, size int64, opts *acteon.PluginUploadOptions) (*acteon.WasmPlugin, error) {
+	m.record("RegisterPluginFromReader", name, r, size, opts)
+	if m.RegisterPluginFromReaderFunc == nil {
+		var r0 *acteon.WasmPlugin
+		return r0, notStubbed("RegisterPluginFromReader")
+	}
+	return m.RegisterPluginFromReaderFunc(ctx, name, r, size, opts)
+}
+
+// RegisterPluginVersion calls RegisterPluginVersionFunc.
+func (m *Client) RegisterPluginVersion(ctx context.Context, name string, req *acteon.RegisterPluginVersionRequest) (*acteon.PluginVersion, error) {
+	m.record("RegisterPluginVersion", name, req)
+	if m.RegisterPluginVersionFunc == nil {
+		var r0 *acteon.PluginVersion
+		return r0, notStubbed("RegisterPluginVersion")
+	}
+	return m.RegisterPluginVersionFunc(ctx, name, req)
+}
+
+// Reject calls RejectFunc.
+func (m *Client) Reject(ctx context.Context, namespace, tenant, id, sig string, expiresAt int64, kid string) (*acteon.ApprovalActionResponse, error) {
+	m.record("Reject", namespace, tenant, id, sig, expiresAt, kid)
+	if m.RejectFunc == nil {
+		var r0 *acteon.ApprovalActionResponse
+		return r0, notStubbed("Reject")
+	}
+	return m.RejectFunc(ctx, namespace, tenant, id, sig, expiresAt, kid)
+}
+
+// RejectBusApproval calls RejectBusApprovalFunc.
+func (m *Client) RejectBusApproval(ctx context.Context, namespace, tenant, approvalID string, decision *acteon.BusApprovalDecision) (*acteon.BusApprovalDecisionResponse, error) {
+	m.record("RejectBusApproval", namespace, tenant, approvalID, decision)
+	if m.RejectBusApprovalFunc == nil {
+		var r0 *acteon.BusApprovalDecisionResponse
+		return r0, notStubbed("RejectBusApproval")
+	}
+	return m.RejectBusApprovalFunc(ctx, namespace, tenant, approvalID, decision)
+}
+
+// ReloadConfig calls ReloadConfigFunc.
+func (m *Client) ReloadConfig(ctx context.Context) (*acteon.ConfigReloadResult, error) {
+	m.record("ReloadConfig")
+	if m.ReloadConfigFunc == nil {
+		var r0 *acteon.ConfigReloadResult
+		return r0, notStubbed("ReloadConfig")
+	}
+	return m.ReloadConfigFunc(ctx)
+}
+
+// ReloadRules calls ReloadRulesFunc.
+func (m *Client) ReloadRules(ctx context.Context) (*acteon.ReloadResult, error) {
+	m.record("ReloadRules")
+	if m.ReloadRulesFunc == nil {
+		var r0 *acteon.ReloadResult
+		return r0, notStubbed("ReloadRules")
+	}
+	return m.ReloadRulesFunc(ctx)
+}
+
+// RenderPreview calls RenderPreviewFunc.
+func (m *Client) RenderPreview(ctx context.Context, req *acteon.RenderPreviewRequest) (*acteon.RenderPreviewResponse, error) {
+	m.record("RenderPreview", req)
+	if m.RenderPreviewFunc == nil {
+		var r0 *acteon.RenderPreviewResponse
+		return r0, notStubbed("RenderPreview")
+	}
+	return m.RenderPreviewFunc(ctx, req)
+}
+
+// ReplayAction calls ReplayActionFunc.
+func (m *Client) ReplayAction(ctx context.Context, actionID string) (*acteon.ReplayResult, error) {
+	m.record("ReplayAction", actionID)
+	if m.ReplayActionFunc == nil {
+		var r0 *acteon.ReplayResult
+		return r0, notStubbed("ReplayAction")
+	}
+	return m.ReplayActionFunc(ctx, actionID)
+}
+
+// ReplayAudit calls ReplayAuditFunc.
+func (m *Client) ReplayAudit(ctx context.Context, query *acteon.ReplayQuery) (*acteon.ReplaySummary, error) {
+	m.record("ReplayAudit", query)
+	if m.ReplayAuditFunc == nil {
+		var r0 *acteon.ReplaySummary
+		return r0, notStubbed("ReplayAudit")
+	}
+	return m.ReplayAuditFunc(ctx, query)
+}
+
+// ReplayBusConversationMessages calls ReplayBusConversationMessagesFunc.
+func (m *Client) ReplayBusConversationMessages(ctx context.Context, namespace, tenant, conversationID string, params *acteon.ReplayBusConversationParams) (*acteon.BusReplayResponse, error) {
+	m.record("ReplayBusConversationMessages", namespace, tenant, conversationID, params)
+	if m.ReplayBusConversationMessagesFunc == nil {
+		var r0 *acteon.BusReplayResponse
+		return r0, notStubbed("ReplayBusConversationMessages")
+	}
+	return m.ReplayBusConversationMessagesFunc(ctx, namespace, tenant, conversationID, params)
+}
+
+// ResolveProfile calls ResolveProfileFunc.
+func (m *Client) ResolveProfile(ctx context.Context, profileID string) (*acteon.ResolvedProfile, error) {
+	m.record("ResolveProfile", profileID)
+	if m.ResolveProfileFunc == nil {
+		var r0 *acteon.ResolvedProfile
+		return r0, notStubbed("ResolveProfile")
+	}
+	return m.ResolveProfileFunc(ctx, profileID)
+}
+
+// RestoreBackup calls RestoreBackupFunc.
+func (m *Client) RestoreBackup(ctx context.Context, backupID string, opts acteon.RestoreOptions) (*acteon.RestoreResult, error) {
+	m.record("RestoreBackup", backupID, opts)
+	if m.RestoreBackupFunc == nil {
+		var r0 *acteon.RestoreResult
+		return r0, notStubbed("RestoreBackup")
+	}
+	return m.RestoreBackupFunc(ctx, backupID, opts)
+}
+
+// ResumeRecurring calls ResumeRecurringFunc.
+func (m *Client) ResumeRecurring(ctx context.Context, recurringID, namespace, tenant string) (*acteon.RecurringDetail, error) {
+	m.record("ResumeRecurring", recurringID, namespace, tenant)
+	if m.ResumeRecurringFunc == nil {
+		var r0 *acteon.RecurringDetail
+		return r0, notStubbed("ResumeRecurring")
+	}
+	return m.ResumeRecurringFunc(ctx, recurringID, namespace, tenant)
+}
+
+// RetryChain calls RetryChainFunc.
+func (m *Client) RetryChain(ctx context.Context, chainID string, opts acteon.RetryOptions) (*acteon.ChainDetailResponse, error) {
+	m.record("RetryChain", chainID, opts)
+	if m.RetryChainFunc == nil {
+		var r0 *acteon.ChainDetailResponse
+		return r0, notStubbed("RetryChain")
+	}
+	return m.RetryChainFunc(ctx, chainID, opts)
+}
+
+// RetryDlq calls RetryDlqFunc.
+func (m *Client) RetryDlq(ctx context.Context, filter acteon.DlqFilter, opts acteon.DlqRetryOptions) (*acteon.DlqRetryReport, error) {
+	m.record("RetryDlq", filter, opts)
+	if m.RetryDlqFunc == nil {
+		var r0 *acteon.DlqRetryReport
+		return r0, notStubbed("RetryDlq")
+	}
+	return m.RetryDlqFunc(ctx, filter, opts)
+}
+
+// RetryDlqEntry calls RetryDlqEntryFunc.
+func (m *Client) RetryDlqEntry(ctx context.Context, actionID string) (*acteon.DlqRetryResult, error) {
+	m.record("RetryDlqEntry", actionID)
+	if m.RetryDlqEntryFunc == nil {
+		var r0 *acteon.DlqRetryResult
+		return r0, notStubbed("RetryDlqEntry")
+	}
+	return m.RetryDlqEntryFunc(ctx, actionID)
+}
+
+// RollbackPlugin calls RollbackPluginFunc.
+func (m *Client) RollbackPlugin(ctx context.Context, name string, version int) (*acteon.WasmPlugin, error) {
+	m.record("RollbackPlugin", name, version)
+	if m.RollbackPluginFunc == nil {
+		var r0 *acteon.WasmPlugin
+		return r0, notStubbed("RollbackPlugin")
+	}
+	return m.RollbackPluginFunc(ctx, name, version)
+}
+
+// RulesCoverage calls RulesCoverageFunc.
+func (m *Client) RulesCoverage(ctx context.Context, query *acteon.CoverageQuery) (*acteon.CoverageReport, error) {
+	m.record("RulesCoverage", query)
+	if m.RulesCoverageFunc == nil {
+		var r0 *acteon.CoverageReport
+		return r0, notStubbed("RulesCoverage")
+	}
+	return m.RulesCoverageFunc(ctx, query)
+}
+
+// SetBusAgentAdminState calls SetBusAgentAdminStateFunc.
+func (m *Client) SetBusAgentAdminState(ctx context.Context, namespace, tenant, agentID string, req *acteon.SetBusAgentAdminState) (*acteon.BusAgent, error) {
+	m.record("SetBusAgentAdminState", namespace, tenant, agentID, req)
+	if m.SetBusAgentAdminStateFunc == nil {
+		var r0 *acteon.BusAgent
+		return r0, notStubbed("SetBusAgentAdminState")
+	}
+	return m.SetBusAgentAdminStateFunc(ctx, namespace, tenant, agentID, req)
+}
+
+// SetDlqPolicy calls SetDlqPolicyFunc.
+func (m *Client) SetDlqPolicy(ctx context.Context, policy acteon.DlqPolicy) (*acteon.DlqPolicy, error) {
+	m.record("SetDlqPolicy", policy)
+	if m.SetDlqPolicyFunc == nil {
+		var r0 *acteon.DlqPolicy
+		return r0, notStubbed("SetDlqPolicy")
+	}
+	return m.SetDlqPolicyFunc(ctx, policy)
+}
+
+// SetMaintenanceMode calls SetMaintenanceModeFunc.
+func (m *Client) SetMaintenanceMode(ctx context.Context, enabled bool, opts acteon.MaintenanceOptions) (*acteon.MaintenanceStatus, error) {
+	m.record("SetMaintenanceMode", enabled, opts)
+	if m.SetMaintenanceModeFunc == nil {
+		var r0 *acteon.MaintenanceStatus
+		return r0, notStubbed("SetMaintenanceMode")
+	}
+	return m.SetMaintenanceModeFunc(ctx, enabled, opts)
+}
+
+// SetPluginEnabled calls SetPluginEnabledFunc.
+func (m *Client) SetPluginEnabled(ctx context.Context, name string, enabled bool) (*acteon.WasmPlugin, error) {
+	m.record("SetPluginEnabled", name, enabled)
+	if m.SetPluginEnabledFunc == nil {
+		var r0 *acteon.WasmPlugin
+		return r0, notStubbed("SetPluginEnabled")
+	}
+	return m.SetPluginEnabledFunc(ctx, name, enabled)
+}
+
+// SetRuleEnabled calls SetRuleEnabledFunc.
+func (m *Client) SetRuleEnabled(ctx context.Context, ruleName string, enabled bool) error {
+	m.record("SetRuleEnabled", ruleName, enabled)
+	if m.SetRuleEnabledFunc == nil {
+		return notStubbed("SetRuleEnabled")
+	}
+	return m.SetRuleEnabledFunc(ctx, ruleName, enabled)
+}
+
+// StartChain calls StartChainFunc.
+func (m *Client) StartChain(ctx context.Context, req acteon.StartChainRequest) (*acteon.StartChainResponse, error) {
+	m.record("StartChain", req)
+	if m.StartChainFunc == nil {
+		var r0 *acteon.StartChainResponse
+		return r0, notStubbed("StartChain")
+	}
+	return m.StartChainFunc(ctx, req)
+}
+
+// Stream calls StreamFunc.
+func (m *Client) Stream(ctx context.Context, opts *acteon.StreamOptions) (<-chan *acteon.SseEvent, error) {
+	m.record("Stream", opts)
+	if m.StreamFunc == nil {
+		var r0 <-chan *acteon.SseEvent
+		return r0, notStubbed("Stream")
+	}
+	return m.StreamFunc(ctx, opts)
+}
+
+// Subscribe calls SubscribeFunc.
+func (m *Client) Subscribe(ctx context.Context, entityType, entityID string, opts *acteon.SubscribeOptions) (<-chan *acteon.SseEvent, error) {
+	m.record("Subscribe", entityType, entityID, opts)
+	if m.SubscribeFunc == nil {
+		var r0 <-chan *acteon.SseEvent
+		return r0, notStubbed("Subscribe")
+	}
+	return m.SubscribeFunc(ctx, entityType, entityID, opts)
+}
+
+// SubscribeChain calls SubscribeChainFunc.
+func (m *Client) SubscribeChain(ctx context.Context, chainID string, opts *acteon.SubscribeOptions) (<-chan *acteon.ChainEvent, error) {
+	m.record("SubscribeChain", chainID, opts)
+	if m.SubscribeChainFunc == nil {
+		var r0 <-chan *acteon.ChainEvent
+		return r0, notStubbed("SubscribeChain")
+	}
+	return m.SubscribeChainFunc(ctx, chainID, opts)
+}
+
+// SyncPluginFromRegistry calls SyncPluginFromRegistryFunc.
+func (m *Client) SyncPluginFromRegistry(ctx context.Context, ref acteon.PluginRef) (*acteon.WasmPlugin, error) {
+	m.record("SyncPluginFromRegistry", ref)
+	if m.SyncPluginFromRegistryFunc == nil {
+		var r0 *acteon.WasmPlugin
+		return r0, notStubbed("SyncPluginFromRegistry")
+	}
+	return m.SyncPluginFromRegistryFunc(ctx, ref)
+}
+
+// TestProvider calls TestProviderFunc.
+func (m *Client) TestProvider(ctx context.Context, provider string, sampleAction *acteon.Action) (*acteon.ProviderTestResult, error) {
+	m.record("TestProvider", provider, sampleAction)
+	if m.TestProviderFunc == nil {
+		var r0 *acteon.ProviderTestResult
+		return r0, notStubbed("TestProvider")
+	}
+	return m.TestProviderFunc(ctx, provider, sampleAction)
+}
+
+// TransitionBusConversation calls TransitionBusConversationFunc.
+func (m *Client) TransitionBusConversation(ctx context.Context, namespace, tenant, conversationID, targetState string) (*acteon.BusConversation, error) {
+	m.record("TransitionBusConversation", namespace, tenant, conversationID, targetState)
+	if m.TransitionBusConversationFunc == nil {
+		var r0 *acteon.BusConversation
+		return r0, notStubbed("TransitionBusConversation")
+	}
+	return m.TransitionBusConversationFunc(ctx, namespace, tenant, conversationID, targetState)
+}
+
+// TransitionEvent calls TransitionEventFunc.
+func (m *Client) TransitionEvent(ctx context.Context, fingerprint, toState, namespace, tenant string) (*acteon.TransitionResponse, error) {
+	m.record("TransitionEvent", fingerprint, toState, namespace, tenant)
+	if m.TransitionEventFunc == nil {
+		var r0 *acteon.TransitionResponse
+		return r0, notStubbed("TransitionEvent")
+	}
+	return m.TransitionEventFunc(ctx, fingerprint, toState, namespace, tenant)
+}
+
+// UpdateEscalationPolicy calls UpdateEscalationPolicyFunc.
+func (m *Client) UpdateEscalationPolicy(ctx context.Context, policyID string, update *acteon.UpdateEscalationPolicyRequest) (*acteon.EscalationPolicy, error) {
+	m.record("UpdateEscalationPolicy", policyID, update)
+	if m.UpdateEscalationPolicyFunc == nil {
+		var r0 *acteon.EscalationPolicy
+		return r0, notStubbed("UpdateEscalationPolicy")
+	}
+	return m.UpdateEscalationPolicyFunc(ctx, policyID, update)
+}
+
+// UpdateGuardrailConfig calls UpdateGuardrailConfigFunc.
+func (m *Client) UpdateGuardrailConfig(ctx context.Context, update *acteon.UpdateGuardrailConfigRequest) (*acteon.GuardrailConfig, error) {
+	m.record("UpdateGuardrailConfig", update)
+	if m.UpdateGuardrailConfigFunc == nil {
+		var r0 *acteon.GuardrailConfig
+		return r0, notStubbed("UpdateGuardrailConfig")
+	}
+	return m.UpdateGuardrailConfigFunc(ctx, update)
+}
+
+// UpdatePluginConfig calls UpdatePluginConfigFunc.
+func (m *Client) UpdatePluginConfig(ctx context.Context, name string, cfg *acteon.WasmPluginConfig) (*acteon.WasmPlugin, error) {
+	m.record("UpdatePluginConfig", name, cfg)
+	if m.UpdatePluginConfigFunc == nil {
+		var r0 *acteon.WasmPlugin
+		return r0, notStubbed("UpdatePluginConfig")
+	}
+	return m.UpdatePluginConfigFunc(ctx, name, cfg)
+}
+
+// UpdateProfile calls UpdateProfileFunc.
+func (m *Client) UpdateProfile(ctx context.Context, profileID string, update *acteon.UpdateProfileRequest) (*acteon.TemplateProfileInfo, error) {
+	m.record("UpdateProfile", profileID, update)
+	if m.UpdateProfileFunc == nil {
+		var r0 *acteon.TemplateProfileInfo
+		return r0, notStubbed("UpdateProfile")
+	}
+	return m.UpdateProfileFunc(ctx, profileID, update)
+}
+
+// UpdateProviderConfig calls UpdateProviderConfigFunc.
+func (m *Client) UpdateProviderConfig(ctx context.Context, name string, update *acteon.UpdateProviderConfigRequest) (*acteon.ProviderConfig, error) {
+	m.record("UpdateProviderConfig", name, update)
+	if m.UpdateProviderConfigFunc == nil {
+		var r0 *acteon.ProviderConfig
+		return r0, notStubbed("UpdateProviderConfig")
+	}
+	return m.UpdateProviderConfigFunc(ctx, name, update)
+}
+
+// UpdateQuota calls UpdateQuotaFunc.
+func (m *Client) UpdateQuota(ctx context.Context, quotaID string, update *acteon.UpdateQuotaRequest) (*acteon.QuotaPolicy, error) {
+	m.record("UpdateQuota", quotaID, update)
+	if m.UpdateQuotaFunc == nil {
+		var r0 *acteon.QuotaPolicy
+		return r0, notStubbed("UpdateQuota")
+	}
+	return m.UpdateQuotaFunc(ctx, quotaID, update)
+}
+
+// UpdateRecurring calls UpdateRecurringFunc.
+func (m *Client) UpdateRecurring(ctx context.Context, recurringID string, update *acteon.UpdateRecurringAction) (*acteon.RecurringDetail, error) {
+	m.record("UpdateRecurring", recurringID, update)
+	if m.UpdateRecurringFunc == nil {
+		var r0 *acteon.RecurringDetail
+		return r0, notStubbed("UpdateRecurring")
+	}
+	return m.UpdateRecurringFunc(ctx, recurringID, update)
+}
+
+// UpdateRetention calls UpdateRetentionFunc.
+func (m *Client) UpdateRetention(ctx context.Context, retentionID string, update *acteon.UpdateRetentionRequest) (*acteon.RetentionPolicy, error) {
+	m.record("UpdateRetention", retentionID, update)
+	if m.UpdateRetentionFunc == nil {
+		var r0 *acteon.RetentionPolicy
+		return r0, notStubbed("UpdateRetention")
+	}
+	return m.UpdateRetentionFunc(ctx, retentionID, update)
+}
+
+// UpdateRoleBinding calls UpdateRoleBindingFunc.
+func (m *Client) UpdateRoleBinding(ctx context.Context, bindingID string, update *acteon.UpdateRoleBindingRequest) (*acteon.RoleBinding, error) {
+	m.record("UpdateRoleBinding", bindingID, update)
+	if m.UpdateRoleBindingFunc == nil {
+		var r0 *acteon.RoleBinding
+		return r0, notStubbed("UpdateRoleBinding")
+	}
+	return m.UpdateRoleBindingFunc(ctx, bindingID, update)
+}
+
+// UpdateSilence calls UpdateSilenceFunc.
+func (m *Client) UpdateSilence(ctx context.Context, silenceID string, update *acteon.UpdateSilenceRequest) (*acteon.Silence, error) {
+	m.record("UpdateSilence", silenceID, update)
+	if m.UpdateSilenceFunc == nil {
+		var r0 *acteon.Silence
+		return r0, notStubbed("UpdateSilence")
+	}
+	return m.UpdateSilenceFunc(ctx, silenceID, update)
+}
+
+// UpdateTemplate calls UpdateTemplateFunc.
+func (m *Client) UpdateTemplate(ctx context.Context, templateID string, update *acteon.UpdateTemplateRequest) (*acteon.TemplateInfo, error) {
+	m.record("UpdateTemplate", templateID, update)
+	if m.UpdateTemplateFunc == nil {
+		var r0 *acteon.TemplateInfo
+		return r0, notStubbed("UpdateTemplate")
+	}
+	return m.UpdateTemplateFunc(ctx, templateID, update)
+}
+
+// UpdateThrottle calls UpdateThrottleFunc.
+func (m *Client) UpdateThrottle(ctx context.Context, throttleID string, update *acteon.UpdateThrottleRequest) (*acteon.ThrottlePolicy, error) {
+	m.record("UpdateThrottle", throttleID, update)
+	if m.UpdateThrottleFunc == nil {
+		var r0 *acteon.ThrottlePolicy
+		return r0, notStubbed("UpdateThrottle")
+	}
+	return m.UpdateThrottleFunc(ctx, throttleID, update)
+}
+
+// UpdateTimeInterval calls UpdateTimeIntervalFunc.
+func (m *Client) UpdateTimeInterval(ctx context.Context, namespace, tenant, name string, update *acteon.UpdateTimeIntervalRequest) (*acteon.TimeInterval, error) {
+	m.record("UpdateTimeInterval", namespace, tenant, name, update)
+	if m.UpdateTimeIntervalFunc == nil {
+		var r0 *acteon.TimeInterval
+		return r0, notStubbed("UpdateTimeInterval")
+	}
+	return m.UpdateTimeIntervalFunc(ctx, namespace, tenant, name, update)
+}
+
+// ValidateChainDefinition calls ValidateChainDefinitionFunc.
+func (m *Client) ValidateChainDefinition(ctx context.Context, def *acteon.ChainDefinition) (*acteon.ChainValidationResult, error) {
+	m.record("ValidateChainDefinition", def)
+	if m.ValidateChainDefinitionFunc == nil {
+		var r0 *acteon.ChainValidationResult
+		return r0, notStubbed("ValidateChainDefinition")
+	}
+	return m.ValidateChainDefinitionFunc(ctx, def)
+}
+
+// ValidateTemplate calls ValidateTemplateFunc.
+func (m *Client) ValidateTemplate(ctx context.Context, content string) (*acteon.TemplateValidationResult, error) {
+	m.record("ValidateTemplate", content)
+	if m.ValidateTemplateFunc == nil {
+		var r0 *acteon.TemplateValidationResult
+		return r0, notStubbed("ValidateTemplate")
+	}
+	return m.ValidateTemplateFunc(ctx, content)
+}
+
+// VerifyAuditChain calls VerifyAuditChainFunc.
+func (m *Client) VerifyAuditChain(ctx context.Context, req *acteon.VerifyHashChainRequest) (*acteon.HashChainVerification, error) {
+	m.record("VerifyAuditChain", req)
+	if m.VerifyAuditChainFunc == nil {
+		var r0 *acteon.HashChainVerification
+		return r0, notStubbed("VerifyAuditChain")
+	}
+	return m.VerifyAuditChainFunc(ctx, req)
+}
+
+// VerifyPlugin calls VerifyPluginFunc.
+func (m *Client) VerifyPlugin(ctx context.Context, name string) (*acteon.PluginVerification, error) {
+	m.record("VerifyPlugin", name)
+	if m.VerifyPluginFunc == nil {
+		var r0 *acteon.PluginVerification
+		return r0, notStubbed("VerifyPlugin")
+	}
+	return m.VerifyPluginFunc(ctx, name)
+}
+
+// WhoAmI calls WhoAmIFunc.
+func (m *Client) WhoAmI(ctx context.Context) (*acteon.CallerIdentity, error) {
+	m.record("WhoAmI")
+	if m.WhoAmIFunc == nil {
+		var r0 *acteon.CallerIdentity
+		return r0, notStubbed("WhoAmI")
+	}
+	return m.WhoAmIFunc(ctx)
+}
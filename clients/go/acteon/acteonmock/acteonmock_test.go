@@ -0,0 +1,52 @@
+package acteonmock
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+// notify is code under test that depends on the client interface.
+func notify(ctx context.Context, api acteon.ActeonAPI, to string) error {
+	action := acteon.NewAction("alerts", "acme", "email", "send", map[string]any{"to": to})
+	_, err := api.Dispatch(ctx, action)
+	return err
+}
+
+func TestStubbedMethod(t *testing.T) {
+	mock := &Client{
+		DispatchFunc: func(_ context.Context, a *acteon.Action) (*acteon.ActionOutcome, error) {
+			return &acteon.ActionOutcome{Type: acteon.OutcomeExecuted}, nil
+		},
+	}
+	if err := notify(context.Background(), mock, "ops@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	calls := mock.CallsTo("Dispatch")
+	if len(calls) != 1 || len(calls[0].Args) != 1 {
+		t.Fatalf("calls = %+v", calls)
+	}
+	if a := calls[0].Args[0].(*acteon.Action); a.Payload["to"] != "ops@example.com" {
+		t.Errorf("payload = %v", a.Payload)
+	}
+}
+
+func TestUnstubbedMethod(t *testing.T) {
+	mock := &Client{}
+	page, err := mock.QueryAudit(context.Background(), &acteon.AuditQuery{})
+	if page != nil || !errors.Is(err, ErrNotStubbed) {
+		t.Fatalf("QueryAudit = %v, %v", page, err)
+	}
+	if err.Error() != "acteonmock: method not stubbed: QueryAudit" {
+		t.Errorf("error = %q", err)
+	}
+	if len(mock.Calls()) != 1 {
+		t.Errorf("calls = %+v", mock.Calls())
+	}
+	mock.Reset()
+	if len(mock.Calls()) != 0 {
+		t.Error("Reset kept calls")
+	}
+}
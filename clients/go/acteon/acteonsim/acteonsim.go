@@ -0,0 +1,349 @@
+// Package acteonsim generates synthetic action streams, dispatches them
+// against a gateway at a controlled rate, and reports latency and
+// outcome distributions. It is meant for load tests and capacity
+// checks.
+//
+// A Profile describes the stream: weighted choices of tenant,
+// provider, and action type, the fraction of actions that reuse an
+// earlier dedup key, and the payload size range. Run dispatches it:
+//
+//	gen := acteonsim.NewGenerator(acteonsim.Profile{
+//		Namespace:    "loadtest",
+//		Tenants:      acteonsim.Uniform("t1", "t2", "t3"),
+//		Providers:    []acteonsim.Weighted{{Value: "email", Weight: 9}, {Value: "slack", Weight: 1}},
+//		DedupRate:    0.05,
+//		PayloadBytes: acteonsim.Range{Min: 256, Max: 4096},
+//		Seed:         1,
+//	})
+//	report, err := acteonsim.Run(ctx, client, gen, acteonsim.RunOptions{
+//		Rate: 200, Duration: time.Minute, Concurrency: 32,
+//	})
+//	fmt.Println(report)
+//
+// The same Seed produces the same stream, so runs can be compared
+// across gateway builds.
+package acteonsim
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+// Dispatcher is the subset of *acteon.Client that Run needs.
+type Dispatcher interface {
+	Dispatch(ctx context.Context, action *acteon.Action) (*acteon.ActionOutcome, error)
+}
+
+// Weighted is one choice in a weighted distribution.
+type Weighted struct {
+	Value  string
+	Weight int
+}
+
+// Uniform returns a distribution choosing each value equally often.
+func Uniform(values ...string) []Weighted {
+	out := make([]Weighted, len(values))
+	for i, v := range values {
+		out[i] = Weighted{Value: v, Weight: 1}
+	}
+	return out
+}
+
+// Range is an inclusive integer range.
+type Range struct {
+	Min, Max int
+}
+
+// Profile describes a synthetic action stream.
+type Profile struct {
+	Namespace   string
+	Tenants     []Weighted
+	Providers   []Weighted
+	ActionTypes []Weighted
+	// DedupRate is the fraction of actions (0-1) that reuse the dedup
+	// key of an earlier action and so should be deduplicated.
+	DedupRate float64
+	// PayloadBytes is the size range of the filler string added to
+	// each payload.
+	PayloadBytes Range
+	// Seed makes the stream reproducible. Zero uses the current time.
+	Seed int64
+}
+
+// Generator produces actions following a Profile. It is safe for
+// concurrent use.
+type Generator struct {
+	profile Profile
+	mu      sync.Mutex
+	rng     *rand.Rand
+	seq     int
+	keys    []string
+}
+
+// NewGenerator returns a Generator for p. Empty distributions default
+// to a single "sim" tenant, "log" provider, and "sim_event" action type.
+func NewGenerator(p Profile) *Generator {
+	if p.Namespace == "" {
+		p.Namespace = "sim"
+	}
+	if len(p.Tenants) == 0 {
+		p.Tenants = Uniform("sim")
+	}
+	if len(p.Providers) == 0 {
+		p.Providers = Uniform("log")
+	}
+	if len(p.ActionTypes) == 0 {
+		p.ActionTypes = Uniform("sim_event")
+	}
+	seed := p.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	return &Generator{profile: p, rng: rand.New(rand.NewSource(seed))}
+}
+
+// Next returns the next action in the stream.
+func (g *Generator) Next() *acteon.Action {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.seq++
+	p := g.profile
+	action := acteon.NewAction(
+		p.Namespace,
+		g.pick(p.Tenants),
+		g.pick(p.Providers),
+		g.pick(p.ActionTypes),
+		map[string]any{"seq": g.seq, "filler": g.filler()},
+	)
+
+	key := fmt.Sprintf("sim-%d", g.seq)
+	if len(g.keys) > 0 && g.rng.Float64() < p.DedupRate {
+		key = g.keys[g.rng.Intn(len(g.keys))]
+	} else {
+		g.keys = append(g.keys, key)
+	}
+	return action.WithDedupKey(key)
+}
+
+func (g *Generator) pick(dist []Weighted) string {
+	total := 0
+	for _, w := range dist {
+		total += w.Weight
+	}
+	if total <= 0 {
+		return dist[0].Value
+	}
+	n := g.rng.Intn(total)
+	for _, w := range dist {
+		if n < w.Weight {
+			return w.Value
+		}
+		n -= w.Weight
+	}
+	return dist[len(dist)-1].Value
+}
+
+func (g *Generator) filler() string {
+	r := g.profile.PayloadBytes
+	n := r.Min
+	if r.Max > r.Min {
+		n += g.rng.Intn(r.Max - r.Min + 1)
+	}
+	return strings.Repeat("x", n)
+}
+
+// RunOptions configures Run. At least one of Count and Duration must
+// be set; the run stops at whichever limit is reached first.
+type RunOptions struct {
+	// Rate is the target dispatch rate per second. Zero dispatches as
+	// fast as Concurrency allows.
+	Rate float64
+	// Count stops the run after this many dispatches.
+	Count int
+	// Duration stops the run after this long.
+	Duration time.Duration
+	// Concurrency bounds in-flight dispatches. Defaults to 1.
+	Concurrency int
+}
+
+// Run dispatches actions from gen to d until the limits in opts are
+// reached or ctx is done, and reports what happened. Dispatch errors
+// are counted in the report, not returned.
+func Run(ctx context.Context, d Dispatcher, gen *Generator, opts RunOptions) (*Report, error) {
+	if opts.Count <= 0 && opts.Duration <= 0 {
+		return nil, fmt.Errorf("acteonsim: RunOptions needs Count or Duration")
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 1
+	}
+	if opts.Duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Duration)
+		defer cancel()
+	}
+
+	var tick <-chan time.Time
+	if opts.Rate > 0 {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / opts.Rate))
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	report := newReport()
+	sem := make(chan struct{}, opts.Concurrency)
+	var wg sync.WaitGroup
+	start := time.Now()
+
+loop:
+	for sent := 0; opts.Count <= 0 || sent < opts.Count; sent++ {
+		if tick != nil {
+			select {
+			case <-ctx.Done():
+				break loop
+			case <-tick:
+			}
+		}
+		select {
+		case <-ctx.Done():
+			break loop
+		case sem <- struct{}{}:
+		}
+
+		action := gen.Next()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			began := time.Now()
+			// Dispatches already started are allowed to finish after
+			// the run's deadline so their latency is not cut short.
+			outcome, err := d.Dispatch(context.WithoutCancel(ctx), action)
+			report.record(time.Since(began), outcome, err)
+		}()
+	}
+	wg.Wait()
+	report.Elapsed = time.Since(start)
+	return report, nil
+}
+
+// Report summarizes a run.
+type Report struct {
+	Total  int
+	Errors int
+	// Outcomes counts successful dispatches by outcome type.
+	Outcomes map[acteon.OutcomeType]int
+	// ErrorSamples holds up to ten distinct error messages.
+	ErrorSamples []string
+	Elapsed      time.Duration
+
+	mu        sync.Mutex
+	latencies []time.Duration
+	sorted    bool
+}
+
+func newReport() *Report {
+	return &Report{Outcomes: map[acteon.OutcomeType]int{}}
+}
+
+func (r *Report) record(latency time.Duration, outcome *acteon.ActionOutcome, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Total++
+	r.latencies = append(r.latencies, latency)
+	r.sorted = false
+	if err != nil {
+		r.Errors++
+		msg := err.Error()
+		if len(r.ErrorSamples) < 10 && !contains(r.ErrorSamples, msg) {
+			r.ErrorSamples = append(r.ErrorSamples, msg)
+		}
+		return
+	}
+	r.Outcomes[outcome.Type]++
+}
+
+// Throughput returns completed dispatches per second.
+func (r *Report) Throughput() float64 {
+	if r.Elapsed <= 0 {
+		return 0
+	}
+	return float64(r.Total) / r.Elapsed.Seconds()
+}
+
+// Percentile returns the latency at percentile p (0-100), including
+// failed dispatches.
+func (r *Report) Percentile(p float64) time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.latencies) == 0 {
+		return 0
+	}
+	if !r.sorted {
+		sort.Slice(r.latencies, func(i, j int) bool { return r.latencies[i] < r.latencies[j] })
+		r.sorted = true
+	}
+	i := int(p / 100 * float64(len(r.latencies)-1))
+	if i < 0 {
+		i = 0
+	}
+	if i >= len(r.latencies) {
+		i = len(r.latencies) - 1
+	}
+	return r.latencies[i]
+}
+
+// Bucket is one latency histogram bucket: Count dispatches took less
+// than UpperBound and at least the previous bucket's bound.
+type Bucket struct {
+	UpperBound time.Duration
+	Count      int
+}
+
+// Histogram buckets latencies by the given ascending upper bounds. A
+// final bucket with UpperBound 0 counts latencies above the last bound.
+func (r *Report) Histogram(bounds ...time.Duration) []Bucket {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	buckets := make([]Bucket, len(bounds)+1)
+	for i, b := range bounds {
+		buckets[i].UpperBound = b
+	}
+	for _, l := range r.latencies {
+		i := sort.Search(len(bounds), func(i int) bool { return l < bounds[i] })
+		buckets[i].Count++
+	}
+	return buckets
+}
+
+// String renders a short human-readable summary.
+func (r *Report) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d dispatches in %s (%.1f/s), %d errors\n", r.Total, r.Elapsed.Round(time.Millisecond), r.Throughput(), r.Errors)
+	fmt.Fprintf(&b, "latency p50=%s p95=%s p99=%s\n", r.Percentile(50), r.Percentile(95), r.Percentile(99))
+	types := make([]string, 0, len(r.Outcomes))
+	for t := range r.Outcomes {
+		types = append(types, string(t))
+	}
+	sort.Strings(types)
+	for _, t := range types {
+		fmt.Fprintf(&b, "  %-14s %d\n", t, r.Outcomes[acteon.OutcomeType(t)])
+	}
+	return b.String()
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
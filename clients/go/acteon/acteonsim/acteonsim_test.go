@@ -0,0 +1,96 @@
+package acteonsim
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+type fakeDispatcher struct {
+	mu   sync.Mutex
+	seen map[string]bool
+}
+
+func (f *fakeDispatcher) Dispatch(_ context.Context, a *acteon.Action) (*acteon.ActionOutcome, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if a.Provider == "broken" {
+		return nil, errors.New("provider unavailable")
+	}
+	if f.seen[a.DedupKey] {
+		return &acteon.ActionOutcome{Type: acteon.OutcomeDeduplicated}, nil
+	}
+	f.seen[a.DedupKey] = true
+	return &acteon.ActionOutcome{Type: acteon.OutcomeExecuted}, nil
+}
+
+func TestGeneratorIsReproducible(t *testing.T) {
+	p := Profile{
+		Tenants:      Uniform("t1", "t2"),
+		Providers:    []Weighted{{Value: "email", Weight: 3}, {Value: "slack", Weight: 1}},
+		DedupRate:    0.2,
+		PayloadBytes: Range{Min: 10, Max: 20},
+		Seed:         42,
+	}
+	a, b := NewGenerator(p), NewGenerator(p)
+	for i := 0; i < 50; i++ {
+		x, y := a.Next(), b.Next()
+		if x.Tenant != y.Tenant || x.Provider != y.Provider || x.DedupKey != y.DedupKey {
+			t.Fatalf("action %d differs: %+v vs %+v", i, x, y)
+		}
+		if n := len(x.Payload["filler"].(string)); n < 10 || n > 20 {
+			t.Fatalf("filler length %d outside range", n)
+		}
+	}
+}
+
+func TestRunCountsOutcomesAndErrors(t *testing.T) {
+	gen := NewGenerator(Profile{
+		Providers: []Weighted{{Value: "email", Weight: 4}, {Value: "broken", Weight: 1}},
+		DedupRate: 0.3,
+		Seed:      7,
+	})
+	report, err := Run(context.Background(), &fakeDispatcher{seen: map[string]bool{}}, gen, RunOptions{Count: 200, Concurrency: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Total != 200 {
+		t.Errorf("total = %d", report.Total)
+	}
+	ok := report.Outcomes[acteon.OutcomeExecuted] + report.Outcomes[acteon.OutcomeDeduplicated]
+	if ok+report.Errors != 200 || report.Errors == 0 || report.Outcomes[acteon.OutcomeDeduplicated] == 0 {
+		t.Errorf("outcomes = %v, errors = %d", report.Outcomes, report.Errors)
+	}
+	if len(report.ErrorSamples) != 1 || report.ErrorSamples[0] != "provider unavailable" {
+		t.Errorf("error samples = %v", report.ErrorSamples)
+	}
+	buckets := report.Histogram(time.Second)
+	if buckets[0].Count != 200 || buckets[1].Count != 0 {
+		t.Errorf("histogram = %+v", buckets)
+	}
+}
+
+func TestRunHonorsRate(t *testing.T) {
+	gen := NewGenerator(Profile{Seed: 1})
+	start := time.Now()
+	report, err := Run(context.Background(), &fakeDispatcher{seen: map[string]bool{}}, gen, RunOptions{Rate: 100, Count: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("10 dispatches at 100/s took %s", elapsed)
+	}
+	if report.Total != 10 {
+		t.Errorf("total = %d", report.Total)
+	}
+}
+
+func TestRunRequiresLimit(t *testing.T) {
+	if _, err := Run(context.Background(), &fakeDispatcher{}, NewGenerator(Profile{}), RunOptions{}); err == nil {
+		t.Error("expected error without Count or Duration")
+	}
+}
@@ -0,0 +1,476 @@
+// Package acteontest provides an in-memory fake of the Acteon gateway
+// for integration tests of services that use the Go client.
+//
+// A Server is an httptest.Server speaking the gateway's REST dialect.
+// It keeps dispatched actions, their audit records, recurring actions,
+// and approvals in memory and streams events over SSE, so code under
+// test runs against a real *acteon.Client without the Rust gateway:
+//
+//	srv := acteontest.NewServer()
+//	defer srv.Close()
+//	client := srv.Client()
+//
+//	svc := NewNotifier(client)
+//	svc.Send(ctx, "ops@example.com")
+//
+//	if got := srv.Dispatched(); len(got) != 1 { ... }
+//
+// Every action is executed unless WithDispatchFunc says otherwise, and
+// repeated dedup keys come back Deduplicated. Endpoints the fake does
+// not implement answer 404; Handle and HandleJSON register canned
+// responses for them, or override the built-in ones.
+package acteontest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+// Server is a fake Acteon gateway. It is safe for concurrent use.
+type Server struct {
+	// URL is the base URL of the server, for acteon.NewClient.
+	URL string
+
+	srv       *httptest.Server
+	routes    *http.ServeMux
+	overrides *http.ServeMux
+	apiKey    string
+	dispatch  func(*acteon.Action) (*acteon.ActionOutcome, error)
+
+	mu         sync.Mutex
+	dispatched []*acteon.Action
+	dedupKeys  map[string]bool
+	audit      []acteon.AuditRecord
+	recurring  map[string]*acteon.RecurringDetail
+	approvals  map[string]*approval
+	events     []acteon.SseEvent
+	published  chan struct{} // closed and replaced on every Publish
+	nextID     int           // for recurring action and approval IDs
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithAPIKey makes the server reject requests that do not carry key
+// as a bearer token. /health stays open. Client adds the key.
+func WithAPIKey(key string) Option {
+	return func(s *Server) { s.apiKey = key }
+}
+
+// WithDispatchFunc sets the function that decides each dispatched
+// action's outcome, in place of executing everything. An *acteon.APIError
+// is answered as the gateway would answer it, with 503 when it is
+// retryable and 400 otherwise; any other error is a 500. The function
+// is not called for deduplicated actions or dry runs.
+func WithDispatchFunc(fn func(*acteon.Action) (*acteon.ActionOutcome, error)) Option {
+	return func(s *Server) { s.dispatch = fn }
+}
+
+// NewServer starts a fake gateway. Close it when done.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		routes:    http.NewServeMux(),
+		overrides: http.NewServeMux(),
+		dedupKeys: map[string]bool{},
+		recurring: map[string]*acteon.RecurringDetail{},
+		approvals: map[string]*approval{},
+		published: make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.routes.HandleFunc("GET /health", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	})
+	s.routes.HandleFunc("POST /v1/dispatch", s.handleDispatch)
+	s.routes.HandleFunc("POST /v1/dispatch/batch", s.handleDispatchBatch)
+	s.routes.HandleFunc("GET /v1/audit", s.handleAuditQuery)
+	s.routes.HandleFunc("GET /v1/audit/{id}", s.handleAuditGet)
+	s.routes.HandleFunc("POST /v1/audit/batch", s.handleAuditBatch)
+	s.routes.HandleFunc("GET /v1/stream", s.handleStream)
+	s.registerRecurring()
+	s.registerApprovals()
+
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.srv.URL
+	return s
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.srv.CloseClientConnections()
+	s.srv.Close()
+}
+
+// Client returns a client for the server, authenticated when the
+// server requires an API key. opts are applied after the defaults.
+func (s *Server) Client(opts ...acteon.ClientOption) *acteon.Client {
+	if s.apiKey != "" {
+		opts = append([]acteon.ClientOption{acteon.WithAPIKey(s.apiKey)}, opts...)
+	}
+	return acteon.NewClient(s.URL, opts...)
+}
+
+// Handle registers handler for pattern, a net/http ServeMux pattern
+// such as "GET /v1/quotas". It takes precedence over the built-in
+// routes.
+func (s *Server) Handle(pattern string, handler http.Handler) {
+	s.overrides.Handle(pattern, handler)
+}
+
+// HandleJSON registers a canned response for pattern: every matching
+// request is answered with status and body encoded as JSON.
+func (s *Server) HandleJSON(pattern string, status int, body any) {
+	s.Handle(pattern, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, status, body)
+	}))
+}
+
+// Dispatched returns the actions dispatched so far, dry runs excluded,
+// in the order they arrived.
+func (s *Server) Dispatched() []*acteon.Action {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*acteon.Action(nil), s.dispatched...)
+}
+
+// Publish sends an event to every open stream and keeps it for
+// streams that resume with Last-Event-ID. data is encoded as JSON.
+func (s *Server) Publish(event string, data any) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		panic(fmt.Sprintf("acteontest: encode event data: %v", err))
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.publishLocked(event, string(encoded))
+}
+
+func (s *Server) publishLocked(event, data string) {
+	// Event IDs are 1-based positions in s.events, which handleStream
+	// relies on to resume.
+	s.events = append(s.events, acteon.SseEvent{ID: strconv.Itoa(len(s.events) + 1), Event: event, Data: data})
+	close(s.published)
+	s.published = make(chan struct{})
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.apiKey != "" && r.URL.Path != "/health" && r.Header.Get("Authorization") != "Bearer "+s.apiKey {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing or invalid API key")
+		return
+	}
+	if h, pattern := s.overrides.Handler(r); pattern != "" {
+		h.ServeHTTP(w, r)
+		return
+	}
+	if _, pattern := s.routes.Handler(r); pattern == "" {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("%s %s is not implemented by acteontest", r.Method, r.URL.Path))
+		return
+	}
+	s.routes.ServeHTTP(w, r)
+}
+
+// =============================================================================
+// Dispatch
+// =============================================================================
+
+func (s *Server) handleDispatch(w http.ResponseWriter, r *http.Request) {
+	var action acteon.Action
+	if err := json.NewDecoder(r.Body).Decode(&action); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+	outcome, err := s.dispatchAction(&action, r.URL.Query().Get("dry_run") == "true")
+	if err != nil {
+		writeDispatchError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, outcome)
+}
+
+func (s *Server) handleDispatchBatch(w http.ResponseWriter, r *http.Request) {
+	var actions []*acteon.Action
+	if err := json.NewDecoder(r.Body).Decode(&actions); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+	dryRun := r.URL.Query().Get("dry_run") == "true"
+	results := make(acteon.BatchResults, len(actions))
+	for i, a := range actions {
+		outcome, err := s.dispatchAction(a, dryRun)
+		if err != nil {
+			results[i] = acteon.BatchResult{Error: errorResponse(err)}
+			continue
+		}
+		results[i] = acteon.BatchResult{Success: true, Outcome: outcome}
+	}
+	writeJSON(w, http.StatusOK, results)
+}
+
+// dispatchAction decides action's outcome and, unless this is a dry
+// run, records it, audits it, and publishes an action_dispatched event.
+func (s *Server) dispatchAction(action *acteon.Action, dryRun bool) (*acteon.ActionOutcome, error) {
+	if dryRun {
+		return &acteon.ActionOutcome{Type: acteon.OutcomeDryRun, Verdict: "allow", WouldBeProvider: action.Provider}, nil
+	}
+
+	s.mu.Lock()
+	duplicate := action.DedupKey != "" && s.dedupKeys[dedupScope(action)]
+	if action.DedupKey != "" {
+		s.dedupKeys[dedupScope(action)] = true
+	}
+	s.mu.Unlock()
+
+	started := time.Now()
+	var outcome *acteon.ActionOutcome
+	switch {
+	case duplicate:
+		outcome = &acteon.ActionOutcome{Type: acteon.OutcomeDeduplicated}
+	case s.dispatch != nil:
+		var err error
+		if outcome, err = s.dispatch(action); err != nil {
+			// A rejected action does not use up its dedup key.
+			s.mu.Lock()
+			delete(s.dedupKeys, dedupScope(action))
+			s.mu.Unlock()
+			return nil, err
+		}
+	default:
+		outcome = &acteon.ActionOutcome{
+			Type:     acteon.OutcomeExecuted,
+			Response: &acteon.ProviderResponse{Status: "success", Body: map[string]any{}, Headers: map[string]string{}},
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dispatched = append(s.dispatched, action)
+	verdict := "allow"
+	if outcome.IsSuppressed() {
+		verdict = "deny"
+	}
+	s.audit = append(s.audit, acteon.AuditRecord{
+		ID:            fmt.Sprintf("audit-%d", len(s.audit)+1),
+		ActionID:      action.ID,
+		Namespace:     action.Namespace,
+		Tenant:        action.Tenant,
+		Provider:      action.Provider,
+		ActionType:    action.ActionType,
+		Verdict:       verdict,
+		Outcome:       string(outcome.Type),
+		DurationMs:    time.Since(started).Milliseconds(),
+		DispatchedAt:  started.UTC(),
+		ActionPayload: action.Payload,
+		Metadata:      auditMetadata(action),
+	})
+	data, _ := json.Marshal(map[string]any{
+		"type":        "action_dispatched",
+		"timestamp":   started.UTC(),
+		"namespace":   action.Namespace,
+		"tenant":      action.Tenant,
+		"action_id":   action.ID,
+		"provider":    action.Provider,
+		"action_type": action.ActionType,
+		"outcome":     string(outcome.Type),
+	})
+	s.publishLocked("action_dispatched", string(data))
+	return outcome, nil
+}
+
+// auditMetadata flattens the action's labels and adds its dedup key,
+// the way the gateway records them.
+func auditMetadata(a *acteon.Action) map[string]any {
+	meta := map[string]any{}
+	if a.Metadata != nil {
+		for k, v := range a.Metadata.Labels {
+			meta[k] = v
+		}
+	}
+	if a.DedupKey != "" {
+		meta["__dedup_key"] = a.DedupKey
+	}
+	return meta
+}
+
+func dedupScope(a *acteon.Action) string {
+	return a.Namespace + "\x00" + a.Tenant + "\x00" + a.DedupKey
+}
+
+// =============================================================================
+// Audit
+// =============================================================================
+
+func (s *Server) handleAuditQuery(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	limit, _ := strconv.Atoi(q.Get("limit"))
+	if limit <= 0 {
+		limit = 50
+	}
+	offset, _ := strconv.Atoi(q.Get("offset"))
+
+	s.mu.Lock()
+	var matched []acteon.AuditRecord
+	for i := len(s.audit) - 1; i >= 0; i-- {
+		rec := s.audit[i]
+		if matches(q.Get("namespace"), rec.Namespace) && matches(q.Get("tenant"), rec.Tenant) &&
+			matches(q.Get("provider"), rec.Provider) && matches(q.Get("action_type"), rec.ActionType) &&
+			matches(q.Get("outcome"), rec.Outcome) {
+			matched = append(matched, rec)
+		}
+	}
+	s.mu.Unlock()
+
+	total := int64(len(matched))
+	page := acteon.AuditPage{Records: []acteon.AuditRecord{}, Total: &total, Limit: int64(limit), Offset: int64(offset)}
+	if offset < len(matched) {
+		page.Records = matched[offset:min(offset+limit, len(matched))]
+	}
+	writeJSON(w, http.StatusOK, page)
+}
+
+func (s *Server) handleAuditGet(w http.ResponseWriter, r *http.Request) {
+	if rec, ok := s.auditRecord(r.PathValue("id")); ok {
+		writeJSON(w, http.StatusOK, rec)
+		return
+	}
+	writeError(w, http.StatusNotFound, "NOT_FOUND", "audit record not found")
+}
+
+func (s *Server) handleAuditBatch(w http.ResponseWriter, r *http.Request) {
+	var req acteon.AuditBatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+	resp := acteon.AuditBatchResponse{Records: []acteon.AuditRecord{}, Missing: []string{}}
+	for _, id := range req.ActionIDs {
+		if rec, ok := s.auditRecord(id); ok {
+			resp.Records = append(resp.Records, rec)
+		} else {
+			resp.Missing = append(resp.Missing, id)
+		}
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// auditRecord returns the newest audit record for actionID.
+func (s *Server) auditRecord(actionID string) (acteon.AuditRecord, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := len(s.audit) - 1; i >= 0; i-- {
+		if s.audit[i].ActionID == actionID {
+			return s.audit[i], true
+		}
+	}
+	return acteon.AuditRecord{}, false
+}
+
+// =============================================================================
+// Stream (SSE)
+// =============================================================================
+
+// handleStream replays the events after Last-Event-ID, then sends new
+// ones as they are published until the client goes away. The
+// namespace and event_type filters are honoured.
+func (s *Server) handleStream(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, "INTERNAL", "streaming unsupported")
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	namespace := r.URL.Query().Get("namespace")
+	eventType := r.URL.Query().Get("event_type")
+	next := 0
+	if last, err := strconv.Atoi(r.Header.Get("Last-Event-ID")); err == nil {
+		next = last
+	}
+	for {
+		s.mu.Lock()
+		pending := append([]acteon.SseEvent(nil), s.events[min(next, len(s.events)):]...)
+		published := s.published
+		s.mu.Unlock()
+
+		for _, ev := range pending {
+			next++
+			if !matches(eventType, ev.Event) || !matches(namespace, eventNamespace(ev)) {
+				continue
+			}
+			fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", ev.ID, ev.Event, ev.Data)
+		}
+		flusher.Flush()
+
+		select {
+		case <-published:
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+func eventNamespace(ev acteon.SseEvent) string {
+	var data struct {
+		Namespace string `json:"namespace"`
+	}
+	_ = json.Unmarshal([]byte(ev.Data), &data)
+	return data.Namespace
+}
+
+// =============================================================================
+// Helpers
+// =============================================================================
+
+// matches reports whether value passes an optional equality filter.
+func matches(filter, value string) bool {
+	return filter == "" || filter == value
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if body != nil {
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, acteon.ErrorResponse{Code: code, Message: message, Retryable: status >= 500})
+}
+
+func errorResponse(err error) *acteon.ErrorResponse {
+	var apiErr *acteon.APIError
+	if errors.As(err, &apiErr) {
+		return &acteon.ErrorResponse{Code: apiErr.Code, Message: apiErr.Message, Retryable: apiErr.Retryable}
+	}
+	return &acteon.ErrorResponse{Code: "INTERNAL", Message: err.Error(), Retryable: true}
+}
+
+func writeDispatchError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	var apiErr *acteon.APIError
+	if errors.As(err, &apiErr) {
+		status = http.StatusBadRequest
+		if apiErr.Retryable {
+			status = http.StatusServiceUnavailable
+		}
+	}
+	writeJSON(w, status, errorResponse(err))
+}
+
+// pathKey joins path segments into a map key.
+func pathKey(parts ...string) string {
+	return strings.Join(parts, "/")
+}
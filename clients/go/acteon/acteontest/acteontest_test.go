@@ -0,0 +1,176 @@
+package acteontest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+func TestDispatchAuditAndDedup(t *testing.T) {
+	srv := NewServer(WithAPIKey("secret"))
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+
+	first := acteon.NewAction("alerts", "acme", "email", "send", map[string]any{"to": "a@b.c"}).WithDedupKey("k1")
+	outcome, err := client.Dispatch(ctx, first)
+	if err != nil || !outcome.IsExecuted() {
+		t.Fatalf("Dispatch = %+v, %v", outcome, err)
+	}
+	again := acteon.NewAction("alerts", "acme", "email", "send", nil).WithDedupKey("k1")
+	if outcome, err := client.Dispatch(ctx, again); err != nil || !outcome.IsDeduplicated() {
+		t.Fatalf("second Dispatch = %+v, %v", outcome, err)
+	}
+	if outcome, err := client.DispatchDryRun(ctx, first); err != nil || !outcome.IsDryRun() {
+		t.Fatalf("DispatchDryRun = %+v, %v", outcome, err)
+	}
+	if got := srv.Dispatched(); len(got) != 2 || got[0].ID != first.ID {
+		t.Errorf("Dispatched = %+v", got)
+	}
+
+	page, err := client.QueryAudit(ctx, &acteon.AuditQuery{Outcome: "executed"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Records) != 1 || page.Records[0].ActionID != first.ID || *page.Total != 1 {
+		t.Errorf("audit page = %+v", page)
+	}
+	if rebuilt, err := acteon.ActionFromAuditRecord(&page.Records[0], nil); err != nil || rebuilt.DedupKey != "k1" || rebuilt.Payload["to"] != "a@b.c" {
+		t.Errorf("ActionFromAuditRecord = %+v, %v", rebuilt, err)
+	}
+	records, err := client.GetAuditRecords(ctx, []string{first.ID, "missing"})
+	if err != nil || len(records.Records) != 1 || len(records.Missing) != 1 {
+		t.Errorf("GetAuditRecords = %+v, %v", records, err)
+	}
+
+	if _, err := acteon.NewClient(srv.URL).Dispatch(ctx, first); err == nil {
+		t.Error("unauthenticated dispatch succeeded")
+	}
+}
+
+func TestDispatchFuncAndBatch(t *testing.T) {
+	srv := NewServer(WithDispatchFunc(func(a *acteon.Action) (*acteon.ActionOutcome, error) {
+		if a.Provider == "sms" {
+			return nil, &acteon.APIError{Code: "PROVIDER_DOWN", Message: "sms is down"}
+		}
+		return &acteon.ActionOutcome{Type: acteon.OutcomeSuppressed, Rule: "quiet-hours"}, nil
+	}))
+	defer srv.Close()
+	client := srv.Client()
+
+	results, err := client.DispatchBatch(context.Background(), []*acteon.Action{
+		acteon.NewAction("alerts", "acme", "email", "send", nil),
+		acteon.NewAction("alerts", "acme", "sms", "send", nil),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !results[0].Outcome.IsSuppressed() || results[1].Success || results[1].Error.Code != "PROVIDER_DOWN" {
+		t.Errorf("results = %+v", results)
+	}
+
+	_, err = client.Dispatch(context.Background(), acteon.NewAction("alerts", "acme", "sms", "send", nil))
+	var apiErr *acteon.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != "PROVIDER_DOWN" {
+		t.Errorf("Dispatch error = %v", err)
+	}
+}
+
+func TestRecurringLifecycle(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+
+	created, err := client.CreateRecurring(ctx, &acteon.CreateRecurringAction{
+		Namespace: "alerts", Tenant: "acme", Provider: "email", ActionType: "digest",
+		CronExpression: "0 9 * * *", Name: "daily digest",
+	})
+	if err != nil || created.Status != acteon.RecurringActive {
+		t.Fatalf("CreateRecurring = %+v, %v", created, err)
+	}
+	if _, err := client.PauseRecurring(ctx, created.ID, "alerts", "acme"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.PauseRecurring(ctx, created.ID, "alerts", "acme"); err == nil {
+		t.Error("pausing twice succeeded")
+	}
+	list, err := client.ListRecurring(ctx, &acteon.RecurringFilter{Namespace: "alerts", Status: acteon.RecurringPaused})
+	if err != nil || list.Count != 1 || list.RecurringActions[0].CronExpr != "0 9 * * *" {
+		t.Fatalf("ListRecurring = %+v, %v", list, err)
+	}
+	if err := client.DeleteRecurring(ctx, created.ID, "alerts", "acme"); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := client.GetRecurring(ctx, created.ID, "alerts", "acme"); err != nil || got != nil {
+		t.Errorf("GetRecurring after delete = %+v, %v", got, err)
+	}
+}
+
+func TestApprovals(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+	id := srv.AddApproval("alerts", "acme", "needs-approval", time.Hour)
+
+	list, err := client.ListApprovals(ctx, "alerts", "acme")
+	if err != nil || list.Count != 1 || list.Approvals[0].Rule != "needs-approval" {
+		t.Fatalf("ListApprovals = %+v, %v", list, err)
+	}
+	resp, err := client.Approve(ctx, "alerts", "acme", id, "sig", time.Now().Add(time.Hour).Unix(), "")
+	if err != nil || resp.Status != acteon.ApprovalApproved {
+		t.Fatalf("Approve = %+v, %v", resp, err)
+	}
+	_, err = client.Reject(ctx, "alerts", "acme", id, "sig", 0, "")
+	var httpErr *acteon.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.Status != http.StatusGone {
+		t.Errorf("Reject after approve = %v", err)
+	}
+}
+
+func TestStreamResumeAndCannedRoutes(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv.Publish("chain_completed", map[string]any{"namespace": "alerts", "chain_id": "c-1"})
+	srv.Publish("chain_completed", map[string]any{"namespace": "other", "chain_id": "c-2"})
+	ns := "alerts"
+	events, err := client.Stream(ctx, &acteon.StreamOptions{Namespace: &ns})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ev := <-events; ev.ID != "1" || ev.Event != "chain_completed" {
+		t.Errorf("first event = %+v", ev)
+	}
+	if _, err := client.Dispatch(ctx, acteon.NewAction("alerts", "acme", "email", "send", nil)); err != nil {
+		t.Fatal(err)
+	}
+	if ev := <-events; ev.ID != "3" || ev.Event != "action_dispatched" {
+		t.Errorf("live event = %+v", ev)
+	}
+
+	last := "1"
+	resumed, err := client.Stream(ctx, &acteon.StreamOptions{LastEventID: &last})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ev := <-resumed; ev.ID != "2" {
+		t.Errorf("resumed event = %+v", ev)
+	}
+
+	if _, err := client.ListQuotas(ctx, nil, nil, nil, nil); err == nil {
+		t.Error("unimplemented route succeeded")
+	}
+	srv.HandleJSON("GET /v1/quotas", http.StatusOK, acteon.ListQuotasResponse{Count: 0})
+	if _, err := client.ListQuotas(ctx, nil, nil, nil, nil); err != nil {
+		t.Errorf("canned route: %v", err)
+	}
+}
@@ -0,0 +1,326 @@
+package acteontest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+// =============================================================================
+// Recurring Actions
+// =============================================================================
+
+func (s *Server) registerRecurring() {
+	s.routes.HandleFunc("POST /v1/recurring", s.handleRecurringCreate)
+	s.routes.HandleFunc("GET /v1/recurring", s.handleRecurringList)
+	s.routes.HandleFunc("GET /v1/recurring/{id}", s.handleRecurringGet)
+	s.routes.HandleFunc("PUT /v1/recurring/{id}", s.handleRecurringUpdate)
+	s.routes.HandleFunc("DELETE /v1/recurring/{id}", s.handleRecurringDelete)
+	s.routes.HandleFunc("POST /v1/recurring/{id}/pause", s.handleRecurringLifecycle(false))
+	s.routes.HandleFunc("POST /v1/recurring/{id}/resume", s.handleRecurringLifecycle(true))
+}
+
+func (s *Server) handleRecurringCreate(w http.ResponseWriter, r *http.Request) {
+	var req acteon.CreateRecurringAction
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+	if req.Namespace == "" || req.Tenant == "" || req.CronExpression == "" {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "namespace, tenant, and cron_expression are required")
+		return
+	}
+	now := time.Now().UTC()
+	timezone := req.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	d := &acteon.RecurringDetail{
+		Namespace:  req.Namespace,
+		Tenant:     req.Tenant,
+		CronExpr:   req.CronExpression,
+		Timezone:   timezone,
+		Enabled:    true,
+		Provider:   req.Provider,
+		ActionType: req.ActionType,
+		Payload:    req.Payload,
+		Metadata:   req.Metadata,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		Labels:     req.Labels,
+		EndsAt:     req.EndDate,
+	}
+	if req.Description != "" {
+		d.Description = &req.Description
+	}
+	if req.DedupKey != "" {
+		d.DedupKey = &req.DedupKey
+	}
+
+	s.mu.Lock()
+	s.nextID++
+	d.ID = fmt.Sprintf("rec-%d", s.nextID)
+	s.recurring[d.ID] = d
+	s.mu.Unlock()
+
+	resp := acteon.CreateRecurringResponse{ID: d.ID, Status: acteon.RecurringActive}
+	if req.Name != "" {
+		resp.Name = &req.Name
+	}
+	writeJSON(w, http.StatusCreated, resp)
+}
+
+func (s *Server) handleRecurringList(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	s.mu.Lock()
+	resp := acteon.ListRecurringResponse{RecurringActions: []acteon.RecurringSummary{}}
+	for _, d := range s.recurring {
+		if !matches(q.Get("namespace"), d.Namespace) || !matches(q.Get("tenant"), d.Tenant) ||
+			!matches(q.Get("status"), string(recurringStatus(d))) {
+			continue
+		}
+		resp.RecurringActions = append(resp.RecurringActions, acteon.RecurringSummary{
+			ID:              d.ID,
+			Namespace:       d.Namespace,
+			Tenant:          d.Tenant,
+			CronExpr:        d.CronExpr,
+			Timezone:        d.Timezone,
+			Enabled:         d.Enabled,
+			Provider:        d.Provider,
+			ActionType:      d.ActionType,
+			ExecutionCount:  d.ExecutionCount,
+			CreatedAt:       d.CreatedAt,
+			NextExecutionAt: d.NextExecutionAt,
+			Description:     d.Description,
+		})
+	}
+	s.mu.Unlock()
+
+	sort.Slice(resp.RecurringActions, func(i, j int) bool {
+		return resp.RecurringActions[i].CreatedAt.Before(resp.RecurringActions[j].CreatedAt)
+	})
+	offset, _ := strconv.Atoi(q.Get("offset"))
+	resp.RecurringActions = resp.RecurringActions[min(offset, len(resp.RecurringActions)):]
+	if limit, _ := strconv.Atoi(q.Get("limit")); limit > 0 && limit < len(resp.RecurringActions) {
+		resp.RecurringActions = resp.RecurringActions[:limit]
+	}
+	resp.Count = len(resp.RecurringActions)
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) handleRecurringGet(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d := s.recurringFor(r.PathValue("id"), r.URL.Query().Get("namespace"), r.URL.Query().Get("tenant"))
+	if d == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "recurring action not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, d)
+}
+
+func (s *Server) handleRecurringUpdate(w http.ResponseWriter, r *http.Request) {
+	var req acteon.UpdateRecurringAction
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d := s.recurringFor(r.PathValue("id"), req.Namespace, req.Tenant)
+	if d == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "recurring action not found")
+		return
+	}
+	if req.Payload != nil {
+		d.Payload = req.Payload
+	}
+	if req.Metadata != nil {
+		d.Metadata = req.Metadata
+	}
+	if req.Labels != nil {
+		d.Labels = req.Labels
+	}
+	if req.CronExpression != nil {
+		d.CronExpr = *req.CronExpression
+	}
+	if req.Timezone != nil {
+		d.Timezone = *req.Timezone
+	}
+	if req.EndDate != nil {
+		d.EndsAt = req.EndDate
+	}
+	if req.Description != nil {
+		d.Description = req.Description
+	}
+	if req.DedupKey != nil {
+		d.DedupKey = req.DedupKey
+	}
+	d.UpdatedAt = time.Now().UTC()
+	writeJSON(w, http.StatusOK, d)
+}
+
+func (s *Server) handleRecurringDelete(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d := s.recurringFor(r.PathValue("id"), r.URL.Query().Get("namespace"), r.URL.Query().Get("tenant"))
+	if d == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "recurring action not found")
+		return
+	}
+	delete(s.recurring, d.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleRecurringLifecycle pauses (enable false) or resumes (enable
+// true) a recurring action, answering 409 when it is already in that
+// state.
+func (s *Server) handleRecurringLifecycle(enable bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req acteon.RecurringLifecycleRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		d := s.recurringFor(r.PathValue("id"), req.Namespace, req.Tenant)
+		if d == nil {
+			writeError(w, http.StatusNotFound, "NOT_FOUND", "recurring action not found")
+			return
+		}
+		if d.Enabled == enable {
+			writeError(w, http.StatusConflict, "CONFLICT", fmt.Sprintf("recurring action is already %s", recurringStatus(d)))
+			return
+		}
+		d.Enabled = enable
+		d.UpdatedAt = time.Now().UTC()
+		writeJSON(w, http.StatusOK, d)
+	}
+}
+
+// recurringFor returns the recurring action with id in namespace and
+// tenant, or nil. The caller holds s.mu.
+func (s *Server) recurringFor(id, namespace, tenant string) *acteon.RecurringDetail {
+	d := s.recurring[id]
+	if d == nil || d.Namespace != namespace || d.Tenant != tenant {
+		return nil
+	}
+	return d
+}
+
+func recurringStatus(d *acteon.RecurringDetail) acteon.RecurringStatus {
+	if d.Enabled {
+		return acteon.RecurringActive
+	}
+	return acteon.RecurringPaused
+}
+
+// =============================================================================
+// Approvals
+// =============================================================================
+
+// approval is a pending or decided approval. Signatures are not
+// checked: any sig is accepted.
+type approval struct {
+	namespace string
+	tenant    string
+	status    acteon.ApprovalStatus
+}
+
+// AddApproval creates a pending approval held by rule that expires
+// after ttl, and returns its ID for the approval endpoints.
+func (s *Server) AddApproval(namespace, tenant, rule string, ttl time.Duration) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextID++
+	id := fmt.Sprintf("approval-%d", s.nextID)
+	now := time.Now().UTC()
+	s.approvals[pathKey(namespace, tenant, id)] = &approval{
+		namespace: namespace,
+		tenant:    tenant,
+		status: acteon.ApprovalStatus{
+			Token:     id,
+			Status:    acteon.ApprovalPending,
+			Rule:      rule,
+			CreatedAt: now,
+			ExpiresAt: now.Add(ttl),
+		},
+	}
+	return id
+}
+
+func (s *Server) registerApprovals() {
+	s.routes.HandleFunc("GET /v1/approvals", s.handleApprovalList)
+	s.routes.HandleFunc("GET /v1/approvals/{namespace}/{tenant}/{id}", s.handleApprovalGet)
+	s.routes.HandleFunc("POST /v1/approvals/{namespace}/{tenant}/{id}/approve", s.handleApprovalDecide(acteon.ApprovalApproved))
+	s.routes.HandleFunc("POST /v1/approvals/{namespace}/{tenant}/{id}/reject", s.handleApprovalDecide(acteon.ApprovalRejected))
+}
+
+func (s *Server) handleApprovalList(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	s.mu.Lock()
+	resp := acteon.ApprovalListResponse{Approvals: []acteon.ApprovalStatus{}}
+	for _, a := range s.approvals {
+		s.expire(a)
+		if a.namespace == q.Get("namespace") && a.tenant == q.Get("tenant") && a.status.Status == acteon.ApprovalPending {
+			resp.Approvals = append(resp.Approvals, a.status)
+		}
+	}
+	s.mu.Unlock()
+
+	sort.Slice(resp.Approvals, func(i, j int) bool { return resp.Approvals[i].CreatedAt.Before(resp.Approvals[j].CreatedAt) })
+	resp.Count = len(resp.Approvals)
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) handleApprovalGet(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	a := s.approvals[pathKey(r.PathValue("namespace"), r.PathValue("tenant"), r.PathValue("id"))]
+	if a == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "approval not found")
+		return
+	}
+	s.expire(a)
+	writeJSON(w, http.StatusOK, a.status)
+}
+
+// handleApprovalDecide records decision on a pending approval. Unknown
+// and expired approvals answer 404 and decided ones 410, as the
+// gateway does.
+func (s *Server) handleApprovalDecide(decision acteon.ApprovalState) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		a := s.approvals[pathKey(r.PathValue("namespace"), r.PathValue("tenant"), r.PathValue("id"))]
+		if a != nil {
+			s.expire(a)
+		}
+		switch {
+		case a == nil || a.status.Status == acteon.ApprovalExpired:
+			writeError(w, http.StatusNotFound, "NOT_FOUND", "approval not found or expired")
+			return
+		case a.status.Status != acteon.ApprovalPending:
+			writeError(w, http.StatusGone, "GONE", "approval already decided")
+			return
+		}
+		now := time.Now().UTC()
+		a.status.Status = decision
+		a.status.DecidedAt = &now
+		writeJSON(w, http.StatusOK, acteon.ApprovalActionResponse{ID: a.status.Token, Status: decision})
+	}
+}
+
+// expire marks a pending approval past its deadline expired. The
+// caller holds s.mu.
+func (s *Server) expire(a *approval) {
+	if a.status.Status == acteon.ApprovalPending && time.Now().After(a.status.ExpiresAt) {
+		a.status.Status = acteon.ApprovalExpired
+	}
+}
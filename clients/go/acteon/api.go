@@ -0,0 +1,11 @@
+// Interface over the Go client for callers that want to substitute it.
+//
+// ActeonAPI itself lives in api_gen.go, generated from Client's method
+// set so the two cannot drift apart; acteonmock.Client is generated
+// alongside it.
+
+package acteon
+
+//go:generate go run ./internal/apigen
+
+var _ ActeonAPI = (*Client)(nil)
@@ -0,0 +1,247 @@
+// Code generated by apigen; DO NOT EDIT.
+
+package acteon
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// ActeonAPI is the method set of *Client. Depend on it instead of
+// *Client to substitute a fake, such as acteonmock.Client, in tests.
+type ActeonAPI interface {
+	A2ACancelTask(ctx context.Context, namespace, tenant, taskID string) (map[string]any, error)
+	A2ADeletePushConfig(ctx context.Context, namespace, tenant, taskID, configID string) error
+	A2ADiscoverAgent(ctx context.Context, namespace, tenant string) (map[string]any, error)
+	A2AGetAuthenticatedExtendedCard(ctx context.Context, namespace, tenant string) (map[string]any, error)
+	A2AGetPushConfig(ctx context.Context, namespace, tenant, taskID, configID string) (map[string]any, error)
+	A2AGetTask(ctx context.Context, namespace, tenant, taskID string) (map[string]any, error)
+	A2AListPushConfigs(ctx context.Context, namespace, tenant, taskID string) ([]map[string]any, error)
+	A2ASendMessage(ctx context.Context, namespace, tenant string, message map[string]any) (map[string]any, error)
+	A2ASetPushConfig(ctx context.Context, namespace, tenant, taskID string, config map[string]any) (map[string]any, error)
+	AppendBusConversationMessage(ctx context.Context, namespace, tenant, conversationID string, req *AppendBusConversationMessage) (map[string]any, error)
+	Apply(ctx context.Context, manifest *Manifest, opts ApplyOptions) (*ApplyResult, error)
+	Approve(ctx context.Context, namespace, tenant, id, sig string, expiresAt int64, kid string) (*ApprovalActionResponse, error)
+	ApproveBusApproval(ctx context.Context, namespace, tenant, approvalID string, decision *BusApprovalDecision) (*BusApprovalDecisionResponse, error)
+	AwaitOutcome(ctx context.Context, actionID string, timeout time.Duration) (*AuditRecord, error)
+	BusStreamConsumeURL(namespace, tenant, conversationID, streamID string) string
+	CacheStats() CacheStats
+	CancelChain(ctx context.Context, chainID string, req *CancelChainRequest) (*ChainDetailResponse, error)
+	CancelSwarmRun(ctx context.Context, runID string) (*SwarmRunSnapshot, error)
+	CheckQuota(ctx context.Context, namespace, tenant string, n int) (*QuotaCheckResult, error)
+	CompleteTask(ctx context.Context, taskID string, req *CompleteTaskRequest) (*WorkerTask, error)
+	ConsumeBusStream(ctx context.Context, namespace, tenant, conversationID, streamID string) (<-chan *BusStreamItem, error)
+	ConsumeBusSubscription(ctx context.Context, subscriptionID string, opts *ConsumeBusSubscriptionOptions) (<-chan *BusConsumeItem, error)
+	CreateBackup(ctx context.Context, req *BackupRequest) (*Backup, error)
+	CreateBusConversation(ctx context.Context, req *CreateBusConversation) (*BusConversation, error)
+	CreateBusSubscription(ctx context.Context, req *CreateBusSubscription) (*BusSubscription, error)
+	CreateBusTopic(ctx context.Context, req *CreateBusTopic) (*BusTopic, error)
+	CreateEscalationPolicy(ctx context.Context, req *CreateEscalationPolicyRequest) (*EscalationPolicy, error)
+	CreateProfile(ctx context.Context, req *CreateProfileRequest) (*TemplateProfileInfo, error)
+	CreateProviderConfig(ctx context.Context, req *CreateProviderConfigRequest) (*ProviderConfig, error)
+	CreateQuota(ctx context.Context, req *CreateQuotaRequest) (*QuotaPolicy, error)
+	CreateRecurring(ctx context.Context, recurring *CreateRecurringAction) (*CreateRecurringResponse, error)
+	CreateRetention(ctx context.Context, req *CreateRetentionRequest) (*RetentionPolicy, error)
+	CreateRoleBinding(ctx context.Context, req *CreateRoleBindingRequest) (*RoleBinding, error)
+	CreateSilence(ctx context.Context, req *CreateSilenceRequest) (*Silence, error)
+	CreateTemplate(ctx context.Context, req *CreateTemplateRequest) (*TemplateInfo, error)
+	CreateThrottle(ctx context.Context, req *CreateThrottleRequest) (*ThrottlePolicy, error)
+	CreateTimeInterval(ctx context.Context, req *CreateTimeIntervalRequest) (*TimeInterval, error)
+	DeleteBusAgent(ctx context.Context, namespace, tenant, agentID string) error
+	DeleteBusConversation(ctx context.Context, namespace, tenant, conversationID string) error
+	DeleteBusSchema(ctx context.Context, namespace, tenant, subject string, version int) error
+	DeleteBusSubscription(ctx context.Context, namespace, tenant, subID string) error
+	DeleteBusTopic(ctx context.Context, namespace, tenant, name string) error
+	DeleteDlqEntry(ctx context.Context, actionID string) error
+	DeleteEscalationPolicy(ctx context.Context, policyID string) error
+	DeleteNotificationPreferences(ctx context.Context, tenant string) error
+	DeletePlugin(ctx context.Context, name string) error
+	DeleteProfile(ctx context.Context, profileID string) error
+	DeleteProviderConfig(ctx context.Context, name string) error
+	DeleteQuota(ctx context.Context, quotaID, namespace, tenant string) error
+	DeleteRecurring(ctx context.Context, recurringID, namespace, tenant string) error
+	DeleteRetention(ctx context.Context, retentionID string) error
+	DeleteRoleBinding(ctx context.Context, bindingID string) error
+	DeleteSecret(ctx context.Context, scope, name string) error
+	DeleteSilence(ctx context.Context, silenceID string) error
+	DeleteTemplate(ctx context.Context, templateID string) error
+	DeleteThrottle(ctx context.Context, throttleID, namespace, tenant string) error
+	DeleteTimeInterval(ctx context.Context, namespace, tenant, name string) error
+	Dispatch(ctx context.Context, action *Action) (*ActionOutcome, error)
+	DispatchBatch(ctx context.Context, actions []*Action) (BatchResults, error)
+	DispatchBatchAtomic(ctx context.Context, actions []*Action) (BatchResults, error)
+	DispatchBatchDryRun(ctx context.Context, actions []*Action) (BatchResults, error)
+	DispatchDryRun(ctx context.Context, action *Action) (*ActionOutcome, error)
+	DlqDrain(ctx context.Context) (*DlqDrainResponse, error)
+	DlqStats(ctx context.Context) (*DlqStatsResponse, error)
+	DlqStatsDetailed(ctx context.Context) (*DlqStatsDetailedResponse, error)
+	DumpAction(action *Action) ([]byte, error)
+	Endpoint(namespace, tenant string) string
+	EnqueueTask(ctx context.Context, queue string, req *EnqueueTaskRequest) (*WorkerTask, error)
+	EnsureQuota(ctx context.Context, req *CreateQuotaRequest) (bool, error)
+	EnsureRecurring(ctx context.Context, req *CreateRecurringAction) (bool, error)
+	EnsureRetention(ctx context.Context, req *CreateRetentionRequest) (bool, error)
+	EnsureTemplate(ctx context.Context, req *CreateTemplateRequest) (bool, error)
+	EraseSubjectData(ctx context.Context, query *SubjectQuery) (*SubjectErasureReport, error)
+	EvaluateGuardrail(ctx context.Context, text string) (*GuardrailEvaluation, error)
+	EvaluateRules(ctx context.Context, req EvaluateRulesRequest) (*EvaluateRulesResponse, error)
+	ExportSubjectData(ctx context.Context, query *SubjectQuery) (*SubjectDataExport, error)
+	ExportTemplates(ctx context.Context, filter *TemplateBundleFilter, w io.Writer) error
+	FailTask(ctx context.Context, taskID string, req *FailTaskRequest) (*WorkerTask, error)
+	FetchSigningKeys(ctx context.Context) (*SigningKeysResponse, error)
+	FlushGroup(ctx context.Context, groupKey string) (*FlushGroupResponse, error)
+	GetApproval(ctx context.Context, namespace, tenant, id, sig string, expiresAt int64, kid string) (*ApprovalStatus, error)
+	GetAuditRecord(ctx context.Context, actionID string) (*AuditRecord, error)
+	GetAuditRecords(ctx context.Context, actionIDs []string) (*AuditRecordsResult, error)
+	GetBackupStatus(ctx context.Context, backupID string) (*Backup, error)
+	GetBusAgent(ctx context.Context, namespace, tenant, agentID string) (*BusAgent, error)
+	GetBusApproval(ctx context.Context, namespace, tenant, approvalID string) (*BusApprovalView, error)
+	GetBusConversation(ctx context.Context, namespace, tenant, conversationID string) (*BusConversation, error)
+	GetBusSchema(ctx context.Context, namespace, tenant, subject string, version int) (*BusSchema, error)
+	GetBusSubscription(ctx context.Context, namespace, tenant, subID string) (*BusSubscription, error)
+	GetBusSubscriptionLag(ctx context.Context, namespace, tenant, subID string) (*BusLag, error)
+	GetBusTopic(ctx context.Context, namespace, tenant, name string) (*BusTopic, error)
+	GetChain(ctx context.Context, chainID, namespace, tenant string) (*ChainDetailResponse, error)
+	GetChainDag(ctx context.Context, chainID, namespace, tenant string) (*DagResponse, error)
+	GetChainDefinitionDag(ctx context.Context, name string) (*DagResponse, error)
+	GetChainHistory(ctx context.Context, chainID, namespace, tenant string) (*ChainHistoryResponse, error)
+	GetChainMetrics(ctx context.Context, chainName string, window time.Duration) (*ChainMetricsResponse, error)
+	GetComplianceStatus(ctx context.Context) (*ComplianceStatus, error)
+	GetConfig(ctx context.Context) (*GatewayConfig, error)
+	GetDlqEntry(ctx context.Context, actionID string) (*DlqEntryDetail, error)
+	GetDlqPolicy(ctx context.Context) (*DlqPolicy, error)
+	GetEscalationPolicy(ctx context.Context, policyID string) (*EscalationPolicy, error)
+	GetEscalationState(ctx context.Context, eventFingerprint string) (*EscalationState, error)
+	GetEvent(ctx context.Context, fingerprint, namespace, tenant string) (*EventState, error)
+	GetGroup(ctx context.Context, groupKey string) (*GroupDetail, error)
+	GetGuardrailConfig(ctx context.Context) (*GuardrailConfig, error)
+	GetHealthDetail(ctx context.Context) (*HealthDetail, error)
+	GetMaintenanceStatus(ctx context.Context) (*MaintenanceStatus, error)
+	GetMetrics(ctx context.Context) (*GatewayMetrics, error)
+	GetNotificationPreferences(ctx context.Context, tenant string) (*NotificationPreferences, error)
+	GetPlugin(ctx context.Context, name string) (*WasmPlugin, error)
+	GetProfile(ctx context.Context, profileID string) (*TemplateProfileInfo, error)
+	GetQuota(ctx context.Context, quotaID string) (*QuotaPolicy, error)
+	GetQuotaUsage(ctx context.Context, quotaID string) (*QuotaUsage, error)
+	GetRecurring(ctx context.Context, recurringID, namespace, tenant string) (*RecurringDetail, error)
+	GetRetention(ctx context.Context, retentionID string) (*RetentionPolicy, error)
+	GetRetentionArchiveStatus(ctx context.Context, retentionID string) (*RetentionArchiveStatus, error)
+	GetServerInfo(ctx context.Context) (*ServerInfo, error)
+	GetSilence(ctx context.Context, silenceID string) (*Silence, error)
+	GetSwarmRun(ctx context.Context, runID string) (*SwarmRunSnapshot, error)
+	GetTask(ctx context.Context, taskID, namespace, tenant string) (*WorkerTask, error)
+	GetTemplate(ctx context.Context, templateID string) (*TemplateInfo, error)
+	GetTemplateUsage(ctx context.Context, templateID string, window time.Duration) (*TemplateUsageResponse, error)
+	GetThrottle(ctx context.Context, throttleID string) (*ThrottlePolicy, error)
+	GetTimeInterval(ctx context.Context, namespace, tenant, name string) (*TimeInterval, error)
+	Health(ctx context.Context) (bool, error)
+	HeartbeatBusAgent(ctx context.Context, namespace, tenant, agentID string) (*BusAgent, error)
+	HeartbeatTask(ctx context.Context, taskID string, req *HeartbeatTaskRequest) (*WorkerTask, error)
+	ImportTemplates(ctx context.Context, r io.Reader, opts ImportOptions) (*ImportResult, error)
+	InspectPlugin(ctx context.Context, name string) (*PluginInspection, error)
+	InvokePlugin(ctx context.Context, name string, req *PluginInvocationRequest) (*PluginInvocationResponse, error)
+	InvokePluginBatch(ctx context.Context, name string, reqs []PluginInvocationRequest) (*PluginBatchInvocationResponse, error)
+	ListApprovals(ctx context.Context, namespace, tenant string) (*ApprovalListResponse, error)
+	ListBackups(ctx context.Context) (*ListBackupsResponse, error)
+	ListBusAgents(ctx context.Context, filter *ListBusAgentsFilter) ([]BusAgent, error)
+	ListBusApprovals(ctx context.Context, namespace, tenant string, filter *ListBusApprovalsFilter) ([]BusApprovalView, error)
+	ListBusConversations(ctx context.Context, filter *ListBusConversationsFilter) ([]BusConversation, error)
+	ListBusSchemas(ctx context.Context, filter *ListBusSchemasFilter) ([]BusSchema, error)
+	ListBusSubscriptions(ctx context.Context, filter *ListBusSubscriptionsFilter) ([]BusSubscription, error)
+	ListBusTopics(ctx context.Context, filter *ListBusTopicsFilter) ([]BusTopic, error)
+	ListChains(ctx context.Context, namespace, tenant string, status *string) (*ListChainsResponse, error)
+	ListDlq(ctx context.Context, filter DlqFilter) (*DlqListResponse, error)
+	ListEscalationPolicies(ctx context.Context, namespace, tenant *string) (*ListEscalationPoliciesResponse, error)
+	ListEvents(ctx context.Context, query *EventQuery) (*EventListResponse, error)
+	ListGroups(ctx context.Context) (*GroupListResponse, error)
+	ListPluginVersions(ctx context.Context, name string) (*ListPluginVersionsResponse, error)
+	ListPlugins(ctx context.Context) (*ListPluginsResponse, error)
+	ListProfiles(ctx context.Context, namespace, tenant *string) (*ListProfilesResponse, error)
+	ListProviderConfigs(ctx context.Context, tenant *string) (*ListProviderConfigsResponse, error)
+	ListProviderHealth(ctx context.Context) (*ListProviderHealthResponse, error)
+	ListProviders(ctx context.Context) (*ListProvidersResponse, error)
+	ListQuotas(ctx context.Context, namespace, tenant, provider, principal *string) (*ListQuotasResponse, error)
+	ListRecurring(ctx context.Context, filter *RecurringFilter) (*ListRecurringResponse, error)
+	ListRetention(ctx context.Context, namespace, tenant *string, limit, offset *int) (*ListRetentionResponse, error)
+	ListRoleBindings(ctx context.Context, namespace, tenant *string) (*ListRoleBindingsResponse, error)
+	ListRoles(ctx context.Context) ([]RoleInfo, error)
+	ListRules(ctx context.Context) ([]RuleInfo, error)
+	ListSecrets(ctx context.Context, scope string) (*ListSecretsResponse, error)
+	ListSilences(ctx context.Context, namespace, tenant *string, includeExpired bool) (*ListSilencesResponse, error)
+	ListSwarmRuns(ctx context.Context, filter *SwarmRunFilter) (*ListSwarmRunsResponse, error)
+	ListTasks(ctx context.Context, queue, namespace, tenant, status string) ([]WorkerTask, error)
+	ListTemplates(ctx context.Context, namespace, tenant *string) (*ListTemplatesResponse, error)
+	ListThrottles(ctx context.Context, namespace, tenant, provider, actionType *string) (*ListThrottlesResponse, error)
+	ListTimeIntervals(ctx context.Context, namespace, tenant *string) (*ListTimeIntervalsResponse, error)
+	LookupBusToolResult(ctx context.Context, namespace, tenant, callID string, params *BusToolResultLookupParams) (*BusToolResultLookup, error)
+	PauseRecurring(ctx context.Context, recurringID, namespace, tenant string) (*RecurringDetail, error)
+	PollTasks(ctx context.Context, queue string, req *PollTasksRequest) ([]WorkerTask, error)
+	PostBusStreamChunk(ctx context.Context, namespace, tenant, conversationID string, req *PostBusStreamChunk) (*BusStreamEnvelopeReceipt, error)
+	PostBusStreamEnd(ctx context.Context, namespace, tenant, conversationID string, req *PostBusStreamEnd) (*BusStreamEnvelopeReceipt, error)
+	PostBusToolCall(ctx context.Context, namespace, tenant, conversationID string, req *PostBusToolCall) (*PostBusToolCallOutcome, error)
+	PostBusToolResult(ctx context.Context, namespace, tenant, conversationID string, req *PostBusToolResult) (*BusToolEnvelopeReceipt, error)
+	ProvideChainStepInput(ctx context.Context, chainID, stepName string, req *ProvideStepInputRequest) (*ChainDetailResponse, error)
+	PublishBusMessage(ctx context.Context, req *PublishBusMessage) (*PublishReceipt, error)
+	PurgeCache()
+	PurgeDlq(ctx context.Context, filter DlqPurgeFilter) (int, error)
+	PutChainDefinition(ctx context.Context, def *ChainDefinition) (*ChainDefinition, error)
+	PutNotificationPreferences(ctx context.Context, tenant string, prefs *NotificationPreferences) (*NotificationPreferences, error)
+	PutSecret(ctx context.Context, scope, name, value string) (*SecretInfo, error)
+	QueryAnalytics(ctx context.Context, query *AnalyticsQuery) (*AnalyticsResponse, error)
+	QueryAudit(ctx context.Context, query *AuditQuery) (*AuditPage, error)
+	Ready(ctx context.Context) (*Readiness, error)
+	RegisterBusAgent(ctx context.Context, req *RegisterBusAgent) (*BusAgent, error)
+	RegisterBusSchema(ctx context.Context, req *RegisterBusSchema) (*BusSchema, error)
+	RegisterPlugin(ctx context.Context, req *RegisterPluginRequest) (*WasmPlugin, error)
+	RegisterPluginFromFile(ctx context.Context, name, path string, opts *PluginUploadOptions) (*WasmPlugin, error)
+	RegisterPluginFromReader(ctx context.Context, name string, r io.Reader, size int64, opts *PluginUploadOptions) (*WasmPlugin, error)
+	RegisterPluginVersion(ctx context.Context, name string, req *RegisterPluginVersionRequest) (*PluginVersion, error)
+	Reject(ctx context.Context, namespace, tenant, id, sig string, expiresAt int64, kid string) (*ApprovalActionResponse, error)
+	RejectBusApproval(ctx context.Context, namespace, tenant, approvalID string, decision *BusApprovalDecision) (*BusApprovalDecisionResponse, error)
+	ReloadConfig(ctx context.Context) (*ConfigReloadResult, error)
+	ReloadRules(ctx context.Context) (*ReloadResult, error)
+	RenderPreview(ctx context.Context, req *RenderPreviewRequest) (*RenderPreviewResponse, error)
+	ReplayAction(ctx context.Context, actionID string) (*ReplayResult, error)
+	ReplayAudit(ctx context.Context, query *ReplayQuery) (*ReplaySummary, error)
+	ReplayBusConversationMessages(ctx context.Context, namespace, tenant, conversationID string, params *ReplayBusConversationParams) (*BusReplayResponse, error)
+	ResolveProfile(ctx context.Context, profileID string) (*ResolvedProfile, error)
+	RestoreBackup(ctx context.Context, backupID string, opts RestoreOptions) (*RestoreResult, error)
+	ResumeRecurring(ctx context.Context, recurringID, namespace, tenant string) (*RecurringDetail, error)
+	RetryChain(ctx context.Context, chainID string, opts RetryOptions) (*ChainDetailResponse, error)
+	RetryDlq(ctx context.Context, filter DlqFilter, opts DlqRetryOptions) (*DlqRetryReport, error)
+	RetryDlqEntry(ctx context.Context, actionID string) (*DlqRetryResult, error)
+	RollbackPlugin(ctx context.Context, name string, version int) (*WasmPlugin, error)
+	RulesCoverage(ctx context.Context, query *CoverageQuery) (*CoverageReport, error)
+	SetBusAgentAdminState(ctx context.Context, namespace, tenant, agentID string, req *SetBusAgentAdminState) (*BusAgent, error)
+	SetDlqPolicy(ctx context.Context, policy DlqPolicy) (*DlqPolicy, error)
+	SetMaintenanceMode(ctx context.Context, enabled bool, opts MaintenanceOptions) (*MaintenanceStatus, error)
+	SetPluginEnabled(ctx context.Context, name string, enabled bool) (*WasmPlugin, error)
+	SetRuleEnabled(ctx context.Context, ruleName string, enabled bool) error
+	StartChain(ctx context.Context, req StartChainRequest) (*StartChainResponse, error)
+	Stream(ctx context.Context, opts *StreamOptions) (<-chan *SseEvent, error)
+	Subscribe(ctx context.Context, entityType, entityID string, opts *SubscribeOptions) (<-chan *SseEvent, error)
+	SubscribeChain(ctx context.Context, chainID string, opts *SubscribeOptions) (<-chan *ChainEvent, error)
+	SyncPluginFromRegistry(ctx context.Context, ref PluginRef) (*WasmPlugin, error)
+	TestProvider(ctx context.Context, provider string, sampleAction *Action) (*ProviderTestResult, error)
+	TransitionBusConversation(ctx context.Context, namespace, tenant, conversationID, targetState string) (*BusConversation, error)
+	TransitionEvent(ctx context.Context, fingerprint, toState, namespace, tenant string) (*TransitionResponse, error)
+	UpdateEscalationPolicy(ctx context.Context, policyID string, update *UpdateEscalationPolicyRequest) (*EscalationPolicy, error)
+	UpdateGuardrailConfig(ctx context.Context, update *UpdateGuardrailConfigRequest) (*GuardrailConfig, error)
+	UpdatePluginConfig(ctx context.Context, name string, cfg *WasmPluginConfig) (*WasmPlugin, error)
+	UpdateProfile(ctx context.Context, profileID string, update *UpdateProfileRequest) (*TemplateProfileInfo, error)
+	UpdateProviderConfig(ctx context.Context, name string, update *UpdateProviderConfigRequest) (*ProviderConfig, error)
+	UpdateQuota(ctx context.Context, quotaID string, update *UpdateQuotaRequest) (*QuotaPolicy, error)
+	UpdateRecurring(ctx context.Context, recurringID string, update *UpdateRecurringAction) (*RecurringDetail, error)
+	UpdateRetention(ctx context.Context, retentionID string, update *UpdateRetentionRequest) (*RetentionPolicy, error)
+	UpdateRoleBinding(ctx context.Context, bindingID string, update *UpdateRoleBindingRequest) (*RoleBinding, error)
+	UpdateSilence(ctx context.Context, silenceID string, update *UpdateSilenceRequest) (*Silence, error)
+	UpdateTemplate(ctx context.Context, templateID string, update *UpdateTemplateRequest) (*TemplateInfo, error)
+	UpdateThrottle(ctx context.Context, throttleID string, update *UpdateThrottleRequest) (*ThrottlePolicy, error)
+	UpdateTimeInterval(ctx context.Context, namespace, tenant, name string, update *UpdateTimeIntervalRequest) (*TimeInterval, error)
+	ValidateChainDefinition(ctx context.Context, def *ChainDefinition) (*ChainValidationResult, error)
+	ValidateTemplate(ctx context.Context, content string) (*TemplateValidationResult, error)
+	VerifyAuditChain(ctx context.Context, req *VerifyHashChainRequest) (*HashChainVerification, error)
+	VerifyPlugin(ctx context.Context, name string) (*PluginVerification, error)
+	WhoAmI(ctx context.Context) (*CallerIdentity, error)
+}
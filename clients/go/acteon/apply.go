@@ -0,0 +1,873 @@
+// Declarative resource sync for the Go ActeonClient.
+//
+// A Manifest lists the quotas, retention policies, templates, profiles,
+// recurring actions, and rule toggles a gateway should have. Apply
+// diffs it against what the gateway holds and creates, updates, or
+// (with Prune) deletes resources until the two match, so tenant setup
+// can live in version control and be converged from CI. Resources are
+// matched by a natural key rather than by ID:
+//
+//   - quotas by namespace, tenant, provider, principal, and per_principal
+//   - retention policies by namespace and tenant
+//   - templates and profiles by namespace, tenant, and name
+//   - recurring actions by namespace, tenant, and name, recorded in the
+//     ApplyNameLabel label since the gateway does not return names
+//   - rules by name; only their enabled flag is managed
+//
+// Pruning only touches the namespace and tenant pairs that appear
+// somewhere in the manifest, and never touches recurring actions that
+// were not created by Apply or rules, which are loaded from files.
+//
+// EnsureQuota, EnsureRetention, EnsureTemplate, and EnsureRecurring
+// apply a single resource the same way, for bootstrap code that wants
+// get-or-create semantics without writing a manifest.
+
+package acteon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ApplyNameLabel is the label Apply stores a recurring action's
+// manifest name under.
+const ApplyNameLabel = "acteon.apply/name"
+
+// Manifest is the desired state read by Apply. Entries reuse the
+// corresponding create request types, so field names match the API.
+type Manifest struct {
+	Quotas    []CreateQuotaRequest     `json:"quotas,omitempty"`
+	Retention []CreateRetentionRequest `json:"retention,omitempty"`
+	Templates []CreateTemplateRequest  `json:"templates,omitempty"`
+	Profiles  []CreateProfileRequest   `json:"profiles,omitempty"`
+	Recurring []CreateRecurringAction  `json:"recurring,omitempty"`
+	Rules     []ManifestRule           `json:"rules,omitempty"`
+}
+
+// ManifestRule sets whether a loaded rule is enabled.
+type ManifestRule struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
+
+// ParseManifest reads a YAML (or JSON) manifest from r. Unknown fields
+// are rejected so that typos fail loudly instead of being ignored.
+func ParseManifest(r io.Reader) (*Manifest, error) {
+	var doc any
+	if err := yaml.NewDecoder(r).Decode(&doc); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("decode manifest: %w", err)
+	}
+	if doc == nil {
+		return &Manifest{}, nil
+	}
+	// Round-trip through JSON so the manifest shares the request types'
+	// json tags and profile fields decode as raw JSON.
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("decode manifest: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	var m Manifest
+	if err := dec.Decode(&m); err != nil {
+		return nil, fmt.Errorf("decode manifest: %w", err)
+	}
+	return &m, nil
+}
+
+// Validate reports missing required fields and duplicate entries.
+func (m *Manifest) Validate() error {
+	var errs []error
+	seen := map[string]bool{}
+	check := func(kind, namespace, tenant, name string, key any, needName bool) {
+		if namespace == "" || tenant == "" {
+			errs = append(errs, fmt.Errorf("%s %q: namespace and tenant are required", kind, name))
+		}
+		if needName && name == "" {
+			errs = append(errs, fmt.Errorf("%s in %s/%s: name is required", kind, namespace, tenant))
+		}
+		id := fmt.Sprintf("%s %v", kind, key)
+		if seen[id] {
+			errs = append(errs, fmt.Errorf("%s %q in %s/%s is declared more than once", kind, name, namespace, tenant))
+		}
+		seen[id] = true
+	}
+	for _, q := range m.Quotas {
+		check("quota", q.Namespace, q.Tenant, quotaName(q.Provider, q.Principal, q.PerPrincipal), keyOfQuota(q.Namespace, q.Tenant, q.Provider, q.Principal, q.PerPrincipal), false)
+	}
+	for _, r := range m.Retention {
+		check("retention", r.Namespace, r.Tenant, "retention", bundleScope{r.Namespace, r.Tenant}, false)
+	}
+	for _, t := range m.Templates {
+		check("template", t.Namespace, t.Tenant, t.Name, bundleKey{t.Namespace, t.Tenant, t.Name}, true)
+	}
+	for _, p := range m.Profiles {
+		check("profile", p.Namespace, p.Tenant, p.Name, bundleKey{p.Namespace, p.Tenant, p.Name}, true)
+	}
+	for _, r := range m.Recurring {
+		check("recurring", r.Namespace, r.Tenant, r.Name, bundleKey{r.Namespace, r.Tenant, r.Name}, true)
+	}
+	for _, r := range m.Rules {
+		if r.Name == "" {
+			errs = append(errs, errors.New("rule: name is required"))
+		}
+		if seen["rule "+r.Name] {
+			errs = append(errs, fmt.Errorf("rule %q is declared more than once", r.Name))
+		}
+		seen["rule "+r.Name] = true
+	}
+	return errors.Join(errs...)
+}
+
+// scopes returns every namespace and tenant pair the manifest mentions,
+// in sorted order.
+func (m *Manifest) scopes() []bundleScope {
+	set := map[bundleScope]bool{}
+	for _, q := range m.Quotas {
+		set[bundleScope{q.Namespace, q.Tenant}] = true
+	}
+	for _, r := range m.Retention {
+		set[bundleScope{r.Namespace, r.Tenant}] = true
+	}
+	for _, t := range m.Templates {
+		set[bundleScope{t.Namespace, t.Tenant}] = true
+	}
+	for _, p := range m.Profiles {
+		set[bundleScope{p.Namespace, p.Tenant}] = true
+	}
+	for _, r := range m.Recurring {
+		set[bundleScope{r.Namespace, r.Tenant}] = true
+	}
+	out := make([]bundleScope, 0, len(set))
+	for s := range set {
+		out = append(out, s)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return bundleKey{out[i].namespace, out[i].tenant, ""}.less(bundleKey{out[j].namespace, out[j].tenant, ""})
+	})
+	return out
+}
+
+// ApplyOptions configures Apply.
+type ApplyOptions struct {
+	// Prune deletes resources in the manifest's namespaces and tenants
+	// that the manifest does not declare.
+	Prune bool
+	// DryRun computes the plan without writing anything.
+	DryRun bool
+}
+
+// Apply actions reported in ApplyChange.Action.
+const (
+	ApplyCreate    = "create"
+	ApplyUpdate    = "update"
+	ApplyReplace   = "replace"
+	ApplyDelete    = "delete"
+	ApplyUnchanged = "unchanged"
+)
+
+// ApplyChange records what Apply did, or would do on a dry run, to one
+// resource.
+type ApplyChange struct {
+	// Kind is "quota", "retention", "template", "profile", "recurring",
+	// or "rule".
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	Tenant    string `json:"tenant,omitempty"`
+	// ID is the gateway ID of an existing resource; empty for creates.
+	ID     string `json:"id,omitempty"`
+	Action string `json:"action"`
+	// Fields lists the fields that differ for updates and replaces.
+	Fields []string `json:"fields,omitempty"`
+
+	run func(context.Context) error
+}
+
+// ApplyResult is the outcome of Apply.
+type ApplyResult struct {
+	DryRun  bool          `json:"dry_run"`
+	Changes []ApplyChange `json:"changes"`
+}
+
+// Changed reports whether any change is something other than
+// ApplyUnchanged.
+func (r *ApplyResult) Changed() bool {
+	for _, c := range r.Changes {
+		if c.Action != ApplyUnchanged {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply converges the gateway towards manifest. It validates the
+// manifest, reads the current state of every namespace and tenant the
+// manifest mentions, and plans every change before making any, so a
+// bad manifest or a dangling profile `$ref` writes nothing. Changes
+// run in dependency order (templates before the profiles that
+// reference them, deletions last); on a write error Apply stops and
+// returns the changes made so far alongside the error.
+func (c *Client) Apply(ctx context.Context, manifest *Manifest, opts ApplyOptions) (*ApplyResult, error) {
+	ctx = withoutLabelSelector(ctx)
+	ctx = withoutIdempotencyKey(ctx)
+	if err := manifest.Validate(); err != nil {
+		return nil, err
+	}
+	p := &applyPlan{c: c, m: manifest, prune: opts.Prune}
+	if err := p.build(ctx); err != nil {
+		return nil, err
+	}
+
+	result := &ApplyResult{DryRun: opts.DryRun, Changes: make([]ApplyChange, 0, len(p.changes))}
+	for _, ch := range p.changes {
+		if !opts.DryRun && ch.run != nil {
+			if err := ch.run(ctx); err != nil {
+				return result, fmt.Errorf("%s %s %q: %w", ch.Action, ch.Kind, ch.Name, err)
+			}
+		}
+		result.Changes = append(result.Changes, ch)
+	}
+	return result, nil
+}
+
+// EnsureQuota creates the quota described by req, or updates the
+// existing quota with the same namespace, tenant, provider, and
+// principal scope to match it. It reports whether anything changed.
+func (c *Client) EnsureQuota(ctx context.Context, req *CreateQuotaRequest) (bool, error) {
+	return c.ensure(ctx, &Manifest{Quotas: []CreateQuotaRequest{*req}})
+}
+
+// EnsureRetention creates the retention policy for req's namespace and
+// tenant, or updates the existing one to match it. It reports whether
+// anything changed.
+func (c *Client) EnsureRetention(ctx context.Context, req *CreateRetentionRequest) (bool, error) {
+	return c.ensure(ctx, &Manifest{Retention: []CreateRetentionRequest{*req}})
+}
+
+// EnsureTemplate creates the template named by req in its namespace
+// and tenant, or updates the existing one to match it. It reports
+// whether anything changed.
+func (c *Client) EnsureTemplate(ctx context.Context, req *CreateTemplateRequest) (bool, error) {
+	return c.ensure(ctx, &Manifest{Templates: []CreateTemplateRequest{*req}})
+}
+
+// EnsureRecurring creates the recurring action named by req in its
+// namespace and tenant, or updates the existing one to match it,
+// replacing it if the provider or action type changed. Like Apply, it
+// finds existing actions by the ApplyNameLabel label, so actions
+// created without it are never matched. It reports whether anything
+// changed.
+func (c *Client) EnsureRecurring(ctx context.Context, req *CreateRecurringAction) (bool, error) {
+	return c.ensure(ctx, &Manifest{Recurring: []CreateRecurringAction{*req}})
+}
+
+// ensure applies a one-resource manifest without pruning.
+func (c *Client) ensure(ctx context.Context, m *Manifest) (bool, error) {
+	res, err := c.Apply(ctx, m, ApplyOptions{})
+	if err != nil {
+		return false, err
+	}
+	return res.Changed(), nil
+}
+
+// applyPlan accumulates the changes Apply will make.
+type applyPlan struct {
+	c     *Client
+	m     *Manifest
+	prune bool
+
+	changes []ApplyChange
+	deletes []ApplyChange
+}
+
+func (p *applyPlan) add(ch ApplyChange) {
+	if ch.Action == ApplyDelete {
+		p.deletes = append(p.deletes, ch)
+		return
+	}
+	p.changes = append(p.changes, ch)
+}
+
+func (p *applyPlan) build(ctx context.Context) error {
+	scopes := p.m.scopes()
+	steps := []func(context.Context, []bundleScope) error{
+		p.planRetention,
+		p.planQuotas,
+		p.planTemplatesAndProfiles,
+		p.planRecurring,
+		p.planRules,
+	}
+	for _, step := range steps {
+		if err := step(ctx, scopes); err != nil {
+			return err
+		}
+	}
+	// Delete in reverse dependency order: recurring actions and
+	// profiles go before the templates they may reference.
+	for i := len(p.deletes) - 1; i >= 0; i-- {
+		p.changes = append(p.changes, p.deletes[i])
+	}
+	return nil
+}
+
+func (p *applyPlan) planRetention(ctx context.Context, scopes []bundleScope) error {
+	desired := map[bundleScope]*CreateRetentionRequest{}
+	for i := range p.m.Retention {
+		r := &p.m.Retention[i]
+		desired[bundleScope{r.Namespace, r.Tenant}] = r
+	}
+	if len(desired) == 0 && !p.prune {
+		return nil
+	}
+	for _, s := range scopes {
+		ns, tenant := s.namespace, s.tenant
+		policies, err := p.c.Retention().all(ctx, &ns, &tenant)
+		if err != nil {
+			return err
+		}
+		var current *RetentionPolicy
+		for i := range policies {
+			if policies[i].Namespace == ns && policies[i].Tenant == tenant {
+				current = &policies[i]
+				break
+			}
+		}
+		want := desired[s]
+		ch := ApplyChange{Kind: "retention", Name: "retention", Namespace: ns, Tenant: tenant}
+		switch {
+		case want == nil && current == nil:
+			continue
+		case want == nil:
+			if !p.prune {
+				continue
+			}
+			id := current.ID
+			ch.ID, ch.Action = id, ApplyDelete
+			ch.run = func(ctx context.Context) error { return p.c.DeleteRetention(ctx, id) }
+		case current == nil:
+			ch.Action = ApplyCreate
+			ch.run = func(ctx context.Context) error {
+				_, err := p.c.CreateRetention(ctx, want)
+				return err
+			}
+		default:
+			ch.ID = current.ID
+			update := &UpdateRetentionRequest{}
+			if current.AuditTTLSeconds != want.AuditTTLSeconds {
+				ch.Fields = append(ch.Fields, "audit_ttl_seconds")
+				update.AuditTTLSeconds = &want.AuditTTLSeconds
+			}
+			if current.StateTTLSeconds != want.StateTTLSeconds {
+				ch.Fields = append(ch.Fields, "state_ttl_seconds")
+				update.StateTTLSeconds = &want.StateTTLSeconds
+			}
+			if current.EventTTLSeconds != want.EventTTLSeconds {
+				ch.Fields = append(ch.Fields, "event_ttl_seconds")
+				update.EventTTLSeconds = &want.EventTTLSeconds
+			}
+			if current.ComplianceHold != want.ComplianceHold {
+				ch.Fields = append(ch.Fields, "compliance_hold")
+				update.ComplianceHold = &want.ComplianceHold
+			}
+			if derefString(current.Description) != want.Description {
+				ch.Fields = append(ch.Fields, "description")
+				update.Description = &want.Description
+			}
+			if !equalLabels(current.Labels, want.Labels) {
+				ch.Fields = append(ch.Fields, "labels")
+				update.Labels = want.Labels
+			}
+			if !reflect.DeepEqual(current.Archive, want.Archive) {
+				ch.Fields = append(ch.Fields, "archive")
+				update.Archive = want.Archive
+				update.RemoveArchive = want.Archive == nil
+			}
+			p.setUpdate(&ch, func(ctx context.Context) error {
+				_, err := p.c.UpdateRetention(ctx, ch.ID, update)
+				return err
+			})
+		}
+		p.add(ch)
+	}
+	return nil
+}
+
+func (p *applyPlan) planQuotas(ctx context.Context, scopes []bundleScope) error {
+	desired := map[quotaKey]*CreateQuotaRequest{}
+	for i := range p.m.Quotas {
+		q := &p.m.Quotas[i]
+		desired[keyOfQuota(q.Namespace, q.Tenant, q.Provider, q.Principal, q.PerPrincipal)] = q
+	}
+	if len(desired) == 0 && !p.prune {
+		return nil
+	}
+	current := map[quotaKey]*QuotaPolicy{}
+	for _, s := range scopes {
+		ns, tenant := s.namespace, s.tenant
+		quotas, err := p.c.Quotas().all(ctx, &ns, &tenant, nil, nil)
+		if err != nil {
+			return err
+		}
+		for i := range quotas {
+			q := &quotas[i]
+			current[keyOfQuota(q.Namespace, q.Tenant, q.Provider, q.Principal, q.PerPrincipal)] = q
+		}
+	}
+
+	for i := range p.m.Quotas {
+		want := &p.m.Quotas[i]
+		key := keyOfQuota(want.Namespace, want.Tenant, want.Provider, want.Principal, want.PerPrincipal)
+		ch := ApplyChange{Kind: "quota", Name: quotaName(want.Provider, want.Principal, want.PerPrincipal), Namespace: want.Namespace, Tenant: want.Tenant}
+		have, ok := current[key]
+		if !ok {
+			ch.Action = ApplyCreate
+			ch.run = func(ctx context.Context) error {
+				_, err := p.c.CreateQuota(ctx, want)
+				return err
+			}
+			p.add(ch)
+			continue
+		}
+		ch.ID = have.ID
+		update := &UpdateQuotaRequest{Namespace: want.Namespace, Tenant: want.Tenant}
+		if have.MaxActions != want.MaxActions {
+			ch.Fields = append(ch.Fields, "max_actions")
+			update.MaxActions = &want.MaxActions
+		}
+		if have.Window != want.Window {
+			ch.Fields = append(ch.Fields, "window")
+			update.Window = &want.Window
+		}
+		if have.OverageBehavior != want.OverageBehavior {
+			ch.Fields = append(ch.Fields, "overage_behavior")
+			update.OverageBehavior = &want.OverageBehavior
+		}
+		if derefString(have.Description) != want.Description {
+			ch.Fields = append(ch.Fields, "description")
+			update.Description = &want.Description
+		}
+		p.setUpdate(&ch, func(ctx context.Context) error {
+			_, err := p.c.UpdateQuota(ctx, ch.ID, update)
+			return err
+		})
+		p.add(ch)
+	}
+
+	if p.prune {
+		for _, key := range sortedQuotaKeys(current) {
+			if desired[key] != nil {
+				continue
+			}
+			q := current[key]
+			id, ns, tenant := q.ID, q.Namespace, q.Tenant
+			p.add(ApplyChange{
+				Kind: "quota", Name: quotaName(q.Provider, q.Principal, q.PerPrincipal),
+				Namespace: ns, Tenant: tenant, ID: id, Action: ApplyDelete,
+				run: func(ctx context.Context) error { return p.c.DeleteQuota(ctx, id, ns, tenant) },
+			})
+		}
+	}
+	return nil
+}
+
+func (p *applyPlan) planTemplatesAndProfiles(ctx context.Context, scopes []bundleScope) error {
+	if len(p.m.Templates) == 0 && len(p.m.Profiles) == 0 && !p.prune {
+		return nil
+	}
+	templates := map[bundleKey]*TemplateInfo{}
+	profiles := map[bundleKey]*TemplateProfileInfo{}
+	for _, s := range scopes {
+		ns, tenant := s.namespace, s.tenant
+		tl, err := p.c.allTemplates(ctx, &ns, &tenant)
+		if err != nil {
+			return err
+		}
+		for i := range tl {
+			t := &tl[i]
+			templates[bundleKey{t.Namespace, t.Tenant, t.Name}] = t
+		}
+		pl, err := p.c.allProfiles(ctx, &ns, &tenant)
+		if err != nil {
+			return err
+		}
+		for i := range pl {
+			pr := &pl[i]
+			profiles[bundleKey{pr.Namespace, pr.Tenant, pr.Name}] = pr
+		}
+	}
+
+	// Profile refs must resolve against what will exist afterwards:
+	// templates in the manifest, plus gateway templates that are not
+	// about to be pruned.
+	desiredTemplates := map[bundleKey]bool{}
+	for _, t := range p.m.Templates {
+		desiredTemplates[bundleKey{t.Namespace, t.Tenant, t.Name}] = true
+	}
+	remaining := map[bundleKey]string{}
+	for key, t := range templates {
+		if !p.prune || desiredTemplates[key] {
+			remaining[key] = t.ID
+		}
+	}
+	if err := checkBundleRefs(&TemplateBundle{Templates: p.m.Templates, Profiles: p.m.Profiles}, remaining); err != nil {
+		return err
+	}
+
+	for i := range p.m.Templates {
+		want := &p.m.Templates[i]
+		ch := ApplyChange{Kind: "template", Name: want.Name, Namespace: want.Namespace, Tenant: want.Tenant}
+		have, ok := templates[bundleKey{want.Namespace, want.Tenant, want.Name}]
+		if !ok {
+			ch.Action = ApplyCreate
+			ch.run = func(ctx context.Context) error {
+				_, err := p.c.CreateTemplate(ctx, want)
+				return err
+			}
+			p.add(ch)
+			continue
+		}
+		ch.ID = have.ID
+		update := &UpdateTemplateRequest{}
+		if have.Content != want.Content {
+			ch.Fields = append(ch.Fields, "content")
+			update.Content = &want.Content
+		}
+		if derefString(have.Description) != want.Description {
+			ch.Fields = append(ch.Fields, "description")
+			update.Description = &want.Description
+		}
+		if !equalLabels(have.Labels, want.Labels) {
+			ch.Fields = append(ch.Fields, "labels")
+			update.Labels = want.Labels
+		}
+		p.setUpdate(&ch, func(ctx context.Context) error {
+			_, err := p.c.UpdateTemplate(ctx, ch.ID, update)
+			return err
+		})
+		p.add(ch)
+	}
+
+	desiredProfiles := map[bundleKey]bool{}
+	for i := range p.m.Profiles {
+		want := &p.m.Profiles[i]
+		key := bundleKey{want.Namespace, want.Tenant, want.Name}
+		desiredProfiles[key] = true
+		ch := ApplyChange{Kind: "profile", Name: want.Name, Namespace: want.Namespace, Tenant: want.Tenant}
+		have, ok := profiles[key]
+		if !ok {
+			ch.Action = ApplyCreate
+			ch.run = func(ctx context.Context) error {
+				_, err := p.c.CreateProfile(ctx, want)
+				return err
+			}
+			p.add(ch)
+			continue
+		}
+		ch.ID = have.ID
+		update := &UpdateProfileRequest{}
+		if !equalJSON(have.Fields, want.Fields) {
+			ch.Fields = append(ch.Fields, "fields")
+			update.Fields = want.Fields
+		}
+		if derefString(have.Description) != want.Description {
+			ch.Fields = append(ch.Fields, "description")
+			update.Description = &want.Description
+		}
+		if !equalLabels(have.Labels, want.Labels) {
+			ch.Fields = append(ch.Fields, "labels")
+			update.Labels = want.Labels
+		}
+		p.setUpdate(&ch, func(ctx context.Context) error {
+			_, err := p.c.UpdateProfile(ctx, ch.ID, update)
+			return err
+		})
+		p.add(ch)
+	}
+
+	if p.prune {
+		for _, key := range sortedKeys(templates) {
+			if desiredTemplates[key] {
+				continue
+			}
+			id := templates[key].ID
+			p.add(ApplyChange{
+				Kind: "template", Name: key.name, Namespace: key.namespace, Tenant: key.tenant,
+				ID: id, Action: ApplyDelete,
+				run: func(ctx context.Context) error { return p.c.DeleteTemplate(ctx, id) },
+			})
+		}
+		for _, key := range sortedKeys(profiles) {
+			if desiredProfiles[key] {
+				continue
+			}
+			id := profiles[key].ID
+			p.add(ApplyChange{
+				Kind: "profile", Name: key.name, Namespace: key.namespace, Tenant: key.tenant,
+				ID: id, Action: ApplyDelete,
+				run: func(ctx context.Context) error { return p.c.DeleteProfile(ctx, id) },
+			})
+		}
+	}
+	return nil
+}
+
+func (p *applyPlan) planRecurring(ctx context.Context, scopes []bundleScope) error {
+	if len(p.m.Recurring) == 0 && !p.prune {
+		return nil
+	}
+	// Summaries carry no labels, so each action is fetched to find the
+	// ones Apply manages.
+	current := map[bundleKey]*RecurringDetail{}
+	for _, s := range scopes {
+		actions, err := p.c.Recurring().all(ctx, RecurringFilter{Namespace: s.namespace, Tenant: s.tenant})
+		if err != nil {
+			return err
+		}
+		for _, summary := range actions {
+			detail, err := p.c.GetRecurring(ctx, summary.ID, summary.Namespace, summary.Tenant)
+			if err != nil {
+				return err
+			}
+			if detail == nil || detail.Labels[ApplyNameLabel] == "" {
+				continue
+			}
+			current[bundleKey{detail.Namespace, detail.Tenant, detail.Labels[ApplyNameLabel]}] = detail
+		}
+	}
+
+	desired := map[bundleKey]bool{}
+	for i := range p.m.Recurring {
+		want := withApplyLabel(p.m.Recurring[i])
+		key := bundleKey{want.Namespace, want.Tenant, want.Name}
+		desired[key] = true
+		ch := ApplyChange{Kind: "recurring", Name: want.Name, Namespace: want.Namespace, Tenant: want.Tenant}
+		create := func(ctx context.Context) error {
+			_, err := p.c.CreateRecurring(ctx, want)
+			return err
+		}
+		have, ok := current[key]
+		if !ok {
+			ch.Action = ApplyCreate
+			ch.run = create
+			p.add(ch)
+			continue
+		}
+		ch.ID = have.ID
+
+		// Provider and action type cannot be changed in place.
+		if have.Provider != want.Provider {
+			ch.Fields = append(ch.Fields, "provider")
+		}
+		if have.ActionType != want.ActionType {
+			ch.Fields = append(ch.Fields, "action_type")
+		}
+		if len(ch.Fields) > 0 {
+			ch.Action = ApplyReplace
+			id := have.ID
+			ch.run = func(ctx context.Context) error {
+				if err := p.c.DeleteRecurring(ctx, id, want.Namespace, want.Tenant); err != nil {
+					return err
+				}
+				return create(ctx)
+			}
+			p.add(ch)
+			continue
+		}
+
+		update := &UpdateRecurringAction{Namespace: want.Namespace, Tenant: want.Tenant}
+		if have.CronExpr != want.CronExpression {
+			ch.Fields = append(ch.Fields, "cron_expression")
+			update.CronExpression = &want.CronExpression
+		}
+		if want.Timezone != "" && have.Timezone != want.Timezone {
+			ch.Fields = append(ch.Fields, "timezone")
+			update.Timezone = &want.Timezone
+		}
+		if !equalJSON(have.Payload, want.Payload) {
+			ch.Fields = append(ch.Fields, "payload")
+			update.Payload = want.Payload
+		}
+		if !equalLabels(have.Metadata, want.Metadata) {
+			ch.Fields = append(ch.Fields, "metadata")
+			update.Metadata = want.Metadata
+		}
+		if !equalLabels(have.Labels, want.Labels) {
+			ch.Fields = append(ch.Fields, "labels")
+			update.Labels = want.Labels
+		}
+		if derefString(have.Description) != want.Description {
+			ch.Fields = append(ch.Fields, "description")
+			update.Description = &want.Description
+		}
+		if derefString(have.DedupKey) != want.DedupKey {
+			ch.Fields = append(ch.Fields, "dedup_key")
+			update.DedupKey = &want.DedupKey
+		}
+		p.setUpdate(&ch, func(ctx context.Context) error {
+			_, err := p.c.UpdateRecurring(ctx, ch.ID, update)
+			return err
+		})
+		p.add(ch)
+	}
+
+	if p.prune {
+		for _, key := range sortedKeys(current) {
+			if desired[key] {
+				continue
+			}
+			id := current[key].ID
+			p.add(ApplyChange{
+				Kind: "recurring", Name: key.name, Namespace: key.namespace, Tenant: key.tenant,
+				ID: id, Action: ApplyDelete,
+				run: func(ctx context.Context) error { return p.c.DeleteRecurring(ctx, id, key.namespace, key.tenant) },
+			})
+		}
+	}
+	return nil
+}
+
+func (p *applyPlan) planRules(ctx context.Context, _ []bundleScope) error {
+	if len(p.m.Rules) == 0 {
+		return nil
+	}
+	rules, err := p.c.ListRules(ctx)
+	if err != nil {
+		return err
+	}
+	enabled := make(map[string]bool, len(rules))
+	for _, r := range rules {
+		enabled[r.Name] = r.Enabled
+	}
+	var errs []error
+	for _, want := range p.m.Rules {
+		have, ok := enabled[want.Name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("rule %q is not loaded on the gateway", want.Name))
+			continue
+		}
+		ch := ApplyChange{Kind: "rule", Name: want.Name, Action: ApplyUnchanged}
+		if have != want.Enabled {
+			name, on := want.Name, want.Enabled
+			ch.Action, ch.Fields = ApplyUpdate, []string{"enabled"}
+			ch.run = func(ctx context.Context) error { return p.c.SetRuleEnabled(ctx, name, on) }
+		}
+		p.add(ch)
+	}
+	return errors.Join(errs...)
+}
+
+// setUpdate marks ch as an update running fn when any field differs,
+// and as unchanged otherwise.
+func (p *applyPlan) setUpdate(ch *ApplyChange, fn func(context.Context) error) {
+	if len(ch.Fields) == 0 {
+		ch.Action = ApplyUnchanged
+		return
+	}
+	ch.Action = ApplyUpdate
+	ch.run = fn
+}
+
+// withApplyLabel returns a copy of r carrying its name in ApplyNameLabel.
+func withApplyLabel(r CreateRecurringAction) *CreateRecurringAction {
+	labels := make(map[string]string, len(r.Labels)+1)
+	for k, v := range r.Labels {
+		labels[k] = v
+	}
+	labels[ApplyNameLabel] = r.Name
+	r.Labels = labels
+	return &r
+}
+
+type quotaKey struct {
+	namespace, tenant, provider, principal string
+	perPrincipal                           bool
+}
+
+func keyOfQuota(namespace, tenant, provider, principal string, perPrincipal bool) quotaKey {
+	return quotaKey{namespace, tenant, provider, principal, perPrincipal}
+}
+
+// quotaName describes a quota's scope for ApplyChange.Name, since
+// quotas have no name of their own.
+func quotaName(provider, principal string, perPrincipal bool) string {
+	name := "*"
+	if provider != "" {
+		name = provider
+	}
+	if principal != "" {
+		name += "/" + principal
+	}
+	if perPrincipal {
+		name += "/per-principal"
+	}
+	return name
+}
+
+func sortedQuotaKeys(m map[quotaKey]*QuotaPolicy) []quotaKey {
+	keys := make([]quotaKey, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+	})
+	return keys
+}
+
+func sortedKeys[V any](m map[bundleKey]V) []bundleKey {
+	keys := make([]bundleKey, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].less(keys[j]) })
+	return keys
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// equalLabels compares string maps, treating nil and empty as equal.
+func equalLabels(a, b map[string]string) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// equalJSON compares two values by their JSON encoding, so numbers
+// decoded from YAML and from the gateway compare equal and empty
+// collections match nil ones.
+func equalJSON(a, b any) bool {
+	var x, y any
+	if !normalizeJSON(a, &x) || !normalizeJSON(b, &y) {
+		return false
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+func normalizeJSON(v any, out *any) bool {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return false
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return false
+	}
+	if m, ok := (*out).(map[string]any); ok && len(m) == 0 {
+		*out = nil
+	}
+	return true
+}
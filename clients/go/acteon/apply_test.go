@@ -0,0 +1,346 @@
+package acteon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const applyManifest = `
+quotas:
+  - namespace: alerts
+    tenant: acme
+    provider: email
+    max_actions: 500
+    window: daily
+    overage_behavior: block
+templates:
+  - name: body
+    namespace: alerts
+    tenant: acme
+    content: "Hello {{ name }}"
+profiles:
+  - name: welcome
+    namespace: alerts
+    tenant: acme
+    fields:
+      body: {$ref: body}
+      subject: Welcome
+recurring:
+  - name: digest
+    namespace: alerts
+    tenant: acme
+    provider: email
+    action_type: send_digest
+    cron_expression: "0 9 * * *"
+    payload: {to: ops@example.com, limit: 10}
+rules:
+  - name: block-spam
+    enabled: false
+`
+
+// gatewayState is the fake gateway state applyServer serves.
+type gatewayState struct {
+	quotas    []QuotaPolicy
+	templates []TemplateInfo
+	profiles  []TemplateProfileInfo
+	recurring []RecurringDetail
+	rules     []RuleInfo
+}
+
+// applyServer serves state's lists and recurring details and records
+// every write it receives as "METHOD path".
+func applyServer(t *testing.T, state gatewayState) (*httptest.Server, *[]string) {
+	t.Helper()
+	var writes []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodGet {
+			writes = append(writes, r.Method+" "+r.URL.Path)
+			switch r.Method {
+			case http.MethodPost:
+				w.WriteHeader(http.StatusCreated)
+			case http.MethodDelete:
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+		var v any
+		switch {
+		case r.URL.Path == "/v1/quotas":
+			v = ListQuotasResponse{Quotas: servePage(state.quotas, r)}
+		case r.URL.Path == "/v1/retention":
+			v = ListRetentionResponse{}
+		case r.URL.Path == "/v1/templates":
+			v = ListTemplatesResponse{Templates: servePage(state.templates, r)}
+		case r.URL.Path == "/v1/templates/profiles":
+			v = ListProfilesResponse{Profiles: servePage(state.profiles, r)}
+		case r.URL.Path == "/v1/rules":
+			v = state.rules
+		case r.URL.Path == "/v1/recurring":
+			summaries := make([]RecurringSummary, len(state.recurring))
+			for i, d := range state.recurring {
+				summaries[i] = RecurringSummary{ID: d.ID, Namespace: d.Namespace, Tenant: d.Tenant}
+			}
+			v = ListRecurringResponse{RecurringActions: servePage(summaries, r)}
+		case strings.HasPrefix(r.URL.Path, "/v1/recurring/"):
+			for _, d := range state.recurring {
+				if d.ID == strings.TrimPrefix(r.URL.Path, "/v1/recurring/") {
+					v = d
+				}
+			}
+		}
+		if v == nil {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(v)
+	}))
+	return srv, &writes
+}
+
+func parseTestManifest(t *testing.T) *Manifest {
+	t.Helper()
+	m, err := ParseManifest(strings.NewReader(applyManifest))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestParseManifest(t *testing.T) {
+	m := parseTestManifest(t)
+	if len(m.Quotas) != 1 || m.Quotas[0].MaxActions != 500 {
+		t.Errorf("quotas = %+v", m.Quotas)
+	}
+	if ref, ok := profileFieldRef(m.Profiles[0].Fields["body"]); !ok || ref != "body" {
+		t.Errorf("profile body field = %s", m.Profiles[0].Fields["body"])
+	}
+	if m.Recurring[0].Payload["limit"] != float64(10) {
+		t.Errorf("payload = %v", m.Recurring[0].Payload)
+	}
+
+	if _, err := ParseManifest(strings.NewReader("quotas:\n  - namespace: a\n    max_action: 5\n")); err == nil {
+		t.Error("expected unknown field error")
+	}
+}
+
+func TestManifestValidate(t *testing.T) {
+	m := &Manifest{
+		Templates: []CreateTemplateRequest{
+			{Name: "a", Namespace: "ns", Tenant: "t"},
+			{Name: "a", Namespace: "ns", Tenant: "t"},
+		},
+		Recurring: []CreateRecurringAction{{Namespace: "ns"}},
+	}
+	err := m.Validate()
+	if err == nil {
+		t.Fatal("expected validation errors")
+	}
+	for _, want := range []string{"declared more than once", "namespace and tenant are required", "name is required"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %q", err, want)
+		}
+	}
+}
+
+func TestApplyCreatesIntoEmptyGateway(t *testing.T) {
+	srv, writes := applyServer(t, gatewayState{rules: []RuleInfo{{Name: "block-spam", Enabled: true}}})
+	defer srv.Close()
+
+	res, err := NewClient(srv.URL).Apply(context.Background(), parseTestManifest(t), ApplyOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"POST /v1/quotas",
+		"POST /v1/templates",
+		"POST /v1/templates/profiles",
+		"POST /v1/recurring",
+		"PUT /v1/rules/block-spam/enabled",
+	}
+	if strings.Join(*writes, "\n") != strings.Join(want, "\n") {
+		t.Errorf("writes = %v, want %v", *writes, want)
+	}
+	if !res.Changed() || res.Changes[0].Action != ApplyCreate {
+		t.Errorf("changes = %+v", res.Changes)
+	}
+}
+
+func TestApplyIsIdempotentAndPrunes(t *testing.T) {
+	state := gatewayState{
+		quotas: []QuotaPolicy{
+			{ID: "q1", Namespace: "alerts", Tenant: "acme", Provider: "email", MaxActions: 500, Window: "daily", OverageBehavior: "block"},
+			{ID: "q2", Namespace: "alerts", Tenant: "acme", Provider: "sms", MaxActions: 10, Window: "hourly", OverageBehavior: "warn"},
+		},
+		templates: []TemplateInfo{
+			{ID: "t1", Name: "body", Namespace: "alerts", Tenant: "acme", Content: "Hello {{ name }}"},
+			{ID: "t2", Name: "old", Namespace: "alerts", Tenant: "acme", Content: "x"},
+		},
+		profiles: []TemplateProfileInfo{{ID: "p1", Name: "welcome", Namespace: "alerts", Tenant: "acme",
+			Fields: map[string]TemplateProfileField{"body": RefField("body"), "subject": InlineField("Welcome")}}},
+		recurring: []RecurringDetail{
+			{ID: "r1", Namespace: "alerts", Tenant: "acme", Provider: "email", ActionType: "send_digest",
+				CronExpr: "0 9 * * *", Timezone: "UTC", Payload: map[string]any{"to": "ops@example.com", "limit": 10},
+				Labels: map[string]string{ApplyNameLabel: "digest"}},
+			// Not created by Apply, so never pruned.
+			{ID: "r2", Namespace: "alerts", Tenant: "acme", Provider: "email", ActionType: "ping", CronExpr: "* * * * *"},
+		},
+		rules: []RuleInfo{{Name: "block-spam", Enabled: false}},
+	}
+	srv, writes := applyServer(t, state)
+	defer srv.Close()
+	client := NewClient(srv.URL)
+
+	res, err := client.Apply(context.Background(), parseTestManifest(t), ApplyOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Changed() || len(*writes) != 0 {
+		t.Errorf("expected no changes, got %+v (writes %v)", res.Changes, *writes)
+	}
+
+	res, err = client.Apply(context.Background(), parseTestManifest(t), ApplyOptions{Prune: true, DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*writes) != 0 {
+		t.Errorf("dry run wrote %v", *writes)
+	}
+	var deleted []string
+	for _, c := range res.Changes {
+		if c.Action == ApplyDelete {
+			deleted = append(deleted, c.Kind+":"+c.ID)
+		}
+	}
+	if strings.Join(deleted, ",") != "template:t2,quota:q2" {
+		t.Errorf("deleted = %v", deleted)
+	}
+}
+
+func TestApplyUpdatesAndReplaces(t *testing.T) {
+	state := gatewayState{
+		templates: []TemplateInfo{{ID: "t1", Name: "body", Namespace: "alerts", Tenant: "acme", Content: "Hi"}},
+		profiles: []TemplateProfileInfo{{ID: "p1", Name: "welcome", Namespace: "alerts", Tenant: "acme",
+			Fields: map[string]TemplateProfileField{"body": RefField("body"), "subject": InlineField("Welcome")}}},
+		recurring: []RecurringDetail{{ID: "r1", Namespace: "alerts", Tenant: "acme", Provider: "sms", ActionType: "send_digest",
+			CronExpr: "0 9 * * *", Labels: map[string]string{ApplyNameLabel: "digest"}}},
+		rules: []RuleInfo{{Name: "block-spam", Enabled: false}},
+	}
+	srv, writes := applyServer(t, state)
+	defer srv.Close()
+
+	res, err := NewClient(srv.URL).Apply(context.Background(), parseTestManifest(t), ApplyOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	byKind := map[string]ApplyChange{}
+	for _, c := range res.Changes {
+		byKind[c.Kind] = c
+	}
+	if c := byKind["template"]; c.Action != ApplyUpdate || strings.Join(c.Fields, ",") != "content" {
+		t.Errorf("template change = %+v", c)
+	}
+	if c := byKind["profile"]; c.Action != ApplyUnchanged {
+		t.Errorf("profile change = %+v", c)
+	}
+	if c := byKind["recurring"]; c.Action != ApplyReplace || strings.Join(c.Fields, ",") != "provider" {
+		t.Errorf("recurring change = %+v", c)
+	}
+	want := "POST /v1/quotas,PUT /v1/templates/t1,DELETE /v1/recurring/r1,POST /v1/recurring"
+	if got := strings.Join(*writes, ","); got != want {
+		t.Errorf("writes = %s, want %s", got, want)
+	}
+}
+
+func TestApplyRejectsDanglingRefsAndUnknownRules(t *testing.T) {
+	srv, writes := applyServer(t, gatewayState{})
+	defer srv.Close()
+	client := NewClient(srv.URL)
+
+	m := parseTestManifest(t)
+	m.Templates = nil
+	m.Rules = nil
+	if _, err := client.Apply(context.Background(), m, ApplyOptions{}); err == nil || !strings.Contains(err.Error(), `unknown template "body"`) {
+		t.Errorf("err = %v", err)
+	}
+
+	m = parseTestManifest(t)
+	if _, err := client.Apply(context.Background(), m, ApplyOptions{}); err == nil || !strings.Contains(err.Error(), "not loaded") {
+		t.Errorf("err = %v", err)
+	}
+	if len(*writes) != 0 {
+		t.Errorf("writes = %v", *writes)
+	}
+}
+
+func TestEnsureHelpers(t *testing.T) {
+	state := gatewayState{
+		quotas:    []QuotaPolicy{{ID: "q1", Namespace: "alerts", Tenant: "acme", Provider: "email", MaxActions: 500, Window: "daily", OverageBehavior: "block"}},
+		templates: []TemplateInfo{{ID: "t1", Name: "body", Namespace: "alerts", Tenant: "acme", Content: "Hi"}},
+	}
+	srv, writes := applyServer(t, state)
+	defer srv.Close()
+	client := NewClient(srv.URL)
+	ctx := context.Background()
+
+	quota := CreateQuotaRequest{Namespace: "alerts", Tenant: "acme", Provider: "email", MaxActions: 500, Window: "daily", OverageBehavior: "block"}
+	if changed, err := client.EnsureQuota(ctx, &quota); err != nil || changed {
+		t.Errorf("EnsureQuota unchanged = %v, %v", changed, err)
+	}
+	quota.Provider = "sms"
+	if changed, err := client.EnsureQuota(ctx, &quota); err != nil || !changed {
+		t.Errorf("EnsureQuota create = %v, %v", changed, err)
+	}
+	tmpl := CreateTemplateRequest{Name: "body", Namespace: "alerts", Tenant: "acme", Content: "Hello"}
+	if changed, err := client.EnsureTemplate(ctx, &tmpl); err != nil || !changed {
+		t.Errorf("EnsureTemplate update = %v, %v", changed, err)
+	}
+	retention := CreateRetentionRequest{Namespace: "alerts", Tenant: "acme", AuditTTLSeconds: 86400}
+	if changed, err := client.EnsureRetention(ctx, &retention); err != nil || !changed {
+		t.Errorf("EnsureRetention create = %v, %v", changed, err)
+	}
+	if _, err := client.EnsureRecurring(ctx, &CreateRecurringAction{Namespace: "alerts", Tenant: "acme"}); err == nil {
+		t.Error("EnsureRecurring without a name should fail validation")
+	}
+
+	want := "POST /v1/quotas,PUT /v1/templates/t1,POST /v1/retention"
+	if got := strings.Join(*writes, ","); got != want {
+		t.Errorf("writes = %s, want %s", got, want)
+	}
+}
+
+func TestEnsureSeesResourcesBeyondFirstPage(t *testing.T) {
+	var state gatewayState
+	for i := range 150 {
+		state.quotas = append(state.quotas, QuotaPolicy{
+			ID: fmt.Sprintf("q%d", i), Namespace: "alerts", Tenant: "acme", Provider: fmt.Sprintf("p%d", i),
+			MaxActions: 500, Window: "daily", OverageBehavior: "block",
+		})
+		state.templates = append(state.templates, TemplateInfo{
+			ID: fmt.Sprintf("t%d", i), Name: fmt.Sprintf("body-%d", i), Namespace: "alerts", Tenant: "acme", Content: "Hi",
+		})
+	}
+	srv, writes := applyServer(t, state)
+	defer srv.Close()
+	client := NewClient(srv.URL)
+	ctx := context.Background()
+
+	quota := CreateQuotaRequest{Namespace: "alerts", Tenant: "acme", Provider: "p149", MaxActions: 500, Window: "daily", OverageBehavior: "block"}
+	if changed, err := client.EnsureQuota(ctx, &quota); err != nil || changed {
+		t.Errorf("EnsureQuota = %v, %v", changed, err)
+	}
+	tmpl := CreateTemplateRequest{Name: "body-149", Namespace: "alerts", Tenant: "acme", Content: "Hi"}
+	if changed, err := client.EnsureTemplate(ctx, &tmpl); err != nil || changed {
+		t.Errorf("EnsureTemplate = %v, %v", changed, err)
+	}
+	if len(*writes) != 0 {
+		t.Errorf("writes = %v, want none", *writes)
+	}
+}
@@ -0,0 +1,182 @@
+// Human-in-the-loop approval surface for the Go client.
+
+package acteon
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ApprovalService lists and decides human-in-the-loop approvals.
+type ApprovalService struct {
+	c *Client
+}
+
+// Approvals returns the client's ApprovalService.
+func (c *Client) Approvals() *ApprovalService {
+	return &ApprovalService{c: c}
+}
+
+// Approve approves a pending action by namespace, tenant, ID, and HMAC signature.
+// Does not require authentication -- the HMAC signature serves as proof of authorization.
+// Pass an empty string for kid to omit the key ID parameter.
+func (s *ApprovalService) Approve(ctx context.Context, namespace, tenant, id, sig string, expiresAt int64, kid string) (*ApprovalActionResponse, error) {
+	params := url.Values{}
+	params.Set("sig", sig)
+	params.Set("expires_at", strconv.FormatInt(expiresAt, 10))
+	if kid != "" {
+		params.Set("kid", kid)
+	}
+	path := fmt.Sprintf("/v1/approvals/%s/%s/%s/approve?%s", namespace, tenant, id, params.Encode())
+
+	resp, err := s.c.doRequest(ctx, http.MethodPost, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var result ApprovalActionResponse
+		if err := s.c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Approval not found or expired"}
+	}
+	if resp.StatusCode == http.StatusGone {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Approval already decided"}
+	}
+
+	return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to approve"}
+}
+
+// Reject rejects a pending action by namespace, tenant, ID, and HMAC signature.
+// Does not require authentication -- the HMAC signature serves as proof of authorization.
+// Pass an empty string for kid to omit the key ID parameter.
+func (s *ApprovalService) Reject(ctx context.Context, namespace, tenant, id, sig string, expiresAt int64, kid string) (*ApprovalActionResponse, error) {
+	params := url.Values{}
+	params.Set("sig", sig)
+	params.Set("expires_at", strconv.FormatInt(expiresAt, 10))
+	if kid != "" {
+		params.Set("kid", kid)
+	}
+	path := fmt.Sprintf("/v1/approvals/%s/%s/%s/reject?%s", namespace, tenant, id, params.Encode())
+
+	resp, err := s.c.doRequest(ctx, http.MethodPost, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var result ApprovalActionResponse
+		if err := s.c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Approval not found or expired"}
+	}
+	if resp.StatusCode == http.StatusGone {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Approval already decided"}
+	}
+
+	return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to reject"}
+}
+
+// Get gets the status of an approval by namespace, tenant, ID, and HMAC signature.
+// Returns nil if not found or expired.
+// Pass an empty string for kid to omit the key ID parameter.
+func (s *ApprovalService) Get(ctx context.Context, namespace, tenant, id, sig string, expiresAt int64, kid string) (*ApprovalStatus, error) {
+	params := url.Values{}
+	params.Set("sig", sig)
+	params.Set("expires_at", strconv.FormatInt(expiresAt, 10))
+	if kid != "" {
+		params.Set("kid", kid)
+	}
+	path := fmt.Sprintf("/v1/approvals/%s/%s/%s?%s", namespace, tenant, id, params.Encode())
+
+	resp, err := s.c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get approval"}
+	}
+
+	var status ApprovalStatus
+	if err := s.c.decodeBody(resp.Body, &status); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &status, nil
+}
+
+// List lists pending approvals filtered by namespace and tenant.
+// Requires authentication.
+func (s *ApprovalService) List(ctx context.Context, namespace, tenant string) (*ApprovalListResponse, error) {
+	params := url.Values{}
+	params.Set("namespace", namespace)
+	params.Set("tenant", tenant)
+	path := "/v1/approvals?" + params.Encode()
+
+	resp, err := s.c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list approvals"}
+	}
+
+	var result ApprovalListResponse
+	if err := s.c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &result, nil
+}
+
+// Approve is shorthand for c.Approvals().Approve.
+func (c *Client) Approve(ctx context.Context, namespace, tenant, id, sig string, expiresAt int64, kid string) (*ApprovalActionResponse, error) {
+	return c.Approvals().Approve(ctx, namespace, tenant, id, sig, expiresAt, kid)
+}
+
+// Reject is shorthand for c.Approvals().Reject.
+func (c *Client) Reject(ctx context.Context, namespace, tenant, id, sig string, expiresAt int64, kid string) (*ApprovalActionResponse, error) {
+	return c.Approvals().Reject(ctx, namespace, tenant, id, sig, expiresAt, kid)
+}
+
+// GetApproval is shorthand for c.Approvals().Get.
+func (c *Client) GetApproval(ctx context.Context, namespace, tenant, id, sig string, expiresAt int64, kid string) (*ApprovalStatus, error) {
+	return c.Approvals().Get(ctx, namespace, tenant, id, sig, expiresAt, kid)
+}
+
+// ListApprovals is shorthand for c.Approvals().List.
+func (c *Client) ListApprovals(ctx context.Context, namespace, tenant string) (*ApprovalListResponse, error) {
+	return c.Approvals().List(ctx, namespace, tenant)
+}
@@ -0,0 +1,314 @@
+// Audit trail and replay surface for the Go client.
+
+package acteon
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AuditService reads the audit trail and replays recorded actions.
+type AuditService struct {
+	c *Client
+}
+
+// Audit returns the client's AuditService.
+func (c *Client) Audit() *AuditService {
+	return &AuditService{c: c}
+}
+
+// maxAuditBatch is the number of action IDs GetMany sends per
+// request; larger lists are split.
+const maxAuditBatch = 100
+
+// Query queries audit records.
+func (s *AuditService) Query(ctx context.Context, query *AuditQuery) (*AuditPage, error) {
+	path := "/v1/audit"
+	if query != nil {
+		params := url.Values{}
+		if query.Namespace != "" {
+			params.Set("namespace", query.Namespace)
+		}
+		if query.Tenant != "" {
+			params.Set("tenant", query.Tenant)
+		}
+		if query.Provider != "" {
+			params.Set("provider", query.Provider)
+		}
+		if query.ActionType != "" {
+			params.Set("action_type", query.ActionType)
+		}
+		if query.Outcome != "" {
+			params.Set("outcome", query.Outcome)
+		}
+		if query.Limit > 0 {
+			params.Set("limit", strconv.Itoa(query.Limit))
+		}
+		if query.Offset > 0 {
+			params.Set("offset", strconv.Itoa(query.Offset))
+		}
+		if query.Cursor != "" {
+			params.Set("cursor", query.Cursor)
+		}
+		if len(params) > 0 {
+			path += "?" + params.Encode()
+		}
+	}
+
+	resp, err := s.c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to query audit"}
+	}
+
+	var page AuditPage
+	if err := s.c.decodeBody(resp.Body, &page); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	if err := s.c.openAuditRecords(ctx, page.Records); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// Get gets a specific audit record by action ID.
+func (s *AuditService) Get(ctx context.Context, actionID string) (*AuditRecord, error) {
+	resp, err := s.c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/v1/audit/%s", actionID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get audit record"}
+	}
+
+	var record AuditRecord
+	if err := s.c.decodeBody(resp.Body, &record); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	if err := s.c.openPayload(ctx, record.ActionPayload); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// GetMany fetches the audit records for many action IDs using
+// the batch lookup endpoint, splitting the IDs into requests of at
+// most 100. IDs with no record are listed in Missing rather than
+// failing the call. If a request fails, the records fetched so far are
+// returned along with the error.
+func (s *AuditService) GetMany(ctx context.Context, actionIDs []string) (*AuditRecordsResult, error) {
+	result := &AuditRecordsResult{Records: make(map[string]AuditRecord, len(actionIDs))}
+	for start := 0; start < len(actionIDs); start += maxAuditBatch {
+		end := min(start+maxAuditBatch, len(actionIDs))
+		req := AuditBatchRequest{ActionIDs: actionIDs[start:end]}
+		resp, err := s.c.doRequest(ctx, http.MethodPost, "/v1/audit/batch", req)
+		if err != nil {
+			return result, err
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return result, &ConnectionError{Message: err.Error()}
+		}
+		if resp.StatusCode != http.StatusOK {
+			return result, errorFromResponse(resp, body, "Failed to get audit records")
+		}
+
+		var page AuditBatchResponse
+		if err := s.c.decodeJSON(body, &page); err != nil {
+			return result, &ConnectionError{Message: err.Error()}
+		}
+		if err := s.c.openAuditRecords(ctx, page.Records); err != nil {
+			return result, err
+		}
+		for _, rec := range page.Records {
+			result.Records[rec.ActionID] = rec
+		}
+		result.Missing = append(result.Missing, page.Missing...)
+	}
+	return result, nil
+}
+
+// Replay replays a single action from the audit trail by its action ID.
+// The action is reconstructed from the stored payload and dispatched with a new ID.
+func (s *AuditService) Replay(ctx context.Context, actionID string) (*ReplayResult, error) {
+	resp, err := s.c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/v1/audit/%s/replay", actionID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var result ReplayResult
+		if err := s.c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Audit record not found: %s", actionID)}
+	}
+	if resp.StatusCode == http.StatusUnprocessableEntity {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "No stored payload available for replay"}
+	}
+
+	return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to replay action"}
+}
+
+// ReplayQuery replays actions from the audit trail matching the given query.
+func (s *AuditService) ReplayQuery(ctx context.Context, query *ReplayQuery) (*ReplaySummary, error) {
+	path := "/v1/audit/replay"
+	if query != nil {
+		params := url.Values{}
+		if query.Namespace != "" {
+			params.Set("namespace", query.Namespace)
+		}
+		if query.Tenant != "" {
+			params.Set("tenant", query.Tenant)
+		}
+		if query.Provider != "" {
+			params.Set("provider", query.Provider)
+		}
+		if query.ActionType != "" {
+			params.Set("action_type", query.ActionType)
+		}
+		if query.Outcome != "" {
+			params.Set("outcome", query.Outcome)
+		}
+		if query.Verdict != "" {
+			params.Set("verdict", query.Verdict)
+		}
+		if query.MatchedRule != "" {
+			params.Set("matched_rule", query.MatchedRule)
+		}
+		if query.From != nil {
+			params.Set("from", query.From.Format(time.RFC3339))
+		}
+		if query.To != nil {
+			params.Set("to", query.To.Format(time.RFC3339))
+		}
+		if query.Limit > 0 {
+			params.Set("limit", strconv.Itoa(query.Limit))
+		}
+		if len(params) > 0 {
+			path += "?" + params.Encode()
+		}
+	}
+
+	resp, err := s.c.doRequest(ctx, http.MethodPost, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to replay audit"}
+	}
+
+	var summary ReplaySummary
+	if err := s.c.decodeBody(resp.Body, &summary); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &summary, nil
+}
+
+// auditDedupKey is the Metadata key under which the gateway records an
+// action's dedup key.
+const auditDedupKey = "__dedup_key"
+
+// ActionFromAuditRecord rebuilds a dispatchable action from an audit
+// record, for replay tooling and incident scripts that re-send what
+// the gateway once received. The action gets a new ID and creation
+// time and keeps the record's namespace, tenant, provider, action
+// type, dedup key, and labels. Pass a dedup key of your own with
+// WithDedupKey when the re-sent action must not be deduplicated
+// against the original.
+//
+// payload is used when the record does not carry the stored payload,
+// and takes precedence over it otherwise; with neither, the error is a
+// *ValidationError. Signatures and attachments are not recorded and
+// are not restored.
+func ActionFromAuditRecord(record *AuditRecord, payload map[string]any) (*Action, error) {
+	if payload == nil {
+		payload = record.ActionPayload
+	}
+	if payload == nil {
+		return nil, &ValidationError{Problems: []ValidationProblem{{
+			Field:   "payload",
+			Message: "is not stored in the audit record and was not given",
+		}}}
+	}
+	action := NewAction(record.Namespace, record.Tenant, record.Provider, record.ActionType, payload)
+	labels := map[string]string{}
+	for k, v := range record.Metadata {
+		switch v := v.(type) {
+		case string:
+			if k == auditDedupKey {
+				action.DedupKey = v
+			} else if !strings.HasPrefix(k, "__") {
+				labels[k] = v
+			}
+		case map[string]any:
+			// Labels nested under "labels" rather than flattened.
+			if k == "labels" {
+				for lk, lv := range v {
+					if s, ok := lv.(string); ok {
+						labels[lk] = s
+					}
+				}
+			}
+		}
+	}
+	if len(labels) > 0 {
+		action.Metadata = &ActionMetadata{Labels: labels}
+	}
+	return action, nil
+}
+
+// QueryAudit is shorthand for c.Audit().Query.
+func (c *Client) QueryAudit(ctx context.Context, query *AuditQuery) (*AuditPage, error) {
+	return c.Audit().Query(ctx, query)
+}
+
+// GetAuditRecord is shorthand for c.Audit().Get.
+func (c *Client) GetAuditRecord(ctx context.Context, actionID string) (*AuditRecord, error) {
+	return c.Audit().Get(ctx, actionID)
+}
+
+// GetAuditRecords is shorthand for c.Audit().GetMany.
+func (c *Client) GetAuditRecords(ctx context.Context, actionIDs []string) (*AuditRecordsResult, error) {
+	return c.Audit().GetMany(ctx, actionIDs)
+}
+
+// ReplayAction is shorthand for c.Audit().Replay.
+func (c *Client) ReplayAction(ctx context.Context, actionID string) (*ReplayResult, error) {
+	return c.Audit().Replay(ctx, actionID)
+}
+
+// ReplayAudit is shorthand for c.Audit().ReplayQuery.
+func (c *Client) ReplayAudit(ctx context.Context, query *ReplayQuery) (*ReplaySummary, error) {
+	return c.Audit().ReplayQuery(ctx, query)
+}
@@ -0,0 +1,149 @@
+// Blocking outcome retrieval for the Go ActeonClient.
+//
+// Actions that are scheduled, held for approval, grouped, or started as
+// a chain finish long after Dispatch returns. AwaitOutcome lets a
+// request/response-style caller block until such an action settles
+// without subscribing to the SSE stream: it long-polls
+// `GET /v1/audit/{action_id}/outcome?wait=N`, and against gateways
+// that lack that endpoint falls back to re-reading the audit record
+// with exponential backoff.
+
+package acteon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ErrOutcomeTimeout is returned by AwaitOutcome when the action has not
+// reached a terminal outcome within the timeout.
+var ErrOutcomeTimeout = errors.New("action did not reach a terminal outcome before the timeout")
+
+// Poll bounds for AwaitOutcome's audit fallback and for the pause
+// after a long-poll call that returns before its wait is up, and the
+// longest wait requested from the long-poll endpoint in one call.
+const (
+	awaitMinInterval = 250 * time.Millisecond
+	awaitMaxInterval = 5 * time.Second
+	awaitMaxLongPoll = 20 * time.Second
+)
+
+// IsTerminalOutcome reports whether an audit outcome string is final.
+// Scheduled, pending-approval, grouped, and chain-started actions are
+// still in flight; every other outcome is terminal.
+func IsTerminalOutcome(outcome string) bool {
+	switch outcome {
+	case "scheduled", "pending_approval", "grouped", "chain_started", "":
+		return false
+	}
+	return true
+}
+
+// IsTerminal reports whether the record's outcome is final.
+func (r *AuditRecord) IsTerminal() bool {
+	return IsTerminalOutcome(r.Outcome)
+}
+
+// AwaitOutcome blocks until the action's audit record shows a terminal
+// outcome and returns that record. If timeout elapses first it returns
+// the latest record seen (nil if none was written yet) together with
+// ErrOutcomeTimeout; a cancelled ctx returns ctx.Err() instead.
+func (c *Client) AwaitOutcome(ctx context.Context, actionID string, timeout time.Duration) (*AuditRecord, error) {
+	deadline := time.Now().Add(timeout)
+	maxWait := awaitMaxLongPoll
+	if t := c.httpClient.Timeout; t > 0 && t/2 < maxWait {
+		maxWait = t / 2
+	}
+
+	var last *AuditRecord
+	longPoll := true
+	interval := awaitMinInterval
+	for {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return last, ErrOutcomeTimeout
+		}
+
+		var (
+			record *AuditRecord
+			err    error
+			early  bool
+		)
+		if longPoll {
+			var supported bool
+			wait := min(remaining, maxWait)
+			start := time.Now()
+			record, supported, err = c.longPollOutcome(ctx, actionID, wait)
+			if err != nil {
+				return last, err
+			}
+			if !supported {
+				longPoll = false
+				continue
+			}
+			// A gateway that answers before the wait is up would
+			// otherwise be polled in a tight loop.
+			early = time.Since(start) < wait
+		} else {
+			record, err = c.GetAuditRecord(ctx, actionID)
+			if err != nil {
+				return last, err
+			}
+		}
+		if record != nil {
+			last = record
+			if record.IsTerminal() {
+				return record, nil
+			}
+		}
+		if longPoll && !early {
+			interval = awaitMinInterval
+			continue
+		}
+
+		timer := time.NewTimer(min(interval, time.Until(deadline)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return last, ctx.Err()
+		case <-timer.C:
+		}
+		interval = min(interval*2, awaitMaxInterval)
+	}
+}
+
+// longPollOutcome makes one long-poll call waiting up to wait. It
+// returns the record if the gateway has one (terminal or not), and
+// supported=false when the gateway has no long-poll endpoint.
+func (c *Client) longPollOutcome(ctx context.Context, actionID string, wait time.Duration) (record *AuditRecord, supported bool, err error) {
+	secs := int(wait.Round(time.Second) / time.Second)
+	if secs < 1 {
+		secs = 1
+	}
+	path := fmt.Sprintf("/v1/audit/%s/outcome?wait=%d", url.PathEscape(actionID), secs)
+	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, true, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusAccepted:
+		// 200 carries a terminal record; 202 the still-pending one.
+		var rec AuditRecord
+		if err := c.decodeBody(resp.Body, &rec); err != nil {
+			return nil, true, &ConnectionError{Message: err.Error()}
+		}
+		return &rec, true, nil
+	case http.StatusNoContent:
+		// No audit record yet.
+		return nil, true, nil
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return nil, false, nil
+	}
+	return nil, true, &HTTPError{Status: resp.StatusCode, Message: "Failed to await action outcome"}
+}
@@ -0,0 +1,110 @@
+package acteon
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAwaitOutcomeLongPoll(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/audit/act-1/outcome" || r.URL.Query().Get("wait") == "" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		switch calls.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusNoContent)
+		case 2:
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"action_id":"act-1","outcome":"pending_approval"}`))
+		default:
+			_, _ = w.Write([]byte(`{"action_id":"act-1","outcome":"executed"}`))
+		}
+	}))
+	defer srv.Close()
+
+	rec, err := NewClient(srv.URL).AwaitOutcome(context.Background(), "act-1", 10*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Outcome != "executed" || calls.Load() != 3 {
+		t.Errorf("record = %+v after %d calls", rec, calls.Load())
+	}
+}
+
+func TestAwaitOutcomeFallsBackToAuditPolling(t *testing.T) {
+	var lookups atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/outcome") {
+			http.NotFound(w, r)
+			return
+		}
+		if lookups.Add(1) < 3 {
+			_, _ = w.Write([]byte(`{"action_id":"act-1","outcome":"scheduled"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"action_id":"act-1","outcome":"failed"}`))
+	}))
+	defer srv.Close()
+
+	rec, err := NewClient(srv.URL).AwaitOutcome(context.Background(), "act-1", 10*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Outcome != "failed" || lookups.Load() != 3 {
+		t.Errorf("record = %+v after %d lookups", rec, lookups.Load())
+	}
+}
+
+func TestAwaitOutcomeTimeoutReturnsLastRecord(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/outcome") {
+			w.WriteHeader(http.StatusNotImplemented)
+			return
+		}
+		_, _ = w.Write([]byte(`{"action_id":"act-1","outcome":"pending_approval"}`))
+	}))
+	defer srv.Close()
+
+	rec, err := NewClient(srv.URL).AwaitOutcome(context.Background(), "act-1", 300*time.Millisecond)
+	if !errors.Is(err, ErrOutcomeTimeout) {
+		t.Fatalf("err = %v", err)
+	}
+	if rec == nil || rec.Outcome != "pending_approval" {
+		t.Errorf("record = %+v", rec)
+	}
+}
+
+func TestAwaitOutcomeBacksOffWhenLongPollReturnsEarly(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	_, err := NewClient(srv.URL).AwaitOutcome(context.Background(), "act-1", 600*time.Millisecond)
+	if !errors.Is(err, ErrOutcomeTimeout) {
+		t.Fatalf("err = %v", err)
+	}
+	if n := calls.Load(); n > 4 {
+		t.Errorf("%d long-poll calls in 600ms; want a pause between early returns", n)
+	}
+}
+
+func TestIsTerminalOutcome(t *testing.T) {
+	for outcome, want := range map[string]bool{
+		"executed": true, "failed": true, "suppressed": true, "quota_exceeded": true,
+		"scheduled": false, "pending_approval": false, "grouped": false, "chain_started": false,
+	} {
+		if got := IsTerminalOutcome(outcome); got != want {
+			t.Errorf("IsTerminalOutcome(%q) = %v", outcome, got)
+		}
+	}
+}
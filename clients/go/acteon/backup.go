@@ -0,0 +1,191 @@
+// Backup and restore of gateway-managed resources for disaster
+// recovery. A backup snapshots the selected resource kinds (rules,
+// templates, quotas, recurring actions, retention policies) into the
+// gateway's backup store; a restore writes them back, optionally as a
+// dry run that only reports what would change.
+
+package acteon
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Resource kinds that can be included in a backup.
+const (
+	BackupScopeRules             = "rules"
+	BackupScopeTemplates         = "templates"
+	BackupScopeQuotas            = "quotas"
+	BackupScopeRecurring         = "recurring"
+	BackupScopeRetentionPolicies = "retention_policies"
+)
+
+// Backup lifecycle statuses reported in Backup.Status.
+const (
+	BackupStatusPending   = "pending"
+	BackupStatusRunning   = "running"
+	BackupStatusCompleted = "completed"
+	BackupStatusFailed    = "failed"
+)
+
+// BackupRequest is the request body for CreateBackup.
+type BackupRequest struct {
+	// Scope lists the resource kinds to include (see the BackupScope*
+	// constants). Empty means all of them.
+	Scope []string `json:"scope,omitempty"`
+	// Tenant restricts the backup to one tenant. Empty means all.
+	Tenant string `json:"tenant,omitempty"`
+	// Description is a free-form note stored with the backup.
+	Description string `json:"description,omitempty"`
+}
+
+// Backup describes a backup and its progress.
+type Backup struct {
+	ID          string   `json:"id"`
+	Status      string   `json:"status"`
+	Scope       []string `json:"scope"`
+	Tenant      string   `json:"tenant,omitempty"`
+	Description string   `json:"description,omitempty"`
+	// Counts maps each resource kind to the number of items captured.
+	Counts      map[string]int `json:"counts,omitempty"`
+	SizeBytes   int64          `json:"size_bytes,omitempty"`
+	Error       string         `json:"error,omitempty"`
+	CreatedAt   time.Time      `json:"created_at"`
+	CompletedAt *time.Time     `json:"completed_at,omitempty"`
+}
+
+// IsTerminal reports whether the backup has completed or failed.
+func (b *Backup) IsTerminal() bool {
+	return b.Status == BackupStatusCompleted || b.Status == BackupStatusFailed
+}
+
+// ListBackupsResponse is the response from ListBackups.
+type ListBackupsResponse struct {
+	Backups []Backup `json:"backups"`
+	Count   int      `json:"count"`
+}
+
+// RestoreOptions configures RestoreBackup.
+type RestoreOptions struct {
+	// DryRun reports what the restore would change without writing.
+	DryRun bool `json:"dry_run,omitempty"`
+	// Scope restricts the restore to some of the backup's resource
+	// kinds. Empty restores everything in the backup.
+	Scope []string `json:"scope,omitempty"`
+}
+
+// RestoreChange counts the effect of a restore on one resource kind.
+type RestoreChange struct {
+	Created   int `json:"created"`
+	Updated   int `json:"updated"`
+	Unchanged int `json:"unchanged"`
+}
+
+// RestoreResult reports the outcome of RestoreBackup.
+type RestoreResult struct {
+	BackupID string `json:"backup_id"`
+	DryRun   bool   `json:"dry_run"`
+	// Changes maps each resource kind to what was (or would be) changed.
+	Changes map[string]RestoreChange `json:"changes"`
+	Errors  []string                 `json:"errors,omitempty"`
+}
+
+// CreateBackup starts a backup. The returned Backup is usually still
+// pending; poll GetBackupStatus until IsTerminal.
+func (c *Client) CreateBackup(ctx context.Context, req *BackupRequest) (*Backup, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/admin/backups", req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
+		var result Backup
+		if err := c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to create backup")
+}
+
+// ListBackups lists backups, most recent first.
+func (c *Client) ListBackups(ctx context.Context) (*ListBackupsResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/admin/backups", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list backups"}
+	}
+
+	var result ListBackupsResponse
+	if err := c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &result, nil
+}
+
+// GetBackupStatus returns a backup and its progress.
+func (c *Client) GetBackupStatus(ctx context.Context, backupID string) (*Backup, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/admin/backups/"+url.PathEscape(backupID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Backup not found: %s", backupID)}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get backup"}
+	}
+
+	var result Backup
+	if err := c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &result, nil
+}
+
+// RestoreBackup writes the contents of a completed backup back to the
+// gateway. Run it with DryRun first to review the changes.
+func (c *Client) RestoreBackup(ctx context.Context, backupID string, opts RestoreOptions) (*RestoreResult, error) {
+	path := "/admin/backups/" + url.PathEscape(backupID) + "/restore"
+	resp, err := c.doRequest(ctx, http.MethodPost, path, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var result RestoreResult
+		if err := c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Backup not found: %s", backupID)}
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to restore backup")
+}
@@ -0,0 +1,63 @@
+package acteon
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestCreateBackup(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 202, map[string]any{
+		"id": "bk-1", "status": "pending", "scope": []string{"rules", "templates"}, "created_at": "2026-03-01T10:00:00Z",
+	})
+	defer teardown()
+
+	backup, err := NewClient(url).CreateBackup(context.Background(), &BackupRequest{
+		Scope: []string{BackupScopeRules, BackupScopeTemplates},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != "POST" || captured.path != "/admin/backups" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	if string(captured.body) != `{"scope":["rules","templates"]}` {
+		t.Errorf("body = %s", captured.body)
+	}
+	if backup.ID != "bk-1" || backup.IsTerminal() {
+		t.Errorf("backup = %+v", backup)
+	}
+}
+
+func TestGetBackupStatusNotFound(t *testing.T) {
+	url, _, teardown := newCapturingServer(t, 404, nil)
+	defer teardown()
+
+	_, err := NewClient(url).GetBackupStatus(context.Background(), "missing")
+	var herr *HTTPError
+	if !errors.As(err, &herr) || herr.Status != 404 {
+		t.Errorf("err = %v", err)
+	}
+}
+
+func TestRestoreBackupDryRun(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{
+		"backup_id": "bk-1", "dry_run": true,
+		"changes": map[string]any{"rules": map[string]any{"created": 2, "updated": 1, "unchanged": 40}},
+	})
+	defer teardown()
+
+	res, err := NewClient(url).RestoreBackup(context.Background(), "bk-1", RestoreOptions{DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != "POST" || captured.path != "/admin/backups/bk-1/restore" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	if string(captured.body) != `{"dry_run":true}` {
+		t.Errorf("body = %s", captured.body)
+	}
+	if !res.DryRun || res.Changes["rules"].Created != 2 {
+		t.Errorf("result = %+v", res)
+	}
+}
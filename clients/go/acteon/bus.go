@@ -68,7 +68,7 @@ func (c *Client) busDoJSON(
 		return resp, &HTTPError{Status: resp.StatusCode, Message: string(respBody)}
 	}
 	if out != nil && len(respBody) > 0 {
-		if err := json.Unmarshal(respBody, out); err != nil {
+		if err := c.decodeJSON(respBody, out); err != nil {
 			return resp, &ConnectionError{Message: err.Error()}
 		}
 	}
@@ -463,13 +463,13 @@ func (c *Client) PostBusToolCall(
 	}
 	if resp.StatusCode == http.StatusAccepted {
 		var parked BusApprovalParkedReceipt
-		if err := json.Unmarshal(respBody, &parked); err != nil {
+		if err := c.decodeJSON(respBody, &parked); err != nil {
 			return nil, &ConnectionError{Message: err.Error()}
 		}
 		return &PostBusToolCallOutcome{Parked: &parked}, nil
 	}
 	var produced BusToolEnvelopeReceipt
-	if err := json.Unmarshal(respBody, &produced); err != nil {
+	if err := c.decodeJSON(respBody, &produced); err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
 	return &PostBusToolCallOutcome{Produced: &produced}, nil
@@ -553,10 +553,9 @@ func (c *Client) PostBusStreamEnd(
 func (c *Client) BusStreamConsumeURL(
 	namespace, tenant, conversationID, streamID string,
 ) string {
-	return fmt.Sprintf("%s/v1/bus/streams/%s/%s/%s/%s",
-		c.baseURL,
+	return c.Endpoint(namespace, tenant) + c.apiPath(fmt.Sprintf("/v1/bus/streams/%s/%s/%s/%s",
 		busSeg(namespace), busSeg(tenant),
-		busSeg(conversationID), busSeg(streamID))
+		busSeg(conversationID), busSeg(streamID)))
 }
 
 // ConsumeBusSubscriptionOptions configures the SSE topic tail.
@@ -866,7 +865,7 @@ func extractBusErrorMessage(data string) string {
 // liveness signal so the surface is wider than the dispatch event
 // stream's.
 func (c *Client) openBusSSE(ctx context.Context, path string) (<-chan *busSseEnvelope, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpointFor(ctx, path, nil)+c.apiPath(path), nil)
 	if err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
@@ -877,10 +876,9 @@ func (c *Client) openBusSSE(ctx context.Context, path string) (<-chan *busSseEnv
 	}
 	// SSE is long-lived — bypass the client timeout the same way
 	// `openSSE` does.
-	sseClient := &http.Client{}
-	resp, err := sseClient.Do(req)
+	resp, err := c.roundTrip(c.streamClient(), req)
 	if err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
+		return nil, err
 	}
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
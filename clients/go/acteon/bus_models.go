@@ -1,6 +1,9 @@
 package acteon
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"time"
+)
 
 // DTOs for the Acteon agentic bus surface (Phases 1-6c).
 //
@@ -37,8 +40,17 @@ type BusTopic struct {
 	Labels            map[string]string `json:"labels,omitempty"`
 	SchemaSubject     *string           `json:"schema_subject,omitempty"`
 	SchemaVersion     *int              `json:"schema_version,omitempty"`
-	CreatedAt         string            `json:"created_at"`
-	UpdatedAt         string            `json:"updated_at"`
+	CreatedAt         time.Time         `json:"created_at"`
+	UpdatedAt         time.Time         `json:"updated_at"`
+}
+
+// Retention returns RetentionMs as a time.Duration, or zero when the
+// topic uses the broker default.
+func (t *BusTopic) Retention() time.Duration {
+	if t.RetentionMs == nil {
+		return 0
+	}
+	return time.Duration(*t.RetentionMs) * time.Millisecond
 }
 
 type ListBusTopicsResponse struct {
@@ -62,10 +74,10 @@ type PublishBusMessage struct {
 }
 
 type PublishReceipt struct {
-	Topic      string `json:"topic"`
-	Partition  int32  `json:"partition"`
-	Offset     int64  `json:"offset"`
-	ProducedAt string `json:"produced_at"`
+	Topic      string    `json:"topic"`
+	Partition  int32     `json:"partition"`
+	Offset     int64     `json:"offset"`
+	ProducedAt time.Time `json:"produced_at"`
 }
 
 // =============================================================================
@@ -96,8 +108,13 @@ type BusSubscription struct {
 	AckTimeoutMs    uint64            `json:"ack_timeout_ms"`
 	Description     *string           `json:"description,omitempty"`
 	Labels          map[string]string `json:"labels,omitempty"`
-	CreatedAt       string            `json:"created_at"`
-	UpdatedAt       string            `json:"updated_at"`
+	CreatedAt       time.Time         `json:"created_at"`
+	UpdatedAt       time.Time         `json:"updated_at"`
+}
+
+// AckTimeout returns AckTimeoutMs as a time.Duration.
+func (s *BusSubscription) AckTimeout() time.Duration {
+	return time.Duration(s.AckTimeoutMs) * time.Millisecond
 }
 
 type ListBusSubscriptionsResponse struct {
@@ -144,7 +161,7 @@ type BusSchema struct {
 	Tenant    string            `json:"tenant"`
 	Body      any               `json:"body"`
 	Labels    map[string]string `json:"labels,omitempty"`
-	CreatedAt string            `json:"created_at"`
+	CreatedAt time.Time         `json:"created_at"`
 }
 
 type ListBusSchemasResponse struct {
@@ -181,20 +198,20 @@ type BusAgent struct {
 	Capabilities    []string          `json:"capabilities"`
 	InboxTopic      string            `json:"inbox_topic"`
 	Status          string            `json:"status"`
-	LastHeartbeatAt *string           `json:"last_heartbeat_at,omitempty"`
+	LastHeartbeatAt *time.Time        `json:"last_heartbeat_at,omitempty"`
 	HeartbeatTtlMs  uint64            `json:"heartbeat_ttl_ms"`
 	Description     *string           `json:"description,omitempty"`
 	Labels          map[string]string `json:"labels,omitempty"`
-	CreatedAt       string            `json:"created_at"`
-	UpdatedAt       string            `json:"updated_at"`
+	CreatedAt       time.Time         `json:"created_at"`
+	UpdatedAt       time.Time         `json:"updated_at"`
 	// AdminState is the operator lifecycle state ("active",
 	// "suspended", or "banned"). Defaults to "active" — see
 	// UnmarshalJSON below.
-	AdminState     string  `json:"admin_state,omitempty"`
-	AdminReason    *string `json:"admin_reason,omitempty"`
-	AdminSetBy     *string `json:"admin_set_by,omitempty"`
-	AdminSetAt     *string `json:"admin_set_at,omitempty"`
-	AdminExpiresAt *string `json:"admin_expires_at,omitempty"`
+	AdminState     string     `json:"admin_state,omitempty"`
+	AdminReason    *string    `json:"admin_reason,omitempty"`
+	AdminSetBy     *string    `json:"admin_set_by,omitempty"`
+	AdminSetAt     *time.Time `json:"admin_set_at,omitempty"`
+	AdminExpiresAt *time.Time `json:"admin_expires_at,omitempty"`
 }
 
 // UnmarshalJSON is overridden so a server response that pre-dates
@@ -214,6 +231,11 @@ func (a *BusAgent) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// HeartbeatTTL returns HeartbeatTtlMs as a time.Duration.
+func (a *BusAgent) HeartbeatTTL() time.Duration {
+	return time.Duration(a.HeartbeatTtlMs) * time.Millisecond
+}
+
 // SetBusAgentAdminState is the request body for
 // Client.SetBusAgentAdminState. ExpiresAt is honored only for
 // AdminState = "suspended"; the server returns 400 if it is set
@@ -221,8 +243,8 @@ func (a *BusAgent) UnmarshalJSON(data []byte) error {
 type SetBusAgentAdminState struct {
 	AdminState string  `json:"admin_state"`
 	Reason     *string `json:"reason,omitempty"`
-	// RFC-3339 timestamp.
-	ExpiresAt *string `json:"expires_at,omitempty"`
+	// Sent as an RFC-3339 timestamp.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
 }
 
 type ListBusAgentsResponse struct {
@@ -260,8 +282,8 @@ type BusConversation struct {
 	EventsTopic    *string           `json:"events_topic,omitempty"`
 	Description    *string           `json:"description,omitempty"`
 	Labels         map[string]string `json:"labels,omitempty"`
-	CreatedAt      string            `json:"created_at"`
-	UpdatedAt      string            `json:"updated_at"`
+	CreatedAt      time.Time         `json:"created_at"`
+	UpdatedAt      time.Time         `json:"updated_at"`
 }
 
 type ListBusConversationsResponse struct {
@@ -285,7 +307,7 @@ type AppendBusConversationMessage struct {
 type BusReplayMessage struct {
 	Partition  int32             `json:"partition"`
 	Offset     int64             `json:"offset"`
-	ProducedAt string            `json:"produced_at"`
+	ProducedAt time.Time         `json:"produced_at"`
 	Sender     *string           `json:"sender,omitempty"`
 	Payload    any               `json:"payload"`
 	Headers    map[string]string `json:"headers,omitempty"`
@@ -339,13 +361,13 @@ type PostBusToolResult struct {
 }
 
 type BusToolEnvelopeReceipt struct {
-	EventsTopic    string `json:"events_topic"`
-	ConversationID string `json:"conversation_id"`
-	CallID         string `json:"call_id"`
-	Partition      int32  `json:"partition"`
-	Offset         int64  `json:"offset"`
-	ProducedAt     string `json:"produced_at"`
-	Cursor         string `json:"cursor"`
+	EventsTopic    string    `json:"events_topic"`
+	ConversationID string    `json:"conversation_id"`
+	CallID         string    `json:"call_id"`
+	Partition      int32     `json:"partition"`
+	Offset         int64     `json:"offset"`
+	ProducedAt     time.Time `json:"produced_at"`
+	Cursor         string    `json:"cursor"`
 }
 
 type BusToolResult struct {
@@ -356,7 +378,7 @@ type BusToolResult struct {
 	CorrelationID *string           `json:"correlation_id,omitempty"`
 	Sender        *string           `json:"sender,omitempty"`
 	Metadata      map[string]string `json:"metadata,omitempty"`
-	CreatedAt     string            `json:"created_at"`
+	CreatedAt     time.Time         `json:"created_at"`
 }
 
 type BusToolResultLookupParams struct {
@@ -371,7 +393,7 @@ type BusToolResultLookup struct {
 	ConversationID string        `json:"conversation_id"`
 	Partition      int32         `json:"partition"`
 	Offset         int64         `json:"offset"`
-	ProducedAt     string        `json:"produced_at"`
+	ProducedAt     time.Time     `json:"produced_at"`
 	Result         BusToolResult `json:"result"`
 }
 
@@ -397,14 +419,14 @@ type PostBusStreamEnd struct {
 }
 
 type BusStreamEnvelopeReceipt struct {
-	EventsTopic    string `json:"events_topic"`
-	ConversationID string `json:"conversation_id"`
-	StreamID       string `json:"stream_id"`
-	ChunkSeq       int64  `json:"chunk_seq"`
-	Partition      int32  `json:"partition"`
-	Offset         int64  `json:"offset"`
-	ProducedAt     string `json:"produced_at"`
-	Cursor         string `json:"cursor"`
+	EventsTopic    string    `json:"events_topic"`
+	ConversationID string    `json:"conversation_id"`
+	StreamID       string    `json:"stream_id"`
+	ChunkSeq       int64     `json:"chunk_seq"`
+	Partition      int32     `json:"partition"`
+	Offset         int64     `json:"offset"`
+	ProducedAt     time.Time `json:"produced_at"`
+	Cursor         string    `json:"cursor"`
 }
 
 // =============================================================================
@@ -412,36 +434,36 @@ type BusStreamEnvelopeReceipt struct {
 // =============================================================================
 
 type BusApprovalParkedReceipt struct {
-	ApprovalID       string `json:"approval_id"`
-	Namespace        string `json:"namespace"`
-	Tenant           string `json:"tenant"`
-	ConversationID   string `json:"conversation_id"`
-	CorrelationToken string `json:"correlation_token"`
-	Status           string `json:"status"`
-	CreatedAt        string `json:"created_at"`
-	ExpiresAt        string `json:"expires_at"`
+	ApprovalID       string    `json:"approval_id"`
+	Namespace        string    `json:"namespace"`
+	Tenant           string    `json:"tenant"`
+	ConversationID   string    `json:"conversation_id"`
+	CorrelationToken string    `json:"correlation_token"`
+	Status           string    `json:"status"`
+	CreatedAt        time.Time `json:"created_at"`
+	ExpiresAt        time.Time `json:"expires_at"`
 }
 
 type BusApprovalView struct {
-	ApprovalID        string  `json:"approval_id"`
-	Namespace         string  `json:"namespace"`
-	Tenant            string  `json:"tenant"`
-	Kind              string  `json:"kind"`
-	ConversationID    *string `json:"conversation_id,omitempty"`
-	TaskID            *string `json:"task_id,omitempty"`
-	CorrelationToken  string  `json:"correlation_token"`
-	EnvelopeKind      string  `json:"envelope_kind"`
-	Status            string  `json:"status"`
-	Reason            *string `json:"reason,omitempty"`
-	CreatedAt         string  `json:"created_at"`
-	ExpiresAt         string  `json:"expires_at"`
-	DecidedBy         *string `json:"decided_by,omitempty"`
-	DecidedAt         *string `json:"decided_at,omitempty"`
-	DecisionNote      *string `json:"decision_note,omitempty"`
-	ProducedPartition *int32  `json:"produced_partition,omitempty"`
-	ProducedOffset    *int64  `json:"produced_offset,omitempty"`
-	ProducedAt        *string `json:"produced_at,omitempty"`
-	Envelope          any     `json:"envelope,omitempty"`
+	ApprovalID        string        `json:"approval_id"`
+	Namespace         string        `json:"namespace"`
+	Tenant            string        `json:"tenant"`
+	Kind              string        `json:"kind"`
+	ConversationID    *string       `json:"conversation_id,omitempty"`
+	TaskID            *string       `json:"task_id,omitempty"`
+	CorrelationToken  string        `json:"correlation_token"`
+	EnvelopeKind      string        `json:"envelope_kind"`
+	Status            ApprovalState `json:"status"`
+	Reason            *string       `json:"reason,omitempty"`
+	CreatedAt         time.Time     `json:"created_at"`
+	ExpiresAt         time.Time     `json:"expires_at"`
+	DecidedBy         *string       `json:"decided_by,omitempty"`
+	DecidedAt         *time.Time    `json:"decided_at,omitempty"`
+	DecisionNote      *string       `json:"decision_note,omitempty"`
+	ProducedPartition *int32        `json:"produced_partition,omitempty"`
+	ProducedOffset    *int64        `json:"produced_offset,omitempty"`
+	ProducedAt        *time.Time    `json:"produced_at,omitempty"`
+	Envelope          any           `json:"envelope,omitempty"`
 }
 
 type ListBusApprovalsResponse struct {
@@ -498,7 +520,7 @@ type BusConsumedMessage struct {
 	Headers   map[string]string `json:"headers,omitempty"`
 	Partition *int32            `json:"partition,omitempty"`
 	Offset    *int64            `json:"offset,omitempty"`
-	Timestamp *string           `json:"timestamp,omitempty"`
+	Timestamp *time.Time        `json:"timestamp,omitempty"`
 }
 
 // BusConsumeItemKind tags the variant in BusConsumeItem.
@@ -548,7 +570,7 @@ type StreamChunkEnvelope struct {
 	Body      json.RawMessage   `json:"body,omitempty"`
 	Sender    string            `json:"sender,omitempty"`
 	Metadata  map[string]string `json:"metadata,omitempty"`
-	CreatedAt string            `json:"created_at,omitempty"`
+	CreatedAt *time.Time        `json:"created_at,omitempty"`
 }
 
 // StreamEndEnvelope mirrors `acteon_core::StreamEnd`. Status is one
@@ -560,7 +582,7 @@ type StreamEndEnvelope struct {
 	ErrorMessage string            `json:"error_message,omitempty"`
 	Sender       string            `json:"sender,omitempty"`
 	Metadata     map[string]string `json:"metadata,omitempty"`
-	CreatedAt    string            `json:"created_at,omitempty"`
+	CreatedAt    *time.Time        `json:"created_at,omitempty"`
 }
 
 // BusStreamItemKind tags the variant in BusStreamItem.
@@ -17,6 +17,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 )
 
 func ptr[T any](v T) *T { return &v }
@@ -775,7 +776,7 @@ func TestSetBusAgentAdminStateSerdeDropsOptional(t *testing.T) {
 
 	// Full: every field appears in the expected snake_case form.
 	reason := "flaky retries"
-	expiry := "2026-05-23T12:00:00Z"
+	expiry := time.Date(2026, 5, 23, 12, 0, 0, 0, time.UTC)
 	raw, err = json.Marshal(&SetBusAgentAdminState{
 		AdminState: "suspended",
 		Reason:     &reason,
@@ -788,7 +789,7 @@ func TestSetBusAgentAdminStateSerdeDropsOptional(t *testing.T) {
 	if err := json.Unmarshal(raw, &parsed); err != nil {
 		t.Fatalf("re-parse: %v", err)
 	}
-	if parsed["expires_at"] != expiry {
+	if parsed["expires_at"] != "2026-05-23T12:00:00Z" {
 		t.Errorf("expires_at: got %v", parsed["expires_at"])
 	}
 }
@@ -0,0 +1,86 @@
+package acteon
+
+// Per-call response capture. A caller attaches a ResponseMeta to the
+// context of a single call and, after it returns, has the exact status,
+// headers, and body the server sent — for logging or attaching to a bug
+// report — without turning on any client-wide debugging.
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+)
+
+// maxCapturedBody bounds ResponseMeta.Body so that capturing a
+// long-lived stream cannot grow without limit.
+const maxCapturedBody = 1 << 20 // 1 MiB
+
+// ResponseMeta records the raw HTTP response of one call. It is
+// populated in place when passed to WithResponseCapture.
+type ResponseMeta struct {
+	StatusCode int
+	Header     http.Header
+	// RequestID is the X-Request-Id response header, when the server
+	// or a proxy in front of it sets one.
+	RequestID string
+	// Body holds the response body as read by the client, up to 1 MiB.
+	// Streaming calls capture what had been read when the stream ended.
+	Body []byte
+	// Truncated reports that Body stopped at the size limit.
+	Truncated bool
+}
+
+type responseCaptureKey struct{}
+
+// WithResponseCapture returns a context that makes the client fill in
+// meta with the response of the call made with it:
+//
+//	var meta acteon.ResponseMeta
+//	_, err := client.Dispatch(acteon.WithResponseCapture(ctx, &meta), action)
+//	if err != nil {
+//		log.Printf("dispatch failed: %d %s", meta.StatusCode, meta.Body)
+//	}
+//
+// If the context is reused for several calls, meta describes the last
+// one. Connection errors leave meta untouched.
+func WithResponseCapture(ctx context.Context, meta *ResponseMeta) context.Context {
+	return context.WithValue(ctx, responseCaptureKey{}, meta)
+}
+
+// captureResponse fills in the ResponseMeta attached to ctx, if any,
+// and arranges for the body to be recorded as the caller reads it.
+func captureResponse(ctx context.Context, resp *http.Response) {
+	meta, ok := ctx.Value(responseCaptureKey{}).(*ResponseMeta)
+	if !ok || meta == nil {
+		return
+	}
+	*meta = ResponseMeta{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		RequestID:  resp.Header.Get("X-Request-Id"),
+	}
+	resp.Body = &capturingBody{ReadCloser: resp.Body, meta: meta}
+}
+
+// capturingBody copies everything read through it into meta.Body.
+type capturingBody struct {
+	io.ReadCloser
+	meta *ResponseMeta
+	buf  bytes.Buffer
+}
+
+func (b *capturingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if n > 0 {
+		room := maxCapturedBody - b.buf.Len()
+		if n > room {
+			b.buf.Write(p[:room])
+			b.meta.Truncated = true
+		} else {
+			b.buf.Write(p[:n])
+		}
+		b.meta.Body = b.buf.Bytes()
+	}
+	return n, err
+}
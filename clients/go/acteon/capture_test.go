@@ -0,0 +1,48 @@
+package acteon
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWithResponseCaptureRecordsErrorResponse(t *testing.T) {
+	const body = `{"code":"VALIDATION","message":"missing provider","retryable":false}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-42")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	var meta ResponseMeta
+	ctx := WithResponseCapture(context.Background(), &meta)
+	_, err := NewClient(srv.URL).Dispatch(ctx, NewAction("ns", "t", "email", "send", nil))
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	if meta.StatusCode != http.StatusBadRequest || meta.RequestID != "req-42" {
+		t.Errorf("meta = %+v", meta)
+	}
+	if string(meta.Body) != body || meta.Truncated {
+		t.Errorf("body = %q (truncated %v)", meta.Body, meta.Truncated)
+	}
+}
+
+func TestWithResponseCaptureTruncatesLargeBodies(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(strings.Repeat("x", maxCapturedBody+10)))
+	}))
+	defer srv.Close()
+
+	var meta ResponseMeta
+	_, _ = NewClient(srv.URL).Dispatch(WithResponseCapture(context.Background(), &meta), NewAction("ns", "t", "p", "a", nil))
+	if len(meta.Body) != maxCapturedBody || !meta.Truncated {
+		t.Errorf("captured %d bytes, truncated %v", len(meta.Body), meta.Truncated)
+	}
+}
@@ -0,0 +1,157 @@
+package acteon
+
+// Typed decoding for chain progress events delivered over SSE.
+// Subscribe and Stream yield raw SseEvent frames whose Data is the
+// gateway's JSON-encoded stream event; ParseChainEvent turns the
+// chain-related ones into ChainEvent values, and SubscribeChain wraps
+// Subscribe so progress UIs never see the raw frames.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ChainEventKind identifies the kind of a ChainEvent.
+type ChainEventKind string
+
+const (
+	// ChainEventStepStarted — a step began executing (or a retry
+	// attempt of it did).
+	ChainEventStepStarted ChainEventKind = "chain_step_started"
+	// ChainEventStepCompleted — a step finished successfully or was
+	// skipped.
+	ChainEventStepCompleted ChainEventKind = "chain_step_completed"
+	// ChainEventStepFailed — a step attempt failed. Retries, if
+	// configured, produce further StepStarted events.
+	ChainEventStepFailed ChainEventKind = "chain_step_failed"
+	// ChainEventAdvanced — the chain moved on to its next step.
+	ChainEventAdvanced ChainEventKind = "chain_advanced"
+	// ChainEventCompleted — the chain reached a terminal status.
+	ChainEventCompleted ChainEventKind = "chain_completed"
+)
+
+// ChainEvent is a decoded chain progress event. Which fields are set
+// depends on Kind: step events carry StepName, StepIndex, and Attempt;
+// ChainEventCompleted carries Status and ExecutionPath.
+type ChainEvent struct {
+	Kind      ChainEventKind
+	ID        string
+	Timestamp time.Time
+	Namespace string
+	Tenant    string
+	ChainID   string
+
+	StepName  string
+	StepIndex int
+	// Attempt is the 1-based attempt number of the step, or 0 when the
+	// gateway did not report it.
+	Attempt  int
+	NextStep *string
+	// ResponseSummary is a truncated preview of the provider response
+	// for completed and failed steps.
+	ResponseSummary string
+	Error           *string
+
+	Status        string
+	ExecutionPath []string
+}
+
+type chainEventWire struct {
+	Type            string    `json:"type"`
+	ID              string    `json:"id"`
+	Timestamp       time.Time `json:"timestamp"`
+	Namespace       string    `json:"namespace"`
+	Tenant          string    `json:"tenant"`
+	ChainID         string    `json:"chain_id"`
+	StepName        string    `json:"step_name"`
+	StepIndex       int       `json:"step_index"`
+	Attempt         int       `json:"attempt"`
+	Success         *bool     `json:"success"`
+	NextStep        *string   `json:"next_step"`
+	ResponseSummary string    `json:"response_summary"`
+	Error           *string   `json:"error"`
+	Status          string    `json:"status"`
+	ExecutionPath   []string  `json:"execution_path"`
+}
+
+// ParseChainEvent decodes ev as a chain event. It returns (nil, nil)
+// for events that are not chain events, so it can be applied to every
+// frame from Stream as well as Subscribe.
+func ParseChainEvent(ev *SseEvent) (*ChainEvent, error) {
+	if ev == nil || ev.Data == "" {
+		return nil, nil
+	}
+	if ev.Event != "" && !strings.HasPrefix(ev.Event, "chain_") {
+		return nil, nil
+	}
+
+	var w chainEventWire
+	if err := json.Unmarshal([]byte(ev.Data), &w); err != nil {
+		return nil, fmt.Errorf("decode chain event %q: %w", ev.ID, err)
+	}
+
+	kind := ChainEventKind(w.Type)
+	switch kind {
+	case ChainEventStepStarted, ChainEventStepFailed, ChainEventAdvanced, ChainEventCompleted:
+	case ChainEventStepCompleted:
+		// Older gateways report failed attempts as step_completed
+		// with success=false.
+		if w.Success != nil && !*w.Success {
+			kind = ChainEventStepFailed
+		}
+	default:
+		return nil, nil
+	}
+
+	id := w.ID
+	if id == "" {
+		id = ev.ID
+	}
+	return &ChainEvent{
+		Kind:            kind,
+		ID:              id,
+		Timestamp:       w.Timestamp,
+		Namespace:       w.Namespace,
+		Tenant:          w.Tenant,
+		ChainID:         w.ChainID,
+		StepName:        w.StepName,
+		StepIndex:       w.StepIndex,
+		Attempt:         w.Attempt,
+		NextStep:        w.NextStep,
+		ResponseSummary: w.ResponseSummary,
+		Error:           w.Error,
+		Status:          w.Status,
+		ExecutionPath:   w.ExecutionPath,
+	}, nil
+}
+
+// SubscribeChain subscribes to a single chain execution and yields
+// typed ChainEvent values. Frames that are not chain events, or that
+// cannot be decoded, are dropped. The channel closes under the same
+// conditions as Subscribe.
+func (c *Client) SubscribeChain(ctx context.Context, chainID string, opts *SubscribeOptions) (<-chan *ChainEvent, error) {
+	raw, err := c.Subscribe(ctx, "chain", chainID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *ChainEvent, 64)
+	go func() {
+		defer close(out)
+		for ev := range raw {
+			ce, err := ParseChainEvent(ev)
+			if err != nil || ce == nil {
+				continue
+			}
+			select {
+			case out <- ce:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
@@ -0,0 +1,79 @@
+package acteon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseChainEventStepCompleted(t *testing.T) {
+	ev := &SseEvent{
+		ID:    "evt-1",
+		Event: "chain_step_completed",
+		Data:  `{"id":"evt-1","type":"chain_step_completed","chain_id":"ch-1","step_name":"search","step_index":0,"attempt":2,"success":true,"next_step":"summarize","response_summary":"{\"hits\":3}","namespace":"ns","tenant":"t"}`,
+	}
+	ce, err := ParseChainEvent(ev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ce.Kind != ChainEventStepCompleted || ce.StepName != "search" || ce.Attempt != 2 || ce.NextStep == nil || *ce.NextStep != "summarize" {
+		t.Errorf("unexpected event: %+v", ce)
+	}
+	if ce.ResponseSummary != `{"hits":3}` {
+		t.Errorf("ResponseSummary = %q", ce.ResponseSummary)
+	}
+}
+
+func TestParseChainEventUnsuccessfulStepIsFailed(t *testing.T) {
+	ce, err := ParseChainEvent(&SseEvent{
+		Event: "chain_step_completed",
+		Data:  `{"type":"chain_step_completed","chain_id":"ch-1","step_name":"load","step_index":2,"success":false}`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ce.Kind != ChainEventStepFailed {
+		t.Errorf("Kind = %q, want %q", ce.Kind, ChainEventStepFailed)
+	}
+}
+
+func TestParseChainEventIgnoresOtherEvents(t *testing.T) {
+	ce, err := ParseChainEvent(&SseEvent{Event: "action_dispatched", Data: `{"type":"action_dispatched"}`})
+	if ce != nil || err != nil {
+		t.Errorf("expected (nil, nil), got (%v, %v)", ce, err)
+	}
+	if _, err := ParseChainEvent(&SseEvent{Event: "chain_completed", Data: "{"}); err == nil {
+		t.Error("expected decode error for malformed chain frame")
+	}
+}
+
+func TestSubscribeChainYieldsTypedEvents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/subscribe/chain/ch-1" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte("event: chain_advanced\nid: 1\ndata: {\"type\":\"chain_advanced\",\"chain_id\":\"ch-1\"}\n\n"))
+		_, _ = w.Write([]byte("event: group_flushed\nid: 2\ndata: {\"type\":\"group_flushed\"}\n\n"))
+		_, _ = w.Write([]byte("event: chain_completed\nid: 3\ndata: {\"type\":\"chain_completed\",\"chain_id\":\"ch-1\",\"status\":\"completed\",\"execution_path\":[\"a\",\"b\"]}\n\n"))
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch, err := NewClient(server.URL).SubscribeChain(ctx, "ch-1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []*ChainEvent
+	for ev := range ch {
+		got = append(got, ev)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d events, want 2", len(got))
+	}
+	if got[0].Kind != ChainEventAdvanced || got[1].Kind != ChainEventCompleted || got[1].Status != "completed" || len(got[1].ExecutionPath) != 2 {
+		t.Errorf("unexpected events: %+v %+v", got[0], got[1])
+	}
+}
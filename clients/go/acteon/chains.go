@@ -0,0 +1,450 @@
+// Chain surface for the Go client.
+
+package acteon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ChainService starts, inspects, and manages chains and their definitions.
+type ChainService struct {
+	c *Client
+}
+
+// Chains returns the client's ChainService.
+func (c *Client) Chains() *ChainService {
+	return &ChainService{c: c}
+}
+
+// List lists chain executions filtered by namespace, tenant, and optional status.
+func (s *ChainService) List(ctx context.Context, namespace, tenant string, status *string) (*ListChainsResponse, error) {
+	params := url.Values{}
+	params.Set("namespace", namespace)
+	params.Set("tenant", tenant)
+	if status != nil {
+		params.Set("status", *status)
+	}
+	path := "/v1/chains?" + params.Encode()
+
+	resp, err := s.c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list chains"}
+	}
+
+	var result ListChainsResponse
+	if err := s.c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &result, nil
+}
+
+// Get gets the full details of a chain execution by ID.
+func (s *ChainService) Get(ctx context.Context, chainID, namespace, tenant string) (*ChainDetailResponse, error) {
+	params := url.Values{}
+	params.Set("namespace", namespace)
+	params.Set("tenant", tenant)
+	path := fmt.Sprintf("/v1/chains/%s?%s", chainID, params.Encode())
+
+	resp, err := s.c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Chain not found: %s", chainID)}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get chain"}
+	}
+
+	var detail ChainDetailResponse
+	if err := s.c.decodeBody(resp.Body, &detail); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &detail, nil
+}
+
+// Start starts a new execution of a named chain definition and
+// returns the ID of the new chain instance.
+func (s *ChainService) Start(ctx context.Context, req StartChainRequest) (*StartChainResponse, error) {
+	resp, err := s.c.doRequest(ctx, http.MethodPost, "/v1/chains/start", req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated {
+		var result StartChainResponse
+		if err := s.c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Chain definition not found: %s", req.Name)}
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to start chain")
+}
+
+// Cancel cancels a running chain execution.
+func (s *ChainService) Cancel(ctx context.Context, chainID string, req *CancelChainRequest) (*ChainDetailResponse, error) {
+	resp, err := s.c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/v1/chains/%s/cancel", chainID), req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var detail ChainDetailResponse
+		if err := s.c.decodeJSON(body, &detail); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &detail, nil
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Chain not found: %s", chainID)}
+	}
+	if resp.StatusCode == http.StatusConflict {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Chain is not running"}
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to cancel chain")
+}
+
+// Retry resumes a failed chain execution without re-running the
+// steps that already completed before opts.FromStep.
+func (s *ChainService) Retry(ctx context.Context, chainID string, opts RetryOptions) (*ChainDetailResponse, error) {
+	resp, err := s.c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/v1/chains/%s/retry", chainID), opts)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var detail ChainDetailResponse
+		if err := s.c.decodeJSON(body, &detail); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &detail, nil
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Chain not found: %s", chainID)}
+	}
+	if resp.StatusCode == http.StatusConflict {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Chain is not in a failed state"}
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to retry chain")
+}
+
+// ProvideStepInput unblocks a chain step whose status is
+// StepStatusAwaitingInput by supplying its input.
+func (s *ChainService) ProvideStepInput(ctx context.Context, chainID, stepName string, req *ProvideStepInputRequest) (*ChainDetailResponse, error) {
+	path := fmt.Sprintf("/v1/chains/%s/steps/%s/input", chainID, url.PathEscape(stepName))
+	resp, err := s.c.doRequest(ctx, http.MethodPost, path, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var detail ChainDetailResponse
+		if err := s.c.decodeJSON(body, &detail); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &detail, nil
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Chain step not found: %s/%s", chainID, stepName)}
+	}
+	if resp.StatusCode == http.StatusConflict {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Step is not awaiting input"}
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to provide step input")
+}
+
+// Dag returns the DAG representation for a running chain instance.
+func (s *ChainService) Dag(ctx context.Context, chainID, namespace, tenant string) (*DagResponse, error) {
+	params := url.Values{}
+	params.Set("namespace", namespace)
+	params.Set("tenant", tenant)
+
+	resp, err := s.c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/v1/chains/%s/dag?%s", chainID, params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Chain not found: %s", chainID)}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get chain DAG"}
+	}
+
+	var dag DagResponse
+	if err := s.c.decodeBody(resp.Body, &dag); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &dag, nil
+}
+
+// DefinitionDag returns the DAG representation for a chain definition (config only).
+func (s *ChainService) DefinitionDag(ctx context.Context, name string) (*DagResponse, error) {
+	resp, err := s.c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/v1/chains/definitions/%s/dag", name), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Chain definition not found: %s", name)}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get chain definition DAG"}
+	}
+
+	var dag DagResponse
+	if err := s.c.decodeBody(resp.Body, &dag); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &dag, nil
+}
+
+// PutDefinition creates or replaces the chain definition named
+// def.Name. Use the chains subpackage to build definitions. A
+// definition the gateway rejects as invalid surfaces as an APIError
+// with code "VALIDATION_ERROR" whose message lists every problem.
+func (s *ChainService) PutDefinition(ctx context.Context, def *ChainDefinition) (*ChainDefinition, error) {
+	resp, err := s.c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/v1/chains/definitions/%s", url.PathEscape(def.Name)), def)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated {
+		var result ChainDefinition
+		if err := s.c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	if resp.StatusCode == http.StatusUnprocessableEntity {
+		var verr chainValidationErrorResponse
+		if err := json.Unmarshal(body, &verr); err == nil && verr.Error != "" {
+			msg := verr.Error
+			if len(verr.Details) > 0 {
+				msg += ": " + strings.Join(verr.Details, "; ")
+			}
+			return nil, &APIError{Code: "VALIDATION_ERROR", Message: msg}
+		}
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to put chain definition")
+}
+
+// ValidateDefinition runs the gateway's chain validator against
+// def without storing it. An invalid definition is reported through the
+// result, not as an error.
+func (s *ChainService) ValidateDefinition(ctx context.Context, def *ChainDefinition) (*ChainValidationResult, error) {
+	resp, err := s.c.doRequest(ctx, http.MethodPost, "/v1/chains/definitions/validate", def)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var result ChainValidationResult
+		if err := s.c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	if resp.StatusCode == http.StatusUnprocessableEntity {
+		var verr chainValidationErrorResponse
+		if err := json.Unmarshal(body, &verr); err == nil {
+			errs := verr.Details
+			if len(errs) == 0 && verr.Error != "" {
+				errs = []string{verr.Error}
+			}
+			return &ChainValidationResult{Valid: false, Errors: errs}, nil
+		}
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to validate chain definition")
+}
+
+// History returns the retry history for a chain execution.
+func (s *ChainService) History(ctx context.Context, chainID, namespace, tenant string) (*ChainHistoryResponse, error) {
+	params := url.Values{}
+	params.Set("namespace", namespace)
+	params.Set("tenant", tenant)
+	path := fmt.Sprintf("/v1/chains/%s/history?%s", chainID, params.Encode())
+
+	resp, err := s.c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Chain not found: %s", chainID)}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get chain history"}
+	}
+
+	var history ChainHistoryResponse
+	if err := s.c.decodeBody(resp.Body, &history); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &history, nil
+}
+
+// Metrics returns per-step duration percentiles, failure rates,
+// and throughput for a chain definition over the trailing window. A
+// zero window uses the server default.
+func (s *ChainService) Metrics(ctx context.Context, chainName string, window time.Duration) (*ChainMetricsResponse, error) {
+	path := fmt.Sprintf("/v1/chains/definitions/%s/metrics", url.PathEscape(chainName))
+	if window > 0 {
+		params := url.Values{}
+		params.Set("window_seconds", strconv.FormatInt(int64(window/time.Second), 10))
+		path += "?" + params.Encode()
+	}
+
+	resp, err := s.c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Chain definition not found: %s", chainName)}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get chain metrics"}
+	}
+
+	var metrics ChainMetricsResponse
+	if err := s.c.decodeBody(resp.Body, &metrics); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &metrics, nil
+}
+
+// ListChains is shorthand for c.Chains().List.
+func (c *Client) ListChains(ctx context.Context, namespace, tenant string, status *string) (*ListChainsResponse, error) {
+	return c.Chains().List(ctx, namespace, tenant, status)
+}
+
+// GetChain is shorthand for c.Chains().Get.
+func (c *Client) GetChain(ctx context.Context, chainID, namespace, tenant string) (*ChainDetailResponse, error) {
+	return c.Chains().Get(ctx, chainID, namespace, tenant)
+}
+
+// StartChain is shorthand for c.Chains().Start.
+func (c *Client) StartChain(ctx context.Context, req StartChainRequest) (*StartChainResponse, error) {
+	return c.Chains().Start(ctx, req)
+}
+
+// CancelChain is shorthand for c.Chains().Cancel.
+func (c *Client) CancelChain(ctx context.Context, chainID string, req *CancelChainRequest) (*ChainDetailResponse, error) {
+	return c.Chains().Cancel(ctx, chainID, req)
+}
+
+// RetryChain is shorthand for c.Chains().Retry.
+func (c *Client) RetryChain(ctx context.Context, chainID string, opts RetryOptions) (*ChainDetailResponse, error) {
+	return c.Chains().Retry(ctx, chainID, opts)
+}
+
+// ProvideChainStepInput is shorthand for c.Chains().ProvideStepInput.
+func (c *Client) ProvideChainStepInput(ctx context.Context, chainID, stepName string, req *ProvideStepInputRequest) (*ChainDetailResponse, error) {
+	return c.Chains().ProvideStepInput(ctx, chainID, stepName, req)
+}
+
+// GetChainDag is shorthand for c.Chains().Dag.
+func (c *Client) GetChainDag(ctx context.Context, chainID, namespace, tenant string) (*DagResponse, error) {
+	return c.Chains().Dag(ctx, chainID, namespace, tenant)
+}
+
+// GetChainDefinitionDag is shorthand for c.Chains().DefinitionDag.
+func (c *Client) GetChainDefinitionDag(ctx context.Context, name string) (*DagResponse, error) {
+	return c.Chains().DefinitionDag(ctx, name)
+}
+
+// PutChainDefinition is shorthand for c.Chains().PutDefinition.
+func (c *Client) PutChainDefinition(ctx context.Context, def *ChainDefinition) (*ChainDefinition, error) {
+	return c.Chains().PutDefinition(ctx, def)
+}
+
+// ValidateChainDefinition is shorthand for c.Chains().ValidateDefinition.
+func (c *Client) ValidateChainDefinition(ctx context.Context, def *ChainDefinition) (*ChainValidationResult, error) {
+	return c.Chains().ValidateDefinition(ctx, def)
+}
+
+// GetChainHistory is shorthand for c.Chains().History.
+func (c *Client) GetChainHistory(ctx context.Context, chainID, namespace, tenant string) (*ChainHistoryResponse, error) {
+	return c.Chains().History(ctx, chainID, namespace, tenant)
+}
+
+// GetChainMetrics is shorthand for c.Chains().Metrics.
+func (c *Client) GetChainMetrics(ctx context.Context, chainName string, window time.Duration) (*ChainMetricsResponse, error) {
+	return c.Chains().Metrics(ctx, chainName, window)
+}
@@ -0,0 +1,441 @@
+// Package chains provides a typed builder for Acteon chain definitions.
+//
+// Chains are normally authored as TOML on the gateway. The builder lets
+// Go programs assemble the same configuration in code, validates it
+// locally, and produces an [acteon.ChainDefinition] ready for
+// [acteon.Client.PutChainDefinition]:
+//
+//	def, err := chains.New("search-summarize").
+//		Step("search", "search-api", "web_search",
+//			map[string]any{"q": "{{origin.payload.query}}"},
+//			chains.Retry(3, 500*time.Millisecond, acteon.RetryBackoffExponential)).
+//		Step("summarize", "llm", "summarize",
+//			map[string]any{"text": "{{prev.response_body.results}}"},
+//			chains.When("body.results", acteon.BranchExists, nil, "notify"),
+//			chains.Otherwise("fallback")).
+//		Step("notify", "slack", "post", map[string]any{"text": "{{prev.response_body.summary}}"}).
+//		Step("fallback", "email", "send", map[string]any{"subject": "no results"}).
+//		Timeout(10 * time.Minute).
+//		Build()
+//
+// Lint adds the checks that need more context than one definition,
+// such as the gateway's provider list and the other deployed chains,
+// so CI can gate chain changes before they reach the gateway.
+package chains
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+// Builder assembles a chain definition step by step. Builder methods
+// return the receiver so calls can be chained; problems are collected
+// and reported together by Build.
+type Builder struct {
+	def acteon.ChainDefinition
+}
+
+// New starts a chain definition with the given name.
+func New(name string) *Builder {
+	return &Builder{def: acteon.ChainDefinition{Name: name}}
+}
+
+// StepOption customises a step added to a Builder or built with Task.
+type StepOption func(*acteon.ChainStepDefinition)
+
+// ParallelOption customises a parallel step group.
+type ParallelOption func(*acteon.ChainParallelGroup)
+
+// Step appends a provider step that dispatches actionType to provider
+// with the given payload template.
+func (b *Builder) Step(name, provider, actionType string, payload map[string]any, opts ...StepOption) *Builder {
+	return b.add(Task(name, provider, actionType, payload, opts...))
+}
+
+// SubChain appends a step that runs the named chain definition as a
+// child and waits for it to finish.
+func (b *Builder) SubChain(name, chainName string, opts ...StepOption) *Builder {
+	step := newStep(name, opts)
+	step.SubChain = chainName
+	return b.add(step)
+}
+
+// Parallel appends a step that runs tasks concurrently. Build the
+// sub-steps with Task.
+func (b *Builder) Parallel(name string, tasks []acteon.ChainStepDefinition, opts ...ParallelOption) *Builder {
+	group := &acteon.ChainParallelGroup{Steps: tasks}
+	for _, opt := range opts {
+		opt(group)
+	}
+	step := newStep(name, nil)
+	step.Parallel = group
+	return b.add(step)
+}
+
+// Sleep appends a timer step that pauses the chain for d, rounded up
+// to whole seconds like every duration the builder sets. A d of zero
+// fails Build, since the timer would have no duration.
+func (b *Builder) Sleep(name string, d time.Duration, opts ...StepOption) *Builder {
+	step := newStep(name, opts)
+	step.Timer = &acteon.ChainTimerConfig{DurationSeconds: seconds(d)}
+	return b.add(step)
+}
+
+// SleepUntil appends a timer step that pauses the chain until t.
+func (b *Builder) SleepUntil(name string, t time.Time, opts ...StepOption) *Builder {
+	step := newStep(name, opts)
+	until := t.UTC()
+	step.Timer = &acteon.ChainTimerConfig{Until: &until}
+	return b.add(step)
+}
+
+// WaitForSignal appends a step that pauses until signal is delivered.
+// A zero timeout waits indefinitely; otherwise the chain jumps to
+// onTimeout (or fails, if onTimeout is empty) when it elapses.
+func (b *Builder) WaitForSignal(name, signal string, timeout time.Duration, onTimeout string, opts ...StepOption) *Builder {
+	step := newStep(name, opts)
+	cfg := &acteon.ChainSignalConfig{SignalName: signal, OnTimeout: onTimeout}
+	if timeout > 0 {
+		cfg.TimeoutSeconds = seconds(timeout)
+	}
+	step.WaitForSignal = cfg
+	return b.add(step)
+}
+
+// Worker appends a step that enqueues the work on queue for an
+// external worker and waits for its result.
+func (b *Builder) Worker(name, queue string, cfg acteon.ChainWorkerConfig, opts ...StepOption) *Builder {
+	step := newStep(name, opts)
+	cfg.Queue = queue
+	step.Worker = &cfg
+	return b.add(step)
+}
+
+// OnFailure sets the chain-level failure policy
+// (acteon.ChainFailureAbort or acteon.ChainFailureAbortNoDlq).
+func (b *Builder) OnFailure(policy string) *Builder {
+	b.def.OnFailure = policy
+	return b
+}
+
+// Timeout bounds the total wall-clock time of a chain execution. Zero
+// leaves the chain unbounded.
+func (b *Builder) Timeout(d time.Duration) *Builder {
+	b.def.TimeoutSeconds = seconds(d)
+	return b
+}
+
+// OnCancel dispatches actionType to provider when the chain is cancelled.
+func (b *Builder) OnCancel(provider, actionType string) *Builder {
+	b.def.OnCancel = &acteon.ChainNotificationTarget{Provider: provider, ActionType: actionType}
+	return b
+}
+
+// Build validates the definition and returns it. All problems found
+// are returned together, joined with errors.Join.
+func (b *Builder) Build() (*acteon.ChainDefinition, error) {
+	if err := Validate(&b.def); err != nil {
+		return nil, err
+	}
+	def := b.def
+	return &def, nil
+}
+
+// MustBuild is like Build but panics if the definition is invalid. It
+// is intended for package-level definitions known at compile time.
+func (b *Builder) MustBuild() *acteon.ChainDefinition {
+	def, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return def
+}
+
+func (b *Builder) add(step acteon.ChainStepDefinition) *Builder {
+	b.def.Steps = append(b.def.Steps, step)
+	return b
+}
+
+// Task builds a provider step. It is used for the sub-steps of a
+// Parallel group and by Builder.Step.
+func Task(name, provider, actionType string, payload map[string]any, opts ...StepOption) acteon.ChainStepDefinition {
+	step := newStep(name, opts)
+	step.Provider = provider
+	step.ActionType = actionType
+	if payload != nil {
+		step.PayloadTemplate = payload
+	}
+	return step
+}
+
+func newStep(name string, opts []StepOption) acteon.ChainStepDefinition {
+	step := acteon.ChainStepDefinition{Name: name, PayloadTemplate: map[string]any{}}
+	for _, opt := range opts {
+		opt(&step)
+	}
+	return step
+}
+
+// =============================================================================
+// Step Options
+// =============================================================================
+
+// Retry retries a failed step up to maxRetries times, waiting backoff
+// between attempts according to strategy (acteon.RetryBackoffFixed,
+// acteon.RetryBackoffLinear, or acteon.RetryBackoffExponential).
+// backoff is rounded up to whole milliseconds; zero leaves it unset,
+// so the gateway's default of one second applies.
+func Retry(maxRetries uint32, backoff time.Duration, strategy string) StepOption {
+	return func(s *acteon.ChainStepDefinition) {
+		var jitter *uint64
+		if s.Retry != nil {
+			jitter = s.Retry.JitterMs
+		}
+		s.Retry = &acteon.ChainRetryPolicy{
+			MaxRetries: maxRetries,
+			BackoffMs:  millis(backoff),
+			Strategy:   strategy,
+			JitterMs:   jitter,
+		}
+	}
+}
+
+// RetryJitter adds random jitter to a step's retry backoff. It must be
+// combined with Retry, before or after it; on its own, Build reports
+// an error.
+func RetryJitter(jitter time.Duration) StepOption {
+	return func(s *acteon.ChainStepDefinition) {
+		if s.Retry == nil {
+			s.Retry = &acteon.ChainRetryPolicy{}
+		}
+		ms := millis(jitter)
+		s.Retry.JitterMs = &ms
+	}
+}
+
+// OnStepFailure sets what happens when the step fails after any
+// retries (acteon.StepFailureAbort, acteon.StepFailureSkip, or
+// acteon.StepFailureDlq).
+func OnStepFailure(policy string) StepOption {
+	return func(s *acteon.ChainStepDefinition) {
+		s.OnFailure = policy
+	}
+}
+
+// Delay waits d, rounded up to whole seconds, before executing the
+// step.
+func Delay(d time.Duration) StepOption {
+	return func(s *acteon.ChainStepDefinition) {
+		s.DelaySeconds = seconds(d)
+	}
+}
+
+// When adds a branch: if field in the step response satisfies op
+// against value, the chain continues at target. Branches are evaluated
+// in the order they are added.
+func When(field, op string, value any, target string) StepOption {
+	return func(s *acteon.ChainStepDefinition) {
+		s.Branches = append(s.Branches, acteon.ChainBranchCondition{
+			Field:    field,
+			Operator: op,
+			Value:    value,
+			Target:   target,
+		})
+	}
+}
+
+// Otherwise sets the step to continue at when no branch matches.
+func Otherwise(target string) StepOption {
+	return func(s *acteon.ChainStepDefinition) {
+		s.DefaultNext = target
+	}
+}
+
+// =============================================================================
+// Parallel Options
+// =============================================================================
+
+// Join sets how a parallel group completes (acteon.ParallelJoinAll or
+// acteon.ParallelJoinAny).
+func Join(policy string) ParallelOption {
+	return func(g *acteon.ChainParallelGroup) {
+		g.Join = policy
+	}
+}
+
+// FailurePolicy sets how a parallel group reacts to a failing sub-step
+// (acteon.ParallelFailureFailFast or acteon.ParallelFailureBestEffort).
+func FailurePolicy(policy string) ParallelOption {
+	return func(g *acteon.ChainParallelGroup) {
+		g.OnFailure = policy
+	}
+}
+
+// GroupTimeout bounds the time a parallel group may run.
+func GroupTimeout(d time.Duration) ParallelOption {
+	return func(g *acteon.ChainParallelGroup) {
+		g.TimeoutSeconds = seconds(d)
+	}
+}
+
+// MaxConcurrency limits how many sub-steps of a group run at once.
+func MaxConcurrency(n int) ParallelOption {
+	return func(g *acteon.ChainParallelGroup) {
+		g.MaxConcurrency = &n
+	}
+}
+
+// =============================================================================
+// Validation
+// =============================================================================
+
+// Validate checks def against the rules the gateway enforces when a
+// definition is loaded. It is called by Build and is exported for
+// definitions assembled by hand or decoded from elsewhere.
+func Validate(def *acteon.ChainDefinition) error {
+	var errs []error
+	if def.Name == "" {
+		errs = append(errs, errors.New("chain name is required"))
+	}
+	if len(def.Steps) == 0 {
+		errs = append(errs, fmt.Errorf("chain %q has no steps", def.Name))
+	}
+	if def.OnFailure != "" && def.OnFailure != acteon.ChainFailureAbort && def.OnFailure != acteon.ChainFailureAbortNoDlq {
+		errs = append(errs, fmt.Errorf("unknown chain failure policy %q", def.OnFailure))
+	}
+
+	names := make(map[string]bool, len(def.Steps))
+	for _, step := range def.Steps {
+		if step.Name == "" {
+			errs = append(errs, errors.New("step name is required"))
+			continue
+		}
+		if names[step.Name] {
+			errs = append(errs, fmt.Errorf("duplicate step name %q", step.Name))
+		}
+		names[step.Name] = true
+	}
+
+	for i := range def.Steps {
+		errs = append(errs, validateStep(&def.Steps[i], names, false)...)
+	}
+	return errors.Join(errs...)
+}
+
+func validateStep(step *acteon.ChainStepDefinition, names map[string]bool, inGroup bool) []error {
+	var errs []error
+	kinds := 0
+	for _, set := range []bool{
+		step.SubChain != "",
+		step.Parallel != nil,
+		step.Timer != nil,
+		step.WaitForSignal != nil,
+		step.Worker != nil,
+	} {
+		if set {
+			kinds++
+		}
+	}
+
+	switch {
+	case kinds > 1:
+		errs = append(errs, fmt.Errorf("step %q: sub_chain, parallel, timer, wait_for_signal and worker are mutually exclusive", step.Name))
+	case kinds == 0 && (step.Provider == "" || step.ActionType == ""):
+		errs = append(errs, fmt.Errorf("step %q: provider and action_type are required", step.Name))
+	case kinds == 1 && step.Provider != "":
+		errs = append(errs, fmt.Errorf("step %q: provider must be empty for a non-provider step", step.Name))
+	}
+
+	if step.Retry != nil && (step.SubChain != "" || step.Parallel != nil) {
+		errs = append(errs, fmt.Errorf("step %q: retry is not supported on sub_chain or parallel steps", step.Name))
+	}
+	if step.Retry != nil && step.Retry.JitterMs != nil && step.Retry.MaxRetries == 0 {
+		errs = append(errs, fmt.Errorf("step %q: retry jitter needs a retry count", step.Name))
+	}
+	if step.OnFailure != "" && step.OnFailure != acteon.StepFailureAbort &&
+		step.OnFailure != acteon.StepFailureSkip && step.OnFailure != acteon.StepFailureDlq {
+		errs = append(errs, fmt.Errorf("step %q: unknown failure policy %q", step.Name, step.OnFailure))
+	}
+	if t := step.Timer; t != nil && (t.DurationSeconds == nil) == (t.Until == nil) {
+		errs = append(errs, fmt.Errorf("step %q: timer needs exactly one of duration or until", step.Name))
+	}
+	if w := step.WaitForSignal; w != nil && w.SignalName == "" {
+		errs = append(errs, fmt.Errorf("step %q: signal name is required", step.Name))
+	}
+	if w := step.Worker; w != nil && w.Queue == "" {
+		errs = append(errs, fmt.Errorf("step %q: worker queue is required", step.Name))
+	}
+
+	if inGroup {
+		if len(step.Branches) > 0 || step.DefaultNext != "" {
+			errs = append(errs, fmt.Errorf("step %q: parallel sub-steps cannot branch", step.Name))
+		}
+	} else {
+		for _, br := range step.Branches {
+			if !validOperator(br.Operator) {
+				errs = append(errs, fmt.Errorf("step %q: unknown branch operator %q", step.Name, br.Operator))
+			}
+			if !names[br.Target] {
+				errs = append(errs, fmt.Errorf("step %q: branch target %q does not exist", step.Name, br.Target))
+			}
+		}
+		if step.DefaultNext != "" && !names[step.DefaultNext] {
+			errs = append(errs, fmt.Errorf("step %q: default_next %q does not exist", step.Name, step.DefaultNext))
+		}
+		if w := step.WaitForSignal; w != nil && w.OnTimeout != "" && !names[w.OnTimeout] {
+			errs = append(errs, fmt.Errorf("step %q: on_timeout %q does not exist", step.Name, w.OnTimeout))
+		}
+	}
+
+	if g := step.Parallel; g != nil {
+		if len(g.Steps) == 0 {
+			errs = append(errs, fmt.Errorf("step %q: parallel group has no steps", step.Name))
+		}
+		seen := make(map[string]bool, len(g.Steps))
+		for i := range g.Steps {
+			sub := &g.Steps[i]
+			if seen[sub.Name] {
+				errs = append(errs, fmt.Errorf("step %q: duplicate parallel sub-step %q", step.Name, sub.Name))
+			}
+			seen[sub.Name] = true
+			if sub.Parallel != nil {
+				errs = append(errs, fmt.Errorf("step %q: parallel groups cannot be nested", step.Name))
+				continue
+			}
+			errs = append(errs, validateStep(sub, names, true)...)
+		}
+	}
+	return errs
+}
+
+func validOperator(op string) bool {
+	switch op {
+	case acteon.BranchEq, acteon.BranchNeq, acteon.BranchContains, acteon.BranchExists,
+		acteon.BranchGt, acteon.BranchLt, acteon.BranchGte, acteon.BranchLte:
+		return true
+	}
+	return false
+}
+
+// seconds converts d to the whole seconds the gateway takes, rounding
+// up so a sub-second duration does not become zero. It returns nil,
+// leaving the field unset, when d is zero or negative.
+func seconds(d time.Duration) *uint64 {
+	if d <= 0 {
+		return nil
+	}
+	s := uint64((d + time.Second - 1) / time.Second)
+	return &s
+}
+
+// millis is seconds for the millisecond fields, returning 0 where
+// seconds returns nil.
+func millis(d time.Duration) uint64 {
+	if d <= 0 {
+		return 0
+	}
+	return uint64((d + time.Millisecond - 1) / time.Millisecond)
+}
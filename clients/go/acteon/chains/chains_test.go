@@ -0,0 +1,155 @@
+package chains
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+func TestBuildWireShape(t *testing.T) {
+	def, err := New("triage").
+		Step("classify", "llm", "classify", map[string]any{"text": "{{origin.payload.body}}"},
+			Retry(3, 250*time.Millisecond, acteon.RetryBackoffExponential),
+			When("body.severity", acteon.BranchEq, "high", "page"),
+			Otherwise("ticket")).
+		Step("page", "pagerduty", "trigger", nil, OnStepFailure(acteon.StepFailureDlq)).
+		Step("ticket", "jira", "create", map[string]any{"summary": "{{prev.response_body.title}}"}).
+		OnFailure(acteon.ChainFailureAbortNoDlq).
+		Timeout(5 * time.Minute).
+		Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	raw, err := json.Marshal(def)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]any
+	if err := json.Unmarshal(raw, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got["name"] != "triage" || got["on_failure"] != "abort_no_dlq" || got["timeout_seconds"] != float64(300) {
+		t.Errorf("chain fields = %s", raw)
+	}
+	steps := got["steps"].([]any)
+	if len(steps) != 3 {
+		t.Fatalf("steps = %d, want 3", len(steps))
+	}
+	first := steps[0].(map[string]any)
+	retry := first["retry"].(map[string]any)
+	if retry["max_retries"] != float64(3) || retry["backoff_ms"] != float64(250) || retry["strategy"] != "exponential" {
+		t.Errorf("retry = %v", retry)
+	}
+	branch := first["branches"].([]any)[0].(map[string]any)
+	if branch["field"] != "body.severity" || branch["operator"] != "eq" || branch["value"] != "high" || branch["target"] != "page" {
+		t.Errorf("branch = %v", branch)
+	}
+	if first["default_next"] != "ticket" {
+		t.Errorf("default_next = %v", first["default_next"])
+	}
+	page := steps[1].(map[string]any)
+	if pt, ok := page["payload_template"].(map[string]any); !ok || len(pt) != 0 {
+		t.Errorf("nil payload should encode as {}, got %v", page["payload_template"])
+	}
+}
+
+func TestBuildNonProviderSteps(t *testing.T) {
+	until := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	def := New("ops").
+		Parallel("fanout", []acteon.ChainStepDefinition{
+			Task("a", "slack", "post", nil),
+			Task("b", "email", "send", nil),
+		}, Join(acteon.ParallelJoinAny), MaxConcurrency(2)).
+		Sleep("cool-off", 30*time.Second).
+		SleepUntil("wake", until).
+		WaitForSignal("approve", "approved", time.Hour, "expire").
+		SubChain("expire", "cleanup").
+		MustBuild()
+
+	raw, _ := json.Marshal(def)
+	for _, want := range []string{
+		`"parallel":{"steps":[`,
+		`"join":"any"`,
+		`"max_concurrency":2`,
+		`"timer":{"duration_seconds":30}`,
+		`"timer":{"until":"2030-01-02T03:04:05Z"}`,
+		`"wait_for_signal":{"signal_name":"approved","timeout_seconds":3600,"on_timeout":"expire"}`,
+		`"sub_chain":"cleanup"`,
+	} {
+		if !strings.Contains(string(raw), want) {
+			t.Errorf("missing %s in %s", want, raw)
+		}
+	}
+}
+
+func TestBuildCollectsAllErrors(t *testing.T) {
+	_, err := New("bad").
+		Step("one", "", "", nil, When("x", "approx", 1, "nowhere")).
+		Step("one", "slack", "post", nil).
+		SubChain("child", "other", Retry(1, time.Second, "")).
+		Parallel("empty", nil).
+		Step("jitter", "slack", "post", nil, RetryJitter(time.Second)).
+		Build()
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+	msg := err.Error()
+	for _, want := range []string{
+		`duplicate step name "one"`,
+		`provider and action_type are required`,
+		`unknown branch operator "approx"`,
+		`branch target "nowhere" does not exist`,
+		`retry is not supported`,
+		`parallel group has no steps`,
+		`step "jitter": retry jitter needs a retry count`,
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("error %q missing %q", msg, want)
+		}
+	}
+}
+
+func TestBuildRoundsUpSubSecondDurations(t *testing.T) {
+	def := New("quick").
+		Step("post", "slack", "post", nil, Delay(200*time.Millisecond),
+			RetryJitter(250*time.Millisecond), Retry(2, time.Second, "")).
+		Sleep("blink", 1500*time.Millisecond).
+		Timeout(time.Millisecond).
+		MustBuild()
+	if *def.Steps[0].DelaySeconds != 1 || *def.Steps[1].Timer.DurationSeconds != 2 || *def.TimeoutSeconds != 1 {
+		t.Errorf("delay = %d, sleep = %d, timeout = %d",
+			*def.Steps[0].DelaySeconds, *def.Steps[1].Timer.DurationSeconds, *def.TimeoutSeconds)
+	}
+	if r := def.Steps[0].Retry; r.MaxRetries != 2 || r.JitterMs == nil || *r.JitterMs != 250 {
+		t.Errorf("retry = %+v", r)
+	}
+	def = New("defaults").
+		Step("post", "slack", "post", nil, Retry(1, 300*time.Microsecond, "")).
+		Step("page", "pagerduty", "trigger", nil, Retry(1, 0, "")).
+		Timeout(0).
+		MustBuild()
+	raw, _ := json.Marshal(def)
+	if !strings.Contains(string(raw), `"retry":{"max_retries":1,"backoff_ms":1}`) ||
+		!strings.Contains(string(raw), `"retry":{"max_retries":1}`) || strings.Contains(string(raw), "timeout_seconds") {
+		t.Errorf("definition = %s", raw)
+	}
+}
+
+func TestBuildRequiresSteps(t *testing.T) {
+	if _, err := New("").Build(); err == nil || !strings.Contains(err.Error(), "chain name is required") {
+		t.Errorf("err = %v", err)
+	}
+}
+
+func TestMustBuildPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("MustBuild did not panic on an invalid definition")
+		}
+	}()
+	New("empty").MustBuild()
+}
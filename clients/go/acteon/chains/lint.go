@@ -0,0 +1,194 @@
+package chains
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+// LintOptions supplies the context Lint needs for checks that look
+// beyond a single definition.
+type LintOptions struct {
+	// Providers lists the provider names configured on the target
+	// gateway. When empty, provider names are not checked.
+	Providers []string
+	// Chains holds the other chain definitions deployed alongside the
+	// one being linted, keyed by name. When nil, sub-chain references
+	// are not checked.
+	Chains map[string]*acteon.ChainDefinition
+}
+
+// Issue is a single finding reported by Lint.
+type Issue struct {
+	Chain   string
+	Step    string
+	Message string
+}
+
+func (i Issue) String() string {
+	if i.Step == "" {
+		return fmt.Sprintf("chain %q: %s", i.Chain, i.Message)
+	}
+	return fmt.Sprintf("chain %q step %q: %s", i.Chain, i.Step, i.Message)
+}
+
+// Lint reports problems that Validate cannot see on its own: unknown
+// providers, steps no execution path can reach, branch loops, and
+// cycles or dangling references in the sub-chain graph. It assumes def
+// already passes Validate. Issues are returned in step order; an empty
+// result means the definition is clean.
+func Lint(def *acteon.ChainDefinition, opts LintOptions) []Issue {
+	var issues []Issue
+	report := func(step, format string, args ...any) {
+		issues = append(issues, Issue{Chain: def.Name, Step: step, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if len(opts.Providers) > 0 {
+		known := make(map[string]bool, len(opts.Providers))
+		for _, p := range opts.Providers {
+			known[p] = true
+		}
+		for _, step := range def.Steps {
+			if step.Provider != "" && !known[step.Provider] {
+				report(step.Name, "unknown provider %q", step.Provider)
+			}
+			if step.Parallel != nil {
+				for _, sub := range step.Parallel.Steps {
+					if sub.Provider != "" && !known[sub.Provider] {
+						report(step.Name, "parallel sub-step %q uses unknown provider %q", sub.Name, sub.Provider)
+					}
+				}
+			}
+		}
+		if def.OnCancel != nil && !known[def.OnCancel.Provider] {
+			report("", "on_cancel uses unknown provider %q", def.OnCancel.Provider)
+		}
+	}
+
+	graph := stepGraph(def)
+	if len(def.Steps) > 0 {
+		reached := reachable(graph, 0)
+		for i, step := range def.Steps {
+			if !reached[i] {
+				report(step.Name, "step is unreachable")
+			}
+		}
+	}
+	for i, step := range def.Steps {
+		if reachable(graph, graph[i]...)[i] {
+			report(step.Name, "cycle detected: step is reachable from itself via branches")
+		}
+	}
+
+	if opts.Chains != nil {
+		for _, step := range def.Steps {
+			if step.SubChain != "" && step.SubChain != def.Name && opts.Chains[step.SubChain] == nil {
+				report(step.Name, "unknown sub-chain %q", step.SubChain)
+			}
+		}
+		if cycle := subChainCycle(def, opts.Chains); cycle != nil {
+			report("", "sub-chain cycle detected: %s", strings.Join(cycle, " -> "))
+		}
+	}
+	return issues
+}
+
+// stepGraph returns, for each step index, the indices execution may
+// continue at. A step advances sequentially unless it sets
+// default_next; branches and signal timeouts add further edges.
+func stepGraph(def *acteon.ChainDefinition) [][]int {
+	index := make(map[string]int, len(def.Steps))
+	for i, step := range def.Steps {
+		index[step.Name] = i
+	}
+	graph := make([][]int, len(def.Steps))
+	for i, step := range def.Steps {
+		var next []int
+		add := func(name string) {
+			if j, ok := index[name]; ok {
+				next = append(next, j)
+			}
+		}
+		for _, br := range step.Branches {
+			add(br.Target)
+		}
+		if step.DefaultNext != "" {
+			add(step.DefaultNext)
+		} else if i+1 < len(def.Steps) {
+			next = append(next, i+1)
+		}
+		if step.WaitForSignal != nil && step.WaitForSignal.OnTimeout != "" {
+			add(step.WaitForSignal.OnTimeout)
+		}
+		graph[i] = next
+	}
+	return graph
+}
+
+func reachable(graph [][]int, from ...int) map[int]bool {
+	seen := make(map[int]bool)
+	queue := append([]int(nil), from...)
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		if seen[n] {
+			continue
+		}
+		seen[n] = true
+		queue = append(queue, graph[n]...)
+	}
+	return seen
+}
+
+// subChainCycle returns the first sub-chain cycle through def, as the
+// list of chain names from def back to itself, or nil if there is none.
+func subChainCycle(def *acteon.ChainDefinition, others map[string]*acteon.ChainDefinition) []string {
+	lookup := func(name string) *acteon.ChainDefinition {
+		if name == def.Name {
+			return def
+		}
+		return others[name]
+	}
+	refs := func(d *acteon.ChainDefinition) []string {
+		set := map[string]bool{}
+		for _, step := range d.Steps {
+			if step.SubChain != "" {
+				set[step.SubChain] = true
+			}
+		}
+		out := make([]string, 0, len(set))
+		for name := range set {
+			out = append(out, name)
+		}
+		sort.Strings(out)
+		return out
+	}
+
+	visited := map[string]bool{}
+	var path []string
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		if len(path) > 0 && name == def.Name {
+			return append(append([]string(nil), path...), name)
+		}
+		if visited[name] {
+			return nil
+		}
+		visited[name] = true
+		d := lookup(name)
+		if d == nil {
+			return nil
+		}
+		path = append(path, name)
+		for _, ref := range refs(d) {
+			if cycle := visit(ref); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		return nil
+	}
+	return visit(def.Name)
+}
@@ -0,0 +1,69 @@
+package chains
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+func lintMessages(issues []Issue) string {
+	parts := make([]string, len(issues))
+	for i, is := range issues {
+		parts[i] = is.String()
+	}
+	return strings.Join(parts, "\n")
+}
+
+func TestLintCleanChain(t *testing.T) {
+	def := New("ok").
+		Step("a", "slack", "post", nil, When("body.ok", acteon.BranchEq, true, "c"), Otherwise("b")).
+		Step("b", "email", "send", nil).
+		Step("c", "slack", "post", nil).
+		MustBuild()
+	if issues := Lint(def, LintOptions{Providers: []string{"slack", "email"}}); len(issues) != 0 {
+		t.Errorf("unexpected issues:\n%s", lintMessages(issues))
+	}
+}
+
+func TestLintUnknownProviderAndUnreachable(t *testing.T) {
+	def := New("lint").
+		Step("a", "slack", "post", nil, Otherwise("c")).
+		Step("b", "sms", "send", nil).
+		Step("c", "slack", "post", nil).
+		MustBuild()
+	msg := lintMessages(Lint(def, LintOptions{Providers: []string{"slack"}}))
+	for _, want := range []string{
+		`step "b": unknown provider "sms"`,
+		`step "b": step is unreachable`,
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("issues missing %q:\n%s", want, msg)
+		}
+	}
+}
+
+func TestLintBranchCycle(t *testing.T) {
+	def := New("loop").
+		Step("a", "p", "t", nil, Otherwise("b")).
+		Step("b", "p", "t", nil, Otherwise("a")).
+		MustBuild()
+	msg := lintMessages(Lint(def, LintOptions{}))
+	if !strings.Contains(msg, `step "a": cycle detected`) {
+		t.Errorf("expected cycle issue, got:\n%s", msg)
+	}
+}
+
+func TestLintSubChainGraph(t *testing.T) {
+	parent := New("parent").SubChain("child", "child").SubChain("ghost", "missing").MustBuild()
+	child := New("child").SubChain("back", "parent").MustBuild()
+	msg := lintMessages(Lint(parent, LintOptions{Chains: map[string]*acteon.ChainDefinition{"child": child}}))
+	for _, want := range []string{
+		`step "ghost": unknown sub-chain "missing"`,
+		`sub-chain cycle detected: parent -> child -> parent`,
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("issues missing %q:\n%s", want, msg)
+		}
+	}
+}
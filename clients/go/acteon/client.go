@@ -14,14 +14,33 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
 // Client is an HTTP client for the Acteon action gateway.
 type Client struct {
-	baseURL    string
-	httpClient *http.Client
-	apiKey     string
+	baseURL           string
+	httpClient        *http.Client
+	apiKey            string
+	validateTemplates bool
+	strictDecoding    bool
+	skipValidation    bool
+	cache             *responseCache
+	routes            map[string]string
+	retry             *RetryPolicy
+	throttle          *ThrottleRetry
+	encryption        *payloadEncryption
+	scrubbers         []func(*Action)
+	slowCall          *slowCallHook
+	interceptors      []Interceptor
+	apiVersion        string
+	basePath          string
+	sseClient         *http.Client
+	namespace         string
+	tenant            string
+	tenantLimit       *tenantLimiter
+	atomicGateways    sync.Map // base URL -> true, for gateways with FeatureAtomicBatch
 }
 
 // ClientOption is a function that configures a Client.
@@ -48,6 +67,101 @@ func WithHTTPClient(httpClient *http.Client) ClientOption {
 	}
 }
 
+// WithTemplateValidation makes CreateTemplate and UpdateTemplate run
+// ValidateTemplate on the content first and refuse to store a template
+// that does not validate.
+func WithTemplateValidation() ClientOption {
+	return func(c *Client) {
+		c.validateTemplates = true
+	}
+}
+
+// WithStrictDecoding makes the client reject response bodies that
+// contain fields its models do not declare. Use it in CI against a
+// new server build to catch schema drift; leave it off in production
+// so older clients keep working when the server adds fields.
+//
+// Types with custom decoding, such as ActionOutcome, are not covered.
+func WithStrictDecoding() ClientOption {
+	return func(c *Client) {
+		c.strictDecoding = true
+	}
+}
+
+// WithoutActionValidation turns off the client-side checks Dispatch
+// and the batch dispatch methods run before sending, leaving all
+// validation to the server.
+func WithoutActionValidation() ClientOption {
+	return func(c *Client) {
+		c.skipValidation = true
+	}
+}
+
+// WithDefaultScope fills in namespace and tenant on actions passed to
+// Dispatch and the batch dispatch methods that leave them empty, so a
+// service scoped to one tenant can build actions without repeating it.
+// Either may be empty to leave that field alone.
+func WithDefaultScope(namespace, tenant string) ClientOption {
+	return func(c *Client) {
+		c.namespace = namespace
+		c.tenant = tenant
+	}
+}
+
+// WithAPIVersion makes the client call version of the gateway API,
+// such as "v2", instead of the default "v1". It rewrites the leading
+// /v1 of each versioned path; unversioned endpoints such as /health
+// are left alone.
+func WithAPIVersion(version string) ClientOption {
+	return func(c *Client) {
+		c.apiVersion = strings.Trim(version, "/")
+	}
+}
+
+// WithBasePath prefixes every request path with prefix, for a gateway
+// that a path-rewriting proxy serves below the root of its host. With
+// WithBasePath("/acteon"), Dispatch posts to /acteon/v1/dispatch.
+func WithBasePath(prefix string) ClientOption {
+	return func(c *Client) {
+		c.basePath = ""
+		if p := strings.Trim(prefix, "/"); p != "" {
+			c.basePath = "/" + p
+		}
+	}
+}
+
+// apiPath returns the path a request for path is sent to under
+// WithAPIVersion and WithBasePath.
+func (c *Client) apiPath(path string) string {
+	if c.apiVersion != "" && c.apiVersion != "v1" {
+		if rest, ok := strings.CutPrefix(path, "/v1"); ok && (rest == "" || rest[0] == '/' || rest[0] == '?') {
+			path = "/" + c.apiVersion + rest
+		}
+	}
+	return c.basePath + path
+}
+
+// WithSSEClient sets the HTTP client event streams such as Stream and
+// Subscribe are opened with. By default they use a copy of the
+// client's own HTTP client, sharing its transport, without the timeout
+// that would cut a long-lived stream off. httpClient's Timeout should
+// be zero for the same reason.
+func WithSSEClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		c.sseClient = httpClient
+	}
+}
+
+// streamClient returns the HTTP client to open an event stream with.
+func (c *Client) streamClient() *http.Client {
+	if c.sseClient != nil {
+		return c.sseClient
+	}
+	hc := *c.httpClient
+	hc.Timeout = 0
+	return &hc
+}
+
 // TLSConfig configures TLS for the Acteon client.
 type TLSConfig struct {
 	// CACertPath is the path to a custom CA certificate file (PEM) for server verification.
@@ -112,6 +226,51 @@ func NewClient(baseURL string, opts ...ClientOption) *Client {
 	return c
 }
 
+// listPageSize is the page size the client requests when it needs a
+// whole list. It is the gateway's default limit, so a shorter page is
+// the last one.
+const listPageSize = 100
+
+// listAll calls page with increasing offsets until it returns a short
+// page, and returns the items of every page.
+func listAll[T any](page func(limit, offset int) ([]T, error)) ([]T, error) {
+	var all []T
+	for offset := 0; ; offset += listPageSize {
+		items, err := page(listPageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if len(items) < listPageSize {
+			return all, nil
+		}
+	}
+}
+
+// setPage adds limit and offset query parameters; a zero limit adds
+// neither.
+func setPage(params url.Values, limit, offset int) {
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+		params.Set("offset", strconv.Itoa(offset))
+	}
+}
+
+// decodeJSON decodes a successful response body into v, honouring
+// WithStrictDecoding.
+func (c *Client) decodeJSON(data []byte, v any) error {
+	return c.decodeBody(bytes.NewReader(data), v)
+}
+
+// decodeBody is decodeJSON for a streamed body.
+func (c *Client) decodeBody(r io.Reader, v any) error {
+	dec := json.NewDecoder(r)
+	if c.strictDecoding {
+		dec.DisallowUnknownFields()
+	}
+	return dec.Decode(v)
+}
+
 func (c *Client) doRequest(ctx context.Context, method, path string, body any) (*http.Response, error) {
 	return c.doRequestExt(ctx, method, path, body, requestOpts{})
 }
@@ -128,6 +287,17 @@ type requestOpts struct {
 	// skipAuth suppresses the Authorization header even when an
 	// API key is configured on the client.
 	skipAuth bool
+	// rawBody, when set, is sent as-is instead of JSON-encoding the
+	// body argument. Used by the streaming plugin upload.
+	rawBody io.Reader
+	// contentType overrides the default `application/json`.
+	contentType string
+	// baseURL, when set, sends the request there instead of the
+	// endpoint WithRouting would pick.
+	baseURL string
+	// noRetry sends the request once even under WithRetryPolicy, for
+	// calls where an error status is itself the answer.
+	noRetry bool
 }
 
 // doRequestExt is the request workhorse with hook points for
@@ -139,22 +309,97 @@ func (c *Client) doRequestExt(
 	method, path string,
 	body any,
 	opts requestOpts,
+) (resp *http.Response, err error) {
+	if c.slowCall != nil {
+		start := time.Now()
+		defer func() { c.slowCall.observe(method, path, start, resp, err) }()
+	}
+	call, ok := ctx.Value(requestOptionsContext{}).(*callOptions)
+	if !ok {
+		return c.sendWithRetries(ctx, c.httpClient, method, path, body, opts)
+	}
+	path, opts = call.apply(path, opts)
+	if call.timeout <= 0 {
+		return c.sendWithRetries(ctx, c.httpClient, method, path, body, opts)
+	}
+
+	// The per-call deadline replaces the client-wide timeout, which
+	// would otherwise cut a longer call short.
+	hc := *c.httpClient
+	hc.Timeout = 0
+	ctx, cancel := context.WithTimeout(ctx, call.timeout)
+	resp, err = c.sendWithRetries(ctx, &hc, method, path, body, opts)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// sendWithRetries sends a doRequestExt call through hc, retrying as
+// WithRetryPolicy and WithThrottleRetry allow.
+func (c *Client) sendWithRetries(
+	ctx context.Context,
+	hc *http.Client,
+	method, path string,
+	body any,
+	opts requestOpts,
 ) (*http.Response, error) {
-	var bodyReader io.Reader
-	if body != nil {
-		jsonBody, err := json.Marshal(body)
+	bodyReader := opts.rawBody
+	var jsonBody []byte
+	if bodyReader == nil && body != nil {
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, err
 		}
-		bodyReader = bytes.NewReader(jsonBody)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bodyReader)
+	base := opts.baseURL
+	if base == "" {
+		base = c.endpointFor(ctx, path, jsonBody)
+	}
+
+	retryable := (c.retry != nil || c.throttle != nil) && opts.rawBody == nil && !opts.noRetry
+	for attempt := 1; ; attempt++ {
+		if jsonBody != nil {
+			bodyReader = bytes.NewReader(jsonBody)
+		}
+		resp, err := c.sendRequest(ctx, hc, method, base+c.apiPath(path), bodyReader, opts)
+		var delay time.Duration
+		retry := retryable && ctx.Err() == nil
+		if retry {
+			delay, retry = c.retryDelay(resp, err, attempt)
+		}
+		if !retry {
+			if err != nil {
+				return nil, err
+			}
+			captureResponse(ctx, resp)
+			return resp, nil
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+		if err := sleepContext(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// sendRequest makes one attempt of a doRequestExt call.
+func (c *Client) sendRequest(ctx context.Context, hc *http.Client, method, target string, body io.Reader, opts requestOpts) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, target, body)
 	if err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	contentType := opts.contentType
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	req.Header.Set("Content-Type", contentType)
 	if !opts.skipAuth && c.apiKey != "" {
 		req.Header.Set("Authorization", "Bearer "+c.apiKey)
 	}
@@ -162,22 +407,104 @@ func (c *Client) doRequestExt(
 		req.Header.Set(k, v)
 	}
 
-	resp, err := c.httpClient.Do(req)
+	return c.roundTrip(hc, req)
+}
+
+// Health checks if the server is healthy. A server that answers with
+// a non-200 status reports (false, nil); a server that cannot be
+// reached reports the connection error.
+func (c *Client) Health(ctx context.Context) (bool, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/health", nil)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode == http.StatusOK, nil
+}
+
+// Ready reports whether the gateway is safe to send traffic to. It
+// returns the readiness checks both when the gateway is ready (200)
+// and when it is not (503); other statuses and unreachable servers are
+// errors.
+func (c *Client) Ready(ctx context.Context) (*Readiness, error) {
+	resp, err := c.doRequestExt(ctx, http.MethodGet, "/ready", nil, requestOpts{noRetry: true})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		var r Readiness
+		if err := c.decodeJSON(body, &r); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &r, nil
+	case http.StatusServiceUnavailable:
+		var r Readiness
+		if err := c.decodeJSON(body, &r); err == nil && len(r.Checks) > 0 {
+			r.Ready = false
+			return &r, nil
+		}
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to check readiness")
+}
+
+// GetHealthDetail returns the gateway's health per dependency: state
+// and audit stores, each provider, and background processors. The
+// detail is returned even when the gateway answers 503 because a
+// required dependency is down.
+func (c *Client) GetHealthDetail(ctx context.Context) (*HealthDetail, error) {
+	resp, err := c.doRequestExt(ctx, http.MethodGet, "/health/detail", nil, requestOpts{noRetry: true})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
 
-	return resp, nil
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusServiceUnavailable {
+		var detail HealthDetail
+		if err := c.decodeJSON(body, &detail); err == nil && detail.Status != "" {
+			return &detail, nil
+		}
+		if resp.StatusCode == http.StatusOK {
+			return nil, &ConnectionError{Message: "malformed health detail response"}
+		}
+		// A 503 without a detail body came from a proxy, not the gateway.
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to get health detail")
 }
 
-// Health checks if the server is healthy.
-func (c *Client) Health(ctx context.Context) (bool, error) {
-	resp, err := c.doRequest(ctx, http.MethodGet, "/health", nil)
+// GetServerInfo returns the gateway's version, build, enabled features,
+// and storage backend. Use HasFeature to gate calls to optional
+// subsystems, and include the result in bug reports.
+func (c *Client) GetServerInfo(ctx context.Context) (*ServerInfo, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/v1/info", nil)
 	if err != nil {
-		return false, nil
+		return nil, err
 	}
 	defer resp.Body.Close()
-	return resp.StatusCode == http.StatusOK, nil
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get server info"}
+	}
+
+	var info ServerInfo
+	if err := c.decodeBody(resp.Body, &info); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &info, nil
 }
 
 // FetchSigningKeys returns the server's active signing keyring.
@@ -216,7 +543,7 @@ func (c *Client) FetchSigningKeys(ctx context.Context) (*SigningKeysResponse, er
 	}
 
 	var out SigningKeysResponse
-	if err := json.Unmarshal(body, &out); err != nil {
+	if err := c.decodeJSON(body, &out); err != nil {
 		// Wrap the raw json.Unmarshal error so upstream callers get a
 		// clear "malformed response" signal instead of a cryptic
 		// "invalid character '<' looking for beginning of value" —
@@ -238,8 +565,39 @@ func (c *Client) FetchSigningKeys(ctx context.Context) (*SigningKeysResponse, er
 	return &out, nil
 }
 
-// Dispatch dispatches a single action.
+// Dispatch dispatches a single action. Under WithThrottleRetry, a
+// Throttled outcome is retried after its RetryAfter.
 func (c *Client) Dispatch(ctx context.Context, action *Action) (*ActionOutcome, error) {
+	action = c.scopeAction(action)
+	if err := c.validateAction(action); err != nil {
+		return nil, err
+	}
+	action, err := c.prepareAction(ctx, action)
+	if err != nil {
+		return nil, err
+	}
+	for attempt := 1; ; attempt++ {
+		outcome, err := c.dispatchOnce(ctx, action)
+		if err != nil || !outcome.IsThrottled() {
+			return outcome, err
+		}
+		wait, ok := c.throttleWait(attempt, outcome.RetryAfter)
+		if !ok {
+			return outcome, nil
+		}
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// dispatchOnce sends action once, leaving throttling to Dispatch.
+func (c *Client) dispatchOnce(ctx context.Context, action *Action) (*ActionOutcome, error) {
+	release, err := c.acquireTenants(ctx, action)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/dispatch", action)
 	if err != nil {
 		return nil, err
@@ -253,22 +611,31 @@ func (c *Client) Dispatch(ctx context.Context, action *Action) (*ActionOutcome,
 
 	if resp.StatusCode == http.StatusOK {
 		var outcome ActionOutcome
-		if err := json.Unmarshal(body, &outcome); err != nil {
+		if err := c.decodeJSON(body, &outcome); err != nil {
 			return nil, &ConnectionError{Message: err.Error()}
 		}
 		return &outcome, nil
 	}
 
-	var errResp ErrorResponse
-	if err := json.Unmarshal(body, &errResp); err != nil {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to parse error response"}
-	}
-	return nil, &APIError{Code: errResp.Code, Message: errResp.Message, Retryable: errResp.Retryable}
+	return nil, errorFromResponse(resp, body, "Failed to parse error response")
 }
 
 // DispatchDryRun dispatches a single action in dry-run mode.
 // Rules are evaluated but the action is not executed and no state is mutated.
 func (c *Client) DispatchDryRun(ctx context.Context, action *Action) (*ActionOutcome, error) {
+	action = c.scopeAction(action)
+	if err := c.validateAction(action); err != nil {
+		return nil, err
+	}
+	action, err := c.prepareAction(ctx, action)
+	if err != nil {
+		return nil, err
+	}
+	release, err := c.acquireTenants(ctx, action)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/dispatch?dry_run=true", action)
 	if err != nil {
 		return nil, err
@@ -282,51 +649,227 @@ func (c *Client) DispatchDryRun(ctx context.Context, action *Action) (*ActionOut
 
 	if resp.StatusCode == http.StatusOK {
 		var outcome ActionOutcome
-		if err := json.Unmarshal(body, &outcome); err != nil {
+		if err := c.decodeJSON(body, &outcome); err != nil {
 			return nil, &ConnectionError{Message: err.Error()}
 		}
 		return &outcome, nil
 	}
 
-	var errResp ErrorResponse
-	if err := json.Unmarshal(body, &errResp); err != nil {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to parse error response"}
+	return nil, errorFromResponse(resp, body, "Failed to parse error response")
+}
+
+// validateAction runs Action.Validate unless validation is turned off.
+func (c *Client) validateAction(action *Action) error {
+	if c.skipValidation {
+		return nil
+	}
+	if action == nil {
+		return &ValidationError{Problems: []ValidationProblem{{Field: "action", Message: "is nil"}}}
 	}
-	return nil, &APIError{Code: errResp.Code, Message: errResp.Message, Retryable: errResp.Retryable}
+	return action.Validate()
 }
 
-// DispatchBatch dispatches multiple actions in a single request.
-func (c *Client) DispatchBatch(ctx context.Context, actions []*Action) ([]BatchResult, error) {
-	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/dispatch/batch", actions)
-	if err != nil {
-		return nil, err
+// scopeAction returns a copy of action with WithDefaultScope applied,
+// or action itself when there is nothing to fill in.
+func (c *Client) scopeAction(action *Action) *Action {
+	if action == nil || (action.Namespace != "" || c.namespace == "") && (action.Tenant != "" || c.tenant == "") {
+		return action
 	}
-	defer resp.Body.Close()
+	out := *action
+	if out.Namespace == "" {
+		out.Namespace = c.namespace
+	}
+	if out.Tenant == "" {
+		out.Tenant = c.tenant
+	}
+	return &out
+}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
+// scopeActions is scopeAction for a batch.
+func (c *Client) scopeActions(actions []*Action) []*Action {
+	if c.namespace == "" && c.tenant == "" {
+		return actions
 	}
+	out := make([]*Action, len(actions))
+	for i, a := range actions {
+		out[i] = c.scopeAction(a)
+	}
+	return out
+}
 
-	if resp.StatusCode == http.StatusOK {
-		var results []BatchResult
-		if err := json.Unmarshal(body, &results); err != nil {
-			return nil, &ConnectionError{Message: err.Error()}
+// prepareAction applies any WithPayloadScrubber hooks and then any
+// WithPayloadEncryption sealing to action, returning the copy to send.
+func (c *Client) prepareAction(ctx context.Context, action *Action) (*Action, error) {
+	return c.sealAction(ctx, c.scrubAction(action))
+}
+
+// prepareActions is prepareAction for a batch.
+func (c *Client) prepareActions(ctx context.Context, actions []*Action) ([]*Action, error) {
+	if len(c.scrubbers) > 0 {
+		scrubbed := make([]*Action, len(actions))
+		for i, a := range actions {
+			scrubbed[i] = c.scrubAction(a)
 		}
-		return results, nil
+		actions = scrubbed
+	}
+	return c.sealActions(ctx, actions)
+}
+
+// validateBatch is validateAction for every batch entry, reporting
+// all problems together with each field prefixed by its entry index.
+func (c *Client) validateBatch(actions []*Action) error {
+	if c.skipValidation {
+		return nil
+	}
+	var problems []ValidationProblem
+	for i, a := range actions {
+		if a == nil {
+			problems = append(problems, ValidationProblem{Field: fmt.Sprintf("[%d]", i), Message: "action is nil"})
+			continue
+		}
+		problems = append(problems, a.validationProblems(fmt.Sprintf("[%d].", i))...)
+	}
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
 	}
+	return nil
+}
 
-	var errResp ErrorResponse
-	if err := json.Unmarshal(body, &errResp); err != nil {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to parse error response"}
+// DispatchBatch dispatches multiple actions in a single request.
+// Under WithThrottleRetry, entries that come back Throttled are sent
+// again, on their own, once their RetryAfter has passed.
+func (c *Client) DispatchBatch(ctx context.Context, actions []*Action) (BatchResults, error) {
+	actions = c.scopeActions(actions)
+	if err := c.validateBatch(actions); err != nil {
+		return nil, err
+	}
+	actions, err := c.prepareActions(ctx, actions)
+	if err != nil {
+		return nil, err
+	}
+	results, err := c.sendLimitedBatch(ctx, "/v1/dispatch/batch", actions)
+	if err != nil {
+		return results, err
+	}
+	for attempt := 1; ; attempt++ {
+		var idx []int
+		var wait time.Duration
+		for i, r := range results {
+			if r.Outcome != nil && r.Outcome.IsThrottled() {
+				idx = append(idx, i)
+				wait = max(wait, r.Outcome.RetryAfter)
+			}
+		}
+		if len(idx) == 0 {
+			break
+		}
+		var ok bool
+		if wait, ok = c.throttleWait(attempt, wait); !ok {
+			break
+		}
+		if err := sleepContext(ctx, wait); err != nil {
+			return results, err
+		}
+		sub := make([]*Action, len(idx))
+		for j, i := range idx {
+			sub[j] = actions[i]
+		}
+		retried, err := c.sendLimitedBatch(ctx, "/v1/dispatch/batch", sub)
+		for j, i := range idx {
+			// Entries a failed gateway did not dispatch keep their
+			// throttled outcome.
+			if j < len(retried) && (err == nil || retried[j].Error == nil) {
+				results[i] = retried[j]
+			}
+		}
+		correlateBatch(results, actions)
+		if err != nil {
+			return results, err
+		}
 	}
-	return nil, &APIError{Code: errResp.Code, Message: errResp.Message, Retryable: errResp.Retryable}
+	return results, nil
 }
 
 // DispatchBatchDryRun dispatches multiple actions in dry-run mode.
 // Rules are evaluated for each action but none are executed and no state is mutated.
-func (c *Client) DispatchBatchDryRun(ctx context.Context, actions []*Action) ([]BatchResult, error) {
-	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/dispatch/batch?dry_run=true", actions)
+func (c *Client) DispatchBatchDryRun(ctx context.Context, actions []*Action) (BatchResults, error) {
+	actions = c.scopeActions(actions)
+	if err := c.validateBatch(actions); err != nil {
+		return nil, err
+	}
+	actions, err := c.prepareActions(ctx, actions)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendLimitedBatch(ctx, "/v1/dispatch/batch?dry_run=true", actions)
+}
+
+// sendLimitedBatch is sendBatch under WithTenantConcurrencyLimit.
+func (c *Client) sendLimitedBatch(ctx context.Context, path string, actions []*Action) (BatchResults, error) {
+	release, err := c.acquireTenants(ctx, actions...)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return c.sendBatch(ctx, path, actions)
+}
+
+// DispatchBatchAtomic dispatches actions all-or-nothing: the gateway
+// evaluates rules for every action first and dispatches the batch only
+// if each one would be executed. Otherwise nothing is dispatched and
+// the error is an *AtomicBatchRejectedError naming the first offending
+// action. Throttled outcomes are not retried.
+//
+// A gateway without atomic batches would dispatch the actions one by
+// one, so the client first checks that the gateway lists
+// FeatureAtomicBatch in its server info, remembering a positive answer,
+// and fails with ErrAtomicBatchUnsupported without sending anything
+// otherwise. A successful response must also carry the
+// Acteon-Atomic-Batch header; without it the results are returned
+// together with an error wrapping ErrAtomicBatchUnsupported, since the
+// actions may have been dispatched individually.
+//
+// Under WithRouting every action must route to the same gateway.
+func (c *Client) DispatchBatchAtomic(ctx context.Context, actions []*Action) (BatchResults, error) {
+	actions = c.scopeActions(actions)
+	if err := c.validateBatch(actions); err != nil {
+		return nil, err
+	}
+	actions, err := c.prepareActions(ctx, actions)
+	if err != nil {
+		return nil, err
+	}
+
+	var base string
+	if len(c.routes) > 0 {
+		if key, ok := ctx.Value(routeKeyContext{}).(string); ok {
+			base = c.Endpoint(key, key)
+		} else {
+			for _, a := range actions {
+				if a == nil {
+					continue
+				}
+				endpoint := c.Endpoint(a.Namespace, a.Tenant)
+				if base != "" && endpoint != base {
+					return nil, &ValidationError{Problems: []ValidationProblem{{
+						Field:   "actions",
+						Message: "route to more than one gateway; an atomic batch must go to one",
+					}}}
+				}
+				base = endpoint
+			}
+		}
+	}
+
+	if err := c.checkAtomicBatch(ctx, base); err != nil {
+		return nil, err
+	}
+	release, err := c.acquireTenants(ctx, actions...)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	resp, err := c.doRequestExt(ctx, http.MethodPost, "/v1/dispatch/batch?atomic=true", actions, requestOpts{baseURL: base})
 	if err != nil {
 		return nil, err
 	}
@@ -338,238 +881,151 @@ func (c *Client) DispatchBatchDryRun(ctx context.Context, actions []*Action) ([]
 	}
 
 	if resp.StatusCode == http.StatusOK {
-		var results []BatchResult
-		if err := json.Unmarshal(body, &results); err != nil {
+		var results BatchResults
+		if err := c.decodeJSON(body, &results); err != nil {
 			return nil, &ConnectionError{Message: err.Error()}
 		}
+		correlateBatch(results, actions)
+		if resp.Header.Get(atomicBatchHeader) != "true" {
+			return results, fmt.Errorf("%w: the gateway did not acknowledge atomic mode and may have dispatched the actions individually", ErrAtomicBatchUnsupported)
+		}
 		return results, nil
 	}
 
-	var errResp ErrorResponse
-	if err := json.Unmarshal(body, &errResp); err != nil {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to parse error response"}
+	if resp.StatusCode == http.StatusConflict {
+		var rejection atomicBatchRejection
+		if err := json.Unmarshal(body, &rejection); err == nil && rejection.Code != "" {
+			if rejection.ActionID == "" && rejection.Index >= 0 && rejection.Index < len(actions) && actions[rejection.Index] != nil {
+				rejection.ActionID = actions[rejection.Index].ID
+			}
+			return nil, &AtomicBatchRejectedError{
+				Index:    rejection.Index,
+				ActionID: rejection.ActionID,
+				Outcome:  rejection.Outcome,
+				Err:      &APIError{Code: rejection.Code, Message: rejection.Message, Retryable: rejection.Retryable},
+			}
+		}
 	}
-	return nil, &APIError{Code: errResp.Code, Message: errResp.Message, Retryable: errResp.Retryable}
+
+	return nil, errorFromResponse(resp, body, "Failed to parse error response")
 }
 
-// ListRules lists all loaded rules.
-func (c *Client) ListRules(ctx context.Context) ([]RuleInfo, error) {
-	resp, err := c.doRequest(ctx, http.MethodGet, "/v1/rules", nil)
+// atomicBatchHeader is the response header with which a gateway
+// acknowledges that it dispatched a batch atomically.
+const atomicBatchHeader = "Acteon-Atomic-Batch"
+
+// checkAtomicBatch returns ErrAtomicBatchUnsupported unless the gateway
+// at base, or the default one when base is empty, lists
+// FeatureAtomicBatch.
+func (c *Client) checkAtomicBatch(ctx context.Context, base string) error {
+	if _, ok := c.atomicGateways.Load(base); ok {
+		return nil
+	}
+	resp, err := c.doRequestExt(ctx, http.MethodGet, "/v1/info", nil, requestOpts{baseURL: base})
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list rules"}
+		return &HTTPError{Status: resp.StatusCode, Message: "Failed to get server info"}
 	}
-
-	var rules []RuleInfo
-	if err := json.NewDecoder(resp.Body).Decode(&rules); err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
+	var info ServerInfo
+	if err := c.decodeBody(resp.Body, &info); err != nil {
+		return &ConnectionError{Message: err.Error()}
 	}
-	return rules, nil
+	if !info.HasFeature(FeatureAtomicBatch) {
+		return ErrAtomicBatchUnsupported
+	}
+	c.atomicGateways.Store(base, true)
+	return nil
 }
 
-// ReloadRules reloads rules from the configured directory.
-func (c *Client) ReloadRules(ctx context.Context) (*ReloadResult, error) {
-	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/rules/reload", nil)
+// atomicBatchRejection is the 409 body of a refused atomic batch.
+type atomicBatchRejection struct {
+	ErrorResponse
+	Index    int            `json:"index"`
+	ActionID string         `json:"action_id"`
+	Outcome  *ActionOutcome `json:"outcome"`
+}
+
+// postBatch sends one batch request to path, on base when it is set
+// and on the routed endpoint otherwise.
+func (c *Client) postBatch(ctx context.Context, path, base string, actions []*Action) (BatchResults, error) {
+	resp, err := c.doRequestExt(ctx, http.MethodPost, path, actions, requestOpts{baseURL: base})
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to reload rules"}
-	}
-
-	var result ReloadResult
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
-	return &result, nil
-}
 
-// SetRuleEnabled enables or disables a specific rule.
-func (c *Client) SetRuleEnabled(ctx context.Context, ruleName string, enabled bool) error {
-	body := map[string]bool{"enabled": enabled}
-	resp, err := c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/v1/rules/%s/enabled", ruleName), body)
-	if err != nil {
-		return err
+	if resp.StatusCode == http.StatusOK {
+		var results BatchResults
+		if err := c.decodeJSON(body, &results); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		correlateBatch(results, actions)
+		return results, nil
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return &HTTPError{Status: resp.StatusCode, Message: "Failed to set rule enabled"}
-	}
-	return nil
+	return nil, errorFromResponse(resp, body, "Failed to parse error response")
 }
 
-// QueryAudit queries audit records.
-func (c *Client) QueryAudit(ctx context.Context, query *AuditQuery) (*AuditPage, error) {
-	path := "/v1/audit"
-	if query != nil {
-		params := url.Values{}
-		if query.Namespace != "" {
-			params.Set("namespace", query.Namespace)
-		}
-		if query.Tenant != "" {
-			params.Set("tenant", query.Tenant)
-		}
-		if query.Provider != "" {
-			params.Set("provider", query.Provider)
-		}
-		if query.ActionType != "" {
-			params.Set("action_type", query.ActionType)
-		}
-		if query.Outcome != "" {
-			params.Set("outcome", query.Outcome)
-		}
-		if query.Limit > 0 {
-			params.Set("limit", strconv.Itoa(query.Limit))
-		}
-		if query.Offset > 0 {
-			params.Set("offset", strconv.Itoa(query.Offset))
-		}
-		if query.Cursor != "" {
-			params.Set("cursor", query.Cursor)
-		}
-		if len(params) > 0 {
-			path += "?" + params.Encode()
-		}
-	}
-
-	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+// ListRules lists all loaded rules.
+func (c *Client) ListRules(ctx context.Context) ([]RuleInfo, error) {
+	resp, err := c.getCached(ctx, "/v1/rules")
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to query audit"}
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list rules"}
 	}
 
-	var page AuditPage
-	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+	var rules []RuleInfo
+	if err := c.decodeBody(resp.Body, &rules); err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
-	return &page, nil
+	return rules, nil
 }
 
-// GetAuditRecord gets a specific audit record by action ID.
-func (c *Client) GetAuditRecord(ctx context.Context, actionID string) (*AuditRecord, error) {
-	resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/v1/audit/%s", actionID), nil)
+// ReloadRules reloads rules from the configured directory.
+func (c *Client) ReloadRules(ctx context.Context) (*ReloadResult, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/rules/reload", nil)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, nil
-	}
-
 	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get audit record"}
-	}
-
-	var record AuditRecord
-	if err := json.NewDecoder(resp.Body).Decode(&record); err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
-	}
-	return &record, nil
-}
-
-// =============================================================================
-// Audit Replay
-// =============================================================================
-
-// ReplayAction replays a single action from the audit trail by its action ID.
-// The action is reconstructed from the stored payload and dispatched with a new ID.
-func (c *Client) ReplayAction(ctx context.Context, actionID string) (*ReplayResult, error) {
-	resp, err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/v1/audit/%s/replay", actionID), nil)
-	if err != nil {
-		return nil, err
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to reload rules"}
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
+	var result ReloadResult
+	if err := c.decodeBody(resp.Body, &result); err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
-
-	if resp.StatusCode == http.StatusOK {
-		var result ReplayResult
-		if err := json.Unmarshal(body, &result); err != nil {
-			return nil, &ConnectionError{Message: err.Error()}
-		}
-		return &result, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Audit record not found: %s", actionID)}
-	}
-	if resp.StatusCode == http.StatusUnprocessableEntity {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "No stored payload available for replay"}
-	}
-
-	return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to replay action"}
+	return &result, nil
 }
 
-// ReplayAudit replays actions from the audit trail matching the given query.
-func (c *Client) ReplayAudit(ctx context.Context, query *ReplayQuery) (*ReplaySummary, error) {
-	path := "/v1/audit/replay"
-	if query != nil {
-		params := url.Values{}
-		if query.Namespace != "" {
-			params.Set("namespace", query.Namespace)
-		}
-		if query.Tenant != "" {
-			params.Set("tenant", query.Tenant)
-		}
-		if query.Provider != "" {
-			params.Set("provider", query.Provider)
-		}
-		if query.ActionType != "" {
-			params.Set("action_type", query.ActionType)
-		}
-		if query.Outcome != "" {
-			params.Set("outcome", query.Outcome)
-		}
-		if query.Verdict != "" {
-			params.Set("verdict", query.Verdict)
-		}
-		if query.MatchedRule != "" {
-			params.Set("matched_rule", query.MatchedRule)
-		}
-		if query.From != "" {
-			params.Set("from", query.From)
-		}
-		if query.To != "" {
-			params.Set("to", query.To)
-		}
-		if query.Limit > 0 {
-			params.Set("limit", strconv.Itoa(query.Limit))
-		}
-		if len(params) > 0 {
-			path += "?" + params.Encode()
-		}
-	}
-
-	resp, err := c.doRequest(ctx, http.MethodPost, path, nil)
+// SetRuleEnabled enables or disables a specific rule.
+func (c *Client) SetRuleEnabled(ctx context.Context, ruleName string, enabled bool) error {
+	body := map[string]bool{"enabled": enabled}
+	resp, err := c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/v1/rules/%s/enabled", ruleName), body)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to replay audit"}
-	}
-
-	var summary ReplaySummary
-	if err := json.NewDecoder(resp.Body).Decode(&summary); err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
+		return &HTTPError{Status: resp.StatusCode, Message: "Failed to set rule enabled"}
 	}
-	return &summary, nil
+	return nil
 }
 
 // =============================================================================
@@ -603,7 +1059,7 @@ func (c *Client) ListEvents(ctx context.Context, query *EventQuery) (*EventListR
 	}
 
 	var result EventListResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := c.decodeBody(resp.Body, &result); err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
 	return &result, nil
@@ -631,7 +1087,7 @@ func (c *Client) GetEvent(ctx context.Context, fingerprint, namespace, tenant st
 	}
 
 	var event EventState
-	if err := json.NewDecoder(resp.Body).Decode(&event); err != nil {
+	if err := c.decodeBody(resp.Body, &event); err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
 	return &event, nil
@@ -657,7 +1113,7 @@ func (c *Client) TransitionEvent(ctx context.Context, fingerprint, toState, name
 
 	if resp.StatusCode == http.StatusOK {
 		var result TransitionResponse
-		if err := json.Unmarshal(respBody, &result); err != nil {
+		if err := c.decodeJSON(respBody, &result); err != nil {
 			return nil, &ConnectionError{Message: err.Error()}
 		}
 		return &result, nil
@@ -667,11 +1123,7 @@ func (c *Client) TransitionEvent(ctx context.Context, fingerprint, toState, name
 		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Event not found: %s", fingerprint)}
 	}
 
-	var errResp ErrorResponse
-	if err := json.Unmarshal(respBody, &errResp); err != nil {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to transition event"}
-	}
-	return nil, &APIError{Code: errResp.Code, Message: errResp.Message, Retryable: errResp.Retryable}
+	return nil, errorFromResponse(resp, respBody, "Failed to transition event")
 }
 
 // =============================================================================
@@ -691,7 +1143,7 @@ func (c *Client) ListGroups(ctx context.Context) (*GroupListResponse, error) {
 	}
 
 	var result GroupListResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := c.decodeBody(resp.Body, &result); err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
 	return &result, nil
@@ -714,7 +1166,7 @@ func (c *Client) GetGroup(ctx context.Context, groupKey string) (*GroupDetail, e
 	}
 
 	var detail GroupDetail
-	if err := json.NewDecoder(resp.Body).Decode(&detail); err != nil {
+	if err := c.decodeBody(resp.Body, &detail); err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
 	return &detail, nil
@@ -724,60 +1176,42 @@ func (c *Client) GetGroup(ctx context.Context, groupKey string) (*GroupDetail, e
 // Approvals (Human-in-the-Loop)
 // =============================================================================
 
-// Approve approves a pending action by namespace, tenant, ID, and HMAC signature.
-// Does not require authentication -- the HMAC signature serves as proof of authorization.
-// Pass an empty string for kid to omit the key ID parameter.
-func (c *Client) Approve(ctx context.Context, namespace, tenant, id, sig string, expiresAt int64, kid string) (*ApprovalActionResponse, error) {
-	params := url.Values{}
-	params.Set("sig", sig)
-	params.Set("expires_at", strconv.FormatInt(expiresAt, 10))
-	if kid != "" {
-		params.Set("kid", kid)
-	}
-	path := fmt.Sprintf("/v1/approvals/%s/%s/%s/approve?%s", namespace, tenant, id, params.Encode())
-
-	resp, err := c.doRequest(ctx, http.MethodPost, path, nil)
+// FlushGroup forces a group to flush, triggering immediate notification.
+func (c *Client) FlushGroup(ctx context.Context, groupKey string) (*FlushGroupResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/v1/groups/%s", groupKey), nil)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
 
 	if resp.StatusCode == http.StatusOK {
-		var result ApprovalActionResponse
-		if err := json.Unmarshal(body, &result); err != nil {
+		var result FlushGroupResponse
+		if err := c.decodeJSON(respBody, &result); err != nil {
 			return nil, &ConnectionError{Message: err.Error()}
 		}
 		return &result, nil
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Approval not found or expired"}
-	}
-	if resp.StatusCode == http.StatusGone {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Approval already decided"}
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Group not found: %s", groupKey)}
 	}
 
-	return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to approve"}
+	return nil, errorFromResponse(resp, respBody, "Failed to flush group")
 }
 
-// Reject rejects a pending action by namespace, tenant, ID, and HMAC signature.
-// Does not require authentication -- the HMAC signature serves as proof of authorization.
-// Pass an empty string for kid to omit the key ID parameter.
-func (c *Client) Reject(ctx context.Context, namespace, tenant, id, sig string, expiresAt int64, kid string) (*ApprovalActionResponse, error) {
-	params := url.Values{}
-	params.Set("sig", sig)
-	params.Set("expires_at", strconv.FormatInt(expiresAt, 10))
-	if kid != "" {
-		params.Set("kid", kid)
-	}
-	path := fmt.Sprintf("/v1/approvals/%s/%s/%s/reject?%s", namespace, tenant, id, params.Encode())
+// =============================================================================
+// Time Intervals
+// =============================================================================
 
-	resp, err := c.doRequest(ctx, http.MethodPost, path, nil)
+// CreateTimeInterval creates a tenant-scoped time interval that rules
+// can reference via mute_time_intervals / active_time_intervals.
+func (c *Client) CreateTimeInterval(ctx context.Context, req *CreateTimeIntervalRequest) (*TimeInterval, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/time-intervals", req)
 	if err != nil {
 		return nil, err
 	}
@@ -788,35 +1222,30 @@ func (c *Client) Reject(ctx context.Context, namespace, tenant, id, sig string,
 		return nil, &ConnectionError{Message: err.Error()}
 	}
 
-	if resp.StatusCode == http.StatusOK {
-		var result ApprovalActionResponse
-		if err := json.Unmarshal(body, &result); err != nil {
+	if resp.StatusCode == http.StatusCreated {
+		var result TimeInterval
+		if err := c.decodeJSON(body, &result); err != nil {
 			return nil, &ConnectionError{Message: err.Error()}
 		}
 		return &result, nil
 	}
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Approval not found or expired"}
-	}
-	if resp.StatusCode == http.StatusGone {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Approval already decided"}
-	}
-
-	return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to reject"}
+	return nil, errorFromResponse(resp, body, "Failed to create time interval")
 }
 
-// GetApproval gets the status of an approval by namespace, tenant, ID, and HMAC signature.
-// Returns nil if not found or expired.
-// Pass an empty string for kid to omit the key ID parameter.
-func (c *Client) GetApproval(ctx context.Context, namespace, tenant, id, sig string, expiresAt int64, kid string) (*ApprovalStatus, error) {
+// ListTimeIntervals lists time intervals filtered by namespace/tenant.
+func (c *Client) ListTimeIntervals(ctx context.Context, namespace, tenant *string) (*ListTimeIntervalsResponse, error) {
 	params := url.Values{}
-	params.Set("sig", sig)
-	params.Set("expires_at", strconv.FormatInt(expiresAt, 10))
-	if kid != "" {
-		params.Set("kid", kid)
+	if namespace != nil {
+		params.Set("namespace", *namespace)
+	}
+	if tenant != nil {
+		params.Set("tenant", *tenant)
+	}
+	path := "/v1/time-intervals"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
 	}
-	path := fmt.Sprintf("/v1/approvals/%s/%s/%s?%s", namespace, tenant, id, params.Encode())
 
 	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -824,183 +1253,120 @@ func (c *Client) GetApproval(ctx context.Context, namespace, tenant, id, sig str
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, nil
-	}
-
 	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get approval"}
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list time intervals"}
 	}
 
-	var status ApprovalStatus
-	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+	var result ListTimeIntervalsResponse
+	if err := c.decodeBody(resp.Body, &result); err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
-	return &status, nil
+	return &result, nil
 }
 
-// ListApprovals lists pending approvals filtered by namespace and tenant.
-// Requires authentication.
-func (c *Client) ListApprovals(ctx context.Context, namespace, tenant string) (*ApprovalListResponse, error) {
-	params := url.Values{}
-	params.Set("namespace", namespace)
-	params.Set("tenant", tenant)
-	path := "/v1/approvals?" + params.Encode()
-
+// GetTimeInterval fetches a single time interval. Returns (nil, nil) on 404.
+func (c *Client) GetTimeInterval(ctx context.Context, namespace, tenant, name string) (*TimeInterval, error) {
+	path := fmt.Sprintf("/v1/time-intervals/%s/%s/%s", namespace, tenant, name)
 	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list approvals"}
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get time interval"}
 	}
 
-	var result ApprovalListResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	var result TimeInterval
+	if err := c.decodeBody(resp.Body, &result); err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
 	return &result, nil
 }
 
-// FlushGroup forces a group to flush, triggering immediate notification.
-func (c *Client) FlushGroup(ctx context.Context, groupKey string) (*FlushGroupResponse, error) {
-	resp, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/v1/groups/%s", groupKey), nil)
+// UpdateTimeInterval updates a time interval's ranges, location, or
+// description. The name + (namespace, tenant) tuple is immutable.
+func (c *Client) UpdateTimeInterval(ctx context.Context, namespace, tenant, name string, update *UpdateTimeIntervalRequest) (*TimeInterval, error) {
+	path := fmt.Sprintf("/v1/time-intervals/%s/%s/%s", namespace, tenant, name)
+	resp, err := c.doRequest(ctx, http.MethodPut, path, update)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	respBody, err := io.ReadAll(resp.Body)
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
 
 	if resp.StatusCode == http.StatusOK {
-		var result FlushGroupResponse
-		if err := json.Unmarshal(respBody, &result); err != nil {
+		var result TimeInterval
+		if err := c.decodeJSON(body, &result); err != nil {
 			return nil, &ConnectionError{Message: err.Error()}
 		}
 		return &result, nil
 	}
-
 	if resp.StatusCode == http.StatusNotFound {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Group not found: %s", groupKey)}
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Time interval not found: %s", name)}
 	}
 
-	var errResp ErrorResponse
-	if err := json.Unmarshal(respBody, &errResp); err != nil {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to flush group"}
-	}
-	return nil, &APIError{Code: errResp.Code, Message: errResp.Message, Retryable: errResp.Retryable}
+	return nil, errorFromResponse(resp, body, "Failed to update time interval")
 }
 
-// =============================================================================
-// Recurring Actions
-// =============================================================================
-
-// CreateRecurring creates a recurring action.
-func (c *Client) CreateRecurring(ctx context.Context, recurring *CreateRecurringAction) (*CreateRecurringResponse, error) {
-	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/recurring", recurring)
+// DeleteTimeInterval deletes a time interval.
+func (c *Client) DeleteTimeInterval(ctx context.Context, namespace, tenant, name string) error {
+	path := fmt.Sprintf("/v1/time-intervals/%s/%s/%s", namespace, tenant, name)
+	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
-	}
-
-	if resp.StatusCode == http.StatusCreated {
-		var result CreateRecurringResponse
-		if err := json.Unmarshal(body, &result); err != nil {
-			return nil, &ConnectionError{Message: err.Error()}
-		}
-		return &result, nil
+	if resp.StatusCode == http.StatusNoContent {
+		return nil
 	}
-
-	var errResp ErrorResponse
-	if err := json.Unmarshal(body, &errResp); err != nil {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to create recurring action"}
+	if resp.StatusCode == http.StatusNotFound {
+		return &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Time interval not found: %s", name)}
 	}
-	return nil, &APIError{Code: errResp.Code, Message: errResp.Message, Retryable: errResp.Retryable}
+	return &HTTPError{Status: resp.StatusCode, Message: "Failed to delete time interval"}
 }
 
-// ListRecurring lists recurring actions with optional filters.
-func (c *Client) ListRecurring(ctx context.Context, filter *RecurringFilter) (*ListRecurringResponse, error) {
-	path := "/v1/recurring"
-	if filter != nil {
-		params := url.Values{}
-		if filter.Namespace != "" {
-			params.Set("namespace", filter.Namespace)
-		}
-		if filter.Tenant != "" {
-			params.Set("tenant", filter.Tenant)
-		}
-		if filter.Status != "" {
-			params.Set("status", filter.Status)
-		}
-		if filter.Limit > 0 {
-			params.Set("limit", strconv.Itoa(filter.Limit))
-		}
-		if filter.Offset > 0 {
-			params.Set("offset", strconv.Itoa(filter.Offset))
-		}
-		if len(params) > 0 {
-			path += "?" + params.Encode()
-		}
-	}
+// =============================================================================
+// Notification Preferences
+// =============================================================================
 
+// GetNotificationPreferences returns a tenant's notification
+// preferences. Returns (nil, nil) if the tenant has none set.
+func (c *Client) GetNotificationPreferences(ctx context.Context, tenant string) (*NotificationPreferences, error) {
+	path := fmt.Sprintf("/v1/tenants/%s/notification-preferences", url.PathEscape(tenant))
 	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list recurring actions"}
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get notification preferences"}
 	}
 
-	var result ListRecurringResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	var result NotificationPreferences
+	if err := c.decodeBody(resp.Body, &result); err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
 	return &result, nil
 }
 
-// GetRecurring gets details of a specific recurring action.
-func (c *Client) GetRecurring(ctx context.Context, recurringID, namespace, tenant string) (*RecurringDetail, error) {
-	params := url.Values{}
-	params.Set("namespace", namespace)
-	params.Set("tenant", tenant)
-	path := fmt.Sprintf("/v1/recurring/%s?%s", recurringID, params.Encode())
-
-	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, nil
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get recurring action"}
-	}
-
-	var detail RecurringDetail
-	if err := json.NewDecoder(resp.Body).Decode(&detail); err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
-	}
-	return &detail, nil
-}
-
-// UpdateRecurring updates a recurring action.
-func (c *Client) UpdateRecurring(ctx context.Context, recurringID string, update *UpdateRecurringAction) (*RecurringDetail, error) {
-	resp, err := c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/v1/recurring/%s", recurringID), update)
+// PutNotificationPreferences replaces a tenant's notification
+// preferences.
+func (c *Client) PutNotificationPreferences(ctx context.Context, tenant string, prefs *NotificationPreferences) (*NotificationPreferences, error) {
+	path := fmt.Sprintf("/v1/tenants/%s/notification-preferences", url.PathEscape(tenant))
+	resp, err := c.doRequest(ctx, http.MethodPut, path, prefs)
 	if err != nil {
 		return nil, err
 	}
@@ -1012,210 +1378,45 @@ func (c *Client) UpdateRecurring(ctx context.Context, recurringID string, update
 	}
 
 	if resp.StatusCode == http.StatusOK {
-		var detail RecurringDetail
-		if err := json.Unmarshal(body, &detail); err != nil {
+		var result NotificationPreferences
+		if err := c.decodeJSON(body, &result); err != nil {
 			return nil, &ConnectionError{Message: err.Error()}
 		}
-		return &detail, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Recurring action not found: %s", recurringID)}
+		return &result, nil
 	}
 
-	var errResp ErrorResponse
-	if err := json.Unmarshal(body, &errResp); err != nil {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to update recurring action"}
-	}
-	return nil, &APIError{Code: errResp.Code, Message: errResp.Message, Retryable: errResp.Retryable}
+	return nil, errorFromResponse(resp, body, "Failed to update notification preferences")
 }
 
-// DeleteRecurring deletes a recurring action.
-func (c *Client) DeleteRecurring(ctx context.Context, recurringID, namespace, tenant string) error {
-	params := url.Values{}
-	params.Set("namespace", namespace)
-	params.Set("tenant", tenant)
-	path := fmt.Sprintf("/v1/recurring/%s?%s", recurringID, params.Encode())
-
+// DeleteNotificationPreferences clears a tenant's notification
+// preferences, restoring default routing.
+func (c *Client) DeleteNotificationPreferences(ctx context.Context, tenant string) error {
+	path := fmt.Sprintf("/v1/tenants/%s/notification-preferences", url.PathEscape(tenant))
 	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNoContent {
+	if resp.StatusCode == http.StatusNoContent || resp.StatusCode == http.StatusNotFound {
 		return nil
 	}
-	if resp.StatusCode == http.StatusNotFound {
-		return &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Recurring action not found: %s", recurringID)}
-	}
-	return &HTTPError{Status: resp.StatusCode, Message: "Failed to delete recurring action"}
-}
-
-// PauseRecurring pauses a recurring action.
-func (c *Client) PauseRecurring(ctx context.Context, recurringID, namespace, tenant string) (*RecurringDetail, error) {
-	body := &RecurringLifecycleRequest{Namespace: namespace, Tenant: tenant}
-	resp, err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/v1/recurring/%s/pause", recurringID), body)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
-	}
-
-	if resp.StatusCode == http.StatusOK {
-		var detail RecurringDetail
-		if err := json.Unmarshal(respBody, &detail); err != nil {
-			return nil, &ConnectionError{Message: err.Error()}
-		}
-		return &detail, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Recurring action not found: %s", recurringID)}
-	}
-	if resp.StatusCode == http.StatusConflict {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Recurring action is already paused"}
-	}
-	return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to pause recurring action"}
-}
-
-// ResumeRecurring resumes a paused recurring action.
-func (c *Client) ResumeRecurring(ctx context.Context, recurringID, namespace, tenant string) (*RecurringDetail, error) {
-	body := &RecurringLifecycleRequest{Namespace: namespace, Tenant: tenant}
-	resp, err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/v1/recurring/%s/resume", recurringID), body)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
-	}
-
-	if resp.StatusCode == http.StatusOK {
-		var detail RecurringDetail
-		if err := json.Unmarshal(respBody, &detail); err != nil {
-			return nil, &ConnectionError{Message: err.Error()}
-		}
-		return &detail, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Recurring action not found: %s", recurringID)}
-	}
-	if resp.StatusCode == http.StatusConflict {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Recurring action is already active"}
-	}
-	return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to resume recurring action"}
+	return &HTTPError{Status: resp.StatusCode, Message: "Failed to delete notification preferences"}
 }
 
 // =============================================================================
-// Quotas
+// Payload Templates
 // =============================================================================
 
-// CreateQuota creates a quota policy.
-func (c *Client) CreateQuota(ctx context.Context, req *CreateQuotaRequest) (*QuotaPolicy, error) {
-	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/quotas", req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
-	}
-
-	if resp.StatusCode == http.StatusCreated {
-		var result QuotaPolicy
-		if err := json.Unmarshal(body, &result); err != nil {
-			return nil, &ConnectionError{Message: err.Error()}
+// CreateTemplate creates a payload template.
+func (c *Client) CreateTemplate(ctx context.Context, req *CreateTemplateRequest) (*TemplateInfo, error) {
+	if c.validateTemplates {
+		if err := c.requireValidTemplate(ctx, req.Content); err != nil {
+			return nil, err
 		}
-		return &result, nil
-	}
-
-	var errResp ErrorResponse
-	if err := json.Unmarshal(body, &errResp); err != nil {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to create quota"}
-	}
-	return nil, &APIError{Code: errResp.Code, Message: errResp.Message, Retryable: errResp.Retryable}
-}
-
-// ListQuotas lists quota policies with optional namespace, tenant,
-// provider, and principal filters. Pass "generic" as the provider
-// filter to match only policies without a provider scope; pass a
-// provider name (e.g. "slack") to match only per-provider policies.
-// principal filters to policies scoped to a given caller.
-func (c *Client) ListQuotas(ctx context.Context, namespace, tenant, provider, principal *string) (*ListQuotasResponse, error) {
-	params := url.Values{}
-	if namespace != nil {
-		params.Set("namespace", *namespace)
-	}
-	if tenant != nil {
-		params.Set("tenant", *tenant)
-	}
-	if provider != nil {
-		params.Set("provider", *provider)
-	}
-	if principal != nil {
-		params.Set("principal", *principal)
-	}
-
-	path := "/v1/quotas"
-	if len(params) > 0 {
-		path += "?" + params.Encode()
-	}
-
-	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list quotas"}
-	}
-
-	var result ListQuotasResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
-	}
-	return &result, nil
-}
-
-// GetQuota gets a single quota policy by ID.
-func (c *Client) GetQuota(ctx context.Context, quotaID string) (*QuotaPolicy, error) {
-	path := fmt.Sprintf("/v1/quotas/%s", quotaID)
-
-	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
-	if err != nil {
-		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, nil
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get quota"}
-	}
-
-	var result QuotaPolicy
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
-	}
-	return &result, nil
-}
-
-// UpdateQuota updates a quota policy.
-func (c *Client) UpdateQuota(ctx context.Context, quotaID string, update *UpdateQuotaRequest) (*QuotaPolicy, error) {
-	resp, err := c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/v1/quotas/%s", quotaID), update)
+	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/templates", req)
 	if err != nil {
 		return nil, err
 	}
@@ -1226,109 +1427,44 @@ func (c *Client) UpdateQuota(ctx context.Context, quotaID string, update *Update
 		return nil, &ConnectionError{Message: err.Error()}
 	}
 
-	if resp.StatusCode == http.StatusOK {
-		var result QuotaPolicy
-		if err := json.Unmarshal(body, &result); err != nil {
+	if resp.StatusCode == http.StatusCreated {
+		var result TemplateInfo
+		if err := c.decodeJSON(body, &result); err != nil {
 			return nil, &ConnectionError{Message: err.Error()}
 		}
 		return &result, nil
 	}
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Quota not found: %s", quotaID)}
-	}
-
-	var errResp ErrorResponse
-	if err := json.Unmarshal(body, &errResp); err != nil {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to update quota"}
-	}
-	return nil, &APIError{Code: errResp.Code, Message: errResp.Message, Retryable: errResp.Retryable}
-}
-
-// DeleteQuota deletes a quota policy.
-func (c *Client) DeleteQuota(ctx context.Context, quotaID, namespace, tenant string) error {
-	params := url.Values{}
-	params.Set("namespace", namespace)
-	params.Set("tenant", tenant)
-	path := fmt.Sprintf("/v1/quotas/%s?%s", quotaID, params.Encode())
-
-	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode == http.StatusNoContent {
-		return nil
-	}
-	if resp.StatusCode == http.StatusNotFound {
-		return &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Quota not found: %s", quotaID)}
-	}
-	return &HTTPError{Status: resp.StatusCode, Message: "Failed to delete quota"}
+	return nil, errorFromResponse(resp, body, "Failed to create template")
 }
 
-// GetQuotaUsage gets current usage statistics for a quota policy.
-func (c *Client) GetQuotaUsage(ctx context.Context, quotaID string) (*QuotaUsage, error) {
-	path := fmt.Sprintf("/v1/quotas/%s/usage", quotaID)
-
-	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Quota not found: %s", quotaID)}
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get quota usage"}
-	}
-
-	var result QuotaUsage
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
+// ListTemplates lists payload templates with optional namespace and tenant filters.
+func (c *Client) ListTemplates(ctx context.Context, namespace, tenant *string) (*ListTemplatesResponse, error) {
+	if selector, ok := labelSelectorFrom(ctx); ok {
+		templates, err := c.allTemplates(ctx, namespace, tenant)
+		if err != nil {
+			return nil, err
+		}
+		templates = selectLabels(templates, selector, func(t TemplateInfo) map[string]string { return t.Labels })
+		return &ListTemplatesResponse{Templates: templates, Count: len(templates)}, nil
 	}
-	return &result, nil
+	return c.listTemplates(ctx, namespace, tenant, 0, 0)
 }
 
-// =============================================================================
-// Silences
-// =============================================================================
-
-// CreateSilence creates a silence. Supply either EndsAt or
-// DurationSeconds on the request.
-func (c *Client) CreateSilence(ctx context.Context, req *CreateSilenceRequest) (*Silence, error) {
-	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/silences", req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
-	}
-
-	if resp.StatusCode == http.StatusCreated {
-		var result Silence
-		if err := json.Unmarshal(body, &result); err != nil {
-			return nil, &ConnectionError{Message: err.Error()}
+// allTemplates lists every template matching the filters, page by page.
+func (c *Client) allTemplates(ctx context.Context, namespace, tenant *string) ([]TemplateInfo, error) {
+	return listAll(func(limit, offset int) ([]TemplateInfo, error) {
+		list, err := c.listTemplates(ctx, namespace, tenant, limit, offset)
+		if err != nil {
+			return nil, err
 		}
-		return &result, nil
-	}
-
-	var errResp ErrorResponse
-	if err := json.Unmarshal(body, &errResp); err != nil {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to create silence"}
-	}
-	return nil, &APIError{Code: errResp.Code, Message: errResp.Message, Retryable: errResp.Retryable}
+		return list.Templates, nil
+	})
 }
 
-// ListSilences lists silences, optionally filtered by namespace and
-// tenant. Pass includeExpired=true to include silences whose end
-// time is in the past.
-func (c *Client) ListSilences(ctx context.Context, namespace, tenant *string, includeExpired bool) (*ListSilencesResponse, error) {
+// listTemplates fetches one page of templates; a zero limit leaves the
+// page size to the gateway.
+func (c *Client) listTemplates(ctx context.Context, namespace, tenant *string, limit, offset int) (*ListTemplatesResponse, error) {
 	params := url.Values{}
 	if namespace != nil {
 		params.Set("namespace", *namespace)
@@ -1336,36 +1472,33 @@ func (c *Client) ListSilences(ctx context.Context, namespace, tenant *string, in
 	if tenant != nil {
 		params.Set("tenant", *tenant)
 	}
-	if includeExpired {
-		params.Set("include_expired", "true")
-	}
+	setPage(params, limit, offset)
 
-	path := "/v1/silences"
+	path := "/v1/templates"
 	if len(params) > 0 {
 		path += "?" + params.Encode()
 	}
 
-	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	resp, err := c.getCached(ctx, path)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list silences"}
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list templates"}
 	}
 
-	var result ListSilencesResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	var result ListTemplatesResponse
+	if err := c.decodeBody(resp.Body, &result); err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
 	return &result, nil
 }
 
-// GetSilence fetches a single silence by ID. Returns (nil, nil) if
-// the silence does not exist (404).
-func (c *Client) GetSilence(ctx context.Context, silenceID string) (*Silence, error) {
-	path := fmt.Sprintf("/v1/silences/%s", silenceID)
+// GetTemplate gets a single template by ID.
+func (c *Client) GetTemplate(ctx context.Context, templateID string) (*TemplateInfo, error) {
+	path := fmt.Sprintf("/v1/templates/%s", templateID)
 
 	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -1378,162 +1511,25 @@ func (c *Client) GetSilence(ctx context.Context, silenceID string) (*Silence, er
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get silence"}
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get template"}
 	}
 
-	var result Silence
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	var result TemplateInfo
+	if err := c.decodeBody(resp.Body, &result); err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
 	return &result, nil
 }
 
-// UpdateSilence extends a silence or edits its comment. Matchers
-// are immutable — to change them, expire the silence and create a
-// new one.
-func (c *Client) UpdateSilence(ctx context.Context, silenceID string, update *UpdateSilenceRequest) (*Silence, error) {
-	resp, err := c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/v1/silences/%s", silenceID), update)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
-	}
-
-	if resp.StatusCode == http.StatusOK {
-		var result Silence
-		if err := json.Unmarshal(body, &result); err != nil {
-			return nil, &ConnectionError{Message: err.Error()}
-		}
-		return &result, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Silence not found: %s", silenceID)}
-	}
-
-	var errResp ErrorResponse
-	if err := json.Unmarshal(body, &errResp); err != nil {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to update silence"}
-	}
-	return nil, &APIError{Code: errResp.Code, Message: errResp.Message, Retryable: errResp.Retryable}
-}
-
-// DeleteSilence expires a silence immediately (soft-expire). The
-// record remains queryable for audit-trail purposes.
-func (c *Client) DeleteSilence(ctx context.Context, silenceID string) error {
-	path := fmt.Sprintf("/v1/silences/%s", silenceID)
-
-	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode == http.StatusNoContent {
-		return nil
-	}
-	if resp.StatusCode == http.StatusNotFound {
-		return &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Silence not found: %s", silenceID)}
-	}
-	return &HTTPError{Status: resp.StatusCode, Message: "Failed to delete silence"}
-}
-
-// =============================================================================
-// Time Intervals
-// =============================================================================
-
-// CreateTimeInterval creates a tenant-scoped time interval that rules
-// can reference via mute_time_intervals / active_time_intervals.
-func (c *Client) CreateTimeInterval(ctx context.Context, req *CreateTimeIntervalRequest) (*TimeInterval, error) {
-	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/time-intervals", req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
-	}
-
-	if resp.StatusCode == http.StatusCreated {
-		var result TimeInterval
-		if err := json.Unmarshal(body, &result); err != nil {
-			return nil, &ConnectionError{Message: err.Error()}
+// UpdateTemplate updates a payload template.
+func (c *Client) UpdateTemplate(ctx context.Context, templateID string, update *UpdateTemplateRequest) (*TemplateInfo, error) {
+	if c.validateTemplates && update.Content != nil {
+		if err := c.requireValidTemplate(ctx, *update.Content); err != nil {
+			return nil, err
 		}
-		return &result, nil
-	}
-
-	var errResp ErrorResponse
-	if err := json.Unmarshal(body, &errResp); err != nil {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to create time interval"}
-	}
-	return nil, &APIError{Code: errResp.Code, Message: errResp.Message, Retryable: errResp.Retryable}
-}
-
-// ListTimeIntervals lists time intervals filtered by namespace/tenant.
-func (c *Client) ListTimeIntervals(ctx context.Context, namespace, tenant *string) (*ListTimeIntervalsResponse, error) {
-	params := url.Values{}
-	if namespace != nil {
-		params.Set("namespace", *namespace)
-	}
-	if tenant != nil {
-		params.Set("tenant", *tenant)
-	}
-	path := "/v1/time-intervals"
-	if len(params) > 0 {
-		path += "?" + params.Encode()
-	}
-
-	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list time intervals"}
-	}
-
-	var result ListTimeIntervalsResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
-	}
-	return &result, nil
-}
-
-// GetTimeInterval fetches a single time interval. Returns (nil, nil) on 404.
-func (c *Client) GetTimeInterval(ctx context.Context, namespace, tenant, name string) (*TimeInterval, error) {
-	path := fmt.Sprintf("/v1/time-intervals/%s/%s/%s", namespace, tenant, name)
-	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, nil
-	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get time interval"}
 	}
 
-	var result TimeInterval
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
-	}
-	return &result, nil
-}
-
-// UpdateTimeInterval updates a time interval's ranges, location, or
-// description. The name + (namespace, tenant) tuple is immutable.
-func (c *Client) UpdateTimeInterval(ctx context.Context, namespace, tenant, name string, update *UpdateTimeIntervalRequest) (*TimeInterval, error) {
-	path := fmt.Sprintf("/v1/time-intervals/%s/%s/%s", namespace, tenant, name)
-	resp, err := c.doRequest(ctx, http.MethodPut, path, update)
+	resp, err := c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/v1/templates/%s", templateID), update)
 	if err != nil {
 		return nil, err
 	}
@@ -1545,114 +1541,49 @@ func (c *Client) UpdateTimeInterval(ctx context.Context, namespace, tenant, name
 	}
 
 	if resp.StatusCode == http.StatusOK {
-		var result TimeInterval
-		if err := json.Unmarshal(body, &result); err != nil {
+		var result TemplateInfo
+		if err := c.decodeJSON(body, &result); err != nil {
 			return nil, &ConnectionError{Message: err.Error()}
 		}
 		return &result, nil
 	}
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Time interval not found: %s", name)}
-	}
-
-	var errResp ErrorResponse
-	if err := json.Unmarshal(body, &errResp); err != nil {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to update time interval"}
-	}
-	return nil, &APIError{Code: errResp.Code, Message: errResp.Message, Retryable: errResp.Retryable}
-}
-
-// DeleteTimeInterval deletes a time interval.
-func (c *Client) DeleteTimeInterval(ctx context.Context, namespace, tenant, name string) error {
-	path := fmt.Sprintf("/v1/time-intervals/%s/%s/%s", namespace, tenant, name)
-	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNoContent {
-		return nil
-	}
 	if resp.StatusCode == http.StatusNotFound {
-		return &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Time interval not found: %s", name)}
-	}
-	return &HTTPError{Status: resp.StatusCode, Message: "Failed to delete time interval"}
-}
-
-// =============================================================================
-// Retention Policies
-// =============================================================================
-
-// CreateRetention creates a retention policy.
-func (c *Client) CreateRetention(ctx context.Context, req *CreateRetentionRequest) (*RetentionPolicy, error) {
-	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/retention", req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
-	}
-
-	if resp.StatusCode == http.StatusCreated {
-		var result RetentionPolicy
-		if err := json.Unmarshal(body, &result); err != nil {
-			return nil, &ConnectionError{Message: err.Error()}
-		}
-		return &result, nil
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Template not found: %s", templateID)}
 	}
 
-	var errResp ErrorResponse
-	if err := json.Unmarshal(body, &errResp); err != nil {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to create retention policy"}
-	}
-	return nil, &APIError{Code: errResp.Code, Message: errResp.Message, Retryable: errResp.Retryable}
+	return nil, errorFromResponse(resp, body, "Failed to update template")
 }
 
-// ListRetention lists retention policies with optional namespace, tenant, limit, and offset filters.
-func (c *Client) ListRetention(ctx context.Context, namespace, tenant *string, limit, offset *int) (*ListRetentionResponse, error) {
-	params := url.Values{}
-	if namespace != nil {
-		params.Set("namespace", *namespace)
-	}
-	if tenant != nil {
-		params.Set("tenant", *tenant)
-	}
-	if limit != nil {
-		params.Set("limit", strconv.Itoa(*limit))
-	}
-	if offset != nil {
-		params.Set("offset", strconv.Itoa(*offset))
-	}
-
-	path := "/v1/retention"
-	if len(params) > 0 {
-		path += "?" + params.Encode()
-	}
+// DeleteTemplate deletes a payload template.
+func (c *Client) DeleteTemplate(ctx context.Context, templateID string) error {
+	path := fmt.Sprintf("/v1/templates/%s", templateID)
 
-	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list retention policies"}
+	if resp.StatusCode == http.StatusNoContent {
+		return nil
 	}
-
-	var result ListRetentionResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
+	if resp.StatusCode == http.StatusNotFound {
+		return &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Template not found: %s", templateID)}
 	}
-	return &result, nil
+	return &HTTPError{Status: resp.StatusCode, Message: "Failed to delete template"}
 }
 
-// GetRetention gets a single retention policy by ID.
-func (c *Client) GetRetention(ctx context.Context, retentionID string) (*RetentionPolicy, error) {
-	path := fmt.Sprintf("/v1/retention/%s", retentionID)
+// GetTemplateUsage reports how many dispatches rendered a template over
+// the trailing window, broken down by namespace and tenant, with
+// last-used timestamps. A zero window uses the server default.
+func (c *Client) GetTemplateUsage(ctx context.Context, templateID string, window time.Duration) (*TemplateUsageResponse, error) {
+	path := fmt.Sprintf("/v1/templates/%s/usage", url.PathEscape(templateID))
+	if window > 0 {
+		params := url.Values{}
+		params.Set("window_seconds", strconv.FormatInt(int64(window/time.Second), 10))
+		path += "?" + params.Encode()
+	}
 
 	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -1661,23 +1592,27 @@ func (c *Client) GetRetention(ctx context.Context, retentionID string) (*Retenti
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusNotFound {
-		return nil, nil
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Template not found: %s", templateID)}
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get retention policy"}
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get template usage"}
 	}
 
-	var result RetentionPolicy
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	var usage TemplateUsageResponse
+	if err := c.decodeBody(resp.Body, &usage); err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
-	return &result, nil
+	return &usage, nil
 }
 
-// UpdateRetention updates a retention policy.
-func (c *Client) UpdateRetention(ctx context.Context, retentionID string, update *UpdateRetentionRequest) (*RetentionPolicy, error) {
-	resp, err := c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/v1/retention/%s", retentionID), update)
+// ValidateTemplate checks template content on the gateway without
+// storing it, returning any syntax errors with their line and column and
+// the payload variables the template references. An invalid template is
+// reported through the result, not as an error.
+func (c *Client) ValidateTemplate(ctx context.Context, content string) (*TemplateValidationResult, error) {
+	req := map[string]string{"content": content}
+	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/templates/validate", req)
 	if err != nil {
 		return nil, err
 	}
@@ -1688,51 +1623,32 @@ func (c *Client) UpdateRetention(ctx context.Context, retentionID string, update
 		return nil, &ConnectionError{Message: err.Error()}
 	}
 
-	if resp.StatusCode == http.StatusOK {
-		var result RetentionPolicy
-		if err := json.Unmarshal(body, &result); err != nil {
-			return nil, &ConnectionError{Message: err.Error()}
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusUnprocessableEntity {
+		var result TemplateValidationResult
+		if err := c.decodeJSON(body, &result); err == nil {
+			return &result, nil
 		}
-		return &result, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Retention policy not found: %s", retentionID)}
 	}
 
-	var errResp ErrorResponse
-	if err := json.Unmarshal(body, &errResp); err != nil {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to update retention policy"}
-	}
-	return nil, &APIError{Code: errResp.Code, Message: errResp.Message, Retryable: errResp.Retryable}
+	return nil, errorFromResponse(resp, body, "Failed to validate template")
 }
 
-// DeleteRetention deletes a retention policy.
-func (c *Client) DeleteRetention(ctx context.Context, retentionID string) error {
-	path := fmt.Sprintf("/v1/retention/%s", retentionID)
-
-	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
+// requireValidTemplate runs ValidateTemplate and turns an invalid
+// result into a *TemplateValidationError.
+func (c *Client) requireValidTemplate(ctx context.Context, content string) error {
+	result, err := c.ValidateTemplate(ctx, content)
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode == http.StatusNoContent {
-		return nil
-	}
-	if resp.StatusCode == http.StatusNotFound {
-		return &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Retention policy not found: %s", retentionID)}
+	if !result.Valid {
+		return &TemplateValidationError{Result: result}
 	}
-	return &HTTPError{Status: resp.StatusCode, Message: "Failed to delete retention policy"}
+	return nil
 }
 
-// =============================================================================
-// Payload Templates
-// =============================================================================
-
-// CreateTemplate creates a payload template.
-func (c *Client) CreateTemplate(ctx context.Context, req *CreateTemplateRequest) (*TemplateInfo, error) {
-	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/templates", req)
+// CreateProfile creates a template profile.
+func (c *Client) CreateProfile(ctx context.Context, req *CreateProfileRequest) (*TemplateProfileInfo, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/templates/profiles", req)
 	if err != nil {
 		return nil, err
 	}
@@ -1744,22 +1660,43 @@ func (c *Client) CreateTemplate(ctx context.Context, req *CreateTemplateRequest)
 	}
 
 	if resp.StatusCode == http.StatusCreated {
-		var result TemplateInfo
-		if err := json.Unmarshal(body, &result); err != nil {
+		var result TemplateProfileInfo
+		if err := c.decodeJSON(body, &result); err != nil {
 			return nil, &ConnectionError{Message: err.Error()}
 		}
 		return &result, nil
 	}
 
-	var errResp ErrorResponse
-	if err := json.Unmarshal(body, &errResp); err != nil {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to create template"}
+	return nil, errorFromResponse(resp, body, "Failed to create profile")
+}
+
+// ListProfiles lists template profiles with optional namespace and tenant filters.
+func (c *Client) ListProfiles(ctx context.Context, namespace, tenant *string) (*ListProfilesResponse, error) {
+	if selector, ok := labelSelectorFrom(ctx); ok {
+		profiles, err := c.allProfiles(ctx, namespace, tenant)
+		if err != nil {
+			return nil, err
+		}
+		profiles = selectLabels(profiles, selector, func(p TemplateProfileInfo) map[string]string { return p.Labels })
+		return &ListProfilesResponse{Profiles: profiles, Count: len(profiles)}, nil
 	}
-	return nil, &APIError{Code: errResp.Code, Message: errResp.Message, Retryable: errResp.Retryable}
+	return c.listProfiles(ctx, namespace, tenant, 0, 0)
 }
 
-// ListTemplates lists payload templates with optional namespace and tenant filters.
-func (c *Client) ListTemplates(ctx context.Context, namespace, tenant *string) (*ListTemplatesResponse, error) {
+// allProfiles lists every profile matching the filters, page by page.
+func (c *Client) allProfiles(ctx context.Context, namespace, tenant *string) ([]TemplateProfileInfo, error) {
+	return listAll(func(limit, offset int) ([]TemplateProfileInfo, error) {
+		list, err := c.listProfiles(ctx, namespace, tenant, limit, offset)
+		if err != nil {
+			return nil, err
+		}
+		return list.Profiles, nil
+	})
+}
+
+// listProfiles fetches one page of profiles; a zero limit leaves the
+// page size to the gateway.
+func (c *Client) listProfiles(ctx context.Context, namespace, tenant *string, limit, offset int) (*ListProfilesResponse, error) {
 	params := url.Values{}
 	if namespace != nil {
 		params.Set("namespace", *namespace)
@@ -1767,32 +1704,33 @@ func (c *Client) ListTemplates(ctx context.Context, namespace, tenant *string) (
 	if tenant != nil {
 		params.Set("tenant", *tenant)
 	}
+	setPage(params, limit, offset)
 
-	path := "/v1/templates"
+	path := "/v1/templates/profiles"
 	if len(params) > 0 {
 		path += "?" + params.Encode()
 	}
 
-	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	resp, err := c.getCached(ctx, path)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list templates"}
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list profiles"}
 	}
 
-	var result ListTemplatesResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	var result ListProfilesResponse
+	if err := c.decodeBody(resp.Body, &result); err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
 	return &result, nil
 }
 
-// GetTemplate gets a single template by ID.
-func (c *Client) GetTemplate(ctx context.Context, templateID string) (*TemplateInfo, error) {
-	path := fmt.Sprintf("/v1/templates/%s", templateID)
+// GetProfile gets a single template profile by ID.
+func (c *Client) GetProfile(ctx context.Context, profileID string) (*TemplateProfileInfo, error) {
+	path := fmt.Sprintf("/v1/templates/profiles/%s", profileID)
 
 	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -1805,19 +1743,62 @@ func (c *Client) GetTemplate(ctx context.Context, templateID string) (*TemplateI
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get template"}
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get profile"}
 	}
 
-	var result TemplateInfo
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	var result TemplateProfileInfo
+	if err := c.decodeBody(resp.Body, &result); err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
 	return &result, nil
 }
 
-// UpdateTemplate updates a payload template.
-func (c *Client) UpdateTemplate(ctx context.Context, templateID string, update *UpdateTemplateRequest) (*TemplateInfo, error) {
-	resp, err := c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/v1/templates/%s", templateID), update)
+// ResolveProfile fetches a template profile and every template its
+// `$ref` fields point at, returning the fully materialized profile.
+// Returns nil if the profile does not exist and an error if a field
+// references a template missing from the profile's namespace and tenant.
+func (c *Client) ResolveProfile(ctx context.Context, profileID string) (*ResolvedProfile, error) {
+	ctx = withoutLabelSelector(ctx)
+	profile, err := c.GetProfile(ctx, profileID)
+	if err != nil || profile == nil {
+		return nil, err
+	}
+
+	var byName map[string]*TemplateInfo
+	resolved := &ResolvedProfile{Profile: *profile, Fields: make(map[string]ResolvedProfileField, len(profile.Fields))}
+	for name, raw := range profile.Fields {
+		ref, isRef := profileFieldRef(raw)
+		if !isRef {
+			var inline string
+			if err := json.Unmarshal(raw, &inline); err != nil {
+				return nil, fmt.Errorf("field %q is neither a string nor a $ref object", name)
+			}
+			resolved.Fields[name] = ResolvedProfileField{Content: inline}
+			continue
+		}
+
+		if byName == nil {
+			templates, err := c.allTemplates(ctx, &profile.Namespace, &profile.Tenant)
+			if err != nil {
+				return nil, err
+			}
+			byName = make(map[string]*TemplateInfo, len(templates))
+			for i := range templates {
+				byName[templates[i].Name] = &templates[i]
+			}
+		}
+		tpl, ok := byName[ref]
+		if !ok {
+			return nil, fmt.Errorf("field %q references unknown template %q", name, ref)
+		}
+		resolved.Fields[name] = ResolvedProfileField{Content: tpl.Content, Ref: ref, Template: tpl}
+	}
+	return resolved, nil
+}
+
+// UpdateProfile updates a template profile.
+func (c *Client) UpdateProfile(ctx context.Context, profileID string, update *UpdateProfileRequest) (*TemplateProfileInfo, error) {
+	resp, err := c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/v1/templates/profiles/%s", profileID), update)
 	if err != nil {
 		return nil, err
 	}
@@ -1829,27 +1810,23 @@ func (c *Client) UpdateTemplate(ctx context.Context, templateID string, update *
 	}
 
 	if resp.StatusCode == http.StatusOK {
-		var result TemplateInfo
-		if err := json.Unmarshal(body, &result); err != nil {
+		var result TemplateProfileInfo
+		if err := c.decodeJSON(body, &result); err != nil {
 			return nil, &ConnectionError{Message: err.Error()}
 		}
 		return &result, nil
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Template not found: %s", templateID)}
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Profile not found: %s", profileID)}
 	}
 
-	var errResp ErrorResponse
-	if err := json.Unmarshal(body, &errResp); err != nil {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to update template"}
-	}
-	return nil, &APIError{Code: errResp.Code, Message: errResp.Message, Retryable: errResp.Retryable}
+	return nil, errorFromResponse(resp, body, "Failed to update profile")
 }
 
-// DeleteTemplate deletes a payload template.
-func (c *Client) DeleteTemplate(ctx context.Context, templateID string) error {
-	path := fmt.Sprintf("/v1/templates/%s", templateID)
+// DeleteProfile deletes a template profile.
+func (c *Client) DeleteProfile(ctx context.Context, profileID string) error {
+	path := fmt.Sprintf("/v1/templates/profiles/%s", profileID)
 
 	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -1861,14 +1838,14 @@ func (c *Client) DeleteTemplate(ctx context.Context, templateID string) error {
 		return nil
 	}
 	if resp.StatusCode == http.StatusNotFound {
-		return &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Template not found: %s", templateID)}
+		return &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Profile not found: %s", profileID)}
 	}
-	return &HTTPError{Status: resp.StatusCode, Message: "Failed to delete template"}
+	return &HTTPError{Status: resp.StatusCode, Message: "Failed to delete profile"}
 }
 
-// CreateProfile creates a template profile.
-func (c *Client) CreateProfile(ctx context.Context, req *CreateProfileRequest) (*TemplateProfileInfo, error) {
-	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/templates/profiles", req)
+// RenderPreview renders a template profile with payload data.
+func (c *Client) RenderPreview(ctx context.Context, req *RenderPreviewRequest) (*RenderPreviewResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/templates/render", req)
 	if err != nil {
 		return nil, err
 	}
@@ -1879,56 +1856,75 @@ func (c *Client) CreateProfile(ctx context.Context, req *CreateProfileRequest) (
 		return nil, &ConnectionError{Message: err.Error()}
 	}
 
-	if resp.StatusCode == http.StatusCreated {
-		var result TemplateProfileInfo
-		if err := json.Unmarshal(body, &result); err != nil {
+	if resp.StatusCode == http.StatusOK {
+		var result RenderPreviewResponse
+		if err := c.decodeJSON(body, &result); err != nil {
 			return nil, &ConnectionError{Message: err.Error()}
 		}
 		return &result, nil
 	}
 
-	var errResp ErrorResponse
-	if err := json.Unmarshal(body, &errResp); err != nil {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to create profile"}
-	}
-	return nil, &APIError{Code: errResp.Code, Message: errResp.Message, Retryable: errResp.Retryable}
+	return nil, errorFromResponse(resp, body, "Failed to render preview")
 }
 
-// ListProfiles lists template profiles with optional namespace and tenant filters.
-func (c *Client) ListProfiles(ctx context.Context, namespace, tenant *string) (*ListProfilesResponse, error) {
-	params := url.Values{}
-	if namespace != nil {
-		params.Set("namespace", *namespace)
+// =============================================================================
+// Provider Catalog
+// =============================================================================
+
+// ListProviders returns the gateway's provider catalog: each registered
+// provider with its supported action types and their payload schemas.
+func (c *Client) ListProviders(ctx context.Context) (*ListProvidersResponse, error) {
+	resp, err := c.getCached(ctx, "/v1/providers")
+	if err != nil {
+		return nil, err
 	}
-	if tenant != nil {
-		params.Set("tenant", *tenant)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list providers"}
 	}
 
-	path := "/v1/templates/profiles"
-	if len(params) > 0 {
-		path += "?" + params.Encode()
+	var result ListProvidersResponse
+	if err := c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
 	}
+	return &result, nil
+}
 
-	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+// CreateProviderConfig registers a provider instance at runtime. The
+// gateway starts routing to it without a restart.
+func (c *Client) CreateProviderConfig(ctx context.Context, req *CreateProviderConfigRequest) (*ProviderConfig, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/providers/configs", req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list profiles"}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
 	}
 
-	var result ListProfilesResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
+	if resp.StatusCode == http.StatusCreated {
+		var result ProviderConfig
+		if err := c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
 	}
-	return &result, nil
+
+	return nil, errorFromResponse(resp, body, "Failed to create provider config")
 }
 
-// GetProfile gets a single template profile by ID.
-func (c *Client) GetProfile(ctx context.Context, profileID string) (*TemplateProfileInfo, error) {
-	path := fmt.Sprintf("/v1/templates/profiles/%s", profileID)
+// ListProviderConfigs lists provider instances registered through the
+// API, optionally filtered by tenant.
+func (c *Client) ListProviderConfigs(ctx context.Context, tenant *string) (*ListProviderConfigsResponse, error) {
+	path := "/v1/providers/configs"
+	if tenant != nil {
+		params := url.Values{}
+		params.Set("tenant", *tenant)
+		path += "?" + params.Encode()
+	}
 
 	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -1936,24 +1932,23 @@ func (c *Client) GetProfile(ctx context.Context, profileID string) (*TemplatePro
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, nil
-	}
-
 	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get profile"}
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list provider configs"}
 	}
 
-	var result TemplateProfileInfo
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	var result ListProviderConfigsResponse
+	if err := c.decodeBody(resp.Body, &result); err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
 	return &result, nil
 }
 
-// UpdateProfile updates a template profile.
-func (c *Client) UpdateProfile(ctx context.Context, profileID string, update *UpdateProfileRequest) (*TemplateProfileInfo, error) {
-	resp, err := c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/v1/templates/profiles/%s", profileID), update)
+// UpdateProviderConfig updates a provider instance registered through
+// the API.
+func (c *Client) UpdateProviderConfig(ctx context.Context, name string, update *UpdateProviderConfigRequest) (*ProviderConfig, error) {
+	path := fmt.Sprintf("/v1/providers/configs/%s", url.PathEscape(name))
+
+	resp, err := c.doRequest(ctx, http.MethodPut, path, update)
 	if err != nil {
 		return nil, err
 	}
@@ -1965,27 +1960,24 @@ func (c *Client) UpdateProfile(ctx context.Context, profileID string, update *Up
 	}
 
 	if resp.StatusCode == http.StatusOK {
-		var result TemplateProfileInfo
-		if err := json.Unmarshal(body, &result); err != nil {
+		var result ProviderConfig
+		if err := c.decodeJSON(body, &result); err != nil {
 			return nil, &ConnectionError{Message: err.Error()}
 		}
 		return &result, nil
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Profile not found: %s", profileID)}
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Provider config not found: %s", name)}
 	}
 
-	var errResp ErrorResponse
-	if err := json.Unmarshal(body, &errResp); err != nil {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to update profile"}
-	}
-	return nil, &APIError{Code: errResp.Code, Message: errResp.Message, Retryable: errResp.Retryable}
+	return nil, errorFromResponse(resp, body, "Failed to update provider config")
 }
 
-// DeleteProfile deletes a template profile.
-func (c *Client) DeleteProfile(ctx context.Context, profileID string) error {
-	path := fmt.Sprintf("/v1/templates/profiles/%s", profileID)
+// DeleteProviderConfig removes a provider instance registered through
+// the API. Providers from the config file cannot be deleted this way.
+func (c *Client) DeleteProviderConfig(ctx context.Context, name string) error {
+	path := fmt.Sprintf("/v1/providers/configs/%s", url.PathEscape(name))
 
 	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -1997,14 +1989,20 @@ func (c *Client) DeleteProfile(ctx context.Context, profileID string) error {
 		return nil
 	}
 	if resp.StatusCode == http.StatusNotFound {
-		return &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Profile not found: %s", profileID)}
+		return &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Provider config not found: %s", name)}
 	}
-	return &HTTPError{Status: resp.StatusCode, Message: "Failed to delete profile"}
+	return &HTTPError{Status: resp.StatusCode, Message: "Failed to delete provider config"}
 }
 
-// RenderPreview renders a template profile with payload data.
-func (c *Client) RenderPreview(ctx context.Context, req *RenderPreviewRequest) (*RenderPreviewResponse, error) {
-	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/templates/render", req)
+// TestProvider asks the gateway to check a provider before it carries
+// real traffic. With a nil sampleAction the gateway checks connectivity
+// and credentials only; otherwise it executes sampleAction against the
+// provider in sandbox mode. A failing provider is reported through the
+// result, not as an error.
+func (c *Client) TestProvider(ctx context.Context, provider string, sampleAction *Action) (*ProviderTestResult, error) {
+	path := fmt.Sprintf("/v1/providers/%s/test", url.PathEscape(provider))
+
+	resp, err := c.doRequest(ctx, http.MethodPost, path, providerTestRequest{Action: sampleAction})
 	if err != nil {
 		return nil, err
 	}
@@ -2016,18 +2014,96 @@ func (c *Client) RenderPreview(ctx context.Context, req *RenderPreviewRequest) (
 	}
 
 	if resp.StatusCode == http.StatusOK {
-		var result RenderPreviewResponse
-		if err := json.Unmarshal(body, &result); err != nil {
+		var result ProviderTestResult
+		if err := c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Provider not found: %s", provider)}
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to test provider")
+}
+
+// =============================================================================
+// Secrets
+// =============================================================================
+
+// PutSecret creates or replaces a managed secret. Scope is a tenant, or
+// SecretScopeGlobal for secrets shared by every tenant. The value is
+// write-only: no API returns it. Reference the secret from provider
+// configs and webhook headers with SecretRef.
+func (c *Client) PutSecret(ctx context.Context, scope, name, value string) (*SecretInfo, error) {
+	path := fmt.Sprintf("/v1/secrets/%s/%s", url.PathEscape(scope), url.PathEscape(name))
+
+	resp, err := c.doRequest(ctx, http.MethodPut, path, map[string]string{"value": value})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated {
+		var result SecretInfo
+		if err := c.decodeJSON(body, &result); err != nil {
 			return nil, &ConnectionError{Message: err.Error()}
 		}
 		return &result, nil
 	}
 
-	var errResp ErrorResponse
-	if err := json.Unmarshal(body, &errResp); err != nil {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to render preview"}
+	return nil, errorFromResponse(resp, body, "Failed to put secret")
+}
+
+// ListSecrets lists the secrets in a scope, without their values. An
+// empty scope lists every scope the caller can see.
+func (c *Client) ListSecrets(ctx context.Context, scope string) (*ListSecretsResponse, error) {
+	path := "/v1/secrets"
+	if scope != "" {
+		path += "?" + url.Values{"scope": {scope}}.Encode()
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list secrets"}
+	}
+
+	var result ListSecretsResponse
+	if err := c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &result, nil
+}
+
+// DeleteSecret deletes a managed secret. Provider configs that still
+// reference it fail to resolve it at execution time.
+func (c *Client) DeleteSecret(ctx context.Context, scope, name string) error {
+	path := fmt.Sprintf("/v1/secrets/%s/%s", url.PathEscape(scope), url.PathEscape(name))
+
+	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Secret not found: %s/%s", scope, name)}
 	}
-	return nil, &APIError{Code: errResp.Code, Message: errResp.Message, Retryable: errResp.Retryable}
+	return &HTTPError{Status: resp.StatusCode, Message: "Failed to delete secret"}
 }
 
 // =============================================================================
@@ -2047,7 +2123,7 @@ func (c *Client) ListProviderHealth(ctx context.Context) (*ListProviderHealthRes
 	}
 
 	var result ListProviderHealthResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := c.decodeBody(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to decode provider health response: %w", err)
 	}
 	return &result, nil
@@ -2069,16 +2145,59 @@ func (c *Client) ListPlugins(ctx context.Context) (*ListPluginsResponse, error)
 		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list plugins"}
 	}
 
-	var result ListPluginsResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	var result ListPluginsResponse
+	if err := c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &result, nil
+}
+
+// RegisterPlugin registers a new WASM plugin.
+func (c *Client) RegisterPlugin(ctx context.Context, req *RegisterPluginRequest) (*WasmPlugin, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/plugins", req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
-	return &result, nil
+
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated {
+		var result WasmPlugin
+		if err := c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to register plugin")
 }
 
-// RegisterPlugin registers a new WASM plugin.
-func (c *Client) RegisterPlugin(ctx context.Context, req *RegisterPluginRequest) (*WasmPlugin, error) {
-	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/plugins", req)
+// SyncPluginFromRegistry has the gateway pull a WASM plugin artifact
+// from an OCI registry and register (or replace) the plugin with it,
+// instead of pushing the module bytes through the API.
+func (c *Client) SyncPluginFromRegistry(ctx context.Context, ref PluginRef) (*WasmPlugin, error) {
+	var problems []ValidationProblem
+	if ref.Registry == "" {
+		problems = append(problems, ValidationProblem{Field: "registry", Message: "is required"})
+	}
+	if ref.Repo == "" {
+		problems = append(problems, ValidationProblem{Field: "repository", Message: "is required"})
+	}
+	if ref.Tag == "" && ref.Digest == "" {
+		problems = append(problems, ValidationProblem{Field: "tag", Message: "a tag or digest is required"})
+	}
+	if len(problems) > 0 {
+		return nil, &ValidationError{Problems: problems}
+	}
+	if ref.Name == "" {
+		ref.Name = ref.Repo[strings.LastIndex(ref.Repo, "/")+1:]
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/plugins/sync", ref)
 	if err != nil {
 		return nil, err
 	}
@@ -2091,17 +2210,17 @@ func (c *Client) RegisterPlugin(ctx context.Context, req *RegisterPluginRequest)
 
 	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated {
 		var result WasmPlugin
-		if err := json.Unmarshal(body, &result); err != nil {
+		if err := c.decodeJSON(body, &result); err != nil {
 			return nil, &ConnectionError{Message: err.Error()}
 		}
 		return &result, nil
 	}
 
-	var errResp ErrorResponse
-	if err := json.Unmarshal(body, &errResp); err != nil {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to register plugin"}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Plugin artifact not found: %s", ref)}
 	}
-	return nil, &APIError{Code: errResp.Code, Message: errResp.Message, Retryable: errResp.Retryable}
+
+	return nil, errorFromResponse(resp, body, "Failed to sync plugin from registry")
 }
 
 // GetPlugin gets details of a registered WASM plugin by name.
@@ -2123,7 +2242,7 @@ func (c *Client) GetPlugin(ctx context.Context, name string) (*WasmPlugin, error
 	}
 
 	var result WasmPlugin
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := c.decodeBody(resp.Body, &result); err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
 	return &result, nil
@@ -2148,69 +2267,64 @@ func (c *Client) DeletePlugin(ctx context.Context, name string) error {
 	return &HTTPError{Status: resp.StatusCode, Message: "Failed to delete plugin"}
 }
 
-// InvokePlugin test-invokes a WASM plugin.
-func (c *Client) InvokePlugin(ctx context.Context, name string, req *PluginInvocationRequest) (*PluginInvocationResponse, error) {
-	path := fmt.Sprintf("/v1/plugins/%s/invoke", name)
+// InspectPlugin returns a registered plugin module's exported
+// functions, required host functions, and declared memory.
+func (c *Client) InspectPlugin(ctx context.Context, name string) (*PluginInspection, error) {
+	path := fmt.Sprintf("/v1/plugins/%s/inspect", url.PathEscape(name))
 
-	resp, err := c.doRequest(ctx, http.MethodPost, path, req)
+	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusOK {
-		var result PluginInvocationResponse
-		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-			return nil, &ConnectionError{Message: err.Error()}
-		}
-		return &result, nil
-	}
-
 	if resp.StatusCode == http.StatusNotFound {
 		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Plugin not found: %s", name)}
 	}
-	return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Failed to invoke plugin: %s", name)}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to inspect plugin"}
+	}
+
+	var result PluginInspection
+	if err := c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &result, nil
 }
 
-// =============================================================================
-// Rule Evaluation (Rule Playground)
-// =============================================================================
+// VerifyPlugin re-checks a registered plugin's module signature against
+// its public key and returns the result. An unsigned or invalid module
+// is reported through the result, not as an error.
+func (c *Client) VerifyPlugin(ctx context.Context, name string) (*PluginVerification, error) {
+	path := fmt.Sprintf("/v1/plugins/%s/verify", url.PathEscape(name))
 
-// EvaluateRules evaluates rules against a test action without dispatching.
-func (c *Client) EvaluateRules(ctx context.Context, req EvaluateRulesRequest) (*EvaluateRulesResponse, error) {
-	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/rules/evaluate", req)
+	resp, err := c.doRequest(ctx, http.MethodPost, path, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
-	}
-
 	if resp.StatusCode == http.StatusOK {
-		var result EvaluateRulesResponse
-		if err := json.Unmarshal(body, &result); err != nil {
+		var result PluginVerification
+		if err := c.decodeBody(resp.Body, &result); err != nil {
 			return nil, &ConnectionError{Message: err.Error()}
 		}
 		return &result, nil
 	}
 
-	var errResp ErrorResponse
-	if err := json.Unmarshal(body, &errResp); err != nil {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to evaluate rules"}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Plugin not found: %s", name)}
 	}
-	return nil, &APIError{Code: errResp.Code, Message: errResp.Message, Retryable: errResp.Retryable}
+	return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Failed to verify plugin: %s", name)}
 }
 
-// =============================================================================
-// Compliance (SOC2/HIPAA)
-// =============================================================================
+// SetPluginEnabled enables or disables a registered WASM plugin without
+// unregistering it. A disabled plugin is skipped by rules that reference it.
+func (c *Client) SetPluginEnabled(ctx context.Context, name string, enabled bool) (*WasmPlugin, error) {
+	path := fmt.Sprintf("/v1/plugins/%s", url.PathEscape(name))
 
-// GetComplianceStatus returns the current compliance configuration status.
-func (c *Client) GetComplianceStatus(ctx context.Context) (*ComplianceStatus, error) {
-	resp, err := c.doRequest(ctx, "GET", "/v1/compliance/status", nil)
+	resp, err := c.doRequest(ctx, http.MethodPatch, path, setPluginEnabledRequest{Enabled: enabled})
 	if err != nil {
 		return nil, err
 	}
@@ -2218,22 +2332,30 @@ func (c *Client) GetComplianceStatus(ctx context.Context) (*ComplianceStatus, er
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("reading response body: %w", err)
+		return nil, &ConnectionError{Message: err.Error()}
 	}
 
-	if resp.StatusCode == 200 {
-		var result ComplianceStatus
-		if err := json.Unmarshal(body, &result); err != nil {
-			return nil, fmt.Errorf("decoding compliance status: %w", err)
+	if resp.StatusCode == http.StatusOK {
+		var result WasmPlugin
+		if err := c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
 		}
 		return &result, nil
 	}
-	return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get compliance status"}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Plugin not found: %s", name)}
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to update plugin")
 }
 
-// VerifyAuditChain verifies the integrity of the audit hash chain for a namespace/tenant pair.
-func (c *Client) VerifyAuditChain(ctx context.Context, req *VerifyHashChainRequest) (*HashChainVerification, error) {
-	resp, err := c.doRequest(ctx, "POST", "/v1/audit/verify", req)
+// UpdatePluginConfig replaces the resource configuration of a
+// registered WASM plugin. The module itself is left untouched.
+func (c *Client) UpdatePluginConfig(ctx context.Context, name string, cfg *WasmPluginConfig) (*WasmPlugin, error) {
+	path := fmt.Sprintf("/v1/plugins/%s/config", url.PathEscape(name))
+
+	resp, err := c.doRequest(ctx, http.MethodPut, path, cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -2241,32 +2363,28 @@ func (c *Client) VerifyAuditChain(ctx context.Context, req *VerifyHashChainReque
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("reading response body: %w", err)
+		return nil, &ConnectionError{Message: err.Error()}
 	}
 
-	if resp.StatusCode == 200 {
-		var result HashChainVerification
-		if err := json.Unmarshal(body, &result); err != nil {
-			return nil, fmt.Errorf("decoding verification result: %w", err)
+	if resp.StatusCode == http.StatusOK {
+		var result WasmPlugin
+		if err := c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
 		}
 		return &result, nil
 	}
-	return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to verify audit chain"}
-}
-
-// =============================================================================
-// Chains
-// =============================================================================
 
-// ListChains lists chain executions filtered by namespace, tenant, and optional status.
-func (c *Client) ListChains(ctx context.Context, namespace, tenant string, status *string) (*ListChainsResponse, error) {
-	params := url.Values{}
-	params.Set("namespace", namespace)
-	params.Set("tenant", tenant)
-	if status != nil {
-		params.Set("status", *status)
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Plugin not found: %s", name)}
 	}
-	path := "/v1/chains?" + params.Encode()
+
+	return nil, errorFromResponse(resp, body, "Failed to update plugin config")
+}
+
+// ListPluginVersions lists the stored module versions of a WASM plugin,
+// newest first.
+func (c *Client) ListPluginVersions(ctx context.Context, name string) (*ListPluginVersionsResponse, error) {
+	path := fmt.Sprintf("/v1/plugins/%s/versions", url.PathEscape(name))
 
 	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -2274,48 +2392,58 @@ func (c *Client) ListChains(ctx context.Context, namespace, tenant string, statu
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Plugin not found: %s", name)}
+	}
+
 	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list chains"}
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list plugin versions"}
 	}
 
-	var result ListChainsResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	var result ListPluginVersionsResponse
+	if err := c.decodeBody(resp.Body, &result); err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
 	return &result, nil
 }
 
-// GetChain gets the full details of a chain execution by ID.
-func (c *Client) GetChain(ctx context.Context, chainID, namespace, tenant string) (*ChainDetailResponse, error) {
-	params := url.Values{}
-	params.Set("namespace", namespace)
-	params.Set("tenant", tenant)
-	path := fmt.Sprintf("/v1/chains/%s?%s", chainID, params.Encode())
+// RegisterPluginVersion stores a new module version for an existing
+// WASM plugin.
+func (c *Client) RegisterPluginVersion(ctx context.Context, name string, req *RegisterPluginVersionRequest) (*PluginVersion, error) {
+	path := fmt.Sprintf("/v1/plugins/%s/versions", url.PathEscape(name))
 
-	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	resp, err := c.doRequest(ctx, http.MethodPost, path, req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Chain not found: %s", chainID)}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get chain"}
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated {
+		var result PluginVersion
+		if err := c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
 	}
 
-	var detail ChainDetailResponse
-	if err := json.NewDecoder(resp.Body).Decode(&detail); err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Plugin not found: %s", name)}
 	}
-	return &detail, nil
+
+	return nil, errorFromResponse(resp, body, "Failed to register plugin version")
 }
 
-// CancelChain cancels a running chain execution.
-func (c *Client) CancelChain(ctx context.Context, chainID string, req *CancelChainRequest) (*ChainDetailResponse, error) {
-	resp, err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/v1/chains/%s/cancel", chainID), req)
+// RollbackPlugin switches a WASM plugin's active module to a previously
+// stored version. Takes effect for the next invocation.
+func (c *Client) RollbackPlugin(ctx context.Context, name string, version int) (*WasmPlugin, error) {
+	path := fmt.Sprintf("/v1/plugins/%s/rollback", url.PathEscape(name))
+
+	resp, err := c.doRequest(ctx, http.MethodPost, path, rollbackPluginRequest{Version: version})
 	if err != nil {
 		return nil, err
 	}
@@ -2327,131 +2455,127 @@ func (c *Client) CancelChain(ctx context.Context, chainID string, req *CancelCha
 	}
 
 	if resp.StatusCode == http.StatusOK {
-		var detail ChainDetailResponse
-		if err := json.Unmarshal(body, &detail); err != nil {
+		var result WasmPlugin
+		if err := c.decodeJSON(body, &result); err != nil {
 			return nil, &ConnectionError{Message: err.Error()}
 		}
-		return &detail, nil
+		return &result, nil
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Chain not found: %s", chainID)}
-	}
-	if resp.StatusCode == http.StatusConflict {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Chain is not running"}
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Plugin version not found: %s@%d", name, version)}
 	}
 
-	var errResp ErrorResponse
-	if err := json.Unmarshal(body, &errResp); err != nil {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to cancel chain"}
-	}
-	return nil, &APIError{Code: errResp.Code, Message: errResp.Message, Retryable: errResp.Retryable}
+	return nil, errorFromResponse(resp, body, "Failed to roll back plugin")
 }
 
-// GetChainDag returns the DAG representation for a running chain instance.
-func (c *Client) GetChainDag(ctx context.Context, chainID, namespace, tenant string) (*DagResponse, error) {
-	params := url.Values{}
-	params.Set("namespace", namespace)
-	params.Set("tenant", tenant)
+// InvokePlugin test-invokes a WASM plugin.
+func (c *Client) InvokePlugin(ctx context.Context, name string, req *PluginInvocationRequest) (*PluginInvocationResponse, error) {
+	path := fmt.Sprintf("/v1/plugins/%s/invoke", name)
 
-	resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/v1/chains/%s/dag?%s", chainID, params.Encode()), nil)
+	resp, err := c.doRequest(ctx, http.MethodPost, path, req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Chain not found: %s", chainID)}
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get chain DAG"}
+	if resp.StatusCode == http.StatusOK {
+		var result PluginInvocationResponse
+		if err := c.decodeBody(resp.Body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
 	}
 
-	var dag DagResponse
-	if err := json.NewDecoder(resp.Body).Decode(&dag); err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Plugin not found: %s", name)}
 	}
-	return &dag, nil
+	return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Failed to invoke plugin: %s", name)}
 }
 
-// GetChainDefinitionDag returns the DAG representation for a chain definition (config only).
-func (c *Client) GetChainDefinitionDag(ctx context.Context, name string) (*DagResponse, error) {
-	resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/v1/chains/definitions/%s/dag", name), nil)
+// InvokePluginBatch test-invokes a WASM plugin once per request in a
+// single round trip. Results are returned in request order.
+func (c *Client) InvokePluginBatch(ctx context.Context, name string, reqs []PluginInvocationRequest) (*PluginBatchInvocationResponse, error) {
+	path := fmt.Sprintf("/v1/plugins/%s/invoke/batch", url.PathEscape(name))
+
+	resp, err := c.doRequest(ctx, http.MethodPost, path, pluginBatchInvocationRequest{Invocations: reqs})
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Chain definition not found: %s", name)}
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get chain definition DAG"}
+	if resp.StatusCode == http.StatusOK {
+		var result PluginBatchInvocationResponse
+		if err := c.decodeBody(resp.Body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
 	}
 
-	var dag DagResponse
-	if err := json.NewDecoder(resp.Body).Decode(&dag); err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Plugin not found: %s", name)}
 	}
-	return &dag, nil
+	return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Failed to invoke plugin batch: %s", name)}
 }
 
-// GetChainHistory returns the retry history for a chain execution.
-func (c *Client) GetChainHistory(ctx context.Context, chainID, namespace, tenant string) (*ChainHistoryResponse, error) {
-	params := url.Values{}
-	params.Set("namespace", namespace)
-	params.Set("tenant", tenant)
-	path := fmt.Sprintf("/v1/chains/%s/history?%s", chainID, params.Encode())
+// =============================================================================
+// Rule Evaluation (Rule Playground)
+// =============================================================================
 
-	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+// EvaluateRules evaluates rules against a test action without dispatching.
+func (c *Client) EvaluateRules(ctx context.Context, req EvaluateRulesRequest) (*EvaluateRulesResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/rules/evaluate", req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Chain not found: %s", chainID)}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get chain history"}
+	if resp.StatusCode == http.StatusOK {
+		var result EvaluateRulesResponse
+		if err := c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
 	}
 
-	var history ChainHistoryResponse
-	if err := json.NewDecoder(resp.Body).Decode(&history); err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
-	}
-	return &history, nil
+	return nil, errorFromResponse(resp, body, "Failed to evaluate rules")
 }
 
 // =============================================================================
-// Dead Letter Queue (DLQ)
+// Compliance (SOC2/HIPAA)
 // =============================================================================
 
-// DlqStats returns dead-letter queue statistics.
-func (c *Client) DlqStats(ctx context.Context) (*DlqStatsResponse, error) {
-	resp, err := c.doRequest(ctx, http.MethodGet, "/v1/dlq/stats", nil)
+// GetComplianceStatus returns the current compliance configuration status.
+func (c *Client) GetComplianceStatus(ctx context.Context) (*ComplianceStatus, error) {
+	resp, err := c.doRequest(ctx, "GET", "/v1/compliance/status", nil)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get DLQ stats"}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %w", err)
 	}
 
-	var stats DlqStatsResponse
-	if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
+	if resp.StatusCode == 200 {
+		var result ComplianceStatus
+		if err := c.decodeJSON(body, &result); err != nil {
+			return nil, fmt.Errorf("decoding compliance status: %w", err)
+		}
+		return &result, nil
 	}
-	return &stats, nil
+	return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get compliance status"}
 }
 
-// DlqDrain drains all entries from the dead-letter queue.
-func (c *Client) DlqDrain(ctx context.Context) (*DlqDrainResponse, error) {
-	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/dlq/drain", nil)
+// VerifyAuditChain verifies the integrity of the audit hash chain for a namespace/tenant pair.
+func (c *Client) VerifyAuditChain(ctx context.Context, req *VerifyHashChainRequest) (*HashChainVerification, error) {
+	resp, err := c.doRequest(ctx, "POST", "/v1/audit/verify", req)
 	if err != nil {
 		return nil, err
 	}
@@ -2459,21 +2583,73 @@ func (c *Client) DlqDrain(ctx context.Context) (*DlqDrainResponse, error) {
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
+		return nil, fmt.Errorf("reading response body: %w", err)
 	}
 
-	if resp.StatusCode == http.StatusOK {
-		var result DlqDrainResponse
-		if err := json.Unmarshal(body, &result); err != nil {
-			return nil, &ConnectionError{Message: err.Error()}
+	if resp.StatusCode == 200 {
+		var result HashChainVerification
+		if err := c.decodeJSON(body, &result); err != nil {
+			return nil, fmt.Errorf("decoding verification result: %w", err)
 		}
 		return &result, nil
 	}
+	return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to verify audit chain"}
+}
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, &HTTPError{Status: resp.StatusCode, Message: "DLQ is not enabled"}
+// ExportSubjectData returns every audit record, event state, and
+// approval whose payload references one of the subject's identifiers,
+// for answering a data subject access request.
+func (c *Client) ExportSubjectData(ctx context.Context, query *SubjectQuery) (*SubjectDataExport, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+	resp, err := c.doRequest(ctx, "POST", "/v1/compliance/subjects/export", query)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %w", err)
+	}
+
+	if resp.StatusCode == 200 {
+		var result SubjectDataExport
+		if err := c.decodeJSON(body, &result); err != nil {
+			return nil, fmt.Errorf("decoding subject data export: %w", err)
+		}
+		return &result, nil
+	}
+	return nil, errorFromResponse(resp, body, "Failed to export subject data")
+}
+
+// EraseSubjectData erases the subject's data found by the same search
+// as ExportSubjectData and reports what was removed. Check Verified on
+// the report before closing the request.
+func (c *Client) EraseSubjectData(ctx context.Context, query *SubjectQuery) (*SubjectErasureReport, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+	resp, err := c.doRequest(ctx, "POST", "/v1/compliance/subjects/erase", query)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %w", err)
+	}
+
+	if resp.StatusCode == 200 {
+		var result SubjectErasureReport
+		if err := c.decodeJSON(body, &result); err != nil {
+			return nil, fmt.Errorf("decoding erasure report: %w", err)
+		}
+		return &result, nil
 	}
-	return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to drain DLQ"}
+	return nil, errorFromResponse(resp, body, "Failed to erase subject data")
 }
 
 // =============================================================================
@@ -2506,11 +2682,11 @@ func (c *Client) QueryAnalytics(ctx context.Context, query *AnalyticsQuery) (*An
 		if query.Interval != "" {
 			params.Set("interval", query.Interval)
 		}
-		if query.From != "" {
-			params.Set("from", query.From)
+		if query.From != nil {
+			params.Set("from", query.From.Format(time.RFC3339))
 		}
-		if query.To != "" {
-			params.Set("to", query.To)
+		if query.To != nil {
+			params.Set("to", query.To.Format(time.RFC3339))
 		}
 		if query.GroupBy != "" {
 			params.Set("group_by", query.GroupBy)
@@ -2534,7 +2710,7 @@ func (c *Client) QueryAnalytics(ctx context.Context, query *AnalyticsQuery) (*An
 	}
 
 	var result AnalyticsResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := c.decodeBody(resp.Body, &result); err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
 	return &result, nil
@@ -2581,7 +2757,7 @@ func (c *Client) RulesCoverage(ctx context.Context, query *CoverageQuery) (*Cove
 	}
 
 	var report CoverageReport
-	if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+	if err := c.decodeBody(resp.Body, &report); err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
 	return &report, nil
@@ -2669,7 +2845,7 @@ func (c *Client) Stream(ctx context.Context, opts *StreamOptions) (<-chan *SseEv
 
 // openSSE opens an SSE connection to the given path and returns a channel of events.
 func (c *Client) openSSE(ctx context.Context, path string, lastEventID *string) (<-chan *SseEvent, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpointFor(ctx, path, nil)+c.apiPath(path), nil)
 	if err != nil {
 		return nil, &ConnectionError{Message: err.Error()}
 	}
@@ -2683,14 +2859,9 @@ func (c *Client) openSSE(ctx context.Context, path string, lastEventID *string)
 		req.Header.Set("Last-Event-ID", *lastEventID)
 	}
 
-	// Use a separate client without timeout for SSE (long-lived connection).
-	sseClient := &http.Client{
-		// No timeout -- the connection stays open until context cancellation.
-	}
-
-	resp, err := sseClient.Do(req)
+	resp, err := c.roundTrip(c.streamClient(), req)
 	if err != nil {
-		return nil, &ConnectionError{Message: err.Error()}
+		return nil, err
 	}
 
 	if resp.StatusCode != http.StatusOK {
@@ -2786,7 +2957,7 @@ func (c *Client) ListSwarmRuns(ctx context.Context, filter *SwarmRunFilter) (*Li
 	defer resp.Body.Close()
 	if resp.StatusCode == http.StatusOK {
 		var out ListSwarmRunsResponse
-		if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		if err := c.decodeBody(resp.Body, &out); err != nil {
 			return nil, fmt.Errorf("decode list swarm runs: %w", err)
 		}
 		return &out, nil
@@ -2805,7 +2976,7 @@ func (c *Client) GetSwarmRun(ctx context.Context, runID string) (*SwarmRunSnapsh
 	defer resp.Body.Close()
 	if resp.StatusCode == http.StatusOK {
 		var out SwarmRunSnapshot
-		if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		if err := c.decodeBody(resp.Body, &out); err != nil {
 			return nil, fmt.Errorf("decode swarm run: %w", err)
 		}
 		return &out, nil
@@ -2825,7 +2996,7 @@ func (c *Client) CancelSwarmRun(ctx context.Context, runID string) (*SwarmRunSna
 	defer resp.Body.Close()
 	if resp.StatusCode == http.StatusOK {
 		var out SwarmRunSnapshot
-		if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		if err := c.decodeBody(resp.Body, &out); err != nil {
 			return nil, fmt.Errorf("decode swarm run: %w", err)
 		}
 		return &out, nil
@@ -0,0 +1,1720 @@
+package acteon
+
+// Wire-surface tests for the REST methods in `client.go`, run
+// against the capturing `httptest.Server` from the A2A tests. The
+// contract under test: URLs, methods, and query strings match the
+// server routes, optional request fields drop out of the body, and
+// responses decode into the typed models.
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCreateRetentionSendsArchiveSink(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 201, map[string]any{
+		"id":        "ret-1",
+		"namespace": "ns",
+		"tenant":    "t",
+		"archive":   map[string]any{"s3_bucket": "audit-archive", "s3_prefix": "acme/", "format": "jsonl"},
+	})
+	defer teardown()
+	c := NewClient(url)
+
+	policy, err := c.CreateRetention(context.Background(), &CreateRetentionRequest{
+		Namespace:       "ns",
+		Tenant:          "t",
+		AuditTTLSeconds: 86400,
+		Archive:         &RetentionArchiveConfig{S3Bucket: "audit-archive", S3Prefix: "acme/", Format: "jsonl"},
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	s := string(captured.body)
+	if !strings.Contains(s, `"archive":{"s3_bucket":"audit-archive","s3_prefix":"acme/","format":"jsonl"}`) {
+		t.Errorf("archive missing from body: %s", s)
+	}
+	if policy.Archive == nil || policy.Archive.S3Bucket != "audit-archive" {
+		t.Errorf("archive round-trip: got %+v", policy.Archive)
+	}
+}
+
+func TestUpdateRetentionOmitsArchiveWhenUnset(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{"id": "ret-1"})
+	defer teardown()
+	c := NewClient(url)
+
+	enabled := false
+	if _, err := c.UpdateRetention(context.Background(), "ret-1", &UpdateRetentionRequest{Enabled: &enabled}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	s := string(captured.body)
+	if strings.Contains(s, "archive") {
+		t.Errorf("archive fields should be omitted: %s", s)
+	}
+}
+
+func TestGetRetentionArchiveStatus(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{
+		"retention_id":         "ret-1",
+		"last_run_at":          "2026-06-10T00:00:00Z",
+		"records_archived":     1200,
+		"consecutive_failures": 1,
+		"recent_failures": []map[string]any{
+			{"at": "2026-06-10T00:00:00Z", "error": "AccessDenied"},
+		},
+	})
+	defer teardown()
+	c := NewClient(url)
+
+	status, err := c.GetRetentionArchiveStatus(context.Background(), "ret-1")
+	if err != nil {
+		t.Fatalf("status: %v", err)
+	}
+	if captured.method != "GET" || captured.path != "/v1/retention/ret-1/archive/status" {
+		t.Errorf("route: got %s %s", captured.method, captured.path)
+	}
+	if status.RecordsArchived != 1200 || status.ConsecutiveFailures != 1 {
+		t.Errorf("status: got %+v", status)
+	}
+	if len(status.RecentFailures) != 1 || status.RecentFailures[0].Error != "AccessDenied" {
+		t.Errorf("failures: got %+v", status.RecentFailures)
+	}
+	if status.LastSuccessAt != nil {
+		t.Errorf("last_success_at should be nil, got %v", *status.LastSuccessAt)
+	}
+}
+
+func TestGetRetentionArchiveStatusNotFound(t *testing.T) {
+	url, _, teardown := newCapturingServer(t, 404, nil)
+	defer teardown()
+	c := NewClient(url)
+
+	_, err := c.GetRetentionArchiveStatus(context.Background(), "missing")
+	httpErr, ok := err.(*HTTPError)
+	if !ok || httpErr.Status != 404 {
+		t.Fatalf("expected 404 HTTPError, got %v", err)
+	}
+}
+
+func TestCheckQuotaURLAndBody(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{
+		"allowed": false,
+		"quotas": []map[string]any{{
+			"quota_id":         "q-1",
+			"limit":            100,
+			"used":             95,
+			"remaining":        5,
+			"would_exceed":     true,
+			"overage_behavior": "block",
+			"resets_at":        "2026-06-11T00:00:00Z",
+		}},
+	})
+	defer teardown()
+	c := NewClient(url)
+
+	result, err := c.CheckQuota(context.Background(), "ns", "t", 10)
+	if err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if captured.method != "POST" || captured.path != "/v1/quotas/check" {
+		t.Errorf("route: got %s %s", captured.method, captured.path)
+	}
+	if s := string(captured.body); !strings.Contains(s, `"count":10`) || !strings.Contains(s, `"tenant":"t"`) {
+		t.Errorf("body: got %s", s)
+	}
+	if result.Allowed || len(result.Quotas) != 1 || !result.Quotas[0].WouldExceed {
+		t.Errorf("result: got %+v", result)
+	}
+}
+
+func TestCreateThrottleURLAndBody(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 201, map[string]any{
+		"id":             "th-1",
+		"namespace":      "ns",
+		"tenant":         "t",
+		"provider":       "slack",
+		"max_count":      20,
+		"window_seconds": 60,
+		"enabled":        true,
+		"created_at":     "2026-06-11T00:00:00Z",
+		"updated_at":     "2026-06-11T00:00:00Z",
+	})
+	defer teardown()
+	c := NewClient(url)
+
+	throttle, err := c.CreateThrottle(context.Background(), &CreateThrottleRequest{
+		Namespace: "ns", Tenant: "t", Provider: "slack", MaxCount: 20, WindowSeconds: 60,
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if captured.method != "POST" || captured.path != "/v1/throttles" {
+		t.Errorf("route: got %s %s", captured.method, captured.path)
+	}
+	if s := string(captured.body); !strings.Contains(s, `"max_count":20`) || strings.Contains(s, "action_type") {
+		t.Errorf("body: got %s", s)
+	}
+	if throttle.ID != "th-1" || throttle.Window() != time.Minute {
+		t.Errorf("throttle: got %+v", throttle)
+	}
+}
+
+func TestDeleteThrottleScopesQuery(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 404, map[string]any{})
+	defer teardown()
+	c := NewClient(url)
+
+	err := c.DeleteThrottle(context.Background(), "th-1", "ns", "t")
+	if captured.method != "DELETE" || captured.path != "/v1/throttles/th-1" || captured.query != "namespace=ns&tenant=t" {
+		t.Errorf("route: got %s %s?%s", captured.method, captured.path, captured.query)
+	}
+	httpErr, ok := err.(*HTTPError)
+	if !ok || httpErr.Status != 404 {
+		t.Fatalf("expected 404 HTTPError, got %v", err)
+	}
+}
+
+func TestStartChainURLAndBody(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 201, map[string]any{
+		"chain_id":   "chain-1",
+		"chain_name": "deploy",
+		"status":     "running",
+	})
+	defer teardown()
+	c := NewClient(url)
+
+	result, err := c.StartChain(context.Background(), StartChainRequest{
+		Name:      "deploy",
+		Namespace: "ns",
+		Tenant:    "t",
+		Input:     map[string]any{"version": "1.2.3"},
+	})
+	if err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	if captured.method != "POST" || captured.path != "/v1/chains/start" {
+		t.Errorf("route: got %s %s", captured.method, captured.path)
+	}
+	s := string(captured.body)
+	if !strings.Contains(s, `"name":"deploy"`) || !strings.Contains(s, `"input":{"version":"1.2.3"}`) {
+		t.Errorf("body: got %s", s)
+	}
+	if strings.Contains(s, "labels") {
+		t.Errorf("labels should be omitted when empty: %s", s)
+	}
+	if result.ChainID != "chain-1" {
+		t.Errorf("chain id: got %q", result.ChainID)
+	}
+}
+
+func TestStartChainUnknownDefinition(t *testing.T) {
+	url, _, teardown := newCapturingServer(t, 404, nil)
+	defer teardown()
+	c := NewClient(url)
+
+	_, err := c.StartChain(context.Background(), StartChainRequest{Name: "nope", Namespace: "ns", Tenant: "t"})
+	httpErr, ok := err.(*HTTPError)
+	if !ok || httpErr.Status != 404 || !strings.Contains(httpErr.Message, "nope") {
+		t.Fatalf("expected 404 naming the definition, got %v", err)
+	}
+}
+
+func TestPutChainDefinitionURLAndBody(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{"name": "team/chain", "steps": []any{}})
+	defer teardown()
+	c := NewClient(url)
+
+	def := &ChainDefinition{
+		Name:  "team/chain",
+		Steps: []ChainStepDefinition{{Name: "s1", Provider: "slack", ActionType: "post", PayloadTemplate: map[string]any{}}},
+	}
+	got, err := c.PutChainDefinition(context.Background(), def)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "team/chain" {
+		t.Errorf("Name = %q", got.Name)
+	}
+	if captured.method != "PUT" || captured.path != "/v1/chains/definitions/team%2Fchain" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	if !strings.Contains(string(captured.body), `"provider":"slack"`) {
+		t.Errorf("body = %s", captured.body)
+	}
+}
+
+func TestPutChainDefinitionValidationError(t *testing.T) {
+	url, _, teardown := newCapturingServer(t, 422, map[string]any{
+		"error":   "chain validation failed",
+		"details": []string{"unknown step", "cycle detected"},
+	})
+	defer teardown()
+	c := NewClient(url)
+
+	_, err := c.PutChainDefinition(context.Background(), &ChainDefinition{Name: "x"})
+	apiErr, ok := err.(*APIError)
+	if !ok || apiErr.Code != "VALIDATION_ERROR" || !strings.Contains(apiErr.Message, "cycle detected") {
+		t.Fatalf("expected validation APIError, got %v", err)
+	}
+}
+
+func TestRetryChainURLAndBody(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{
+		"chain_id": "ch-1", "status": "running", "current_step": 3,
+	})
+	defer teardown()
+	c := NewClient(url)
+
+	detail, err := c.RetryChain(context.Background(), "ch-1", RetryOptions{
+		Namespace:     "ns",
+		Tenant:        "t",
+		FromStep:      "charge",
+		OverrideInput: map[string]any{"amount": 10},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if detail.Status != "running" {
+		t.Errorf("Status = %q", detail.Status)
+	}
+	if captured.method != "POST" || captured.path != "/v1/chains/ch-1/retry" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	for _, want := range []string{`"from_step":"charge"`, `"override_input":{"amount":10}`, `"namespace":"ns"`} {
+		if !strings.Contains(string(captured.body), want) {
+			t.Errorf("body %s missing %s", captured.body, want)
+		}
+	}
+}
+
+func TestRetryChainNotFailed(t *testing.T) {
+	url, _, teardown := newCapturingServer(t, 409, nil)
+	defer teardown()
+	c := NewClient(url)
+
+	_, err := c.RetryChain(context.Background(), "ch-1", RetryOptions{Namespace: "ns", Tenant: "t"})
+	httpErr, ok := err.(*HTTPError)
+	if !ok || httpErr.Status != 409 {
+		t.Fatalf("expected 409 HTTPError, got %v", err)
+	}
+}
+
+func TestProvideChainStepInputURLAndBody(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{"chain_id": "ch-1", "status": "running"})
+	defer teardown()
+	c := NewClient(url)
+
+	_, err := c.ProvideChainStepInput(context.Background(), "ch-1", "approvals/manager", &ProvideStepInputRequest{
+		Namespace: "ns",
+		Tenant:    "t",
+		Payload:   map[string]any{"approved": true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != "POST" || captured.path != "/v1/chains/ch-1/steps/approvals%2Fmanager/input" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	if !strings.Contains(string(captured.body), `"payload":{"approved":true}`) {
+		t.Errorf("body = %s", captured.body)
+	}
+}
+
+func TestProvideChainStepInputNotAwaiting(t *testing.T) {
+	url, _, teardown := newCapturingServer(t, 409, nil)
+	defer teardown()
+	c := NewClient(url)
+
+	_, err := c.ProvideChainStepInput(context.Background(), "ch-1", "s", &ProvideStepInputRequest{Namespace: "ns", Tenant: "t"})
+	httpErr, ok := err.(*HTTPError)
+	if !ok || httpErr.Status != 409 || httpErr.Message != "Step is not awaiting input" {
+		t.Fatalf("expected 409 HTTPError, got %v", err)
+	}
+}
+
+func TestGetChainMetrics(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{
+		"chain_name":     "etl",
+		"window_seconds": 3600,
+		"started":        10,
+		"steps": []map[string]any{
+			{"name": "extract", "executions": 10, "duration": map[string]any{"p99_ms": 120.0}},
+			{"name": "load", "executions": 9, "failures": 1, "failure_rate": 0.11, "duration": map[string]any{"p99_ms": 950.0}},
+			{"name": "notify", "executions": 0},
+		},
+	})
+	defer teardown()
+	c := NewClient(url)
+
+	m, err := c.GetChainMetrics(context.Background(), "etl", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.path != "/v1/chains/definitions/etl/metrics" || captured.query != "window_seconds=3600" {
+		t.Errorf("request = %s?%s", captured.path, captured.query)
+	}
+	if s := m.SlowestStep(); s == nil || s.Name != "load" {
+		t.Errorf("SlowestStep = %+v", s)
+	}
+}
+
+func TestValidateChainDefinitionInvalid(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 422, map[string]any{
+		"error":   "chain validation failed",
+		"details": []string{"step `b` has default_next targeting non-existent step `z`"},
+	})
+	defer teardown()
+	c := NewClient(url)
+
+	res, err := c.ValidateChainDefinition(context.Background(), &ChainDefinition{Name: "x"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != "POST" || captured.path != "/v1/chains/definitions/validate" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	if res.Valid || len(res.Errors) != 1 {
+		t.Errorf("result = %+v", res)
+	}
+}
+
+func TestListDlqQuery(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{
+		"entries": []map[string]any{{"action_id": "a-1", "provider": "slack", "attempts": 3}},
+		"total":   41,
+		"limit":   20,
+		"offset":  20,
+	})
+	defer teardown()
+	c := NewClient(url)
+
+	page, err := c.ListDlq(context.Background(), DlqFilter{Namespace: "ns", Provider: "slack", Limit: 20, Offset: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != "GET" || captured.path != "/v1/dlq" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	if captured.query != "limit=20&namespace=ns&offset=20&provider=slack" {
+		t.Errorf("query = %s", captured.query)
+	}
+	if page.Total != 41 || len(page.Entries) != 1 || page.Entries[0].ActionID != "a-1" {
+		t.Errorf("page = %+v", page)
+	}
+}
+
+func TestRetryDlqEntry(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{"action_id": "a-1", "success": true, "outcome": "executed"})
+	defer teardown()
+	c := NewClient(url)
+
+	res, err := c.RetryDlqEntry(context.Background(), "a-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != "POST" || captured.path != "/v1/dlq/a-1/retry" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	if !res.Success || res.Outcome != "executed" {
+		t.Errorf("result = %+v", res)
+	}
+}
+
+func TestRetryDlqPagesAndReportsPerEntry(t *testing.T) {
+	var mu sync.Mutex
+	retried := map[string]bool{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/v1/dlq":
+			if r.URL.Query().Get("provider") != "slack" {
+				t.Errorf("filter not forwarded: %s", r.URL.RawQuery)
+			}
+			if r.URL.Query().Get("offset") == "" {
+				_, _ = w.Write([]byte(`{"entries":[{"action_id":"a-1"},{"action_id":"a-2"}],"total":3}`))
+			} else {
+				_, _ = w.Write([]byte(`{"entries":[{"action_id":"a-3"}],"total":3}`))
+			}
+		case strings.HasSuffix(r.URL.Path, "/retry"):
+			id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v1/dlq/"), "/retry")
+			mu.Lock()
+			retried[id] = true
+			mu.Unlock()
+			if id == "a-2" {
+				_, _ = w.Write([]byte(`{"action_id":"a-2","success":false,"error":"provider unavailable"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"action_id":"` + id + `","success":true}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	// Rates beyond the clock's resolution are clamped, not a panic.
+	for _, rate := range []float64{1000, 1e12} {
+		clear(retried)
+		report, err := NewClient(srv.URL).RetryDlq(context.Background(), DlqFilter{Provider: "slack", Limit: 2},
+			DlqRetryOptions{Concurrency: 2, MaxRate: rate})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(retried) != 3 {
+			t.Errorf("rate %g: retried %v, want 3 entries", rate, retried)
+		}
+		if report.Succeeded != 2 || report.Failed != 1 || report.Results[1].Error != "provider unavailable" {
+			t.Errorf("rate %g: report = %+v", rate, report)
+		}
+	}
+}
+
+func TestDeleteDlqEntry(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 204, nil)
+	defer teardown()
+	c := NewClient(url)
+
+	if err := c.DeleteDlqEntry(context.Background(), "a-1"); err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != "DELETE" || captured.path != "/v1/dlq/a-1" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+}
+
+func TestSetDlqPolicyEncodesSeconds(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{
+		"enabled": true, "max_retries": 5, "backoff_seconds": 30, "backoff_strategy": "exponential", "ttl_seconds": 86400,
+	})
+	defer teardown()
+	c := NewClient(url)
+
+	got, err := c.SetDlqPolicy(context.Background(), DlqPolicy{
+		Enabled:         true,
+		MaxRetries:      5,
+		Backoff:         30 * time.Second,
+		BackoffStrategy: "exponential",
+		TTL:             24 * time.Hour,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != "PUT" || captured.path != "/v1/dlq/policy" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	for _, want := range []string{`"backoff_seconds":30`, `"ttl_seconds":86400`, `"max_retries":5`} {
+		if !strings.Contains(string(captured.body), want) {
+			t.Errorf("body %s missing %s", captured.body, want)
+		}
+	}
+	if got.TTL != 24*time.Hour || got.Backoff != 30*time.Second {
+		t.Errorf("policy = %+v", got)
+	}
+}
+
+func TestGetDlqEntryDecodesDetail(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{
+		"action_id": "a-1",
+		"provider":  "webhook",
+		"error":     "connection refused",
+		"attempts":  2,
+		"action": map[string]any{
+			"id": "a-1", "namespace": "ns", "tenant": "t", "provider": "webhook", "action_type": "post",
+			"payload": map[string]any{"url": "https://old.example"}, "created_at": "2026-01-01T00:00:00Z",
+		},
+		"history": []map[string]any{
+			{"attempt": 1, "at": "2026-01-01T00:00:01Z", "error": "timeout"},
+			{"attempt": 2, "at": "2026-01-01T00:00:05Z", "error": "connection refused", "status_code": 502},
+		},
+		"audit_record_id": "aud-9",
+	})
+	defer teardown()
+	c := NewClient(url)
+
+	d, err := c.GetDlqEntry(context.Background(), "a-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.path != "/v1/dlq/a-1" {
+		t.Errorf("path = %s", captured.path)
+	}
+	if d.ActionID != "a-1" || d.Action.Payload["url"] != "https://old.example" || len(d.History) != 2 {
+		t.Errorf("detail = %+v", d)
+	}
+	if d.History[1].StatusCode == nil || *d.History[1].StatusCode != 502 || d.AuditRecordID == nil {
+		t.Errorf("history = %+v", d.History)
+	}
+}
+
+func TestGetDlqEntryNotFound(t *testing.T) {
+	url, _, teardown := newCapturingServer(t, 404, nil)
+	defer teardown()
+
+	d, err := NewClient(url).GetDlqEntry(context.Background(), "gone")
+	if d != nil || err != nil {
+		t.Errorf("expected (nil, nil), got (%v, %v)", d, err)
+	}
+}
+
+func TestPurgeDlq(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{"purged": 17})
+	defer teardown()
+	c := NewClient(url)
+
+	n, err := c.PurgeDlq(context.Background(), DlqPurgeFilter{OlderThan: 7 * 24 * time.Hour, Provider: "webhook"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 17 {
+		t.Errorf("purged = %d", n)
+	}
+	if captured.method != "POST" || captured.path != "/v1/dlq/purge" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	if string(captured.body) != `{"older_than_seconds":604800,"provider":"webhook"}` {
+		t.Errorf("body = %s", captured.body)
+	}
+}
+
+func TestPurgeDlqRejectsEmptyFilter(t *testing.T) {
+	c := NewClient("http://127.0.0.1:0")
+	if _, err := c.PurgeDlq(context.Background(), DlqPurgeFilter{}); err == nil {
+		t.Fatal("expected an error for an empty purge filter")
+	}
+}
+
+func TestDlqStatsDetailed(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{
+		"enabled":       true,
+		"count":         5,
+		"by_provider":   map[string]int{"slack": 3, "webhook": 2},
+		"by_tenant":     []map[string]any{{"namespace": "ns", "tenant": "acme", "count": 5}},
+		"by_error_code": map[string]int{"TIMEOUT": 4, "HTTP_502": 1},
+	})
+	defer teardown()
+
+	stats, err := NewClient(url).DlqStatsDetailed(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.path != "/v1/dlq/stats/detailed" {
+		t.Errorf("path = %s", captured.path)
+	}
+	if stats.ByProvider["slack"] != 3 || stats.ByTenant[0].Tenant != "acme" || stats.ByErrorCode["TIMEOUT"] != 4 {
+		t.Errorf("stats = %+v", stats)
+	}
+}
+
+func TestSetPluginEnabled(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{"name": "guard", "enabled": false})
+	defer teardown()
+
+	p, err := NewClient(url).SetPluginEnabled(context.Background(), "guard", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != "PATCH" || captured.path != "/v1/plugins/guard" || string(captured.body) != `{"enabled":false}` {
+		t.Errorf("request = %s %s %s", captured.method, captured.path, captured.body)
+	}
+	if p.Enabled {
+		t.Error("expected plugin to be disabled")
+	}
+}
+
+func TestUpdatePluginConfig(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{"name": "guard", "config": map[string]any{"timeout_ms": 50}})
+	defer teardown()
+
+	p, err := NewClient(url).UpdatePluginConfig(context.Background(), "guard", &WasmPluginConfig{TimeoutMs: ptr(int64(50))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != "PUT" || captured.path != "/v1/plugins/guard/config" || string(captured.body) != `{"timeout_ms":50}` {
+		t.Errorf("request = %s %s %s", captured.method, captured.path, captured.body)
+	}
+	if p.Config == nil || *p.Config.TimeoutMs != 50 {
+		t.Errorf("config = %+v", p.Config)
+	}
+}
+
+func TestListPluginVersions(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{
+		"versions": []map[string]any{
+			{"version": 3, "module_sha256": "ccc", "active": false},
+			{"version": 2, "module_sha256": "bbb", "active": true},
+		},
+		"count": 2,
+	})
+	defer teardown()
+
+	res, err := NewClient(url).ListPluginVersions(context.Background(), "guard")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.path != "/v1/plugins/guard/versions" || len(res.Versions) != 2 || !res.Versions[1].Active {
+		t.Errorf("path = %s, res = %+v", captured.path, res)
+	}
+}
+
+func TestRollbackPlugin(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{"name": "guard", "active_version": 2})
+	defer teardown()
+
+	p, err := NewClient(url).RollbackPlugin(context.Background(), "guard", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != "POST" || captured.path != "/v1/plugins/guard/rollback" || string(captured.body) != `{"version":2}` {
+		t.Errorf("request = %s %s %s", captured.method, captured.path, captured.body)
+	}
+	if p.ActiveVersion == nil || *p.ActiveVersion != 2 {
+		t.Errorf("ActiveVersion = %v", p.ActiveVersion)
+	}
+}
+
+func TestInvokePluginBatch(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{
+		"results": []map[string]any{{"verdict": true}, {"verdict": false, "error": "trap"}},
+	})
+	defer teardown()
+
+	res, err := NewClient(url).InvokePluginBatch(context.Background(), "guard", []PluginInvocationRequest{
+		{Input: map[string]any{"a": 1}},
+		{Input: map[string]any{"a": 2}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.path != "/v1/plugins/guard/invoke/batch" || !strings.Contains(string(captured.body), `"invocations":[`) {
+		t.Errorf("request = %s %s", captured.path, captured.body)
+	}
+	if len(res.Results) != 2 || !res.Results[0].Verdict || res.Results[1].Error == nil {
+		t.Errorf("results = %+v", res.Results)
+	}
+}
+
+func TestSyncPluginFromRegistry(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 201, map[string]any{"name": "pii-guard", "status": "active"})
+	defer teardown()
+
+	_, err := NewClient(url).SyncPluginFromRegistry(context.Background(), PluginRef{
+		Registry: "ghcr.io",
+		Repo:     "acme/plugins/pii-guard",
+		Tag:      "v1.2.0",
+		Digest:   "sha256:abc123",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"registry":"ghcr.io","repository":"acme/plugins/pii-guard","tag":"v1.2.0","digest":"sha256:abc123","name":"pii-guard"}`
+	if captured.path != "/v1/plugins/sync" || string(captured.body) != want {
+		t.Errorf("request = %s %s", captured.path, captured.body)
+	}
+}
+
+func TestSyncPluginFromRegistryRequiresTagOrDigest(t *testing.T) {
+	_, err := NewClient("http://127.0.0.1:0").SyncPluginFromRegistry(context.Background(), PluginRef{Registry: "ghcr.io", Repo: "x"})
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.IsRetryable() || verr.Problems[0].Field != "tag" {
+		t.Fatalf("err = %v, want a ValidationError for the missing tag", err)
+	}
+}
+
+func TestRegisterPluginSendsSignature(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 201, map[string]any{
+		"name":         "guard",
+		"verification": map[string]any{"status": "verified", "verified": true, "public_key_ref": "release-2026"},
+	})
+	defer teardown()
+
+	p, err := NewClient(url).RegisterPlugin(context.Background(), &RegisterPluginRequest{
+		Name:         "guard",
+		WasmPath:     "/plugins/guard.wasm",
+		Signature:    "MEUCIQ==",
+		PublicKeyRef: "release-2026",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"signature":"MEUCIQ=="`, `"public_key_ref":"release-2026"`} {
+		if !strings.Contains(string(captured.body), want) {
+			t.Errorf("body %s missing %s", captured.body, want)
+		}
+	}
+	if p.Verification == nil || p.Verification.Status != PluginVerified {
+		t.Errorf("verification = %+v", p.Verification)
+	}
+}
+
+func TestVerifyPlugin(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{"status": "invalid", "verified": false, "error": "signature mismatch"})
+	defer teardown()
+
+	v, err := NewClient(url).VerifyPlugin(context.Background(), "guard")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != "POST" || captured.path != "/v1/plugins/guard/verify" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	if v.Verified || v.Status != PluginInvalid {
+		t.Errorf("verification = %+v", v)
+	}
+}
+
+func TestInspectPlugin(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{
+		"name": "guard",
+		"exports": []map[string]any{
+			{"name": "evaluate", "kind": "function", "params": []string{"i32", "i32"}, "results": []string{"i32"}},
+			{"name": "memory", "kind": "memory"},
+		},
+		"required_host_functions": []string{"log", "http_get"},
+		"memory":                  map[string]any{"initial_pages": 32},
+	})
+	defer teardown()
+
+	info, err := NewClient(url).InspectPlugin(context.Background(), "guard")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.path != "/v1/plugins/guard/inspect" || len(info.Exports) != 2 {
+		t.Errorf("path = %s, info = %+v", captured.path, info)
+	}
+	cfg := &WasmPluginConfig{AllowedHostFunctions: []string{"log"}, MemoryLimitBytes: ptr(int64(1 << 20))}
+	if missing := info.MissingHostFunctions(cfg); len(missing) != 1 || missing[0] != "http_get" {
+		t.Errorf("MissingHostFunctions = %v", missing)
+	}
+	if !info.ExceedsMemoryLimit(cfg) {
+		t.Error("32 pages (2 MiB) should exceed a 1 MiB limit")
+	}
+}
+
+func TestValidateTemplate(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 422, map[string]any{
+		"valid":     false,
+		"errors":    []map[string]any{{"line": 2, "column": 7, "message": "unexpected end of template"}},
+		"variables": []string{"user.name"},
+	})
+	defer teardown()
+
+	res, err := NewClient(url).ValidateTemplate(context.Background(), "Hi\n{% if x %}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != "POST" || captured.path != "/v1/templates/validate" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	if !strings.Contains(string(captured.body), `"content":"Hi\n{% if x %}"`) {
+		t.Errorf("body = %s", captured.body)
+	}
+	if res.Valid || len(res.Errors) != 1 || res.Errors[0].Line != 2 || res.Variables[0] != "user.name" {
+		t.Errorf("result = %+v", res)
+	}
+}
+
+func TestCreateTemplateWithValidationRejectsInvalid(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 422, map[string]any{
+		"valid":  false,
+		"errors": []map[string]any{{"line": 1, "column": 4, "message": "unknown filter"}},
+	})
+	defer teardown()
+
+	_, err := NewClient(url, WithTemplateValidation()).CreateTemplate(context.Background(), &CreateTemplateRequest{Name: "t", Content: "{{ x | nope }}"})
+	var verr *TemplateValidationError
+	if !errors.As(err, &verr) || verr.Result.Errors[0].Column != 4 {
+		t.Fatalf("err = %v", err)
+	}
+	if captured.path != "/v1/templates/validate" {
+		t.Errorf("template should not be stored; last path = %s", captured.path)
+	}
+}
+
+func TestResolveProfile(t *testing.T) {
+	profile := TemplateProfileInfo{ID: "p1", Name: "alert", Namespace: "ns", Tenant: "t",
+		Fields: map[string]TemplateProfileField{"subject": InlineField("Alert: {{ title }}"), "body": RefField("body")}}
+	// The referenced template sits past the gateway's first page.
+	var templates []TemplateInfo
+	for i := range 120 {
+		templates = append(templates, TemplateInfo{ID: fmt.Sprintf("f%d", i), Name: fmt.Sprintf("filler-%d", i)})
+	}
+	templates = append(templates, TemplateInfo{ID: "t1", Name: "body", Content: "Severity {{ severity }}"})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/templates/profiles/p1":
+			_ = json.NewEncoder(w).Encode(profile)
+		case "/v1/templates":
+			if r.URL.Query().Get("namespace") != "ns" || r.URL.Query().Get("tenant") != "t" {
+				t.Errorf("templates query = %s", r.URL.RawQuery)
+			}
+			_ = json.NewEncoder(w).Encode(ListTemplatesResponse{Templates: servePage(templates, r)})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	c := NewClient(srv.URL)
+
+	resolved, err := c.ResolveProfile(context.Background(), "p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body := resolved.Fields["body"]; body.Ref != "body" || body.Template == nil || body.Template.ID != "t1" {
+		t.Errorf("body field = %+v", body)
+	}
+	out, err := resolved.RenderLocal(map[string]any{"title": "disk", "severity": "high"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out["subject"] != "Alert: disk" || out["body"] != "Severity high" {
+		t.Errorf("rendered = %+v", out)
+	}
+
+	if missing, err := c.ResolveProfile(context.Background(), "nope"); missing != nil || err != nil {
+		t.Errorf("missing profile = %+v, %v", missing, err)
+	}
+}
+
+func TestGetTemplateUsage(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{
+		"template_id":    "t1",
+		"template_name":  "body",
+		"window_seconds": 604800,
+		"total_renders":  12,
+		"last_used_at":   "2026-10-01T12:00:00Z",
+		"scopes": []map[string]any{
+			{"namespace": "ns", "tenant": "a", "renders": 10, "last_used_at": "2026-10-01T12:00:00Z"},
+			{"namespace": "ns", "tenant": "b", "renders": 2},
+		},
+	})
+	defer teardown()
+
+	usage, err := NewClient(url).GetTemplateUsage(context.Background(), "t1", 7*24*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.path != "/v1/templates/t1/usage" || captured.query != "window_seconds=604800" {
+		t.Errorf("request = %s?%s", captured.path, captured.query)
+	}
+	if usage.Unused() || len(usage.Scopes) != 2 || usage.Scopes[1].LastUsedAt != nil {
+		t.Errorf("usage = %+v", usage)
+	}
+}
+
+func TestListProviders(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{
+		"providers": []map[string]any{{
+			"name":                 "email",
+			"type":                 "email",
+			"supports_attachments": true,
+			"action_types": []map[string]any{{
+				"name":           "send_email",
+				"payload_schema": map[string]any{"type": "object", "required": []string{"to", "subject"}},
+			}},
+		}},
+		"count": 1,
+	})
+	defer teardown()
+
+	res, err := NewClient(url).ListProviders(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != "GET" || captured.path != "/v1/providers" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	email := res.Provider("email")
+	if email == nil || !email.SupportsAttachments {
+		t.Fatalf("providers = %+v", res.Providers)
+	}
+	at := email.ActionType("send_email")
+	if at == nil || !strings.Contains(string(at.PayloadSchema), `"required":["to","subject"]`) {
+		t.Errorf("action type = %+v", at)
+	}
+	if res.Provider("slack") != nil || email.ActionType("nope") != nil {
+		t.Error("lookups should miss unknown names")
+	}
+}
+
+func TestCreateProviderConfigURLAndBody(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 201, map[string]any{
+		"name": "slack-eu", "type": "slack", "tenant": "acme", "enabled": true,
+		"config": map[string]any{"token": "***"},
+	})
+	defer teardown()
+
+	cfg, err := NewClient(url).CreateProviderConfig(context.Background(), &CreateProviderConfigRequest{
+		Name: "slack-eu", Type: "slack", Tenant: "acme", Config: map[string]any{"token": "xoxb-1"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != "POST" || captured.path != "/v1/providers/configs" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	if !strings.Contains(string(captured.body), `"config":{"token":"xoxb-1"}`) {
+		t.Errorf("body = %s", captured.body)
+	}
+	if cfg.Tenant == nil || *cfg.Tenant != "acme" || !cfg.Enabled {
+		t.Errorf("config = %+v", cfg)
+	}
+}
+
+func TestUpdateProviderConfigOmitsUnsetFields(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{"name": "smtp/acme", "type": "email"})
+	defer teardown()
+
+	if _, err := NewClient(url).UpdateProviderConfig(context.Background(), "smtp/acme", &UpdateProviderConfigRequest{Enabled: ptr(false)}); err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != "PUT" || captured.path != "/v1/providers/configs/smtp%2Facme" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	if string(captured.body) != `{"enabled":false}` {
+		t.Errorf("body = %s", captured.body)
+	}
+}
+
+func TestDeleteProviderConfigNotFound(t *testing.T) {
+	url, _, teardown := newCapturingServer(t, 404, nil)
+	defer teardown()
+
+	err := NewClient(url).DeleteProviderConfig(context.Background(), "gone")
+	var herr *HTTPError
+	if !errors.As(err, &herr) || herr.Status != 404 {
+		t.Errorf("err = %v", err)
+	}
+}
+
+func TestTestProviderConnectivity(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{
+		"provider": "slack-eu", "mode": "connectivity", "success": false, "latency_ms": 41.5,
+		"checks": []map[string]any{
+			{"name": "tls", "passed": true},
+			{"name": "auth", "passed": false, "message": "invalid_auth"},
+		},
+	})
+	defer teardown()
+
+	res, err := NewClient(url).TestProvider(context.Background(), "slack-eu", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != "POST" || captured.path != "/v1/providers/slack-eu/test" || string(captured.body) != "{}" {
+		t.Errorf("request = %s %s %s", captured.method, captured.path, captured.body)
+	}
+	failed := res.FailedChecks()
+	if res.Success || len(failed) != 1 || failed[0].Name != "auth" {
+		t.Errorf("result = %+v", res)
+	}
+}
+
+func TestTestProviderSendsSampleAction(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{"provider": "email", "mode": "test_send", "success": true})
+	defer teardown()
+
+	action := NewAction("ns", "t", "email", "send_email", map[string]any{"to": "a@example.com"})
+	if _, err := NewClient(url).TestProvider(context.Background(), "email", action); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(captured.body), `"action":{"id":"`+action.ID+`"`) {
+		t.Errorf("body = %s", captured.body)
+	}
+}
+
+func TestStrictDecodingRejectsUnknownFields(t *testing.T) {
+	url, _, teardown := newCapturingServer(t, 200, map[string]any{
+		"retention_id":         "ret-1",
+		"records_archived":     3,
+		"consecutive_failures": 0,
+		"archive_region":       "eu-west-1",
+	})
+	defer teardown()
+
+	if _, err := NewClient(url).GetRetentionArchiveStatus(context.Background(), "ret-1"); err != nil {
+		t.Fatalf("lenient: %v", err)
+	}
+	_, err := NewClient(url, WithStrictDecoding()).GetRetentionArchiveStatus(context.Background(), "ret-1")
+	if err == nil || !strings.Contains(err.Error(), "archive_region") {
+		t.Fatalf("strict: expected unknown field error, got %v", err)
+	}
+}
+
+func TestDispatchPreservesUnknownOutcome(t *testing.T) {
+	url, _, teardown := newCapturingServer(t, 200, map[string]any{
+		"Deferred": map[string]any{"until": "2026-06-10T00:00:00Z"},
+	})
+	defer teardown()
+
+	outcome, err := NewClient(url).Dispatch(context.Background(), NewAction("ns", "t", "email", "send", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !outcome.IsUnknown() || !strings.Contains(string(outcome.Raw), `"Deferred"`) {
+		t.Fatalf("outcome = %+v", outcome)
+	}
+	data, err := json.Marshal(outcome)
+	if err != nil || string(data) != string(outcome.Raw) {
+		t.Errorf("re-marshal = %s, %v", data, err)
+	}
+}
+
+func TestDispatchBatchCorrelatesResults(t *testing.T) {
+	url, _, teardown := newCapturingServer(t, 200, []any{
+		map[string]any{"Executed": map[string]any{"status": "success", "body": map[string]any{}}},
+		map[string]any{"error": map[string]any{"code": "SIGNATURE_INVALID", "message": "bad signature"}},
+		map[string]any{"Failed": map[string]any{"code": "TIMEOUT", "message": "slow", "retryable": true, "attempts": 3}},
+	})
+	defer teardown()
+
+	actions := []*Action{
+		NewAction("ns", "t", "email", "send", nil),
+		NewAction("ns", "t", "email", "send", nil),
+		NewAction("ns", "t", "email", "send", nil),
+	}
+	results, err := NewClient(url).DispatchBatch(context.Background(), actions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, r := range results {
+		if r.Index != i || r.ActionID != actions[i].ID {
+			t.Errorf("result %d: index %d, action %q", i, r.Index, r.ActionID)
+		}
+	}
+
+	failed := results.Failed()
+	if len(failed) != 2 || failed[0].Index != 1 || failed[1].Index != 2 {
+		t.Errorf("failed = %+v", failed)
+	}
+	if r, ok := results.ByActionID()[actions[2].ID]; !ok || !r.Outcome.IsFailed() {
+		t.Errorf("ByActionID missing entry 2: %+v", r)
+	}
+
+	err = results.Error()
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) || len(batchErr.Failures) != 2 || len(batchErr.Results) != 3 {
+		t.Fatalf("Error() = %v", err)
+	}
+	if f := batchErr.Failures[1]; f.Index != 2 || f.ActionID != actions[2].ID || f.Err.Code != "TIMEOUT" {
+		t.Errorf("second failure = %+v", f)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != "SIGNATURE_INVALID" {
+		t.Errorf("errors.As should reach the first APIError, got %v", apiErr)
+	}
+	if batchErr.IsRetryable() {
+		t.Error("a signature failure is not retryable")
+	}
+	if results[:1].Error() != nil {
+		t.Error("Error() should be nil when nothing failed")
+	}
+}
+
+func TestDispatchBatchAtomic(t *testing.T) {
+	actions := []*Action{
+		NewAction("ns", "t", "email", "send", nil),
+		NewAction("ns", "t", "email", "send", nil),
+	}
+	executed := []any{
+		map[string]any{"Executed": map[string]any{}},
+		map[string]any{"Executed": map[string]any{}},
+	}
+	// gateway serves /v1/info with features and answers batches with
+	// status and body, acknowledging atomic mode when ack is set.
+	gateway := func(features []string, ack bool, status int, body any) (*Client, *[]string) {
+		var calls []string
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls = append(calls, r.URL.RequestURI())
+			if r.URL.Path == "/v1/info" {
+				_ = json.NewEncoder(w).Encode(map[string]any{"version": "1", "features": features})
+				return
+			}
+			if ack {
+				w.Header().Set("Acteon-Atomic-Batch", "true")
+			}
+			w.WriteHeader(status)
+			_ = json.NewEncoder(w).Encode(body)
+		}))
+		t.Cleanup(srv.Close)
+		return NewClient(srv.URL), &calls
+	}
+	ctx := context.Background()
+
+	c, calls := gateway([]string{FeatureAtomicBatch}, true, 200, executed)
+	for range 2 {
+		results, err := c.DispatchBatchAtomic(ctx, actions)
+		if err != nil || len(results) != 2 || results[1].ActionID != actions[1].ID {
+			t.Fatalf("results = %+v, %v", results, err)
+		}
+	}
+	if want := []string{"/v1/info", "/v1/dispatch/batch?atomic=true", "/v1/dispatch/batch?atomic=true"}; !slices.Equal(*calls, want) {
+		t.Errorf("requests = %v", *calls)
+	}
+
+	c, calls = gateway(nil, false, 200, executed)
+	if _, err := c.DispatchBatchAtomic(ctx, actions); !errors.Is(err, ErrAtomicBatchUnsupported) || len(*calls) != 1 {
+		t.Errorf("gateway without the feature: %v after %v", err, *calls)
+	}
+
+	c, _ = gateway([]string{FeatureAtomicBatch}, false, 200, executed)
+	if results, err := c.DispatchBatchAtomic(ctx, actions); !errors.Is(err, ErrAtomicBatchUnsupported) || len(results) != 2 {
+		t.Errorf("unacknowledged batch = %v, %v", results, err)
+	}
+
+	c, _ = gateway([]string{FeatureAtomicBatch}, false, http.StatusConflict, map[string]any{
+		"code": "BATCH_REJECTED", "message": "action 1 was suppressed", "index": 1,
+		"outcome": map[string]any{"Suppressed": map[string]any{"rule": "block-spam"}},
+	})
+	_, err := c.DispatchBatchAtomic(ctx, actions)
+	var rejected *AtomicBatchRejectedError
+	if !errors.As(err, &rejected) {
+		t.Fatalf("err = %v", err)
+	}
+	if rejected.Index != 1 || rejected.ActionID != actions[1].ID || rejected.Outcome.Rule != "block-spam" || rejected.IsRetryable() {
+		t.Errorf("rejection = %+v", rejected)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != "BATCH_REJECTED" {
+		t.Errorf("errors.As APIError = %v", apiErr)
+	}
+
+	routed := NewClient("http://default.invalid", WithRouting(map[string]string{"acme": "http://eu.invalid"}))
+	_, err = routed.DispatchBatchAtomic(ctx, []*Action{
+		NewAction("ns", "acme", "email", "send", nil),
+		NewAction("ns", "globex", "email", "send", nil),
+	})
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Errorf("cross-region atomic batch: %v", err)
+	}
+}
+
+func TestDispatchValidatesBeforeSending(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{"Deduplicated": nil})
+	defer teardown()
+	c := NewClient(url)
+
+	action := NewAction("ns", "", "", "send", nil)
+	action.Signature = "sig"
+	_, err := c.Dispatch(context.Background(), action)
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected ValidationError, got %v", err)
+	}
+	fields := make([]string, len(verr.Problems))
+	for i, p := range verr.Problems {
+		fields[i] = p.Field
+	}
+	if got := strings.Join(fields, ","); got != "tenant,provider,signer_id" {
+		t.Errorf("problem fields = %s", got)
+	}
+	if captured.method != "" {
+		t.Error("invalid action should not reach the server")
+	}
+
+	batch := []*Action{NewAction("ns", "t", "email", "send", nil), NewAction("ns", "t", "email", "", nil)}
+	_, err = c.DispatchBatch(context.Background(), batch)
+	if !errors.As(err, &verr) || len(verr.Problems) != 1 || verr.Problems[0].Field != "[1].action_type" {
+		t.Errorf("batch validation = %v", err)
+	}
+
+	if _, err := NewClient(url, WithoutActionValidation()).Dispatch(context.Background(), action); err != nil {
+		t.Errorf("validation should be skipped: %v", err)
+	}
+}
+
+func TestErrorResponseKeepsProxyBody(t *testing.T) {
+	page := "<html><body><h1>502 Bad Gateway</h1>" + strings.Repeat("x", 8<<10) + "</body></html>"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write([]byte(page))
+	}))
+	defer srv.Close()
+
+	_, err := NewClient(srv.URL).Dispatch(context.Background(), NewAction("ns", "t", "email", "send", nil))
+	var herr *HTTPError
+	if !errors.As(err, &herr) || herr.Status != 502 {
+		t.Fatalf("err = %v", err)
+	}
+	if herr.ContentType != "text/html" || !strings.HasPrefix(herr.Body, "<html><body><h1>502 Bad Gateway") {
+		t.Errorf("body = %q, content type = %q", herr.Body, herr.ContentType)
+	}
+	if len(herr.Body) != 4<<10 {
+		t.Errorf("body length = %d, want truncated to 4 KiB", len(herr.Body))
+	}
+	if !herr.IsRetryable() {
+		t.Error("502 should be retryable")
+	}
+}
+
+func TestErrorResponseParsesAlternativeEnvelopes(t *testing.T) {
+	cases := []struct {
+		name   string
+		status int
+		body   map[string]any
+		want   APIError
+	}{
+		{"acteon", 400, map[string]any{"code": "INVALID", "message": "bad tenant", "retryable": false},
+			APIError{Code: "INVALID", Message: "bad tenant"}},
+		{"problem+json", 503, map[string]any{"type": "https://example.com/probs/overloaded", "title": "Service Unavailable", "status": 503, "detail": "try again later"},
+			APIError{Code: "Service Unavailable", Message: "try again later", Retryable: true}},
+		{"problem+json without title", 422, map[string]any{"type": "about:blank", "detail": "missing field"},
+			APIError{Code: "about:blank", Message: "missing field"}},
+		{"bare error", 403, map[string]any{"error": "forbidden by policy"},
+			APIError{Code: "Forbidden", Message: "forbidden by policy"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			url, _, teardown := newCapturingServer(t, tc.status, tc.body)
+			defer teardown()
+
+			_, err := NewClient(url).Dispatch(context.Background(), NewAction("ns", "t", "email", "send", nil))
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || *apiErr != tc.want {
+				t.Errorf("err = %#v, want %#v", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestGetServerInfo(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{
+		"version":       "0.9.2",
+		"git_sha":       "4f1c2d9",
+		"features":      []string{"dlq", "wasm"},
+		"state_backend": "redis",
+		"audit_backend": "postgres",
+	})
+	defer teardown()
+
+	info, err := NewClient(url).GetServerInfo(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != "GET" || captured.path != "/v1/info" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	if info.Version != "0.9.2" || info.GitSHA != "4f1c2d9" || info.StateBackend != "redis" {
+		t.Errorf("info = %+v", info)
+	}
+	if !info.HasFeature(FeatureDLQ) || info.HasFeature(FeatureLLMGuardrail) {
+		t.Errorf("features = %v", info.Features)
+	}
+}
+
+func TestExportSubjectData(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{
+		"tenant": "acme", "identifiers": []string{"ada@example.com"},
+		"audit_records": []map[string]any{{"id": "a-1", "action_id": "act-1", "namespace": "alerts", "tenant": "acme"}},
+		"event_states":  []any{},
+		"approvals":     []any{},
+		"exported_at":   "2026-03-01T10:00:00Z",
+	})
+	defer teardown()
+
+	export, err := NewClient(url).ExportSubjectData(context.Background(), &SubjectQuery{
+		Tenant: "acme", Identifiers: []string{"ada@example.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != "POST" || captured.path != "/v1/compliance/subjects/export" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	if string(captured.body) != `{"tenant":"acme","identifiers":["ada@example.com"]}` {
+		t.Errorf("body = %s", captured.body)
+	}
+	if len(export.AuditRecords) != 1 || export.AuditRecords[0].ActionID != "act-1" {
+		t.Errorf("export = %+v", export)
+	}
+}
+
+func TestEraseSubjectDataRequiresIdentifiers(t *testing.T) {
+	called := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer srv.Close()
+
+	_, err := NewClient(srv.URL).EraseSubjectData(context.Background(), &SubjectQuery{Tenant: "acme", Identifiers: []string{" "}})
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Problems[0].Field != "identifiers[0]" {
+		t.Fatalf("err = %v", err)
+	}
+	if called {
+		t.Error("request sent despite invalid query")
+	}
+}
+
+func TestEraseSubjectDataReport(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{
+		"tenant": "acme", "identifiers": []string{"+15550100"},
+		"audit_records_redacted": 12, "event_states_deleted": 3, "approvals_deleted": 0,
+		"verified": false, "remaining": map[string]int{"audit": 1},
+		"erased_at": "2026-03-01T10:00:00Z",
+	})
+	defer teardown()
+
+	report, err := NewClient(url).EraseSubjectData(context.Background(), &SubjectQuery{Tenant: "acme", Identifiers: []string{"+15550100"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.path != "/v1/compliance/subjects/erase" {
+		t.Errorf("path = %s", captured.path)
+	}
+	if report.AuditRecordsRedacted != 12 || report.Verified || report.Remaining["audit"] != 1 {
+		t.Errorf("report = %+v", report)
+	}
+}
+
+func TestPutSecretSendsValueOnly(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 201, map[string]any{
+		"scope": "acme", "name": "pagerduty-token", "version": 1,
+		"created_at": "2026-03-01T10:00:00Z", "updated_at": "2026-03-01T10:00:00Z",
+	})
+	defer teardown()
+
+	secret, err := NewClient(url).PutSecret(context.Background(), "acme", "pagerduty-token", "s3cr3t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != "PUT" || captured.path != "/v1/secrets/acme/pagerduty-token" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	if string(captured.body) != `{"value":"s3cr3t"}` {
+		t.Errorf("body = %s", captured.body)
+	}
+	if secret.Ref() != "${secret:acme/pagerduty-token}" {
+		t.Errorf("ref = %s", secret.Ref())
+	}
+}
+
+func TestListSecretsScope(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{"secrets": []any{}, "count": 0})
+	defer teardown()
+
+	if _, err := NewClient(url).ListSecrets(context.Background(), SecretScopeGlobal); err != nil {
+		t.Fatal(err)
+	}
+	if captured.path != "/v1/secrets" || captured.query != "scope=_global" {
+		t.Errorf("request = %s?%s", captured.path, captured.query)
+	}
+}
+
+func TestParseSecretRef(t *testing.T) {
+	scope, name, ok := ParseSecretRef(SecretRef("acme", "smtp-password"))
+	if !ok || scope != "acme" || name != "smtp-password" {
+		t.Errorf("got %q %q %v", scope, name, ok)
+	}
+	for _, s := range []string{"plain", "${secret:acme}", "${secret:/x}", "Bearer ${secret:acme/x}", "${secret:a/b/c}"} {
+		if _, _, ok := ParseSecretRef(s); ok {
+			t.Errorf("ParseSecretRef(%q) ok", s)
+		}
+	}
+}
+
+func TestPutNotificationPreferences(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{
+		"tenant":      "acme",
+		"quiet_hours": map[string]any{"window": map[string]any{"start": "22:00", "end": "07:00"}, "bypass_severities": []string{"critical"}},
+		"escalation_contacts": []map[string]any{
+			{"name": "on-call", "provider": "pagerduty", "address": "svc-1", "delay_seconds": 900},
+		},
+		"updated_at": "2026-03-01T10:00:00Z",
+	})
+	defer teardown()
+
+	prefs, err := NewClient(url).PutNotificationPreferences(context.Background(), "acme", &NotificationPreferences{
+		QuietHours:       &QuietHours{Window: TimeOfDayInput{Start: "22:00", End: "07:00"}, BypassSeverities: []string{"critical"}},
+		ChannelOverrides: []ChannelOverride{{Severity: "low", Provider: "email"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != "PUT" || captured.path != "/v1/tenants/acme/notification-preferences" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	want := `{"quiet_hours":{"window":{"start":"22:00","end":"07:00"},"bypass_severities":["critical"]},"channel_overrides":[{"severity":"low","provider":"email"}]}`
+	if string(captured.body) != want {
+		t.Errorf("body = %s", captured.body)
+	}
+	if len(prefs.EscalationContacts) != 1 || prefs.EscalationContacts[0].Delay() != 15*time.Minute {
+		t.Errorf("prefs = %+v", prefs)
+	}
+}
+
+func TestGetNotificationPreferencesUnset(t *testing.T) {
+	url, _, teardown := newCapturingServer(t, 404, nil)
+	defer teardown()
+
+	prefs, err := NewClient(url).GetNotificationPreferences(context.Background(), "acme")
+	if err != nil || prefs != nil {
+		t.Errorf("got %+v, %v", prefs, err)
+	}
+}
+
+func TestGetAuditRecordsChunksAndReportsMissing(t *testing.T) {
+	var batches [][]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/audit/batch" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var req AuditBatchRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		batches = append(batches, req.ActionIDs)
+		resp := AuditBatchResponse{}
+		for _, id := range req.ActionIDs {
+			if strings.HasSuffix(id, "7") {
+				resp.Missing = append(resp.Missing, id)
+				continue
+			}
+			resp.Records = append(resp.Records, AuditRecord{ActionID: id, Outcome: "executed"})
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	ids := make([]string, 250)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("act-%d", i)
+	}
+	res, err := NewClient(srv.URL).GetAuditRecords(context.Background(), ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(batches) != 3 || len(batches[0]) != 100 || len(batches[2]) != 50 {
+		t.Errorf("batch sizes = %d", len(batches))
+	}
+	if len(res.Missing) != 25 || len(res.Records) != 225 || res.Records["act-8"].Outcome != "executed" {
+		t.Errorf("records = %d, missing = %v", len(res.Records), res.Missing)
+	}
+}
+
+func TestGetAuditRecordsReturnsPartialResultOnError(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls > 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"code":"UNAVAILABLE","message":"audit backend down","retryable":true}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"records":[{"action_id":"act-0"}],"missing":[]}`))
+	}))
+	defer srv.Close()
+
+	ids := make([]string, 150)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("act-%d", i)
+	}
+	res, err := NewClient(srv.URL).GetAuditRecords(context.Background(), ids)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || !apiErr.Retryable {
+		t.Fatalf("err = %v", err)
+	}
+	if _, ok := res.Records["act-0"]; !ok {
+		t.Errorf("partial records lost: %+v", res)
+	}
+}
+
+func TestActionFromAuditRecord(t *testing.T) {
+	var record AuditRecord
+	if err := json.Unmarshal([]byte(`{
+		"id": "r1", "action_id": "a1", "namespace": "alerts", "tenant": "acme",
+		"provider": "email", "action_type": "send",
+		"action_payload": {"to": "a@b.c"},
+		"metadata": {"team": "payments", "__dedup_key": "order-42", "__fingerprint": "fp"}
+	}`), &record); err != nil {
+		t.Fatal(err)
+	}
+
+	action, err := ActionFromAuditRecord(&record, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if action.ID == "" || action.ID == "a1" || action.Namespace != "alerts" || action.Provider != "email" || action.ActionType != "send" {
+		t.Errorf("action = %+v", action)
+	}
+	if action.DedupKey != "order-42" || len(action.Metadata.Labels) != 1 || action.Metadata.Labels["team"] != "payments" {
+		t.Errorf("dedup = %q, labels = %v", action.DedupKey, action.Metadata.Labels)
+	}
+	if action.Payload["to"] != "a@b.c" || action.Validate() != nil {
+		t.Errorf("payload = %v, validate = %v", action.Payload, action.Validate())
+	}
+
+	override, _ := ActionFromAuditRecord(&record, map[string]any{"to": "x@y.z"})
+	if override.Payload["to"] != "x@y.z" {
+		t.Errorf("explicit payload ignored: %v", override.Payload)
+	}
+	record.ActionPayload = nil
+	var verr *ValidationError
+	if _, err := ActionFromAuditRecord(&record, nil); !errors.As(err, &verr) {
+		t.Errorf("missing payload = %v", err)
+	}
+}
+
+func TestHealthSurfacesConnectionErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := srv.URL
+	srv.Close()
+
+	healthy, err := NewClient(url).Health(context.Background())
+	var connErr *ConnectionError
+	if healthy || !errors.As(err, &connErr) {
+		t.Errorf("Health = %v, %v; want false and a ConnectionError", healthy, err)
+	}
+}
+
+func TestGetHealthDetail(t *testing.T) {
+	detail := map[string]any{
+		"status":  "down",
+		"version": "0.9.0",
+		"dependencies": []map[string]any{
+			{"name": "redis", "kind": "state_store", "status": "ok", "latency_ms": 2},
+			{"name": "postgres", "kind": "audit_store", "status": "down", "message": "connection refused"},
+			{"name": "retention", "kind": "processor", "status": "ok"},
+		},
+		"checked_at": "2026-03-01T10:00:00Z",
+	}
+	url, captured, teardown := newCapturingServer(t, http.StatusServiceUnavailable, detail)
+	defer teardown()
+
+	got, err := NewClient(url).GetHealthDetail(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.path != "/health/detail" {
+		t.Errorf("path = %s", captured.path)
+	}
+	if got.Healthy() || got.Version != "0.9.0" || *got.Dependencies[0].LatencyMs != 2 {
+		t.Errorf("detail = %+v", got)
+	}
+	if bad := got.Unhealthy(); len(bad) != 1 || bad[0].Kind != DependencyAuditStore || bad[0].Message == "" {
+		t.Errorf("unhealthy = %+v", bad)
+	}
+
+	// A 503 from a proxy has no detail and is reported as an error.
+	url2, _, teardown2 := newCapturingServer(t, http.StatusServiceUnavailable, nil)
+	defer teardown2()
+	if _, err := NewClient(url2).GetHealthDetail(context.Background()); err == nil {
+		t.Error("expected error for 503 without detail")
+	}
+}
+
+func TestReady(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, http.StatusServiceUnavailable, map[string]any{
+		"ready": false,
+		"checks": []map[string]any{
+			{"name": "rules_loaded", "ready": true},
+			{"name": "migrations_applied", "ready": false, "message": "3 pending"},
+		},
+	})
+	defer teardown()
+
+	r, err := NewClient(url).Ready(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.path != "/ready" || r.Ready {
+		t.Errorf("path = %s, readiness = %+v", captured.path, r)
+	}
+	if failing := r.Failing(); len(failing) != 1 || failing[0].Name != ReadinessMigrationsApplied {
+		t.Errorf("failing = %+v", failing)
+	}
+
+	url2, _, teardown2 := newCapturingServer(t, http.StatusOK, map[string]any{
+		"ready":  true,
+		"checks": []map[string]any{{"name": "rules_loaded", "ready": true}},
+	})
+	defer teardown2()
+	if r, err := NewClient(url2).Ready(context.Background()); err != nil || !r.Ready {
+		t.Errorf("ready = %+v, %v", r, err)
+	}
+
+	url3, _, teardown3 := newCapturingServer(t, http.StatusNotFound, nil)
+	defer teardown3()
+	if _, err := NewClient(url3).Ready(context.Background()); err == nil {
+		t.Error("expected error when the endpoint is missing")
+	}
+}
+
+func TestAPIVersionAndBasePath(t *testing.T) {
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.RequestURI())
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"records":[],"limit":10,"offset":0}`))
+	}))
+	defer srv.Close()
+	ctx := context.Background()
+
+	_, _ = NewClient(srv.URL).QueryAudit(ctx, &AuditQuery{Limit: 10})
+	c := NewClient(srv.URL, WithAPIVersion("v2"), WithBasePath("/acteon/"))
+	_, _ = c.QueryAudit(ctx, &AuditQuery{Limit: 10})
+	_, _ = c.Health(ctx)
+
+	want := []string{"/v1/audit?limit=10", "/acteon/v2/audit?limit=10", "/acteon/health"}
+	if strings.Join(paths, " ") != strings.Join(want, " ") {
+		t.Errorf("paths = %v, want %v", paths, want)
+	}
+	if got := c.BusStreamConsumeURL("ns", "t", "c", "s"); got != srv.URL+"/acteon/v2/bus/streams/ns/t/c/s" {
+		t.Errorf("BusStreamConsumeURL = %s", got)
+	}
+}
+
+// countingTransport counts the requests sent through it.
+type countingTransport struct {
+	n atomic.Int32
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.n.Add(1)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestStreamUsesConfiguredTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.(http.Flusher).Flush()
+		// Outlast the client timeout, which must not apply to streams.
+		time.Sleep(100 * time.Millisecond)
+		_, _ = w.Write([]byte("id: 1\nevent: ping\ndata: {}\n\n"))
+	}))
+	defer srv.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	transport := &countingTransport{}
+	c := NewClient(srv.URL, WithHTTPClient(&http.Client{Transport: transport, Timeout: 20 * time.Millisecond}))
+	events, err := c.Stream(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ev := <-events; ev == nil || ev.Event != "ping" {
+		t.Errorf("event = %+v", ev)
+	}
+	if transport.n.Load() != 1 {
+		t.Errorf("configured transport saw %d requests", transport.n.Load())
+	}
+
+	sse := &countingTransport{}
+	c = NewClient(srv.URL, WithHTTPClient(&http.Client{Transport: transport}), WithSSEClient(&http.Client{Transport: sse}))
+	if _, err := c.Stream(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	if sse.n.Load() != 1 || transport.n.Load() != 1 {
+		t.Errorf("WithSSEClient transport saw %d requests, default %d", sse.n.Load(), transport.n.Load())
+	}
+}
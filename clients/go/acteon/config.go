@@ -0,0 +1,281 @@
+// Environment and config-file driven construction of the Go client.
+//
+// NewClientFromEnv and NewClientFromConfig build a Client from the
+// same settings every service would otherwise read itself: the gateway
+// URL, the API key, the timeout, TLS files, a default namespace and
+// tenant, and a retry policy. Settings are taken, highest precedence
+// first, from
+//
+//  1. the ClientOptions passed to the constructor,
+//  2. ACTEON_* environment variables,
+//  3. the selected profile of the YAML config file, and
+//  4. the top level of the config file,
+//
+// so a file can hold shared defaults while a deployment overrides a
+// single value through its environment.
+
+package acteon
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ClientConfig holds the settings NewClientFromEnv and
+// NewClientFromConfig read. Each field is set in the config file by its
+// yaml key and in the environment by ACTEON_ followed by that key in
+// upper case, such as ACTEON_API_KEY_FILE; the config file itself is
+// named by ACTEON_CONFIG and its profile by ACTEON_PROFILE.
+//
+// A config file sets fields at the top level, in named profiles, or
+// both:
+//
+//	timeout: 10s
+//	retry_max_attempts: 4
+//	current_profile: staging
+//	profiles:
+//	  staging:
+//	    server: https://acteon.staging.internal
+//	    api_key_file: /run/secrets/acteon
+//	    ca_cert: /etc/acteon/ca.pem
+//	    namespace: alerts
+//	    tenant: acme
+//
+// Unknown keys are rejected so that typos fail loudly.
+type ClientConfig struct {
+	// Server is the gateway base URL.
+	Server string `yaml:"server"`
+	// APIKey is the API key. APIKeyFile names a file holding it
+	// instead; surrounding whitespace is trimmed. When both are set
+	// in the same place APIKey wins, and either one overrides both
+	// from a lower-precedence source.
+	APIKey     string `yaml:"api_key"`
+	APIKeyFile string `yaml:"api_key_file"`
+	// Timeout is the request timeout, such as "30s".
+	Timeout time.Duration `yaml:"timeout"`
+
+	// CACert, ClientCert, ClientKey, and InsecureSkipVerify configure
+	// TLS as the TLSConfig fields of the same names do.
+	CACert             string `yaml:"ca_cert"`
+	ClientCert         string `yaml:"client_cert"`
+	ClientKey          string `yaml:"client_key"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify"`
+
+	// Namespace and Tenant are applied with WithDefaultScope.
+	Namespace string `yaml:"namespace"`
+	Tenant    string `yaml:"tenant"`
+
+	// The retry fields turn on WithRetryPolicy when any is set; the
+	// others take the policy defaults.
+	RetryMaxAttempts    int           `yaml:"retry_max_attempts"`
+	RetryInitialBackoff time.Duration `yaml:"retry_initial_backoff"`
+	RetryMaxBackoff     time.Duration `yaml:"retry_max_backoff"`
+}
+
+// configFile is the layout of a config file.
+type configFile struct {
+	ClientConfig   `yaml:",inline"`
+	CurrentProfile string               `yaml:"current_profile"`
+	Profiles       map[string]yaml.Node `yaml:"profiles"`
+}
+
+// NewClientFromEnv creates a client from ACTEON_* environment
+// variables, on top of the config file named by ACTEON_CONFIG when that
+// is set. opts are applied last and override both. See ClientConfig
+// for the variables.
+//
+//	// ACTEON_SERVER=https://acteon.internal ACTEON_API_KEY_FILE=/run/secrets/acteon
+//	client, err := acteon.NewClientFromEnv()
+func NewClientFromEnv(opts ...ClientOption) (*Client, error) {
+	var cfg ClientConfig
+	if path := os.Getenv("ACTEON_CONFIG"); path != "" {
+		if err := cfg.load(path); err != nil {
+			return nil, err
+		}
+	}
+	return cfg.newClient(opts)
+}
+
+// NewClientFromConfig creates a client from the YAML config file at
+// path, with ACTEON_* environment variables overriding it and opts
+// overriding both. The profile used is the one named by ACTEON_PROFILE,
+// or else the file's current_profile; with neither, only the top-level
+// settings apply.
+func NewClientFromConfig(path string, opts ...ClientOption) (*Client, error) {
+	var cfg ClientConfig
+	if err := cfg.load(path); err != nil {
+		return nil, err
+	}
+	return cfg.newClient(opts)
+}
+
+// load reads the config file at path into cfg, overlaying the selected
+// profile on the top-level settings.
+func (cfg *ClientConfig) load(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("acteon config: %w", err)
+	}
+	var file configFile
+	if err := decodeConfig(data, &file); err != nil {
+		return fmt.Errorf("acteon config %s: %w", path, err)
+	}
+	*cfg = file.ClientConfig
+
+	name := os.Getenv("ACTEON_PROFILE")
+	if name == "" {
+		name = file.CurrentProfile
+	}
+	if name == "" {
+		return nil
+	}
+	node, ok := file.Profiles[name]
+	if !ok {
+		return fmt.Errorf("acteon config %s: profile %q not found", path, name)
+	}
+	// Decoding the profile over cfg replaces only the keys the
+	// profile sets.
+	data, err = yaml.Marshal(&node)
+	if err != nil {
+		return fmt.Errorf("acteon config %s: profile %q: %w", path, name, err)
+	}
+	var profile ClientConfig
+	if err := decodeConfig(data, &profile); err != nil {
+		return fmt.Errorf("acteon config %s: profile %q: %w", path, name, err)
+	}
+	if err := decodeConfig(data, cfg); err != nil {
+		return fmt.Errorf("acteon config %s: profile %q: %w", path, name, err)
+	}
+	if profile.APIKeyFile != "" && profile.APIKey == "" {
+		cfg.APIKey = ""
+	}
+	return nil
+}
+
+func decodeConfig(data []byte, v any) error {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(v); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	return nil
+}
+
+// applyEnv overlays the ACTEON_* environment variables that are set
+// and not empty.
+func (cfg *ClientConfig) applyEnv() error {
+	var errs []error
+	str := func(name string, dst *string) {
+		if v := os.Getenv(name); v != "" {
+			*dst = v
+		}
+	}
+	dur := func(name string, dst *time.Duration) {
+		if v := os.Getenv(name); v != "" {
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			}
+			*dst = d
+		}
+	}
+
+	str("ACTEON_SERVER", &cfg.Server)
+	if v := os.Getenv("ACTEON_API_KEY"); v != "" {
+		cfg.APIKey, cfg.APIKeyFile = v, ""
+	} else if v := os.Getenv("ACTEON_API_KEY_FILE"); v != "" {
+		cfg.APIKey, cfg.APIKeyFile = "", v
+	}
+	dur("ACTEON_TIMEOUT", &cfg.Timeout)
+	str("ACTEON_CA_CERT", &cfg.CACert)
+	str("ACTEON_CLIENT_CERT", &cfg.ClientCert)
+	str("ACTEON_CLIENT_KEY", &cfg.ClientKey)
+	if v := os.Getenv("ACTEON_INSECURE_SKIP_VERIFY"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("ACTEON_INSECURE_SKIP_VERIFY: %w", err))
+		}
+		cfg.InsecureSkipVerify = b
+	}
+	str("ACTEON_NAMESPACE", &cfg.Namespace)
+	str("ACTEON_TENANT", &cfg.Tenant)
+	if v := os.Getenv("ACTEON_RETRY_MAX_ATTEMPTS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("ACTEON_RETRY_MAX_ATTEMPTS: %w", err))
+		}
+		cfg.RetryMaxAttempts = n
+	}
+	dur("ACTEON_RETRY_INITIAL_BACKOFF", &cfg.RetryInitialBackoff)
+	dur("ACTEON_RETRY_MAX_BACKOFF", &cfg.RetryMaxBackoff)
+	return errors.Join(errs...)
+}
+
+// newClient applies the environment to cfg and builds the client,
+// checking up front the files that NewClient would otherwise skip
+// silently when they cannot be read.
+func (cfg *ClientConfig) newClient(opts []ClientOption) (*Client, error) {
+	if err := cfg.applyEnv(); err != nil {
+		return nil, fmt.Errorf("acteon config: %w", err)
+	}
+	if cfg.Server == "" {
+		return nil, errors.New("acteon config: no server; set ACTEON_SERVER or server in the config file")
+	}
+
+	var base []ClientOption
+	apiKey := cfg.APIKey
+	if apiKey == "" && cfg.APIKeyFile != "" {
+		data, err := os.ReadFile(cfg.APIKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("acteon config: api key: %w", err)
+		}
+		apiKey = strings.TrimSpace(string(data))
+	}
+	if apiKey != "" {
+		base = append(base, WithAPIKey(apiKey))
+	}
+	if cfg.Timeout > 0 {
+		base = append(base, WithTimeout(cfg.Timeout))
+	}
+	if cfg.CACert != "" || cfg.ClientCert != "" || cfg.ClientKey != "" || cfg.InsecureSkipVerify {
+		if cfg.CACert != "" {
+			if _, err := os.ReadFile(cfg.CACert); err != nil {
+				return nil, fmt.Errorf("acteon config: ca cert: %w", err)
+			}
+		}
+		if (cfg.ClientCert == "") != (cfg.ClientKey == "") {
+			return nil, errors.New("acteon config: client_cert and client_key must be set together")
+		}
+		if cfg.ClientCert != "" {
+			if _, err := tls.LoadX509KeyPair(cfg.ClientCert, cfg.ClientKey); err != nil {
+				return nil, fmt.Errorf("acteon config: client cert: %w", err)
+			}
+		}
+		base = append(base, WithTLS(TLSConfig{
+			CACertPath:         cfg.CACert,
+			ClientCertPath:     cfg.ClientCert,
+			ClientKeyPath:      cfg.ClientKey,
+			InsecureSkipVerify: cfg.InsecureSkipVerify,
+		}))
+	}
+	if cfg.Namespace != "" || cfg.Tenant != "" {
+		base = append(base, WithDefaultScope(cfg.Namespace, cfg.Tenant))
+	}
+	if cfg.RetryMaxAttempts != 0 || cfg.RetryInitialBackoff != 0 || cfg.RetryMaxBackoff != 0 {
+		base = append(base, WithRetryPolicy(RetryPolicy{
+			MaxAttempts:    cfg.RetryMaxAttempts,
+			InitialBackoff: cfg.RetryInitialBackoff,
+			MaxBackoff:     cfg.RetryMaxBackoff,
+		}))
+	}
+	return NewClient(cfg.Server, append(base, opts...)...), nil
+}
@@ -0,0 +1,123 @@
+package acteon
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// clearConfigEnv blanks the ACTEON_* variables the config loaders read.
+func clearConfigEnv(t *testing.T) {
+	for _, name := range []string{
+		"ACTEON_CONFIG", "ACTEON_PROFILE", "ACTEON_SERVER", "ACTEON_API_KEY", "ACTEON_API_KEY_FILE",
+		"ACTEON_TIMEOUT", "ACTEON_CA_CERT", "ACTEON_CLIENT_CERT", "ACTEON_CLIENT_KEY",
+		"ACTEON_INSECURE_SKIP_VERIFY", "ACTEON_NAMESPACE", "ACTEON_TENANT",
+		"ACTEON_RETRY_MAX_ATTEMPTS", "ACTEON_RETRY_INITIAL_BACKOFF", "ACTEON_RETRY_MAX_BACKOFF",
+	} {
+		t.Setenv(name, "")
+	}
+}
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestNewClientFromConfigPrecedence(t *testing.T) {
+	clearConfigEnv(t)
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{"Executed": map[string]any{}})
+	defer teardown()
+	keyFile := writeFile(t, "key", "file-key\n")
+	path := writeFile(t, "acteon.yaml", `
+api_key: top-level-key
+timeout: 10s
+retry_max_attempts: 4
+current_profile: staging
+profiles:
+  staging:
+    server: `+url+`
+    api_key_file: `+keyFile+`
+    namespace: alerts
+    tenant: acme
+  prod:
+    server: https://prod.invalid
+`)
+	t.Setenv("ACTEON_TENANT", "globex")
+
+	c, err := NewClientFromConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.httpClient.Timeout != 10*time.Second || c.retry == nil || c.retry.MaxAttempts != 4 {
+		t.Errorf("timeout = %v, retry = %+v", c.httpClient.Timeout, c.retry)
+	}
+	if _, err := c.Dispatch(context.Background(), NewAction("", "", "email", "send", nil)); err != nil {
+		t.Fatal(err)
+	}
+	if got := captured.headers.Get("Authorization"); got != "Bearer file-key" {
+		t.Errorf("Authorization = %q", got)
+	}
+	var sent Action
+	if err := json.Unmarshal(captured.body, &sent); err != nil {
+		t.Fatal(err)
+	}
+	if sent.Namespace != "alerts" || sent.Tenant != "globex" {
+		t.Errorf("scope = %s/%s", sent.Namespace, sent.Tenant)
+	}
+
+	c, err = NewClientFromConfig(path, WithTimeout(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.httpClient.Timeout != time.Second {
+		t.Errorf("explicit option overridden: timeout = %v", c.httpClient.Timeout)
+	}
+
+	t.Setenv("ACTEON_PROFILE", "prod")
+	t.Setenv("ACTEON_CONFIG", path)
+	t.Setenv("ACTEON_API_KEY", "env-key")
+	c, err = NewClientFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.baseURL != "https://prod.invalid" || c.apiKey != "env-key" || c.namespace != "" || c.tenant != "globex" {
+		t.Errorf("prod client = %s %q %s/%s", c.baseURL, c.apiKey, c.namespace, c.tenant)
+	}
+}
+
+func TestNewClientFromConfigErrors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		file string
+		env  map[string]string
+		want string
+	}{
+		"no server":       {file: "timeout: 5s", want: "no server"},
+		"unknown key":     {file: "server: http://x\ntimeuot: 5s", want: "timeuot"},
+		"missing profile": {file: "server: http://x\ncurrent_profile: dev", want: `profile "dev" not found`},
+		"bad env":         {file: "server: http://x", env: map[string]string{"ACTEON_TIMEOUT": "soon"}, want: "ACTEON_TIMEOUT"},
+		"missing key file": {
+			file: "server: http://x\napi_key_file: /nonexistent/acteon-key",
+			want: "api key",
+		},
+		"half a key pair": {file: "server: http://x\nclient_cert: cert.pem", want: "set together"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			clearConfigEnv(t)
+			for k, v := range tc.env {
+				t.Setenv(k, v)
+			}
+			_, err := NewClientFromConfig(writeFile(t, "acteon.yaml", tc.file))
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("err = %v, want it to mention %q", err, tc.want)
+			}
+		})
+	}
+}
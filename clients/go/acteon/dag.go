@@ -0,0 +1,190 @@
+package acteon
+
+// Text renderers for chain DAGs returned by GetChainDag and
+// GetChainDefinitionDag. ToDOT emits Graphviz source and ToMermaid emits
+// a Mermaid flowchart; both colour nodes by step status and emphasise
+// the edges on the execution path so a rendered instance DAG shows at a
+// glance how far the chain got and which branch it took.
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// dagStatusColors maps step status to a fill colour shared by both
+// renderers. Statuses not listed here render unfilled.
+var dagStatusColors = map[StepStatus]string{
+	StepStatusCompleted:       "#c8e6c9",
+	StepStatusRunning:         "#bbdefb",
+	StepStatusWaitingSubChain: "#bbdefb",
+	StepStatusAwaitingInput:   "#fff9c4",
+	StepStatusFailed:          "#ffcdd2",
+	StepStatusSkipped:         "#eeeeee",
+	StepStatusPending:         "#ffffff",
+}
+
+// ToDOT renders the DAG as a Graphviz digraph.
+func (d *DagResponse) ToDOT() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", dotQuote(d.ChainName))
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, style=\"rounded,filled\", fillcolor=\"#ffffff\", fontname=\"Helvetica\"];\n")
+	if label := d.title(); label != "" {
+		fmt.Fprintf(&b, "  label=%s;\n  labelloc=t;\n", dotQuote(label))
+	}
+
+	for _, n := range d.Nodes {
+		writeDOTNode(&b, n, n.Name, "  ")
+	}
+	for _, e := range d.Edges {
+		attrs := []string{}
+		if e.Label != nil && *e.Label != "" {
+			attrs = append(attrs, "label="+dotQuote(*e.Label))
+		}
+		if e.OnExecutionPath {
+			attrs = append(attrs, "color=\"#1565c0\"", "penwidth=2.5")
+		}
+		fmt.Fprintf(&b, "  %s -> %s", dotQuote(e.Source), dotQuote(e.Target))
+		if len(attrs) > 0 {
+			fmt.Fprintf(&b, " [%s]", strings.Join(attrs, ", "))
+		}
+		b.WriteString(";\n")
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func writeDOTNode(b *strings.Builder, n DagNode, id, indent string) {
+	attrs := []string{"label=" + dotQuote(dagNodeLabel(n, "\n"))}
+	switch n.NodeType {
+	case "sub_chain":
+		attrs = append(attrs, "shape=component")
+	case "parallel":
+		attrs = append(attrs, "shape=hexagon")
+	}
+	if n.Status != nil {
+		if color, ok := dagStatusColors[*n.Status]; ok {
+			attrs = append(attrs, "fillcolor="+dotQuote(color))
+		}
+	}
+	fmt.Fprintf(b, "%s%s [%s];\n", indent, dotQuote(id), strings.Join(attrs, ", "))
+
+	for _, child := range n.ParallelChildren {
+		childID := id + "/" + child.Name
+		writeDOTNode(b, child, childID, indent)
+		fmt.Fprintf(b, "%s%s -> %s [style=dashed, arrowhead=none];\n", indent, dotQuote(id), dotQuote(childID))
+	}
+}
+
+// ToMermaid renders the DAG as a Mermaid flowchart. Execution-path
+// edges use the thick `==>` arrow.
+func (d *DagResponse) ToMermaid() string {
+	ids := map[string]string{}
+	id := func(name string) string {
+		if v, ok := ids[name]; ok {
+			return v
+		}
+		v := fmt.Sprintf("n%d", len(ids))
+		ids[name] = v
+		return v
+	}
+	classes := map[StepStatus][]string{}
+
+	var b strings.Builder
+	if label := d.title(); label != "" {
+		fmt.Fprintf(&b, "---\ntitle: %s\n---\n", label)
+	}
+	b.WriteString("flowchart LR\n")
+
+	var writeNode func(n DagNode, key string)
+	writeNode = func(n DagNode, key string) {
+		nid := id(key)
+		label := mermaidEscape(dagNodeLabel(n, "<br/>"))
+		switch n.NodeType {
+		case "sub_chain":
+			fmt.Fprintf(&b, "  %s[[\"%s\"]]\n", nid, label)
+		case "parallel":
+			fmt.Fprintf(&b, "  %s{{\"%s\"}}\n", nid, label)
+		default:
+			fmt.Fprintf(&b, "  %s[\"%s\"]\n", nid, label)
+		}
+		if n.Status != nil {
+			if _, ok := dagStatusColors[*n.Status]; ok {
+				classes[*n.Status] = append(classes[*n.Status], nid)
+			}
+		}
+		for _, child := range n.ParallelChildren {
+			childKey := key + "/" + child.Name
+			writeNode(child, childKey)
+			fmt.Fprintf(&b, "  %s -.- %s\n", nid, id(childKey))
+		}
+	}
+	for _, n := range d.Nodes {
+		writeNode(n, n.Name)
+	}
+
+	for _, e := range d.Edges {
+		arrow := "-->"
+		if e.OnExecutionPath {
+			arrow = "==>"
+		}
+		if e.Label != nil && *e.Label != "" {
+			fmt.Fprintf(&b, "  %s %s|\"%s\"| %s\n", id(e.Source), arrow, mermaidEscape(*e.Label), id(e.Target))
+		} else {
+			fmt.Fprintf(&b, "  %s %s %s\n", id(e.Source), arrow, id(e.Target))
+		}
+	}
+
+	statuses := make([]StepStatus, 0, len(classes))
+	for s := range classes {
+		statuses = append(statuses, s)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i] < statuses[j] })
+	for _, s := range statuses {
+		fmt.Fprintf(&b, "  classDef %s fill:%s\n", s, dagStatusColors[s])
+		fmt.Fprintf(&b, "  class %s %s\n", strings.Join(classes[s], ","), s)
+	}
+	return b.String()
+}
+
+// title returns the chain name annotated with the instance ID and
+// status when the DAG describes a running instance.
+func (d *DagResponse) title() string {
+	parts := []string{d.ChainName}
+	if d.ChainID != nil {
+		parts = append(parts, *d.ChainID)
+	}
+	if d.Status != nil {
+		parts = append(parts, "("+string(*d.Status)+")")
+	}
+	return strings.TrimSpace(strings.Join(parts, " "))
+}
+
+func dagNodeLabel(n DagNode, sep string) string {
+	lines := []string{n.Name}
+	switch {
+	case n.SubChainName != nil:
+		lines = append(lines, "sub-chain: "+*n.SubChainName)
+	case n.Provider != nil && n.ActionType != nil:
+		lines = append(lines, *n.Provider+" / "+*n.ActionType)
+	case n.Provider != nil:
+		lines = append(lines, *n.Provider)
+	}
+	if n.Attempt != nil && n.MaxRetries != nil {
+		lines = append(lines, fmt.Sprintf("attempt %d/%d", *n.Attempt, *n.MaxRetries+1))
+	}
+	if n.Status != nil {
+		lines = append(lines, "["+string(*n.Status)+"]")
+	}
+	return strings.Join(lines, sep)
+}
+
+func dotQuote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + r.Replace(s) + `"`
+}
+
+func mermaidEscape(s string) string {
+	return strings.ReplaceAll(s, `"`, "#quot;")
+}
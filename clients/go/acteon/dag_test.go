@@ -0,0 +1,81 @@
+package acteon
+
+import (
+	"strings"
+	"testing"
+)
+
+func sampleDag() *DagResponse {
+	return &DagResponse{
+		ChainName: "search-summarize",
+		ChainID:   ptr("ch-1"),
+		Status:    ptr(ChainStatusRunning),
+		Nodes: []DagNode{
+			{Name: "search", NodeType: "step", Provider: ptr("search-api"), ActionType: ptr("web"), Status: ptr(StepStatusCompleted)},
+			{Name: "summarize", NodeType: "step", Provider: ptr("llm"), ActionType: ptr("sum"), Status: ptr(StepStatusRunning),
+				Attempt: ptr(1), MaxRetries: ptr(2)},
+			{Name: "escalate", NodeType: "sub_chain", SubChainName: ptr("page \"oncall\""), Status: ptr(StepStatusPending)},
+		},
+		Edges: []DagEdge{
+			{Source: "search", Target: "summarize", OnExecutionPath: true},
+			{Source: "summarize", Target: "escalate", Label: ptr("body.severity eq high")},
+		},
+		ExecutionPath: []string{"search", "summarize"},
+	}
+}
+
+func TestDagToDOT(t *testing.T) {
+	out := sampleDag().ToDOT()
+	for _, want := range []string{
+		`digraph "search-summarize" {`,
+		`label="search-summarize ch-1 (running)";`,
+		`"search" [label="search\nsearch-api / web\n[completed]", fillcolor="#c8e6c9"];`,
+		`attempt 1/3`,
+		`"escalate" [label="escalate\nsub-chain: page \"oncall\"\n[pending]", shape=component, fillcolor="#ffffff"];`,
+		`"search" -> "summarize" [color="#1565c0", penwidth=2.5];`,
+		`"summarize" -> "escalate" [label="body.severity eq high"];`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("DOT output missing %s\n%s", want, out)
+		}
+	}
+}
+
+func TestDagToMermaid(t *testing.T) {
+	out := sampleDag().ToMermaid()
+	for _, want := range []string{
+		"flowchart LR\n",
+		`n0["search<br/>search-api / web<br/>[completed]"]`,
+		`n2[["escalate<br/>sub-chain: page #quot;oncall#quot;<br/>[pending]"]]`,
+		"n0 ==> n1\n",
+		`n1 -->|"body.severity eq high"| n2`,
+		"classDef completed fill:#c8e6c9\n  class n0 completed\n",
+		"class n1 running",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Mermaid output missing %s\n%s", want, out)
+		}
+	}
+}
+
+func TestDagParallelChildren(t *testing.T) {
+	d := &DagResponse{
+		ChainName: "fanout",
+		Nodes: []DagNode{{
+			Name:     "notify",
+			NodeType: "parallel",
+			ParallelChildren: []DagNode{
+				{Name: "slack", NodeType: "step", Provider: ptr("slack")},
+				{Name: "email", NodeType: "step", Provider: ptr("email")},
+			},
+		}},
+	}
+	dot := d.ToDOT()
+	if !strings.Contains(dot, `"notify" -> "notify/slack" [style=dashed, arrowhead=none];`) {
+		t.Errorf("DOT missing parallel child edge\n%s", dot)
+	}
+	mm := d.ToMermaid()
+	if !strings.Contains(mm, `n0{{"notify"}}`) || !strings.Contains(mm, "n0 -.- n2") {
+		t.Errorf("Mermaid missing parallel group\n%s", mm)
+	}
+}
@@ -0,0 +1,453 @@
+// Dead letter queue surface for the Go client.
+
+package acteon
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// DlqService inspects, retries, and purges the dead letter queue.
+type DlqService struct {
+	c *Client
+}
+
+// Dlq returns the client's DlqService.
+func (c *Client) Dlq() *DlqService {
+	return &DlqService{c: c}
+}
+
+// Stats returns dead-letter queue statistics.
+func (s *DlqService) Stats(ctx context.Context) (*DlqStatsResponse, error) {
+	resp, err := s.c.doRequest(ctx, http.MethodGet, "/v1/dlq/stats", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get DLQ stats"}
+	}
+
+	var stats DlqStatsResponse
+	if err := s.c.decodeBody(resp.Body, &stats); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &stats, nil
+}
+
+// StatsDetailed returns dead-letter queue statistics broken down by
+// provider, namespace/tenant, and error code.
+func (s *DlqService) StatsDetailed(ctx context.Context) (*DlqStatsDetailedResponse, error) {
+	resp, err := s.c.doRequest(ctx, http.MethodGet, "/v1/dlq/stats/detailed", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get detailed DLQ stats"}
+	}
+
+	var stats DlqStatsDetailedResponse
+	if err := s.c.decodeBody(resp.Body, &stats); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &stats, nil
+}
+
+// List returns dead-letter entries matching filter without removing
+// them, so the queue can be inspected safely. Use Offset to page.
+func (s *DlqService) List(ctx context.Context, filter DlqFilter) (*DlqListResponse, error) {
+	params := url.Values{}
+	if filter.Namespace != "" {
+		params.Set("namespace", filter.Namespace)
+	}
+	if filter.Tenant != "" {
+		params.Set("tenant", filter.Tenant)
+	}
+	if filter.Provider != "" {
+		params.Set("provider", filter.Provider)
+	}
+	if filter.Limit > 0 {
+		params.Set("limit", strconv.Itoa(filter.Limit))
+	}
+	if filter.Offset > 0 {
+		params.Set("offset", strconv.Itoa(filter.Offset))
+	}
+	path := "/v1/dlq"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	resp, err := s.c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "DLQ is not enabled"}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list DLQ"}
+	}
+
+	var list DlqListResponse
+	if err := s.c.decodeBody(resp.Body, &list); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &list, nil
+}
+
+// Drain drains all entries from the dead-letter queue.
+func (s *DlqService) Drain(ctx context.Context) (*DlqDrainResponse, error) {
+	resp, err := s.c.doRequest(ctx, http.MethodPost, "/v1/dlq/drain", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var result DlqDrainResponse
+		if err := s.c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "DLQ is not enabled"}
+	}
+	return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to drain DLQ"}
+}
+
+// RetryEntry re-dispatches a single dead-lettered action through the
+// normal dispatch pipeline. A dispatch that fails again is reported in
+// the result rather than as an error.
+func (s *DlqService) RetryEntry(ctx context.Context, actionID string) (*DlqRetryResult, error) {
+	resp, err := s.c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/v1/dlq/%s/retry", url.PathEscape(actionID)), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var result DlqRetryResult
+		if err := s.c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("DLQ entry not found: %s", actionID)}
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to retry DLQ entry")
+}
+
+// Get returns a dead-letter entry with its original action
+// payload and error history. Returns nil if not found.
+func (s *DlqService) Get(ctx context.Context, actionID string) (*DlqEntryDetail, error) {
+	resp, err := s.c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/v1/dlq/%s", url.PathEscape(actionID)), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get DLQ entry"}
+	}
+
+	var detail DlqEntryDetail
+	if err := s.c.decodeBody(resp.Body, &detail); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	if err := s.c.openPayload(ctx, detail.Action.Payload); err != nil {
+		return nil, err
+	}
+	return &detail, nil
+}
+
+// Delete permanently removes a single dead-letter entry without
+// retrying it.
+func (s *DlqService) Delete(ctx context.Context, actionID string) error {
+	resp, err := s.c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/v1/dlq/%s", url.PathEscape(actionID)), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("DLQ entry not found: %s", actionID)}
+	}
+	return &HTTPError{Status: resp.StatusCode, Message: "Failed to delete DLQ entry"}
+}
+
+// Policy returns the DLQ automatic retry policy.
+func (s *DlqService) Policy(ctx context.Context) (*DlqPolicy, error) {
+	resp, err := s.c.doRequest(ctx, http.MethodGet, "/v1/dlq/policy", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "DLQ is not enabled"}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get DLQ policy"}
+	}
+
+	var policy DlqPolicy
+	if err := s.c.decodeBody(resp.Body, &policy); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &policy, nil
+}
+
+// SetPolicy replaces the DLQ automatic retry policy and returns the
+// policy as stored.
+func (s *DlqService) SetPolicy(ctx context.Context, policy DlqPolicy) (*DlqPolicy, error) {
+	resp, err := s.c.doRequest(ctx, http.MethodPut, "/v1/dlq/policy", policy)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var result DlqPolicy
+		if err := s.c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "DLQ is not enabled"}
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to set DLQ policy")
+}
+
+// Purge permanently removes the dead-letter entries matching filter
+// and returns how many were removed. Unlike Drain it never empties
+// the whole queue: an empty filter is rejected before any request is
+// sent.
+func (s *DlqService) Purge(ctx context.Context, filter DlqPurgeFilter) (int, error) {
+	if filter.OlderThan <= 0 && filter.Provider == "" && filter.Namespace == "" && filter.Tenant == "" {
+		return 0, &ConnectionError{Message: "PurgeDlq: filter must set at least one criterion (use DlqDrain to empty the queue)"}
+	}
+	req := dlqPurgeRequest{
+		OlderThanSeconds: uint64(filter.OlderThan / time.Second),
+		Provider:         filter.Provider,
+		Namespace:        filter.Namespace,
+		Tenant:           filter.Tenant,
+	}
+
+	resp, err := s.c.doRequest(ctx, http.MethodPost, "/v1/dlq/purge", req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var result dlqPurgeResponse
+		if err := s.c.decodeJSON(body, &result); err != nil {
+			return 0, &ConnectionError{Message: err.Error()}
+		}
+		return result.Purged, nil
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return 0, &HTTPError{Status: resp.StatusCode, Message: "DLQ is not enabled"}
+	}
+
+	return 0, errorFromResponse(resp, body, "Failed to purge DLQ")
+}
+
+// Retry re-dispatches every dead-letter entry matching filter,
+// honouring opts.MaxRate and opts.Concurrency. Matching entries are
+// listed up front, so entries dead-lettered during the run are not
+// picked up. Per-entry failures are recorded in the report; the
+// returned error is non-nil only if listing fails or ctx is cancelled,
+// in which case the report covers the entries attempted so far.
+func (s *DlqService) Retry(ctx context.Context, filter DlqFilter, opts DlqRetryOptions) (*DlqRetryReport, error) {
+	ctx = withoutIdempotencyKey(ctx)
+	var entries []DlqEntry
+	page := filter
+	for {
+		list, err := s.c.ListDlq(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, list.Entries...)
+		if len(list.Entries) == 0 || len(entries) >= list.Total {
+			break
+		}
+		page.Offset += len(list.Entries)
+	}
+
+	workers := opts.Concurrency
+	if workers < 1 {
+		workers = 1
+	}
+	var tick <-chan time.Time
+	if opts.MaxRate > 0 {
+		// Clamp rates finer than a nanosecond, or so slow the interval
+		// overflows a Duration, which NewTicker would panic on.
+		every := time.Duration(math.MaxInt64)
+		if d := float64(time.Second) / opts.MaxRate; d < float64(every) {
+			every = max(time.Duration(d), time.Nanosecond)
+		}
+		ticker := time.NewTicker(every)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	results := make([]DlqRetryResult, len(entries))
+	attempted := make([]bool, len(entries))
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				res, err := s.c.RetryDlqEntry(ctx, entries[i].ActionID)
+				if err != nil {
+					res = &DlqRetryResult{ActionID: entries[i].ActionID, Error: err.Error()}
+				}
+				results[i] = *res
+				attempted[i] = true
+			}
+		}()
+	}
+
+	var runErr error
+feed:
+	for i := range entries {
+		if tick != nil && i > 0 {
+			select {
+			case <-tick:
+			case <-ctx.Done():
+				runErr = ctx.Err()
+				break feed
+			}
+		}
+		select {
+		case next <- i:
+		case <-ctx.Done():
+			runErr = ctx.Err()
+			break feed
+		}
+	}
+	close(next)
+	wg.Wait()
+
+	report := &DlqRetryReport{}
+	for i, res := range results {
+		if !attempted[i] {
+			continue
+		}
+		report.Results = append(report.Results, res)
+		if res.Success {
+			report.Succeeded++
+		} else {
+			report.Failed++
+		}
+	}
+	return report, runErr
+}
+
+// DlqStats is shorthand for c.Dlq().Stats.
+func (c *Client) DlqStats(ctx context.Context) (*DlqStatsResponse, error) {
+	return c.Dlq().Stats(ctx)
+}
+
+// DlqStatsDetailed is shorthand for c.Dlq().StatsDetailed.
+func (c *Client) DlqStatsDetailed(ctx context.Context) (*DlqStatsDetailedResponse, error) {
+	return c.Dlq().StatsDetailed(ctx)
+}
+
+// ListDlq is shorthand for c.Dlq().List.
+func (c *Client) ListDlq(ctx context.Context, filter DlqFilter) (*DlqListResponse, error) {
+	return c.Dlq().List(ctx, filter)
+}
+
+// DlqDrain is shorthand for c.Dlq().Drain.
+func (c *Client) DlqDrain(ctx context.Context) (*DlqDrainResponse, error) {
+	return c.Dlq().Drain(ctx)
+}
+
+// RetryDlqEntry is shorthand for c.Dlq().RetryEntry.
+func (c *Client) RetryDlqEntry(ctx context.Context, actionID string) (*DlqRetryResult, error) {
+	return c.Dlq().RetryEntry(ctx, actionID)
+}
+
+// GetDlqEntry is shorthand for c.Dlq().Get.
+func (c *Client) GetDlqEntry(ctx context.Context, actionID string) (*DlqEntryDetail, error) {
+	return c.Dlq().Get(ctx, actionID)
+}
+
+// DeleteDlqEntry is shorthand for c.Dlq().Delete.
+func (c *Client) DeleteDlqEntry(ctx context.Context, actionID string) error {
+	return c.Dlq().Delete(ctx, actionID)
+}
+
+// GetDlqPolicy is shorthand for c.Dlq().Policy.
+func (c *Client) GetDlqPolicy(ctx context.Context) (*DlqPolicy, error) {
+	return c.Dlq().Policy(ctx)
+}
+
+// SetDlqPolicy is shorthand for c.Dlq().SetPolicy.
+func (c *Client) SetDlqPolicy(ctx context.Context, policy DlqPolicy) (*DlqPolicy, error) {
+	return c.Dlq().SetPolicy(ctx, policy)
+}
+
+// PurgeDlq is shorthand for c.Dlq().Purge.
+func (c *Client) PurgeDlq(ctx context.Context, filter DlqPurgeFilter) (int, error) {
+	return c.Dlq().Purge(ctx, filter)
+}
+
+// RetryDlq is shorthand for c.Dlq().Retry.
+func (c *Client) RetryDlq(ctx context.Context, filter DlqFilter, opts DlqRetryOptions) (*DlqRetryReport, error) {
+	return c.Dlq().Retry(ctx, filter, opts)
+}
@@ -0,0 +1,334 @@
+// Client-side payload encryption for the Go client.
+//
+// WithPayloadEncryption seals chosen payload fields before an action
+// leaves the process, so that regulated data such as PHI never reaches
+// the gateway in plaintext. Each sealed field is replaced by a string
+// carrying the ciphertext and the key reference, and the action's
+// metadata labels record which fields were sealed under which key.
+// Audit records and dead-letter entries fetched through the same
+// client are opened again transparently.
+
+package acteon
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Labels WithPayloadEncryption sets on every action it seals.
+const (
+	// EncryptedFieldsLabel lists the sealed payload fields, comma
+	// separated, in the dotted form given to WithPayloadEncryption.
+	EncryptedFieldsLabel = "acteon.encryption/fields"
+	// EncryptionKeyLabel is the key reference the fields were sealed
+	// under, as returned by Encryptor.Encrypt.
+	EncryptionKeyLabel = "acteon.encryption/key"
+)
+
+// encryptedPrefix marks a sealed field value. The value continues
+// with the base64 ciphertext, a colon, and the key reference.
+const encryptedPrefix = "acteon-enc:v1:"
+
+// Encryptor seals and opens payload field values. Implementations
+// typically wrap a KMS; AESEnvelope is a local one.
+type Encryptor interface {
+	// Encrypt seals plaintext and returns the ciphertext together with
+	// a reference to the key needed to open it.
+	Encrypt(ctx context.Context, plaintext []byte) (ciphertext []byte, keyRef string, err error)
+	// Decrypt opens ciphertext sealed under keyRef.
+	Decrypt(ctx context.Context, ciphertext []byte, keyRef string) ([]byte, error)
+}
+
+// EncryptionError reports a payload field that could not be sealed or
+// opened.
+type EncryptionError struct {
+	Field string
+	Err   error
+}
+
+func (e *EncryptionError) Error() string {
+	return fmt.Sprintf("payload encryption: field %s: %v", e.Field, e.Err)
+}
+
+func (e *EncryptionError) Unwrap() error { return e.Err }
+
+func (e *EncryptionError) IsRetryable() bool {
+	return false
+}
+
+// payloadEncryption is the client's WithPayloadEncryption setting.
+type payloadEncryption struct {
+	enc    Encryptor
+	fields []string
+}
+
+// WithPayloadEncryption makes Dispatch, DispatchDryRun, and the batch
+// dispatch methods encrypt the named payload fields with enc before
+// sending. Fields are dotted paths into the payload, such as
+// "patient.ssn"; fields an action does not have are left alone. The
+// caller's action is not modified.
+//
+// Sealed values are decrypted again in the audit records returned by
+// QueryAudit, GetAuditRecord, and GetAuditRecords and in the action of
+// GetDlqEntry. Rules on the gateway see only ciphertext, so do not
+// encrypt fields that routing or dedup rules inspect.
+func WithPayloadEncryption(enc Encryptor, fields ...string) ClientOption {
+	return func(c *Client) {
+		c.encryption = &payloadEncryption{enc: enc, fields: fields}
+	}
+}
+
+// sealAction returns action with the configured fields encrypted, or
+// action itself when encryption is off or none of the fields is set.
+func (c *Client) sealAction(ctx context.Context, action *Action) (*Action, error) {
+	if c.encryption == nil || action == nil || action.Payload == nil {
+		return action, nil
+	}
+	var payload map[string]any
+	if err := cloneJSON(action.Payload, &payload); err != nil {
+		return nil, &EncryptionError{Field: "payload", Err: err}
+	}
+
+	var sealed []string
+	keyRefs := map[string]bool{}
+	for _, field := range c.encryption.fields {
+		parent, key := lookupField(payload, field)
+		value, ok := parent[key]
+		if !ok {
+			continue
+		}
+		plaintext, err := json.Marshal(value)
+		if err != nil {
+			return nil, &EncryptionError{Field: field, Err: err}
+		}
+		ciphertext, keyRef, err := c.encryption.enc.Encrypt(ctx, plaintext)
+		if err != nil {
+			return nil, &EncryptionError{Field: field, Err: err}
+		}
+		parent[key] = encryptedPrefix + base64.StdEncoding.EncodeToString(ciphertext) + ":" + keyRef
+		sealed = append(sealed, field)
+		keyRefs[keyRef] = true
+	}
+	if len(sealed) == 0 {
+		return action, nil
+	}
+
+	out := *action
+	out.Payload = payload
+	labels := map[string]string{}
+	if action.Metadata != nil {
+		for k, v := range action.Metadata.Labels {
+			labels[k] = v
+		}
+	}
+	refs := make([]string, 0, len(keyRefs))
+	for ref := range keyRefs {
+		refs = append(refs, ref)
+	}
+	sort.Strings(refs)
+	labels[EncryptedFieldsLabel] = strings.Join(sealed, ",")
+	labels[EncryptionKeyLabel] = strings.Join(refs, ",")
+	out.Metadata = &ActionMetadata{Labels: labels}
+	return &out, nil
+}
+
+// sealActions is sealAction for a batch.
+func (c *Client) sealActions(ctx context.Context, actions []*Action) ([]*Action, error) {
+	if c.encryption == nil {
+		return actions, nil
+	}
+	out := make([]*Action, len(actions))
+	for i, a := range actions {
+		sealed, err := c.sealAction(ctx, a)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = sealed
+	}
+	return out, nil
+}
+
+// openPayload decrypts, in place, every sealed value in payload.
+func (c *Client) openPayload(ctx context.Context, payload map[string]any) error {
+	if c.encryption == nil || payload == nil {
+		return nil
+	}
+	return c.openValues(ctx, "", payload)
+}
+
+func (c *Client) openValues(ctx context.Context, path string, m map[string]any) error {
+	for key, value := range m {
+		field := key
+		if path != "" {
+			field = path + "." + key
+		}
+		switch v := value.(type) {
+		case map[string]any:
+			if err := c.openValues(ctx, field, v); err != nil {
+				return err
+			}
+		case string:
+			if !strings.HasPrefix(v, encryptedPrefix) {
+				continue
+			}
+			opened, err := c.openValue(ctx, v)
+			if err != nil {
+				return &EncryptionError{Field: field, Err: err}
+			}
+			m[key] = opened
+		}
+	}
+	return nil
+}
+
+func (c *Client) openValue(ctx context.Context, sealed string) (any, error) {
+	data, keyRef, ok := strings.Cut(strings.TrimPrefix(sealed, encryptedPrefix), ":")
+	if !ok {
+		return nil, errors.New("malformed sealed value")
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := c.encryption.enc.Decrypt(ctx, ciphertext, keyRef)
+	if err != nil {
+		return nil, err
+	}
+	var value any
+	if err := json.Unmarshal(plaintext, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// openAuditRecords decrypts the payloads of records in place.
+func (c *Client) openAuditRecords(ctx context.Context, records []AuditRecord) error {
+	for i := range records {
+		if err := c.openPayload(ctx, records[i].ActionPayload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lookupField returns the map holding the last element of the dotted
+// path field, creating nothing; parent is nil when an intermediate
+// element is missing or not an object.
+func lookupField(payload map[string]any, field string) (parent map[string]any, key string) {
+	parts := strings.Split(field, ".")
+	parent = payload
+	for _, p := range parts[:len(parts)-1] {
+		next, ok := parent[p].(map[string]any)
+		if !ok {
+			return nil, ""
+		}
+		parent = next
+	}
+	return parent, parts[len(parts)-1]
+}
+
+// cloneJSON deep-copies v into out through its JSON form, keeping
+// numbers exact.
+func cloneJSON(v any, out any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(out)
+}
+
+// AESEnvelope is an Encryptor doing envelope encryption with
+// AES-256-GCM: every value is sealed under a fresh data key, and the
+// data key is sealed under the key-encryption key named KeyID. Keys
+// maps key IDs to 32-byte key-encryption keys; keep retired keys in
+// it so that values sealed before a rotation still open.
+type AESEnvelope struct {
+	KeyID string
+	Keys  map[string][]byte
+}
+
+// Encrypt implements Encryptor. The ciphertext is the sealed data key
+// followed by the sealed value.
+func (e *AESEnvelope) Encrypt(_ context.Context, plaintext []byte) ([]byte, string, error) {
+	kek, ok := e.Keys[e.KeyID]
+	if !ok {
+		return nil, "", fmt.Errorf("unknown key %q", e.KeyID)
+	}
+	dataKey := make([]byte, 32)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, "", err
+	}
+	wrapped, err := gcmSeal(kek, dataKey)
+	if err != nil {
+		return nil, "", err
+	}
+	sealed, err := gcmSeal(dataKey, plaintext)
+	if err != nil {
+		return nil, "", err
+	}
+	return append(wrapped, sealed...), e.KeyID, nil
+}
+
+// Decrypt implements Encryptor.
+func (e *AESEnvelope) Decrypt(_ context.Context, ciphertext []byte, keyRef string) ([]byte, error) {
+	kek, ok := e.Keys[keyRef]
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", keyRef)
+	}
+	// A sealed data key is nonce + key + tag.
+	const wrappedLen = 12 + 32 + 16
+	if len(ciphertext) < wrappedLen {
+		return nil, errors.New("ciphertext too short")
+	}
+	dataKey, err := gcmOpen(kek, ciphertext[:wrappedLen])
+	if err != nil {
+		return nil, err
+	}
+	return gcmOpen(dataKey, ciphertext[wrappedLen:])
+}
+
+// gcmSeal encrypts plaintext with AES-GCM under key, prefixing the
+// random nonce.
+func gcmSeal(key, plaintext []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// gcmOpen reverses gcmSeal.
+func gcmOpen(key, sealed []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	n := aead.NonceSize()
+	return aead.Open(nil, sealed[:n], sealed[n:], nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
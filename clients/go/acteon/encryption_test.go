@@ -0,0 +1,102 @@
+package acteon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func testEnvelope() *AESEnvelope {
+	return &AESEnvelope{KeyID: "k1", Keys: map[string][]byte{
+		"k1": bytes.Repeat([]byte{1}, 32),
+		"k2": bytes.Repeat([]byte{2}, 32),
+	}}
+}
+
+func TestDispatchEncryptsPayloadFields(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{"Executed": map[string]any{}})
+	defer teardown()
+	enc := testEnvelope()
+	c := NewClient(url, WithPayloadEncryption(enc, "patient.ssn", "notes", "absent.field"))
+
+	action := NewAction("clinic", "t1", "email", "send", map[string]any{
+		"to":      "dr@example.com",
+		"patient": map[string]any{"name": "Ada", "ssn": "123-45-6789"},
+		"notes":   []any{"allergic", 42},
+	})
+	if _, err := c.Dispatch(context.Background(), action); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(captured.body), "123-45-6789") || strings.Contains(string(captured.body), "allergic") {
+		t.Fatalf("plaintext sent: %s", captured.body)
+	}
+
+	var sent Action
+	if err := json.Unmarshal(captured.body, &sent); err != nil {
+		t.Fatal(err)
+	}
+	if sent.Payload["to"] != "dr@example.com" || sent.Payload["patient"].(map[string]any)["name"] != "Ada" {
+		t.Errorf("unsealed fields changed: %v", sent.Payload)
+	}
+	if got := sent.Metadata.Labels; got[EncryptedFieldsLabel] != "patient.ssn,notes" || got[EncryptionKeyLabel] != "k1" {
+		t.Errorf("labels = %v", got)
+	}
+	if action.Payload["patient"].(map[string]any)["ssn"] != "123-45-6789" || action.Metadata != nil {
+		t.Error("caller's action was modified")
+	}
+
+	if err := c.openPayload(context.Background(), sent.Payload); err != nil {
+		t.Fatal(err)
+	}
+	if sent.Payload["patient"].(map[string]any)["ssn"] != "123-45-6789" {
+		t.Errorf("opened ssn = %v", sent.Payload["patient"])
+	}
+	if notes := sent.Payload["notes"].([]any); notes[0] != "allergic" || notes[1] != float64(42) {
+		t.Errorf("opened notes = %v", notes)
+	}
+}
+
+func TestAuditRecordDecrypted(t *testing.T) {
+	enc := testEnvelope()
+	sealer := NewClient("http://unused", WithPayloadEncryption(enc, "ssn"))
+	sealed, err := sealer.sealAction(context.Background(), NewAction("clinic", "t1", "email", "send", map[string]any{"ssn": "123-45-6789"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	url, _, teardown := newCapturingServer(t, 200, map[string]any{
+		"id": "r1", "action_id": sealed.ID, "action_payload": sealed.Payload,
+	})
+	defer teardown()
+	enc.KeyID = "k2" // a rotation must not break older records
+	c := NewClient(url, WithPayloadEncryption(enc, "ssn"))
+	rec, err := c.GetAuditRecord(context.Background(), sealed.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.ActionPayload["ssn"] != "123-45-6789" {
+		t.Errorf("payload = %v", rec.ActionPayload)
+	}
+
+	delete(enc.Keys, "k1")
+	_, err = c.GetAuditRecord(context.Background(), sealed.ID)
+	var encErr *EncryptionError
+	if !errors.As(err, &encErr) || encErr.Field != "ssn" {
+		t.Errorf("err = %v", err)
+	}
+}
+
+func TestAESEnvelopeRejectsTampering(t *testing.T) {
+	enc := testEnvelope()
+	ct, ref, err := enc.Encrypt(context.Background(), []byte(`"secret"`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ct[len(ct)-1] ^= 1
+	if _, err := enc.Decrypt(context.Background(), ct, ref); err == nil {
+		t.Error("tampered ciphertext opened")
+	}
+}
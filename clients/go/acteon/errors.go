@@ -1,6 +1,12 @@
 package acteon
 
-import "fmt"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
 
 // ActeonError is the base interface for Acteon client errors.
 type ActeonError interface {
@@ -21,10 +27,16 @@ func (e *ConnectionError) IsRetryable() bool {
 	return true
 }
 
-// HTTPError represents an HTTP error.
+// HTTPError represents an HTTP error whose body was not a recognized
+// error envelope — typically an HTML page from a proxy or load balancer.
 type HTTPError struct {
 	Status  int
 	Message string
+	// Body holds the start of the response body, up to 4 KiB, so that
+	// proxy error pages can be logged.
+	Body string
+	// ContentType is the Content-Type header of the response.
+	ContentType string
 }
 
 func (e *HTTPError) Error() string {
@@ -49,3 +61,209 @@ func (e *APIError) Error() string {
 func (e *APIError) IsRetryable() bool {
 	return e.Retryable
 }
+
+// TemplateValidationError is returned by CreateTemplate and
+// UpdateTemplate when template validation is enabled on the client and
+// the content does not validate. Nothing is stored.
+type TemplateValidationError struct {
+	Result *TemplateValidationResult
+}
+
+func (e *TemplateValidationError) Error() string {
+	if len(e.Result.Errors) == 0 {
+		return "template validation failed"
+	}
+	first := e.Result.Errors[0]
+	if len(e.Result.Errors) == 1 {
+		return fmt.Sprintf("template validation failed: line %d, column %d: %s", first.Line, first.Column, first.Message)
+	}
+	return fmt.Sprintf("template validation failed: line %d, column %d: %s (and %d more)", first.Line, first.Column, first.Message, len(e.Result.Errors)-1)
+}
+
+func (e *TemplateValidationError) IsRetryable() bool {
+	return false
+}
+
+// PayloadValidationError reports a typed provider payload that failed
+// its Validate check.
+type PayloadValidationError struct {
+	Provider string
+	Field    string
+	Message  string
+}
+
+func (e *PayloadValidationError) Error() string {
+	return fmt.Sprintf("invalid %s payload: %s: %s", e.Provider, e.Field, e.Message)
+}
+
+func (e *PayloadValidationError) IsRetryable() bool {
+	return false
+}
+
+// BatchItemError is the failure of one action in a batch dispatch.
+type BatchItemError struct {
+	Index    int
+	ActionID string
+	Err      *APIError
+}
+
+func (e *BatchItemError) Error() string {
+	return fmt.Sprintf("batch action %d (%s): %s", e.Index, e.ActionID, e.Err)
+}
+
+func (e *BatchItemError) Unwrap() error {
+	return e.Err
+}
+
+func (e *BatchItemError) IsRetryable() bool {
+	return e.Err.IsRetryable()
+}
+
+// BatchError reports that some actions in a batch dispatch failed.
+// It is returned by BatchResults.Error; Results still holds every
+// entry, including the ones that succeeded.
+type BatchError struct {
+	Results  BatchResults
+	Failures []*BatchItemError
+}
+
+func (e *BatchError) Error() string {
+	first := e.Failures[0]
+	if len(e.Failures) == 1 {
+		return fmt.Sprintf("1 of %d batch actions failed: %s", len(e.Results), first)
+	}
+	return fmt.Sprintf("%d of %d batch actions failed: %s (and %d more)", len(e.Failures), len(e.Results), first, len(e.Failures)-1)
+}
+
+// Unwrap returns the per-action failures, so errors.As finds an
+// *APIError or *BatchItemError from any of them.
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, len(e.Failures))
+	for i, f := range e.Failures {
+		errs[i] = f
+	}
+	return errs
+}
+
+// IsRetryable reports whether every failed action is retryable.
+func (e *BatchError) IsRetryable() bool {
+	for _, f := range e.Failures {
+		if !f.IsRetryable() {
+			return false
+		}
+	}
+	return true
+}
+
+// ErrAtomicBatchUnsupported is returned, or wrapped, by
+// DispatchBatchAtomic when the gateway does not dispatch batches
+// atomically.
+var ErrAtomicBatchUnsupported = errors.New("gateway does not support atomic batch dispatch")
+
+// AtomicBatchRejectedError is returned by DispatchBatchAtomic when the
+// gateway refuses the whole batch because of one action. None of the
+// actions were dispatched.
+type AtomicBatchRejectedError struct {
+	// Index and ActionID identify the action that caused the rejection.
+	Index    int
+	ActionID string
+	// Outcome is the result rule evaluation produced for that action,
+	// such as Suppressed or Throttled, or nil when it was rejected
+	// before evaluation, for example for a bad signature.
+	Outcome *ActionOutcome
+	Err     *APIError
+}
+
+func (e *AtomicBatchRejectedError) Error() string {
+	return fmt.Sprintf("atomic batch rejected by action %d (%s): %s", e.Index, e.ActionID, e.Err)
+}
+
+func (e *AtomicBatchRejectedError) Unwrap() error {
+	return e.Err
+}
+
+// IsRetryable reports whether the batch may succeed if sent again
+// unchanged: the gateway said so, or the action was only throttled.
+func (e *AtomicBatchRejectedError) IsRetryable() bool {
+	return e.Err.IsRetryable() || (e.Outcome != nil && e.Outcome.IsThrottled())
+}
+
+// ValidationProblem is one reason an action failed client-side
+// validation. Field is the JSON path of the offending field, prefixed
+// with the entry index for batch dispatches (e.g. "[2].provider").
+type ValidationProblem struct {
+	Field   string
+	Message string
+}
+
+// ValidationError is returned by Dispatch and the batch dispatch
+// methods when an action fails client-side validation, by the
+// subject data methods for an incomplete SubjectQuery, and by
+// SyncPluginFromRegistry for an incomplete PluginRef. It lists every
+// problem found; nothing is sent to the server.
+type ValidationError struct {
+	Problems []ValidationProblem
+}
+
+func (e *ValidationError) Error() string {
+	parts := make([]string, len(e.Problems))
+	for i, p := range e.Problems {
+		parts[i] = p.Field + ": " + p.Message
+	}
+	return "invalid action: " + strings.Join(parts, "; ")
+}
+
+func (e *ValidationError) IsRetryable() bool {
+	return false
+}
+
+// maxErrorBody bounds HTTPError.Body.
+const maxErrorBody = 4 << 10 // 4 KiB
+
+// problemDetails is an RFC 7807 problem+json error body.
+type problemDetails struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Detail string `json:"detail"`
+}
+
+// errorFromResponse converts a non-success response body into an
+// error. It recognizes the gateway's own ErrorResponse, RFC 7807
+// problem details, and a bare {"error": "..."} object; anything else
+// becomes an HTTPError carrying fallback and the start of the body.
+func errorFromResponse(resp *http.Response, body []byte, fallback string) error {
+	var envelope struct {
+		ErrorResponse
+		problemDetails
+		Error string `json:"error"`
+	}
+	if json.Unmarshal(body, &envelope) == nil {
+		switch {
+		case envelope.Code != "" || envelope.Message != "":
+			return &APIError{Code: envelope.Code, Message: envelope.Message, Retryable: envelope.Retryable}
+		case envelope.Title != "" || envelope.Detail != "":
+			code := envelope.Title
+			if code == "" {
+				code = envelope.Type
+			}
+			msg := envelope.Detail
+			if msg == "" {
+				msg = envelope.Title
+			}
+			return &APIError{Code: code, Message: msg, Retryable: resp.StatusCode >= 500}
+		case envelope.Error != "":
+			return &APIError{Code: http.StatusText(resp.StatusCode), Message: envelope.Error, Retryable: resp.StatusCode >= 500}
+		}
+	}
+	raw := body
+	if len(raw) > maxErrorBody {
+		raw = raw[:maxErrorBody]
+	}
+	return &HTTPError{
+		Status:      resp.StatusCode,
+		Message:     fallback,
+		Body:        string(raw),
+		ContentType: resp.Header.Get("Content-Type"),
+	}
+}
@@ -0,0 +1,290 @@
+// Escalation policy surface for the Go client.
+//
+// An escalation policy is a ladder of steps: when an event stays
+// unacknowledged for a step's delay, the gateway notifies that step's
+// targets and moves on to the next. This file manages policies over
+// `/v1/escalation-policies` and reads where a given event currently
+// sits on its ladder over `/v1/escalations`.
+
+package acteon
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Escalation target types for EscalationTarget.Type.
+const (
+	EscalationTargetUser     = "user"
+	EscalationTargetSchedule = "schedule"
+	EscalationTargetProvider = "provider"
+)
+
+// Escalation states reported in EscalationState.Status.
+const (
+	EscalationActive       = "active"
+	EscalationAcknowledged = "acknowledged"
+	EscalationResolved     = "resolved"
+	// EscalationExhausted means every step (and repeat) has fired
+	// without an acknowledgement.
+	EscalationExhausted = "exhausted"
+)
+
+// EscalationTarget is who a step notifies: a user, an on-call
+// schedule, or a provider such as a paging integration.
+type EscalationTarget struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// EscalationStep notifies Targets once the event has been
+// unacknowledged for DelaySeconds since the previous step fired (or
+// since the event opened, for the first step).
+type EscalationStep struct {
+	DelaySeconds int64              `json:"delay_seconds"`
+	Targets      []EscalationTarget `json:"targets"`
+}
+
+// Delay returns DelaySeconds as a time.Duration.
+func (s EscalationStep) Delay() time.Duration {
+	return time.Duration(s.DelaySeconds) * time.Second
+}
+
+// EscalationPolicy is a named escalation ladder for a namespace and
+// tenant.
+type EscalationPolicy struct {
+	ID        string           `json:"id"`
+	Name      string           `json:"name"`
+	Namespace string           `json:"namespace"`
+	Tenant    string           `json:"tenant"`
+	Steps     []EscalationStep `json:"steps"`
+	// Repeat is how many times the ladder restarts from the first step
+	// after the last one fires. Zero runs it once.
+	Repeat      int               `json:"repeat"`
+	Description *string           `json:"description,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+// CreateEscalationPolicyRequest is the request to create an escalation
+// policy.
+type CreateEscalationPolicyRequest struct {
+	Name        string            `json:"name"`
+	Namespace   string            `json:"namespace"`
+	Tenant      string            `json:"tenant"`
+	Steps       []EscalationStep  `json:"steps"`
+	Repeat      int               `json:"repeat,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+}
+
+// UpdateEscalationPolicyRequest is the request to update an escalation
+// policy. A non-nil Steps replaces the whole ladder; escalations in
+// progress continue from the same step index.
+type UpdateEscalationPolicyRequest struct {
+	Steps       []EscalationStep  `json:"steps,omitempty"`
+	Repeat      *int              `json:"repeat,omitempty"`
+	Description *string           `json:"description,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+}
+
+// ListEscalationPoliciesResponse is the response from listing
+// escalation policies.
+type ListEscalationPoliciesResponse struct {
+	Policies []EscalationPolicy `json:"policies"`
+	Count    int                `json:"count"`
+}
+
+// EscalationNotification records one step firing for one target.
+type EscalationNotification struct {
+	Step       int              `json:"step"`
+	Target     EscalationTarget `json:"target"`
+	NotifiedAt time.Time        `json:"notified_at"`
+	// Error is set when the notification could not be delivered.
+	Error *string `json:"error,omitempty"`
+}
+
+// EscalationState is where an event sits on its escalation ladder.
+type EscalationState struct {
+	Fingerprint string `json:"fingerprint"`
+	PolicyID    string `json:"policy_id"`
+	Status      string `json:"status"`
+	// CurrentStep is the index of the last step that fired, or -1 if
+	// none has yet. Round counts repeats of the ladder, from 0.
+	CurrentStep      int                      `json:"current_step"`
+	Round            int                      `json:"round"`
+	StartedAt        time.Time                `json:"started_at"`
+	NextEscalationAt *time.Time               `json:"next_escalation_at,omitempty"`
+	Notifications    []EscalationNotification `json:"notifications"`
+	AcknowledgedBy   *string                  `json:"acknowledged_by,omitempty"`
+	AcknowledgedAt   *time.Time               `json:"acknowledged_at,omitempty"`
+}
+
+// CreateEscalationPolicy creates an escalation policy.
+func (c *Client) CreateEscalationPolicy(ctx context.Context, req *CreateEscalationPolicyRequest) (*EscalationPolicy, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/escalation-policies", req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusCreated {
+		var result EscalationPolicy
+		if err := c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to create escalation policy")
+}
+
+// ListEscalationPolicies lists escalation policies with optional
+// namespace and tenant filters.
+func (c *Client) ListEscalationPolicies(ctx context.Context, namespace, tenant *string) (*ListEscalationPoliciesResponse, error) {
+	result, err := c.listEscalationPolicies(ctx, namespace, tenant)
+	if err != nil {
+		return nil, err
+	}
+	if selector, ok := labelSelectorFrom(ctx); ok {
+		result.Policies = selectLabels(result.Policies, selector, func(p EscalationPolicy) map[string]string { return p.Labels })
+		result.Count = len(result.Policies)
+	}
+	return result, nil
+}
+
+func (c *Client) listEscalationPolicies(ctx context.Context, namespace, tenant *string) (*ListEscalationPoliciesResponse, error) {
+	params := url.Values{}
+	if namespace != nil {
+		params.Set("namespace", *namespace)
+	}
+	if tenant != nil {
+		params.Set("tenant", *tenant)
+	}
+
+	path := "/v1/escalation-policies"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list escalation policies"}
+	}
+
+	var result ListEscalationPoliciesResponse
+	if err := c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &result, nil
+}
+
+// GetEscalationPolicy gets an escalation policy by ID. Returns
+// (nil, nil) if it does not exist.
+func (c *Client) GetEscalationPolicy(ctx context.Context, policyID string) (*EscalationPolicy, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/v1/escalation-policies/"+url.PathEscape(policyID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get escalation policy"}
+	}
+
+	var result EscalationPolicy
+	if err := c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &result, nil
+}
+
+// UpdateEscalationPolicy updates an escalation policy.
+func (c *Client) UpdateEscalationPolicy(ctx context.Context, policyID string, update *UpdateEscalationPolicyRequest) (*EscalationPolicy, error) {
+	resp, err := c.doRequest(ctx, http.MethodPut, "/v1/escalation-policies/"+url.PathEscape(policyID), update)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var result EscalationPolicy
+		if err := c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Escalation policy not found: %s", policyID)}
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to update escalation policy")
+}
+
+// DeleteEscalationPolicy deletes an escalation policy. Escalations
+// already running under it stop at their current step.
+func (c *Client) DeleteEscalationPolicy(ctx context.Context, policyID string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, "/v1/escalation-policies/"+url.PathEscape(policyID), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Escalation policy not found: %s", policyID)}
+	}
+	return &HTTPError{Status: resp.StatusCode, Message: "Failed to delete escalation policy"}
+}
+
+// GetEscalationState returns where the event with the given
+// fingerprint sits on its escalation ladder. Returns (nil, nil) if the
+// event is not being escalated.
+func (c *Client) GetEscalationState(ctx context.Context, eventFingerprint string) (*EscalationState, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/v1/escalations/"+url.PathEscape(eventFingerprint), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get escalation state"}
+	}
+
+	var result EscalationState
+	if err := c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &result, nil
+}
@@ -0,0 +1,108 @@
+package acteon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCreateEscalationPolicy(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, http.StatusCreated, map[string]any{
+		"id": "esc-1", "name": "sev1", "namespace": "alerts", "tenant": "acme",
+		"steps": []map[string]any{
+			{"delay_seconds": 0, "targets": []map[string]string{{"type": "schedule", "id": "primary"}}},
+			{"delay_seconds": 900, "targets": []map[string]string{{"type": "user", "id": "lead"}}},
+		},
+		"repeat":     1,
+		"created_at": "2026-03-01T10:00:00Z", "updated_at": "2026-03-01T10:00:00Z",
+	})
+	defer teardown()
+
+	policy, err := NewClient(url).CreateEscalationPolicy(context.Background(), &CreateEscalationPolicyRequest{
+		Name:      "sev1",
+		Namespace: "alerts",
+		Tenant:    "acme",
+		Steps: []EscalationStep{
+			{Targets: []EscalationTarget{{Type: EscalationTargetSchedule, ID: "primary"}}},
+			{DelaySeconds: 900, Targets: []EscalationTarget{{Type: EscalationTargetUser, ID: "lead"}}},
+		},
+		Repeat: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != http.MethodPost || captured.path != "/v1/escalation-policies" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	var sent map[string]any
+	if err := json.Unmarshal(captured.body, &sent); err != nil {
+		t.Fatal(err)
+	}
+	if sent["name"] != "sev1" || len(sent["steps"].([]any)) != 2 {
+		t.Errorf("body = %s", captured.body)
+	}
+	if policy.ID != "esc-1" || policy.Repeat != 1 || policy.Steps[1].Delay() != 15*time.Minute {
+		t.Errorf("policy = %+v", policy)
+	}
+}
+
+func TestListEscalationPoliciesFilters(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, http.StatusOK, map[string]any{
+		"policies": []map[string]any{{"id": "esc-1", "name": "sev1"}}, "count": 1,
+	})
+	defer teardown()
+
+	ns := "alerts"
+	list, err := NewClient(url).ListEscalationPolicies(context.Background(), &ns, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.query != "namespace=alerts" {
+		t.Errorf("query = %q", captured.query)
+	}
+	if list.Count != 1 || list.Policies[0].ID != "esc-1" {
+		t.Errorf("list = %+v", list)
+	}
+}
+
+func TestEscalationPolicyNotFound(t *testing.T) {
+	url, _, teardown := newCapturingServer(t, http.StatusNotFound, map[string]any{})
+	defer teardown()
+	client := NewClient(url)
+
+	policy, err := client.GetEscalationPolicy(context.Background(), "missing")
+	if err != nil || policy != nil {
+		t.Errorf("get = %+v, %v", policy, err)
+	}
+	var httpErr *HTTPError
+	if err := client.DeleteEscalationPolicy(context.Background(), "missing"); !errors.As(err, &httpErr) || httpErr.Status != http.StatusNotFound {
+		t.Errorf("delete err = %v", err)
+	}
+}
+
+func TestGetEscalationState(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, http.StatusOK, map[string]any{
+		"fingerprint": "fp-1", "policy_id": "esc-1", "status": "active",
+		"current_step": 0, "round": 0,
+		"started_at":         "2026-03-01T10:00:00Z",
+		"next_escalation_at": "2026-03-01T10:15:00Z",
+		"notifications": []map[string]any{
+			{"step": 0, "target": map[string]string{"type": "schedule", "id": "primary"}, "notified_at": "2026-03-01T10:00:01Z"},
+		},
+	})
+	defer teardown()
+
+	state, err := NewClient(url).GetEscalationState(context.Background(), "fp-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != http.MethodGet || captured.path != "/v1/escalations/fp-1" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	if state.Status != EscalationActive || state.NextEscalationAt == nil || len(state.Notifications) != 1 || state.AcknowledgedBy != nil {
+		t.Errorf("state = %+v", state)
+	}
+}
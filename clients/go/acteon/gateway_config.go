@@ -0,0 +1,156 @@
+// Gateway configuration inspection and hot reload.
+//
+// GET /admin/config returns the effective configuration with every
+// secret masked, which makes it safe to diff against the expected
+// configuration in CI or a drift check. POST /admin/config/reload
+// re-reads the configuration file and applies whatever can change
+// without a restart.
+
+package acteon
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+// GatewayConfig is the sanitized effective configuration of a gateway.
+// The sections most often inspected are typed; every section,
+// including those, is also kept verbatim in Sections.
+type GatewayConfig struct {
+	Server    ServerConfigSnapshot     `json:"server"`
+	State     StateConfigSnapshot      `json:"state"`
+	Audit     AuditConfigSnapshot      `json:"audit"`
+	Rules     RulesConfigSnapshot      `json:"rules"`
+	Providers []ProviderConfigSnapshot `json:"providers"`
+
+	// Sections maps each top-level section name (e.g. "executor",
+	// "circuit_breaker") to its JSON.
+	Sections map[string]json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes the typed sections and keeps every section in
+// Sections.
+func (g *GatewayConfig) UnmarshalJSON(data []byte) error {
+	type plain GatewayConfig
+	var typed plain
+	if err := json.Unmarshal(data, &typed); err != nil {
+		return err
+	}
+	var sections map[string]json.RawMessage
+	if err := json.Unmarshal(data, &sections); err != nil {
+		return err
+	}
+	*g = GatewayConfig(typed)
+	g.Sections = sections
+	return nil
+}
+
+// ServerConfigSnapshot is the `server` section of GatewayConfig.
+type ServerConfigSnapshot struct {
+	Host                       string  `json:"host"`
+	Port                       int     `json:"port"`
+	ShutdownTimeoutSeconds     int     `json:"shutdown_timeout_seconds"`
+	ExternalURL                *string `json:"external_url,omitempty"`
+	MaxSSEConnectionsPerTenant *int    `json:"max_sse_connections_per_tenant,omitempty"`
+}
+
+// StateConfigSnapshot is the `state` section of GatewayConfig.
+type StateConfigSnapshot struct {
+	Backend   string  `json:"backend"`
+	HasURL    bool    `json:"has_url"`
+	Prefix    *string `json:"prefix,omitempty"`
+	Region    *string `json:"region,omitempty"`
+	TableName *string `json:"table_name,omitempty"`
+}
+
+// AuditConfigSnapshot is the `audit` section of GatewayConfig.
+type AuditConfigSnapshot struct {
+	Enabled                bool            `json:"enabled"`
+	Backend                string          `json:"backend"`
+	HasURL                 bool            `json:"has_url"`
+	Prefix                 string          `json:"prefix"`
+	TTLSeconds             *int64          `json:"ttl_seconds,omitempty"`
+	CleanupIntervalSeconds int64           `json:"cleanup_interval_seconds"`
+	StorePayload           bool            `json:"store_payload"`
+	Redact                 json.RawMessage `json:"redact,omitempty"`
+}
+
+// RulesConfigSnapshot is the `rules` section of GatewayConfig.
+type RulesConfigSnapshot struct {
+	Directory       *string `json:"directory,omitempty"`
+	DefaultTimezone *string `json:"default_timezone,omitempty"`
+}
+
+// ProviderConfigSnapshot summarizes one configured provider. Secret
+// values are replaced by Has* flags.
+type ProviderConfigSnapshot struct {
+	Name          string  `json:"name"`
+	ProviderType  string  `json:"provider_type"`
+	URL           *string `json:"url,omitempty"`
+	HeaderCount   int     `json:"header_count"`
+	HasToken      bool    `json:"has_token"`
+	HasAuthToken  bool    `json:"has_auth_token"`
+	HasWebhookURL bool    `json:"has_webhook_url"`
+	EmailBackend  *string `json:"email_backend,omitempty"`
+	AWSRegion     *string `json:"aws_region,omitempty"`
+}
+
+// ConfigReloadResult reports the outcome of ReloadConfig.
+type ConfigReloadResult struct {
+	// Applied lists the sections whose changes took effect.
+	Applied []string `json:"applied"`
+	// RestartRequired lists changed sections that only take effect
+	// after a restart, such as the bind address or state backend.
+	RestartRequired []string `json:"restart_required"`
+	// Unchanged is true when the file matched the running configuration.
+	Unchanged bool `json:"unchanged"`
+}
+
+// GetConfig returns the gateway's effective configuration with secrets
+// masked.
+func (c *Client) GetConfig(ctx context.Context) (*GatewayConfig, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/admin/config", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get config"}
+	}
+
+	var result GatewayConfig
+	if err := c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &result, nil
+}
+
+// ReloadConfig makes the gateway re-read its configuration file and
+// apply the changes that do not need a restart, including rules. A
+// configuration that fails to parse is rejected and the running
+// configuration is kept.
+func (c *Client) ReloadConfig(ctx context.Context) (*ConfigReloadResult, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/admin/config/reload", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var result ConfigReloadResult
+		if err := c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to reload config")
+}
@@ -0,0 +1,66 @@
+package acteon
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestGetConfigKeepsAllSections(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{
+		"server":          map[string]any{"host": "0.0.0.0", "port": 8080, "shutdown_timeout_seconds": 30},
+		"state":           map[string]any{"backend": "redis", "has_url": true},
+		"audit":           map[string]any{"enabled": true, "backend": "postgres", "has_url": true, "prefix": "acteon_", "cleanup_interval_seconds": 3600, "store_payload": false},
+		"rules":           map[string]any{"directory": "/etc/acteon/rules"},
+		"circuit_breaker": map[string]any{"enabled": true, "failure_threshold": 5},
+		"providers":       []map[string]any{{"name": "slack", "provider_type": "slack", "header_count": 0, "has_token": true}},
+	})
+	defer teardown()
+
+	// Strict decoding must not reject sections that only live in Sections.
+	cfg, err := NewClient(url, WithStrictDecoding()).GetConfig(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != "GET" || captured.path != "/admin/config" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	if cfg.Server.Port != 8080 || cfg.State.Backend != "redis" || !cfg.Audit.Enabled || *cfg.Rules.Directory != "/etc/acteon/rules" {
+		t.Errorf("config = %+v", cfg)
+	}
+	if len(cfg.Providers) != 1 || !cfg.Providers[0].HasToken {
+		t.Errorf("providers = %+v", cfg.Providers)
+	}
+	if string(cfg.Sections["circuit_breaker"]) != `{"enabled":true,"failure_threshold":5}` {
+		t.Errorf("sections = %v", cfg.Sections)
+	}
+}
+
+func TestReloadConfig(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{
+		"applied": []string{"rules", "rate_limit"}, "restart_required": []string{"server"},
+	})
+	defer teardown()
+
+	res, err := NewClient(url).ReloadConfig(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != "POST" || captured.path != "/admin/config/reload" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	if len(res.Applied) != 2 || res.RestartRequired[0] != "server" {
+		t.Errorf("result = %+v", res)
+	}
+}
+
+func TestReloadConfigRejected(t *testing.T) {
+	url, _, teardown := newCapturingServer(t, 400, map[string]any{"code": "INVALID_CONFIG", "message": "line 12: unknown field"})
+	defer teardown()
+
+	_, err := NewClient(url).ReloadConfig(context.Background())
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != "INVALID_CONFIG" {
+		t.Errorf("err = %v", err)
+	}
+}
@@ -0,0 +1,161 @@
+// LLM guardrail surface for the Go client.
+//
+// When the guardrail is enabled the gateway asks an LLM, under a
+// configurable policy, whether each action that passed the rules may
+// run. A refusal surfaces as a Suppressed outcome with
+// ActionOutcome.Guardrail set. This file reads and updates the
+// guardrail configuration over `/v1/guardrails/llm` and evaluates text
+// against the live evaluator so policies can be tried out before they
+// gate real traffic.
+
+package acteon
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Rule prefixes the gateway uses for guardrail denials on Suppressed
+// outcomes that carry no structured verdict.
+const (
+	guardrailDeniedPrefix      = "LLM guardrail: "
+	guardrailUnavailablePrefix = "LLM guardrail unavailable: "
+)
+
+// GuardrailVerdict is the LLM guardrail's decision on one action or
+// piece of text.
+type GuardrailVerdict struct {
+	Allowed bool   `json:"allowed"`
+	Reason  string `json:"reason"`
+	// Unavailable is true when the evaluator could not be reached and
+	// the guardrail failed closed; Reason then holds the error.
+	Unavailable bool `json:"unavailable,omitempty"`
+	// Policy is the policy prompt the decision was made under.
+	Policy string `json:"policy,omitempty"`
+}
+
+// guardrailVerdictFromRule recovers a verdict from the rule string of
+// a Suppressed outcome, or returns nil if a rule suppressed it.
+func guardrailVerdictFromRule(rule string) *GuardrailVerdict {
+	if reason, ok := strings.CutPrefix(rule, guardrailUnavailablePrefix); ok {
+		return &GuardrailVerdict{Reason: reason, Unavailable: true}
+	}
+	if reason, ok := strings.CutPrefix(rule, guardrailDeniedPrefix); ok {
+		return &GuardrailVerdict{Reason: reason}
+	}
+	return nil
+}
+
+// GuardrailConfig is the LLM guardrail configuration. The API key is
+// never returned; APIKeySet reports whether one is configured.
+type GuardrailConfig struct {
+	Enabled  bool   `json:"enabled"`
+	Endpoint string `json:"endpoint"`
+	Model    string `json:"model"`
+	// Policy is the default policy prompt. Policies overrides it per
+	// action type, and a rule's `llm_policy` metadata overrides both.
+	Policy   string            `json:"policy"`
+	Policies map[string]string `json:"policies,omitempty"`
+	// FailOpen lets actions through when the evaluator is unreachable.
+	// The default, false, denies them.
+	FailOpen       bool     `json:"fail_open"`
+	TimeoutSeconds *uint64  `json:"timeout_seconds,omitempty"`
+	Temperature    *float64 `json:"temperature,omitempty"`
+	MaxTokens      *uint32  `json:"max_tokens,omitempty"`
+	APIKeySet      bool     `json:"api_key_set"`
+}
+
+// UpdateGuardrailConfigRequest changes the guardrail configuration.
+// Nil fields are left as they are; a non-nil Policies replaces the
+// whole map.
+type UpdateGuardrailConfigRequest struct {
+	Enabled        *bool             `json:"enabled,omitempty"`
+	Endpoint       *string           `json:"endpoint,omitempty"`
+	Model          *string           `json:"model,omitempty"`
+	APIKey         *string           `json:"api_key,omitempty"`
+	Policy         *string           `json:"policy,omitempty"`
+	Policies       map[string]string `json:"policies,omitempty"`
+	FailOpen       *bool             `json:"fail_open,omitempty"`
+	TimeoutSeconds *uint64           `json:"timeout_seconds,omitempty"`
+	Temperature    *float64          `json:"temperature,omitempty"`
+	MaxTokens      *uint32           `json:"max_tokens,omitempty"`
+}
+
+// GuardrailEvaluation is the result of EvaluateGuardrail.
+type GuardrailEvaluation struct {
+	GuardrailVerdict
+	LatencyMs int64 `json:"latency_ms"`
+}
+
+// GetGuardrailConfig returns the LLM guardrail configuration.
+func (c *Client) GetGuardrailConfig(ctx context.Context) (*GuardrailConfig, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/v1/guardrails/llm/config", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get guardrail config"}
+	}
+
+	var cfg GuardrailConfig
+	if err := c.decodeBody(resp.Body, &cfg); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &cfg, nil
+}
+
+// UpdateGuardrailConfig changes the LLM guardrail configuration and
+// returns the result. The change applies to the next dispatch.
+func (c *Client) UpdateGuardrailConfig(ctx context.Context, update *UpdateGuardrailConfigRequest) (*GuardrailConfig, error) {
+	resp, err := c.doRequest(ctx, http.MethodPut, "/v1/guardrails/llm/config", update)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var cfg GuardrailConfig
+		if err := c.decodeJSON(body, &cfg); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &cfg, nil
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to update guardrail config")
+}
+
+// EvaluateGuardrail asks the guardrail whether text is allowed under
+// the default policy, without dispatching anything. Evaluator errors
+// come back as a verdict with Unavailable set, as they would on
+// dispatch.
+func (c *Client) EvaluateGuardrail(ctx context.Context, text string) (*GuardrailEvaluation, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/guardrails/llm/evaluate", map[string]string{"text": text})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var eval GuardrailEvaluation
+		if err := c.decodeJSON(body, &eval); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &eval, nil
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to evaluate guardrail")
+}
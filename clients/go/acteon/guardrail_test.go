@@ -0,0 +1,95 @@
+package acteon
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestGuardrailConfigRoundTrip(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, http.StatusOK, map[string]any{
+		"enabled":     true,
+		"endpoint":    "https://api.openai.com/v1/chat/completions",
+		"model":       "gpt-4o-mini",
+		"policy":      "block anything destructive",
+		"policies":    map[string]string{"delete_user": "never allow"},
+		"fail_open":   true,
+		"api_key_set": true,
+	})
+	defer teardown()
+	client := NewClient(url)
+
+	cfg, err := client.GetGuardrailConfig(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != http.MethodGet || captured.path != "/v1/guardrails/llm/config" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	if !cfg.Enabled || !cfg.FailOpen || !cfg.APIKeySet || cfg.Policies["delete_user"] != "never allow" {
+		t.Errorf("config = %+v", cfg)
+	}
+
+	failOpen := false
+	if _, err := client.UpdateGuardrailConfig(context.Background(), &UpdateGuardrailConfigRequest{FailOpen: &failOpen}); err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != http.MethodPut || string(captured.body) != `{"fail_open":false}` {
+		t.Errorf("update = %s %s", captured.method, captured.body)
+	}
+}
+
+func TestEvaluateGuardrail(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, http.StatusOK, map[string]any{
+		"allowed": false, "reason": "mass deletion", "policy": "p", "latency_ms": 420,
+	})
+	defer teardown()
+
+	eval, err := NewClient(url).EvaluateGuardrail(context.Background(), "drop all tables")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.path != "/v1/guardrails/llm/evaluate" || string(captured.body) != `{"text":"drop all tables"}` {
+		t.Errorf("request = %s %s", captured.path, captured.body)
+	}
+	if eval.Allowed || eval.Reason != "mass deletion" || eval.LatencyMs != 420 {
+		t.Errorf("evaluation = %+v", eval)
+	}
+}
+
+func TestOutcomeGuardrailVerdict(t *testing.T) {
+	cases := []struct {
+		body        string
+		denied      bool
+		reason      string
+		unavailable bool
+	}{
+		{`{"Suppressed":{"rule":"block-spam"}}`, false, "", false},
+		{`{"Suppressed":{"rule":"LLM guardrail: looks like phishing"}}`, true, "looks like phishing", false},
+		{`{"Suppressed":{"rule":"LLM guardrail unavailable: timeout"}}`, true, "timeout", true},
+		{`{"Suppressed":{"rule":"llm","guardrail":{"allowed":false,"reason":"pii","policy":"no pii"}}}`, true, "pii", false},
+	}
+	for _, tc := range cases {
+		var o ActionOutcome
+		if err := json.Unmarshal([]byte(tc.body), &o); err != nil {
+			t.Fatal(err)
+		}
+		if o.IsGuardrailDenied() != tc.denied {
+			t.Errorf("%s: IsGuardrailDenied = %v", tc.body, o.IsGuardrailDenied())
+			continue
+		}
+		if tc.denied && (o.Guardrail.Reason != tc.reason || o.Guardrail.Unavailable != tc.unavailable) {
+			t.Errorf("%s: verdict = %+v", tc.body, o.Guardrail)
+		}
+
+		data, err := json.Marshal(o)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var again ActionOutcome
+		if err := json.Unmarshal(data, &again); err != nil || again.Rule != o.Rule || again.IsGuardrailDenied() != tc.denied {
+			t.Errorf("%s: round trip = %s (%v)", tc.body, data, err)
+		}
+	}
+}
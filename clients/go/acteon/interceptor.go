@@ -0,0 +1,61 @@
+// Request interceptors for the Go client.
+//
+// WithInterceptor wraps the function that sends each HTTP request, so
+// that callers can refresh credentials, add headers, record metrics,
+// or inject faults without replacing the http.Client. Interceptors see
+// every request the client makes, including the long-lived event
+// stream connections.
+
+package acteon
+
+import (
+	"errors"
+	"net/http"
+)
+
+// RoundTripFunc sends one HTTP request and returns its response.
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// Interceptor wraps the RoundTripFunc that comes after it in the chain.
+// It may modify the request, short-circuit with its own response or
+// error, or inspect what next returns:
+//
+//	logging := func(next acteon.RoundTripFunc) acteon.RoundTripFunc {
+//		return func(req *http.Request) (*http.Response, error) {
+//			start := time.Now()
+//			resp, err := next(req)
+//			log.Printf("%s %s took %v", req.Method, req.URL.Path, time.Since(start))
+//			return resp, err
+//		}
+//	}
+type Interceptor func(next RoundTripFunc) RoundTripFunc
+
+// WithInterceptor adds interceptor to the client's chain. Interceptors
+// run in the order they were added, the first one outermost, and are
+// called once per attempt when WithRetryPolicy retries a call.
+//
+// An error an interceptor returns reaches the caller unchanged if it is
+// an ActeonError, such as an *HTTPError, and as a *ConnectionError
+// otherwise.
+func WithInterceptor(interceptor Interceptor) ClientOption {
+	return func(c *Client) {
+		c.interceptors = append(c.interceptors, interceptor)
+	}
+}
+
+// roundTrip sends req through hc and the interceptor chain.
+func (c *Client) roundTrip(hc *http.Client, req *http.Request) (*http.Response, error) {
+	send := RoundTripFunc(hc.Do)
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		send = c.interceptors[i](send)
+	}
+	resp, err := send(req)
+	if err != nil {
+		var ae ActeonError
+		if errors.As(err, &ae) {
+			return nil, err
+		}
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return resp, nil
+}
@@ -0,0 +1,88 @@
+package acteon
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestInterceptorChain(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer refreshed" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if strings.HasPrefix(r.URL.Path, "/v1/stream") {
+			w.Header().Set("Content-Type", "text/event-stream")
+			_, _ = w.Write([]byte("id: 1\nevent: ping\ndata: {}\n\n"))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	var order []string
+	trace := func(name string) Interceptor {
+		return func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				order = append(order, name+" "+req.URL.Path)
+				return next(req)
+			}
+		}
+	}
+	auth := func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			req.Header.Set("Authorization", "Bearer refreshed")
+			return next(req)
+		}
+	}
+	c := NewClient(srv.URL, WithAPIKey("stale"), WithInterceptor(trace("outer")), WithInterceptor(auth), WithInterceptor(trace("inner")))
+
+	if ok, err := c.Health(context.Background()); err != nil || !ok {
+		t.Fatalf("Health = %v, %v", ok, err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, err := c.Stream(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ev := <-events; ev == nil || ev.Event != "ping" {
+		t.Errorf("event = %+v", ev)
+	}
+
+	want := []string{"outer /health", "inner /health", "outer /v1/stream", "inner /v1/stream"}
+	if strings.Join(order, ",") != strings.Join(want, ",") {
+		t.Errorf("order = %v", order)
+	}
+}
+
+func TestInterceptorErrors(t *testing.T) {
+	attempts := 0
+	unavailable := func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			attempts++
+			return nil, &HTTPError{Status: http.StatusServiceUnavailable, Message: "injected"}
+		}
+	}
+	c := NewClient("http://unused.invalid", WithInterceptor(unavailable),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}))
+	_, err := c.GetAuditRecord(context.Background(), "a1")
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.Message != "injected" || attempts != 2 {
+		t.Errorf("err = %v after %d attempts", err, attempts)
+	}
+
+	plain := func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) { return nil, errors.New("boom") }
+	}
+	_, err = NewClient("http://unused.invalid", WithInterceptor(plain)).GetAuditRecord(context.Background(), "a1")
+	var connErr *ConnectionError
+	if !errors.As(err, &connErr) {
+		t.Errorf("plain error = %v", err)
+	}
+}
@@ -0,0 +1,469 @@
+// Command apigen generates the ActeonAPI interface (api_gen.go), the
+// acteonmock.Client implementation (acteonmock/acteonmock_gen.go), and
+// the acteonchaos.Client decorator (acteonchaos/acteonchaos_gen.go)
+// from the exported methods of *acteon.Client.
+//
+// It is run by `go generate` in the acteon package directory:
+//
+//	go generate ./acteon
+//
+// Service accessors such as Quotas() return concrete service structs
+// and are left out; every service method has a flat wrapper on Client
+// that the interface covers instead.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const header = "// Code generated by apigen; DO NOT EDIT.\n\n"
+
+const (
+	modulePath = "github.com/penserai/acteon/clients/go/acteon"
+	mockDir    = "acteonmock"
+	chaosDir   = "acteonchaos"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("apigen: ")
+
+	dir := "."
+	if len(os.Args) > 1 {
+		dir = os.Args[1]
+	}
+	api, mock, chaos, err := Generate(dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "api_gen.go"), api, 0o644); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, mockDir, "acteonmock_gen.go"), mock, 0o644); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, chaosDir, "acteonchaos_gen.go"), chaos, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// method is one exported *Client method.
+type method struct {
+	name string
+	typ  *ast.FuncType
+}
+
+// Generate parses the acteon package in dir and returns the formatted
+// sources of api_gen.go, acteonmock_gen.go, and acteonchaos_gen.go.
+func Generate(dir string) (api, mock, chaos []byte, err error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	pkg, ok := pkgs["acteon"]
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("no acteon package in %s", dir)
+	}
+
+	types := map[string]bool{}
+	imports := map[string]string{}
+	var methods []method
+	for _, f := range pkg.Files {
+		for _, spec := range f.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			name := path[strings.LastIndex(path, "/")+1:]
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			imports[name] = path
+		}
+		for _, decl := range f.Decls {
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				if d.Tok != token.TYPE {
+					continue
+				}
+				for _, s := range d.Specs {
+					types[s.(*ast.TypeSpec).Name.Name] = true
+				}
+			case *ast.FuncDecl:
+				if isClientMethod(d) && !isServiceAccessor(d) {
+					methods = append(methods, method{name: d.Name.Name, typ: d.Type})
+				}
+			}
+		}
+	}
+	sort.Slice(methods, func(i, j int) bool { return methods[i].name < methods[j].name })
+
+	api, err = generateAPI(fset, methods, imports)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	qualified := make([]method, len(methods))
+	for i, m := range methods {
+		typ, err := qualify(m.typ, types)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("%s: %w", m.name, err)
+		}
+		qualified[i] = method{name: m.name, typ: typ}
+	}
+	mock, err = generateMock(fset, qualified, imports)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	chaos, err = generateChaos(fset, qualified, imports)
+	return api, mock, chaos, err
+}
+
+func isClientMethod(d *ast.FuncDecl) bool {
+	if d.Recv == nil || len(d.Recv.List) != 1 || !d.Name.IsExported() {
+		return false
+	}
+	star, ok := d.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	id, ok := star.X.(*ast.Ident)
+	return ok && id.Name == "Client"
+}
+
+// isServiceAccessor reports whether d is a method like Quotas() that
+// returns a *QuotaService.
+func isServiceAccessor(d *ast.FuncDecl) bool {
+	if d.Type.Params.NumFields() != 0 || d.Type.Results.NumFields() != 1 {
+		return false
+	}
+	star, ok := d.Type.Results.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	id, ok := star.X.(*ast.Ident)
+	return ok && strings.HasSuffix(id.Name, "Service")
+}
+
+func generateAPI(fset *token.FileSet, methods []method, imports map[string]string) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package acteon\n\n")
+	b.WriteString("import (\n")
+	for _, path := range usedImports(methods, imports) {
+		fmt.Fprintf(&b, "\t%q\n", path)
+	}
+	b.WriteString(")\n\n")
+	b.WriteString("// ActeonAPI is the method set of *Client. Depend on it instead of\n")
+	b.WriteString("// *Client to substitute a fake, such as acteonmock.Client, in tests.\n")
+	b.WriteString("type ActeonAPI interface {\n")
+	for _, m := range methods {
+		// Printing a copy without positions keeps each method on one line.
+		typ, err := qualify(m.typ, nil)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", m.name, err)
+		}
+		fmt.Fprintf(&b, "\t%s%s\n", m.name, strings.TrimPrefix(node(fset, typ), "func"))
+	}
+	b.WriteString("}\n")
+	return format.Source(b.Bytes())
+}
+
+// generateMock writes acteonmock_gen.go from methods whose signatures
+// have been qualified.
+func generateMock(fset *token.FileSet, qualified []method, imports map[string]string) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package acteonmock\n\n")
+	writeImports(&b, usedImports(qualified, imports))
+
+	b.WriteString("// Client is a stub acteon.ActeonAPI. Each method records its call\n")
+	b.WriteString("// and delegates to the matching Func field; methods whose field is\n")
+	b.WriteString("// nil return zero values and an error wrapping ErrNotStubbed.\n")
+	b.WriteString("type Client struct {\n\trecorder\n\n")
+	for _, m := range qualified {
+		fmt.Fprintf(&b, "\t%sFunc %s\n", m.name, node(fset, m.typ))
+	}
+	b.WriteString("}\n\n")
+	b.WriteString("var _ acteon.ActeonAPI = (*Client)(nil)\n")
+
+	for _, m := range qualified {
+		names, args, paramTypes := paramNames(fset, m.typ)
+		sig := signature(fset, m.typ, names)
+		fmt.Fprintf(&b, "\n// %s calls %sFunc.\n", m.name, m.name)
+		fmt.Fprintf(&b, "func (m *Client) %s%s {\n", m.name, sig)
+		recorded := []string{strconv.Quote(m.name)}
+		for i, name := range names {
+			if paramTypes[i] != "context.Context" {
+				recorded = append(recorded, name)
+			}
+		}
+		fmt.Fprintf(&b, "\tm.record(%s)\n", strings.Join(recorded, ", "))
+		call := fmt.Sprintf("m.%sFunc(%s)", m.name, strings.Join(args, ", "))
+		results := resultTypes(fset, m.typ)
+		fmt.Fprintf(&b, "\tif m.%sFunc == nil {\n", m.name)
+		var zeros []string
+		for i, r := range results {
+			if r == "error" && i == len(results)-1 {
+				zeros = append(zeros, fmt.Sprintf("notStubbed(%q)", m.name))
+				continue
+			}
+			fmt.Fprintf(&b, "\t\tvar r%d %s\n", i, r)
+			zeros = append(zeros, fmt.Sprintf("r%d", i))
+		}
+		if len(results) == 0 {
+			b.WriteString("\t\treturn\n\t}\n")
+			fmt.Fprintf(&b, "\t%s\n}\n", call)
+			continue
+		}
+		fmt.Fprintf(&b, "\t\treturn %s\n\t}\n", strings.Join(zeros, ", "))
+		fmt.Fprintf(&b, "\treturn %s\n}\n", call)
+	}
+	return format.Source(b.Bytes())
+}
+
+// generateChaos writes acteonchaos_gen.go from methods whose
+// signatures have been qualified. Methods that take a context consult
+// the injector before delegating; the rest delegate directly.
+func generateChaos(fset *token.FileSet, qualified []method, imports map[string]string) ([]byte, error) {
+	paths := usedImports(qualified, imports)
+	if !slices.Contains(paths, "context") {
+		paths = append([]string{"context"}, paths...)
+	}
+
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package acteonchaos\n\n")
+	writeImports(&b, paths)
+	b.WriteString("var _ acteon.ActeonAPI = (*Client)(nil)\n")
+
+	for _, m := range qualified {
+		names, args, paramTypes := paramNames(fset, m.typ)
+		results := resultTypes(fset, m.typ)
+		call := fmt.Sprintf("c.next.%s(%s)", m.name, strings.Join(args, ", "))
+		ctx := slices.Index(paramTypes, "context.Context")
+
+		fmt.Fprintf(&b, "\n// %s delegates to the wrapped client", m.name)
+		if ctx < 0 {
+			b.WriteString(" without injecting faults.\n")
+		} else {
+			b.WriteString(" after injecting any fault.\n")
+		}
+		fmt.Fprintf(&b, "func (c *Client) %s%s {\n", m.name, signature(fset, m.typ, names))
+		if ctx < 0 || len(results) == 0 || results[len(results)-1] != "error" {
+			if len(results) == 0 {
+				fmt.Fprintf(&b, "\t%s\n}\n", call)
+			} else {
+				fmt.Fprintf(&b, "\treturn %s\n}\n", call)
+			}
+			continue
+		}
+
+		var zeros, vars []string
+		for i, r := range results[:len(results)-1] {
+			zeros = append(zeros, fmt.Sprintf("r%d", i))
+			vars = append(vars, fmt.Sprintf("\t\tvar r%d %s\n", i, r))
+		}
+		extra := "NoFault"
+		if len(results) == 2 {
+			switch {
+			case results[0] == "*acteon.ActionOutcome" || results[0] == "acteon.BatchResults":
+				extra = "MalformedOutcome"
+			case strings.HasPrefix(results[0], "<-chan "):
+				extra = "Disconnect"
+			}
+		}
+		if extra == "NoFault" {
+			fmt.Fprintf(&b, "\tif _, err := c.before(%s, %q, NoFault); err != nil {\n", names[ctx], m.name)
+		} else {
+			fmt.Fprintf(&b, "\tfault, err := c.before(%s, %q, %s)\n", names[ctx], m.name, extra)
+			b.WriteString("\tif err != nil {\n")
+		}
+		b.WriteString(strings.Join(vars, ""))
+		fmt.Fprintf(&b, "\t\treturn %s\n\t}\n", strings.Join(append(zeros, "err"), ", "))
+		switch {
+		case results[0] == "*acteon.ActionOutcome":
+			fmt.Fprintf(&b, "\tr0, err := %s\n\treturn malformOutcome(fault, r0), err\n}\n", call)
+		case results[0] == "acteon.BatchResults":
+			fmt.Fprintf(&b, "\tr0, err := %s\n\treturn c.malformBatch(fault, r0), err\n}\n", call)
+		case extra == "Disconnect":
+			fmt.Fprintf(&b, "\t%s, stop := streamContext(%s, fault)\n", names[ctx], names[ctx])
+			fmt.Fprintf(&b, "\tr0, err := %s\n", call)
+			fmt.Fprintf(&b, "\treturn disconnect(%s, stop, c.cfg.DisconnectAfter, r0, err)\n}\n", names[ctx])
+		default:
+			fmt.Fprintf(&b, "\treturn %s\n}\n", call)
+		}
+	}
+	return format.Source(b.Bytes())
+}
+
+// writeImports writes an import block for paths as sorted by
+// usedImports, setting the acteon package apart.
+func writeImports(b *bytes.Buffer, paths []string) {
+	b.WriteString("import (\n")
+	for _, path := range paths {
+		if path == modulePath {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(b, "\t%q\n", path)
+	}
+	b.WriteString(")\n\n")
+}
+
+// qualify returns a copy of typ with the acteon package's own type
+// names prefixed by "acteon.".
+func qualify(typ *ast.FuncType, types map[string]bool) (*ast.FuncType, error) {
+	var bad error
+	var rewrite func(e ast.Expr) ast.Expr
+	rewrite = func(e ast.Expr) ast.Expr {
+		switch t := e.(type) {
+		case *ast.Ident:
+			if types[t.Name] {
+				if !t.IsExported() {
+					bad = fmt.Errorf("unexported type %s in signature", t.Name)
+				}
+				return &ast.SelectorExpr{X: ast.NewIdent("acteon"), Sel: ast.NewIdent(t.Name)}
+			}
+			return ast.NewIdent(t.Name)
+		case *ast.StarExpr:
+			return &ast.StarExpr{X: rewrite(t.X)}
+		case *ast.ArrayType:
+			return &ast.ArrayType{Len: t.Len, Elt: rewrite(t.Elt)}
+		case *ast.MapType:
+			return &ast.MapType{Key: rewrite(t.Key), Value: rewrite(t.Value)}
+		case *ast.ChanType:
+			return &ast.ChanType{Dir: t.Dir, Value: rewrite(t.Value)}
+		case *ast.Ellipsis:
+			return &ast.Ellipsis{Elt: rewrite(t.Elt)}
+		case *ast.FuncType:
+			return &ast.FuncType{Params: rewriteFields(t.Params, rewrite), Results: rewriteFields(t.Results, rewrite)}
+		case *ast.SelectorExpr, *ast.InterfaceType:
+			return t
+		default:
+			bad = fmt.Errorf("unsupported type expression %T", e)
+			return e
+		}
+	}
+	out := &ast.FuncType{Params: rewriteFields(typ.Params, rewrite), Results: rewriteFields(typ.Results, rewrite)}
+	return out, bad
+}
+
+func rewriteFields(fl *ast.FieldList, rewrite func(ast.Expr) ast.Expr) *ast.FieldList {
+	if fl == nil {
+		return nil
+	}
+	out := &ast.FieldList{}
+	for _, f := range fl.List {
+		var names []*ast.Ident
+		for _, n := range f.Names {
+			names = append(names, ast.NewIdent(n.Name))
+		}
+		out.List = append(out.List, &ast.Field{Names: names, Type: rewrite(f.Type)})
+	}
+	return out
+}
+
+// paramNames returns a name for every parameter, inventing p0, p1, ...
+// for unnamed ones, the matching call arguments, and their types.
+func paramNames(fset *token.FileSet, typ *ast.FuncType) (names, args, types []string) {
+	i := 0
+	for _, f := range typ.Params.List {
+		_, variadic := f.Type.(*ast.Ellipsis)
+		n := max(len(f.Names), 1)
+		for j := range n {
+			name := fmt.Sprintf("p%d", i)
+			if j < len(f.Names) && f.Names[j].Name != "_" {
+				name = f.Names[j].Name
+			}
+			names = append(names, name)
+			types = append(types, node(fset, f.Type))
+			if variadic {
+				args = append(args, name+"...")
+			} else {
+				args = append(args, name)
+			}
+			i++
+		}
+	}
+	return names, args, types
+}
+
+// signature prints typ without the func keyword, using names for the
+// parameters.
+func signature(fset *token.FileSet, typ *ast.FuncType, names []string) string {
+	params := &ast.FieldList{}
+	i := 0
+	for _, f := range typ.Params.List {
+		n := max(len(f.Names), 1)
+		field := &ast.Field{Type: f.Type}
+		for range n {
+			field.Names = append(field.Names, ast.NewIdent(names[i]))
+			i++
+		}
+		params.List = append(params.List, field)
+	}
+	return strings.TrimPrefix(node(fset, &ast.FuncType{Params: params, Results: typ.Results}), "func")
+}
+
+func resultTypes(fset *token.FileSet, typ *ast.FuncType) []string {
+	var out []string
+	if typ.Results == nil {
+		return out
+	}
+	for _, f := range typ.Results.List {
+		for range max(len(f.Names), 1) {
+			out = append(out, node(fset, f.Type))
+		}
+	}
+	return out
+}
+
+// usedImports returns the import paths of the packages referred to in
+// the methods' signatures, resolved through the acteon sources' own
+// imports: standard library paths sorted, then the acteon package.
+func usedImports(methods []method, imports map[string]string) []string {
+	seen := map[string]bool{}
+	for _, m := range methods {
+		ast.Inspect(m.typ, func(n ast.Node) bool {
+			sel, ok := n.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			if id, ok := sel.X.(*ast.Ident); ok {
+				seen[id.Name] = true
+			}
+			return false
+		})
+	}
+	var paths []string
+	for name := range seen {
+		if name != "acteon" {
+			paths = append(paths, imports[name])
+		}
+	}
+	sort.Strings(paths)
+	if seen["acteon"] {
+		paths = append(paths, modulePath)
+	}
+	return paths
+}
+
+func node(fset *token.FileSet, n ast.Node) string {
+	var b bytes.Buffer
+	if err := printer.Fprint(&b, fset, n); err != nil {
+		panic(err)
+	}
+	return b.String()
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestGeneratedFilesUpToDate fails when a *Client method was added or
+// changed without rerunning `go generate ./acteon`.
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	dir := filepath.Join("..", "..")
+	api, mock, chaos, err := Generate(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string][]byte{
+		"api_gen.go": api,
+		filepath.Join(mockDir, "acteonmock_gen.go"):   mock,
+		filepath.Join(chaosDir, "acteonchaos_gen.go"): chaos,
+	} {
+		got, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is stale; run go generate ./acteon", name)
+		}
+	}
+}
@@ -0,0 +1,260 @@
+// Package journal keeps a local record of the events received from an
+// Acteon SSE stream.
+//
+// Every event is written to a SQLite table before the consumer sees
+// it. The table doubles as the stream's
+// cursor: after a crash or restart, Stream reconnects with the ID of
+// the last journaled event as Last-Event-ID, so the gateway replays
+// what was missed. Recently seen events can also be queried offline:
+//
+//	db, _ := sql.Open("sqlite", "events.db") // any SQLite driver
+//	j, err := journal.Open(ctx, db)
+//	events, err := j.Stream(ctx, client, &acteon.StreamOptions{Namespace: &ns})
+//	for ev := range events {
+//		...
+//	}
+//
+//	recent, err := j.Events(ctx, journal.Query{EventType: "action_dispatched", Limit: 50})
+//
+// The package does not import a driver; the caller opens the database
+// with the SQLite driver of their choice. The schema and queries are
+// SQLite's dialect (INTEGER PRIMARY KEY AUTOINCREMENT, ? placeholders)
+// and are not portable to other databases as written.
+package journal
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+// DefaultTable is the table Open uses unless Table is given.
+const DefaultTable = "acteon_journal"
+
+// DefaultStream is the stream name Open uses unless StreamName is
+// given.
+const DefaultStream = "default"
+
+// Record is a journaled event.
+type Record struct {
+	// Seq orders the records of a journal; it grows with every event.
+	Seq        int64
+	ID         string
+	Event      string
+	Data       string
+	ReceivedAt time.Time
+}
+
+// SseEvent returns the event as the client delivered it.
+func (r Record) SseEvent() *acteon.SseEvent {
+	return &acteon.SseEvent{ID: r.ID, Event: r.Event, Data: r.Data}
+}
+
+// Query selects journaled events. Zero fields do not filter.
+type Query struct {
+	// EventType matches the SSE event name exactly.
+	EventType string
+	// Since and Until bound ReceivedAt, inclusive and exclusive.
+	Since time.Time
+	Until time.Time
+	// AfterSeq returns only records with a larger Seq, for paging.
+	AfterSeq int64
+	// Limit caps the number of records; 0 means 100.
+	Limit int
+}
+
+// Streamer is the subset of *acteon.Client that Stream needs.
+type Streamer interface {
+	Stream(ctx context.Context, opts *acteon.StreamOptions) (<-chan *acteon.SseEvent, error)
+}
+
+// Journal records stream events in a table.
+type Journal struct {
+	db      *sql.DB
+	table   string
+	stream  string
+	onError func(*acteon.SseEvent, error)
+}
+
+// Option configures a Journal.
+type Option func(*Journal)
+
+// Table sets the table name. The default is DefaultTable.
+func Table(name string) Option {
+	return func(j *Journal) { j.table = name }
+}
+
+// StreamName separates journals sharing a table, such as consumers
+// with different filters. Each name has its own cursor. The default
+// is DefaultStream.
+func StreamName(name string) Option {
+	return func(j *Journal) { j.stream = name }
+}
+
+// OnError sets a function called when an event cannot be journaled.
+// Stream then stops without delivering that event, so the journal's
+// cursor stays before it and the next Stream replays it. It must not
+// block.
+func OnError(fn func(*acteon.SseEvent, error)) Option {
+	return func(j *Journal) { j.onError = fn }
+}
+
+// Open returns a journal in db, creating its table if needed.
+func Open(ctx context.Context, db *sql.DB, opts ...Option) (*Journal, error) {
+	j := &Journal{db: db, table: DefaultTable, stream: DefaultStream}
+	for _, opt := range opts {
+		opt(j)
+	}
+	if _, err := db.ExecContext(ctx, Schema(j.table)); err != nil {
+		return nil, fmt.Errorf("journal: create table: %w", err)
+	}
+	return j, nil
+}
+
+// Schema returns the CREATE TABLE statement Open runs for table.
+func Schema(table string) string {
+	return fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	seq INTEGER PRIMARY KEY AUTOINCREMENT,
+	stream VARCHAR(128) NOT NULL,
+	event_id VARCHAR(128) NOT NULL,
+	event VARCHAR(128) NOT NULL,
+	data TEXT NOT NULL,
+	received_at INTEGER NOT NULL
+)`, table)
+}
+
+// Append journals ev. Events with an ID move the stream's cursor.
+func (j *Journal) Append(ctx context.Context, ev *acteon.SseEvent) error {
+	_, err := j.db.ExecContext(ctx, fmt.Sprintf(
+		"INSERT INTO %s (stream, event_id, event, data, received_at) VALUES (?, ?, ?, ?, ?)", j.table),
+		j.stream, ev.ID, ev.Event, ev.Data, time.Now().UnixMilli())
+	return err
+}
+
+// LastEventID returns the ID of the newest journaled event that had
+// one, or "" when there is none.
+func (j *Journal) LastEventID(ctx context.Context) (string, error) {
+	var id string
+	err := j.db.QueryRowContext(ctx, fmt.Sprintf(
+		"SELECT event_id FROM %s WHERE stream = ? AND event_id <> '' ORDER BY seq DESC LIMIT 1", j.table),
+		j.stream).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	return id, err
+}
+
+// Events returns the journaled events matching q, oldest first.
+func (j *Journal) Events(ctx context.Context, q Query) ([]Record, error) {
+	where := []string{"stream = ?"}
+	args := []any{j.stream}
+	if q.EventType != "" {
+		where = append(where, "event = ?")
+		args = append(args, q.EventType)
+	}
+	if !q.Since.IsZero() {
+		where = append(where, "received_at >= ?")
+		args = append(args, q.Since.UnixMilli())
+	}
+	if !q.Until.IsZero() {
+		where = append(where, "received_at < ?")
+		args = append(args, q.Until.UnixMilli())
+	}
+	if q.AfterSeq > 0 {
+		where = append(where, "seq > ?")
+		args = append(args, q.AfterSeq)
+	}
+	limit := q.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+	args = append(args, limit)
+
+	rows, err := j.db.QueryContext(ctx, fmt.Sprintf(
+		"SELECT seq, event_id, event, data, received_at FROM %s WHERE %s ORDER BY seq LIMIT ?",
+		j.table, strings.Join(where, " AND ")), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var records []Record
+	for rows.Next() {
+		var r Record
+		var millis int64
+		if err := rows.Scan(&r.Seq, &r.ID, &r.Event, &r.Data, &millis); err != nil {
+			return nil, err
+		}
+		r.ReceivedAt = time.UnixMilli(millis)
+		records = append(records, r)
+	}
+	return records, rows.Err()
+}
+
+// Prune deletes events received before cutoff and returns how many
+// were removed. The newest event with an ID is kept whatever its age,
+// so the stream can still resume.
+func (j *Journal) Prune(ctx context.Context, cutoff time.Time) (int64, error) {
+	res, err := j.db.ExecContext(ctx, fmt.Sprintf(
+		"DELETE FROM %[1]s WHERE stream = ? AND received_at < ? AND seq < "+
+			"(SELECT COALESCE(MAX(seq), 0) FROM %[1]s WHERE stream = ? AND event_id <> '')", j.table),
+		j.stream, cutoff.UnixMilli(), j.stream)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// Stream opens client's event stream, resuming after the last
+// journaled event, and journals every event before delivering it on
+// the returned channel. opts.LastEventID, when set, overrides the
+// journal's cursor. The channel closes when the underlying stream
+// does, or after an event fails to journal; that event and those after
+// it are not delivered, and the caller resumes from the last journaled
+// event by calling Stream again.
+func (j *Journal) Stream(ctx context.Context, client Streamer, opts *acteon.StreamOptions) (<-chan *acteon.SseEvent, error) {
+	var o acteon.StreamOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.LastEventID == nil {
+		id, err := j.LastEventID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("journal: read cursor: %w", err)
+		}
+		if id != "" {
+			o.LastEventID = &id
+		}
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	in, err := client.Stream(streamCtx, &o)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	out := make(chan *acteon.SseEvent, cap(in))
+	go func() {
+		defer close(out)
+		defer cancel()
+		for ev := range in {
+			if err := j.Append(ctx, ev); err != nil {
+				if j.onError != nil {
+					j.onError(ev, err)
+				}
+				return
+			}
+			select {
+			case out <- ev:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
@@ -0,0 +1,221 @@
+package journal
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+// recordingDriver is a database/sql driver that records statements and
+// answers every query with the rows it was given.
+type recordingDriver struct {
+	mu    sync.Mutex
+	stmts []string
+	args  [][]driver.NamedValue
+	cols  []string
+	rows  [][]driver.Value
+	// insertErr, when set, fails every INSERT.
+	insertErr error
+}
+
+func (d *recordingDriver) Open(string) (driver.Conn, error) { return &recordingConn{d}, nil }
+
+type recordingConn struct{ d *recordingDriver }
+
+func (c *recordingConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c *recordingConn) Close() error                        { return nil }
+func (c *recordingConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+func (c *recordingConn) record(query string, args []driver.NamedValue) {
+	c.d.mu.Lock()
+	defer c.d.mu.Unlock()
+	c.d.stmts = append(c.d.stmts, query)
+	c.d.args = append(c.d.args, args)
+}
+
+func (c *recordingConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.record(query, args)
+	if c.d.insertErr != nil && strings.HasPrefix(query, "INSERT") {
+		return nil, c.d.insertErr
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (c *recordingConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.record(query, args)
+	return &recordingRows{cols: c.d.cols, rows: c.d.rows}, nil
+}
+
+type recordingRows struct {
+	cols []string
+	rows [][]driver.Value
+}
+
+func (r *recordingRows) Columns() []string { return r.cols }
+func (r *recordingRows) Close() error      { return nil }
+func (r *recordingRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+var registerOnce sync.Once
+var testDriver = &recordingDriver{}
+
+func openTestDB(t *testing.T) (*sql.DB, *recordingDriver) {
+	t.Helper()
+	registerOnce.Do(func() { sql.Register("journaltest", testDriver) })
+	testDriver.mu.Lock()
+	testDriver.stmts, testDriver.args, testDriver.cols, testDriver.rows = nil, nil, nil, nil
+	testDriver.insertErr = nil
+	testDriver.mu.Unlock()
+	db, err := sql.Open("journaltest", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db, testDriver
+}
+
+// fakeStreamer replays events and records the options it was opened with.
+type fakeStreamer struct {
+	opts   *acteon.StreamOptions
+	events []*acteon.SseEvent
+}
+
+func (f *fakeStreamer) Stream(_ context.Context, opts *acteon.StreamOptions) (<-chan *acteon.SseEvent, error) {
+	f.opts = opts
+	ch := make(chan *acteon.SseEvent, len(f.events))
+	for _, ev := range f.events {
+		ch <- ev
+	}
+	close(ch)
+	return ch, nil
+}
+
+func TestStreamResumesAndJournals(t *testing.T) {
+	db, d := openTestDB(t)
+	ctx := context.Background()
+	j, err := Open(ctx, db, StreamName("alerts"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.cols, d.rows = []string{"event_id"}, [][]driver.Value{{"ev-7"}}
+
+	ns := "alerts"
+	streamer := &fakeStreamer{events: []*acteon.SseEvent{
+		{ID: "ev-8", Event: "action_dispatched", Data: `{"id":"a-1"}`},
+		{Event: "ping"},
+	}}
+	events, err := j.Stream(ctx, streamer, &acteon.StreamOptions{Namespace: &ns})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for ev := range events {
+		got = append(got, ev.Event)
+	}
+
+	if streamer.opts.LastEventID == nil || *streamer.opts.LastEventID != "ev-7" || *streamer.opts.Namespace != "alerts" {
+		t.Errorf("stream options = %+v", streamer.opts)
+	}
+	if strings.Join(got, ",") != "action_dispatched,ping" {
+		t.Errorf("events = %v", got)
+	}
+	if !strings.HasPrefix(d.stmts[0], "CREATE TABLE IF NOT EXISTS acteon_journal (") {
+		t.Errorf("schema = %s", d.stmts[0])
+	}
+	if d.stmts[1] != "SELECT event_id FROM acteon_journal WHERE stream = ? AND event_id <> '' ORDER BY seq DESC LIMIT 1" {
+		t.Errorf("cursor query = %s", d.stmts[1])
+	}
+	if len(d.stmts) != 4 || !strings.HasPrefix(d.stmts[2], "INSERT INTO acteon_journal ") {
+		t.Fatalf("statements = %q", d.stmts)
+	}
+	if a := d.args[2]; a[0].Value != "alerts" || a[1].Value != "ev-8" || a[3].Value != `{"id":"a-1"}` {
+		t.Errorf("insert args = %v", a)
+	}
+}
+
+func TestStreamStopsWhenJournalingFails(t *testing.T) {
+	db, d := openTestDB(t)
+	j, err := Open(context.Background(), db, OnError(func(ev *acteon.SseEvent, err error) {
+		if ev.ID != "ev-8" {
+			t.Errorf("OnError for %q", ev.ID)
+		}
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.insertErr = errors.New("disk full")
+	streamer := &fakeStreamer{events: []*acteon.SseEvent{{ID: "ev-8", Event: "a"}, {ID: "ev-9", Event: "b"}}}
+	events, err := j.Stream(context.Background(), streamer, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for ev := range events {
+		t.Errorf("delivered %q after a failed append", ev.ID)
+	}
+	if inserts := len(d.stmts) - 2; inserts != 1 {
+		t.Errorf("%d inserts attempted, want 1: %q", inserts, d.stmts)
+	}
+}
+
+func TestStreamExplicitLastEventID(t *testing.T) {
+	db, d := openTestDB(t)
+	j, err := Open(context.Background(), db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := "ev-1"
+	streamer := &fakeStreamer{}
+	events, err := j.Stream(context.Background(), streamer, &acteon.StreamOptions{LastEventID: &from})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range events {
+	}
+	if *streamer.opts.LastEventID != "ev-1" || len(d.stmts) != 1 {
+		t.Errorf("cursor consulted: %q", d.stmts)
+	}
+}
+
+func TestEventsQuery(t *testing.T) {
+	db, d := openTestDB(t)
+	j, err := Open(context.Background(), db, Table("events"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	received := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	d.cols = []string{"seq", "event_id", "event", "data", "received_at"}
+	d.rows = [][]driver.Value{{int64(42), "ev-8", "action_dispatched", "{}", received.UnixMilli()}}
+
+	since := received.Add(-time.Hour)
+	records, err := j.Events(context.Background(), Query{EventType: "action_dispatched", Since: since, AfterSeq: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "SELECT seq, event_id, event, data, received_at FROM events WHERE stream = ? AND event = ? AND received_at >= ? AND seq > ? ORDER BY seq LIMIT ?"
+	if d.stmts[1] != want {
+		t.Errorf("query = %s", d.stmts[1])
+	}
+	if a := d.args[1]; a[2].Value != since.UnixMilli() || a[4].Value != int64(100) {
+		t.Errorf("args = %v", a)
+	}
+	if len(records) != 1 || records[0].Seq != 42 || records[0].ID != "ev-8" || !records[0].ReceivedAt.Equal(received) {
+		t.Errorf("records = %+v", records)
+	}
+	if ev := records[0].SseEvent(); ev.Event != "action_dispatched" {
+		t.Errorf("SseEvent = %+v", ev)
+	}
+}
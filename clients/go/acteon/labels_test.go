@@ -0,0 +1,147 @@
+package acteon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestLabelSelectorString(t *testing.T) {
+	s := MatchLabels(map[string]string{"team": "payments", "env": "prod"}).
+		NotEqual("tier", "free").
+		In("region", "eu", "us").
+		NotIn("stage", "canary").
+		Exists("owner").
+		NotExists("legacy")
+	want := "env=prod,team=payments,tier!=free,region in (eu,us),stage notin (canary),owner,!legacy"
+	if got := s.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestLabelSelectorMatches(t *testing.T) {
+	s := LabelSelector{}.Equal("team", "payments").NotIn("env", "dev").NotExists("legacy")
+	cases := []struct {
+		labels map[string]string
+		want   bool
+	}{
+		{map[string]string{"team": "payments"}, true},
+		{map[string]string{"team": "payments", "env": "prod"}, true},
+		{map[string]string{"team": "payments", "env": "dev"}, false},
+		{map[string]string{"team": "payments", "legacy": ""}, false},
+		{map[string]string{"team": "search"}, false},
+		{nil, false},
+	}
+	for _, tc := range cases {
+		if got := s.Matches(tc.labels); got != tc.want {
+			t.Errorf("Matches(%v) = %v, want %v", tc.labels, got, tc.want)
+		}
+	}
+	if !(LabelSelector{}).Matches(nil) {
+		t.Error("empty selector should match everything")
+	}
+}
+
+func TestListMethodsFilterByLabelSelector(t *testing.T) {
+	// 150 templates and quotas, every third one owned by payments.
+	var templates, quotas []map[string]any
+	for i := range 150 {
+		labels := map[string]string{"team": "search"}
+		if i%3 == 0 {
+			labels["team"] = "payments"
+		}
+		templates = append(templates, map[string]any{"id": fmt.Sprint(i), "name": fmt.Sprintf("t%d", i), "namespace": "alerts", "tenant": "acme", "labels": labels})
+		quotas = append(quotas, map[string]any{"id": fmt.Sprint(i), "namespace": "alerts", "tenant": "acme", "labels": labels})
+	}
+	var queries []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		switch r.URL.Path {
+		case "/v1/templates":
+			items := servePage(templates, r)
+			_ = json.NewEncoder(w).Encode(map[string]any{"templates": items, "count": len(items)})
+		case "/v1/templates/profiles":
+			_ = json.NewEncoder(w).Encode(map[string]any{"profiles": []any{}, "count": 0})
+		case "/v1/quotas":
+			items := servePage(quotas, r)
+			_ = json.NewEncoder(w).Encode(map[string]any{"quotas": items, "count": len(items)})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	client := NewClient(srv.URL)
+	ctx := WithLabelSelector(context.Background(), MatchLabels(map[string]string{"team": "payments"}))
+
+	tl, err := client.ListTemplates(ctx, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tl.Count != 50 || len(tl.Templates) != 50 || tl.Templates[49].Name != "t147" {
+		t.Errorf("templates: count %d, %d entries", tl.Count, len(tl.Templates))
+	}
+	for _, q := range queries {
+		if strings.Contains(q, "label_selector") {
+			t.Errorf("query %q sends a selector the gateway ignores", q)
+		}
+	}
+	ql, err := client.ListQuotas(ctx, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ql.Count != 50 {
+		t.Errorf("quotas: count %d", ql.Count)
+	}
+
+	// Without a selector a list call is one page, as before.
+	if tl, err = client.ListTemplates(context.Background(), nil, nil); err != nil || tl.Count != 100 {
+		t.Errorf("unfiltered templates: %v, %v", tl, err)
+	}
+
+	// Export sees every template whatever the context selects.
+	var buf bytes.Buffer
+	if err := client.ExportTemplates(ctx, nil, &buf); err != nil {
+		t.Fatal(err)
+	}
+	var bundle TemplateBundle
+	if err := json.Unmarshal(buf.Bytes(), &bundle); err != nil || len(bundle.Templates) != 150 {
+		t.Errorf("export with a selector exported %d of 150 templates", len(bundle.Templates))
+	}
+}
+
+func TestListRecurringFiltersByLabelSelector(t *testing.T) {
+	teams := map[string]string{"r1": "payments", "r2": "search", "r3": "payments"}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if id, ok := strings.CutPrefix(r.URL.Path, "/v1/recurring/"); ok {
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": id, "labels": map[string]string{"team": teams[id]}})
+			return
+		}
+		var items []map[string]any
+		if r.URL.Query().Get("offset") == "" {
+			for _, id := range []string{"r1", "r2", "r3"} {
+				items = append(items, map[string]any{"id": id, "namespace": "alerts", "tenant": "acme"})
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"recurring_actions": items, "count": len(items)})
+	}))
+	defer srv.Close()
+	client := NewClient(srv.URL)
+	ctx := WithLabelSelector(context.Background(), LabelSelector{}.Equal("team", "payments"))
+
+	list, err := client.ListRecurring(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.Count != 2 || list.RecurringActions[0].ID != "r1" || list.RecurringActions[1].ID != "r3" {
+		t.Errorf("list = %+v", list)
+	}
+	list, err = client.ListRecurring(ctx, &RecurringFilter{Limit: 1, Offset: 1})
+	if err != nil || list.Count != 1 || list.RecurringActions[0].ID != "r3" {
+		t.Errorf("paged list = %+v, %v", list, err)
+	}
+}
@@ -0,0 +1,57 @@
+package acteon
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSetMaintenanceModeSendsDrainTimeout(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{
+		"enabled": true, "message": "upgrading to 0.10", "in_flight": 2, "queued": 0,
+		"since": "2026-03-01T10:00:00Z", "drain_deadline": "2026-03-01T10:02:00Z",
+	})
+	defer teardown()
+
+	status, err := NewClient(url).SetMaintenanceMode(context.Background(), true, MaintenanceOptions{
+		DrainTimeout: 2 * time.Minute,
+		Message:      "upgrading to 0.10",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != "PUT" || captured.path != "/admin/maintenance" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	if string(captured.body) != `{"enabled":true,"drain_timeout_seconds":120,"message":"upgrading to 0.10"}` {
+		t.Errorf("body = %s", captured.body)
+	}
+	if !status.Enabled || status.Drained() || status.DrainDeadline == nil {
+		t.Errorf("status = %+v", status)
+	}
+}
+
+func TestSetMaintenanceModeDisableOmitsOptions(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{"enabled": false})
+	defer teardown()
+
+	if _, err := NewClient(url).SetMaintenanceMode(context.Background(), false, MaintenanceOptions{Message: "ignored"}); err != nil {
+		t.Fatal(err)
+	}
+	if string(captured.body) != `{"enabled":false}` {
+		t.Errorf("body = %s", captured.body)
+	}
+}
+
+func TestGetMaintenanceStatusDrained(t *testing.T) {
+	url, _, teardown := newCapturingServer(t, 200, map[string]any{"enabled": true, "in_flight": 0, "queued": 14})
+	defer teardown()
+
+	status, err := NewClient(url).GetMaintenanceStatus(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !status.Drained() || status.Queued != 14 {
+		t.Errorf("status = %+v", status)
+	}
+}
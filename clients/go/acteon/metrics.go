@@ -0,0 +1,240 @@
+package acteon
+
+// Gateway metrics from the Prometheus exposition endpoint, parsed into
+// typed counters so that health tooling can read dispatch and provider
+// numbers without running a Prometheus server.
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// MetricSample is one sample line of the Prometheus text format.
+type MetricSample struct {
+	Name   string
+	Labels map[string]string
+	Value  float64
+}
+
+// ProviderMetrics holds the per-provider execution stats exported as
+// acteon_provider_* series. Latencies are in milliseconds and
+// SuccessRate is a percentage (0-100), as the gateway reports them.
+type ProviderMetrics struct {
+	Requests     uint64
+	Successes    uint64
+	Failures     uint64
+	SuccessRate  float64
+	AvgLatencyMs float64
+	P50LatencyMs float64
+	P95LatencyMs float64
+	P99LatencyMs float64
+}
+
+// GatewayMetrics is a snapshot of the gateway's metrics.
+type GatewayMetrics struct {
+	// Dispatch outcome counters.
+	Dispatched      uint64
+	Executed        uint64
+	Deduplicated    uint64
+	Suppressed      uint64
+	Silenced        uint64
+	Muted           uint64
+	Rerouted        uint64
+	Throttled       uint64
+	Failed          uint64
+	PendingApproval uint64
+	Scheduled       uint64
+
+	// Recurring action counters and the RecurringActive gauge.
+	RecurringDispatched    uint64
+	RecurringEventsEmitted uint64
+	RecurringErrors        uint64
+	RecurringSkipped       uint64
+	RecurringActive        uint64
+
+	// Providers maps provider name to its execution stats.
+	Providers map[string]ProviderMetrics
+
+	// Samples holds every sample in the exposition, including series
+	// without a typed field above.
+	Samples []MetricSample
+}
+
+// Value returns the value of the unlabeled series name, and whether it
+// was present.
+func (m *GatewayMetrics) Value(name string) (float64, bool) {
+	for _, s := range m.Samples {
+		if s.Name == name && len(s.Labels) == 0 {
+			return s.Value, true
+		}
+	}
+	return 0, false
+}
+
+// GetMetrics fetches the gateway's Prometheus metrics and parses them
+// into a GatewayMetrics.
+func (c *Client) GetMetrics(ctx context.Context) (*GatewayMetrics, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/metrics/prometheus", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get metrics"}
+	}
+
+	metrics, err := ParseMetrics(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return metrics, nil
+}
+
+// ParseMetrics parses the Prometheus text exposition format as served
+// by GET /metrics/prometheus. It is exported so that tooling which
+// already scrapes the endpoint can reuse the parsing.
+func ParseMetrics(r io.Reader) (*GatewayMetrics, error) {
+	m := &GatewayMetrics{Providers: map[string]ProviderMetrics{}}
+	counters := map[string]*uint64{
+		"acteon_actions_dispatched_total":       &m.Dispatched,
+		"acteon_actions_executed_total":         &m.Executed,
+		"acteon_actions_deduplicated_total":     &m.Deduplicated,
+		"acteon_actions_suppressed_total":       &m.Suppressed,
+		"acteon_actions_silenced_total":         &m.Silenced,
+		"acteon_actions_muted_total":            &m.Muted,
+		"acteon_actions_rerouted_total":         &m.Rerouted,
+		"acteon_actions_throttled_total":        &m.Throttled,
+		"acteon_actions_failed_total":           &m.Failed,
+		"acteon_actions_pending_approval_total": &m.PendingApproval,
+		"acteon_actions_scheduled_total":        &m.Scheduled,
+		"acteon_recurring_dispatched_total":     &m.RecurringDispatched,
+		"acteon_recurring_events_emitted_total": &m.RecurringEventsEmitted,
+		"acteon_recurring_errors_total":         &m.RecurringErrors,
+		"acteon_recurring_skipped_total":        &m.RecurringSkipped,
+		"acteon_recurring_active":               &m.RecurringActive,
+	}
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		s, err := parseSample(text)
+		if err != nil {
+			return nil, fmt.Errorf("metrics line %d: %w", line, err)
+		}
+		m.Samples = append(m.Samples, s)
+
+		if provider, ok := s.Labels["provider"]; ok {
+			p := m.Providers[provider]
+			switch s.Name {
+			case "acteon_provider_requests_total":
+				p.Requests = uint64(s.Value)
+			case "acteon_provider_successes_total":
+				p.Successes = uint64(s.Value)
+			case "acteon_provider_failures_total":
+				p.Failures = uint64(s.Value)
+			case "acteon_provider_success_rate":
+				p.SuccessRate = s.Value
+			case "acteon_provider_avg_latency_ms":
+				p.AvgLatencyMs = s.Value
+			case "acteon_provider_p50_latency_ms":
+				p.P50LatencyMs = s.Value
+			case "acteon_provider_p95_latency_ms":
+				p.P95LatencyMs = s.Value
+			case "acteon_provider_p99_latency_ms":
+				p.P99LatencyMs = s.Value
+			default:
+				continue
+			}
+			m.Providers[provider] = p
+		} else if field, ok := counters[s.Name]; ok {
+			*field = uint64(s.Value)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// parseSample parses `name{label="value",...} value [timestamp]`.
+func parseSample(text string) (MetricSample, error) {
+	var s MetricSample
+	i := strings.IndexAny(text, "{ ")
+	if i < 0 {
+		return s, fmt.Errorf("missing value in %q", text)
+	}
+	s.Name = text[:i]
+	rest := text[i:]
+	if strings.HasPrefix(rest, "{") {
+		labels, after, err := parseLabels(rest[1:])
+		if err != nil {
+			return s, err
+		}
+		s.Labels, rest = labels, after
+	}
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return s, fmt.Errorf("missing value in %q", text)
+	}
+	v, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return s, fmt.Errorf("invalid value in %q: %w", text, err)
+	}
+	s.Value = v
+	return s, nil
+}
+
+// parseLabels parses the label set after the opening brace and returns
+// the text following the closing brace.
+func parseLabels(text string) (map[string]string, string, error) {
+	labels := map[string]string{}
+	for {
+		text = strings.TrimLeft(text, " ,")
+		if strings.HasPrefix(text, "}") {
+			return labels, text[1:], nil
+		}
+		eq := strings.Index(text, "=\"")
+		if eq < 0 {
+			return nil, "", fmt.Errorf("malformed labels in %q", text)
+		}
+		name := strings.TrimSpace(text[:eq])
+		text = text[eq+2:]
+
+		var value strings.Builder
+		closed := false
+		for i := 0; i < len(text); i++ {
+			ch := text[i]
+			if ch == '\\' && i+1 < len(text) {
+				i++
+				switch text[i] {
+				case 'n':
+					value.WriteByte('\n')
+				default:
+					value.WriteByte(text[i])
+				}
+				continue
+			}
+			if ch == '"' {
+				text = text[i+1:]
+				closed = true
+				break
+			}
+			value.WriteByte(ch)
+		}
+		if !closed {
+			return nil, "", fmt.Errorf("unterminated label value for %q", name)
+		}
+		labels[name] = value.String()
+	}
+}
@@ -0,0 +1,68 @@
+package acteon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const sampleExposition = `# HELP acteon_actions_dispatched_total Total actions dispatched.
+# TYPE acteon_actions_dispatched_total counter
+acteon_actions_dispatched_total 142
+
+# HELP acteon_actions_executed_total Actions successfully executed.
+# TYPE acteon_actions_executed_total counter
+acteon_actions_executed_total 130
+
+# TYPE acteon_recurring_active gauge
+acteon_recurring_active 7
+
+# TYPE acteon_wasm_invocations_total counter
+acteon_wasm_invocations_total 3
+
+# TYPE acteon_provider_requests_total counter
+acteon_provider_requests_total{provider="email"} 100
+acteon_provider_requests_total{provider="my\"slack"} 20
+
+# TYPE acteon_provider_p95_latency_ms gauge
+acteon_provider_p95_latency_ms{provider="email"} 182.50
+`
+
+func TestGetMetricsParsesExposition(t *testing.T) {
+	var path string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		_, _ = w.Write([]byte(sampleExposition))
+	}))
+	defer srv.Close()
+
+	m, err := NewClient(srv.URL).GetMetrics(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/metrics/prometheus" {
+		t.Errorf("path = %s", path)
+	}
+	if m.Dispatched != 142 || m.Executed != 130 || m.RecurringActive != 7 {
+		t.Errorf("counters = %+v", m)
+	}
+	if v, ok := m.Value("acteon_wasm_invocations_total"); !ok || v != 3 {
+		t.Errorf("wasm_invocations = %v, %v", v, ok)
+	}
+	if email := m.Providers["email"]; email.Requests != 100 || email.P95LatencyMs != 182.5 {
+		t.Errorf("email = %+v", email)
+	}
+	if m.Providers[`my"slack`].Requests != 20 {
+		t.Errorf("providers = %+v", m.Providers)
+	}
+}
+
+func TestParseMetricsRejectsMalformedLine(t *testing.T) {
+	_, err := ParseMetrics(strings.NewReader("acteon_foo_total{provider=\"x} 1\n"))
+	if err == nil || !strings.Contains(err.Error(), "line 1") {
+		t.Errorf("err = %v", err)
+	}
+}
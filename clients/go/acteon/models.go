@@ -2,7 +2,10 @@
 package acteon
 
 import (
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -40,14 +43,18 @@ type Action struct {
 	Metadata    *ActionMetadata `json:"metadata,omitempty"`
 	CreatedAt   time.Time       `json:"created_at"`
 	Template    string          `json:"template,omitempty"`
-	Attachments []Attachment `json:"attachments,omitempty"`
-	Signature   string       `json:"signature,omitempty"`
-	SignerID    string       `json:"signer_id,omitempty"`
+	Attachments []Attachment    `json:"attachments,omitempty"`
+	Signature   string          `json:"signature,omitempty"`
+	SignerID    string          `json:"signer_id,omitempty"`
 	// Kid is an optional key identifier for rotation. When the same
 	// SignerID has multiple active keys on the server, Kid selects
 	// the specific key to verify against. Discoverable via
 	// GET /.well-known/acteon-signing-keys.
 	Kid string `json:"kid,omitempty"`
+	// FallbackProviders is an ordered list of alternates the gateway
+	// reroutes to when Provider's circuit breaker is open. The provider
+	// actually used is reported in a Rerouted outcome.
+	FallbackProviders []string `json:"fallback_providers,omitempty"`
 }
 
 // ActionMetadata contains optional metadata for an action.
@@ -80,12 +87,71 @@ func (a *Action) WithMetadata(labels map[string]string) *Action {
 	return a
 }
 
+// WithFallbackProviders sets the ordered fallback providers.
+func (a *Action) WithFallbackProviders(providers []string) *Action {
+	a.FallbackProviders = providers
+	return a
+}
+
 // WithAttachments sets the attachments on the action.
 func (a *Action) WithAttachments(attachments []Attachment) *Action {
 	a.Attachments = attachments
 	return a
 }
 
+// Validate checks the fields the gateway requires before dispatch and
+// returns a *ValidationError listing every problem, or nil.
+func (a *Action) Validate() error {
+	if problems := a.validationProblems(""); len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+func (a *Action) validationProblems(prefix string) []ValidationProblem {
+	var problems []ValidationProblem
+	add := func(field, msg string) {
+		problems = append(problems, ValidationProblem{Field: prefix + field, Message: msg})
+	}
+	for _, f := range []struct{ name, value string }{
+		{"id", a.ID},
+		{"namespace", a.Namespace},
+		{"tenant", a.Tenant},
+		{"provider", a.Provider},
+		{"action_type", a.ActionType},
+	} {
+		if strings.TrimSpace(f.value) == "" {
+			add(f.name, "is required")
+		}
+	}
+	if a.Signature != "" && a.SignerID == "" {
+		add("signer_id", "is required when signature is set")
+	}
+	for i, p := range a.FallbackProviders {
+		if strings.TrimSpace(p) == "" {
+			add(fmt.Sprintf("fallback_providers[%d]", i), "must not be empty")
+		}
+	}
+	seen := make(map[string]bool, len(a.Attachments))
+	for i, att := range a.Attachments {
+		field := fmt.Sprintf("attachments[%d]", i)
+		switch {
+		case att.ID == "":
+			add(field+".id", "is required")
+		case seen[att.ID]:
+			add(field+".id", fmt.Sprintf("duplicate attachment ID %q", att.ID))
+		}
+		seen[att.ID] = true
+		if att.Filename == "" {
+			add(field+".filename", "is required")
+		}
+		if _, err := base64.StdEncoding.DecodeString(att.DataBase64); err != nil {
+			add(field+".data_base64", "is not valid base64")
+		}
+	}
+	return problems
+}
+
 // ProviderResponse represents a response from a provider.
 type ProviderResponse struct {
 	Status  string            `json:"status"`
@@ -100,6 +166,7 @@ type ActionOutcome struct {
 	Rule             string            // For Suppressed
 	OriginalProvider string            // For Rerouted
 	NewProvider      string            // For Rerouted
+	RerouteReason    string            // For Rerouted ("rule" or "circuit_open")
 	RetryAfter       time.Duration     // For Throttled
 	Error            *ActionError      // For Failed
 	Verdict          string            // For DryRun
@@ -111,6 +178,12 @@ type ActionOutcome struct {
 	Limit            int64             // For QuotaExceeded
 	Used             int64             // For QuotaExceeded
 	OverageBehavior  string            // For QuotaExceeded
+	// Guardrail is set on a Suppressed outcome when the LLM guardrail,
+	// rather than a rule, refused the action.
+	Guardrail *GuardrailVerdict
+	// Raw holds the undecoded outcome for Unknown, i.e. a variant
+	// added to the server after this client was built.
+	Raw json.RawMessage
 }
 
 // OutcomeType represents the type of action outcome.
@@ -126,6 +199,7 @@ const (
 	OutcomeDryRun        OutcomeType = "dry_run"
 	OutcomeScheduled     OutcomeType = "scheduled"
 	OutcomeQuotaExceeded OutcomeType = "quota_exceeded"
+	OutcomeUnknown       OutcomeType = "unknown"
 )
 
 // ActionError represents error details when an action fails.
@@ -142,11 +216,16 @@ func (o *ActionOutcome) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &raw); err != nil {
 		// Try as string (for "Deduplicated")
 		var str string
-		if err := json.Unmarshal(data, &str); err == nil && str == "Deduplicated" {
+		if strErr := json.Unmarshal(data, &str); strErr != nil {
+			return err
+		}
+		if str == "Deduplicated" {
 			o.Type = OutcomeDeduplicated
-			return nil
+		} else {
+			o.Type = OutcomeUnknown
+			o.Raw = append(json.RawMessage(nil), data...)
 		}
-		return err
+		return nil
 	}
 
 	if _, ok := raw["Executed"]; ok {
@@ -167,12 +246,17 @@ func (o *ActionOutcome) UnmarshalJSON(data []byte) error {
 	if suppressed, ok := raw["Suppressed"]; ok {
 		o.Type = OutcomeSuppressed
 		var s struct {
-			Rule string `json:"rule"`
+			Rule      string            `json:"rule"`
+			Guardrail *GuardrailVerdict `json:"guardrail"`
 		}
 		if err := json.Unmarshal(suppressed, &s); err != nil {
 			return err
 		}
 		o.Rule = s.Rule
+		o.Guardrail = s.Guardrail
+		if o.Guardrail == nil {
+			o.Guardrail = guardrailVerdictFromRule(s.Rule)
+		}
 		return nil
 	}
 
@@ -181,6 +265,7 @@ func (o *ActionOutcome) UnmarshalJSON(data []byte) error {
 		var r struct {
 			OriginalProvider string           `json:"original_provider"`
 			NewProvider      string           `json:"new_provider"`
+			Reason           string           `json:"reason"`
 			Response         ProviderResponse `json:"response"`
 		}
 		if err := json.Unmarshal(rerouted, &r); err != nil {
@@ -188,6 +273,7 @@ func (o *ActionOutcome) UnmarshalJSON(data []byte) error {
 		}
 		o.OriginalProvider = r.OriginalProvider
 		o.NewProvider = r.NewProvider
+		o.RerouteReason = r.Reason
 		o.Response = &r.Response
 		return nil
 	}
@@ -266,11 +352,84 @@ func (o *ActionOutcome) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	o.Type = OutcomeFailed
-	o.Error = &ActionError{Code: "UNKNOWN", Message: "Unknown outcome"}
+	o.Type = OutcomeUnknown
+	o.Raw = append(json.RawMessage(nil), data...)
 	return nil
 }
 
+// MarshalJSON implements custom JSON marshaling for ActionOutcome,
+// producing the externally tagged shape the server sends so that
+// UnmarshalJSON can read the result back.
+func (o ActionOutcome) MarshalJSON() ([]byte, error) {
+	switch o.Type {
+	case OutcomeExecuted:
+		return json.Marshal(map[string]any{"Executed": outcomeResponse(o.Response)})
+	case OutcomeDeduplicated:
+		return json.Marshal("Deduplicated")
+	case OutcomeSuppressed:
+		sup := map[string]any{"rule": o.Rule}
+		if o.Guardrail != nil {
+			sup["guardrail"] = o.Guardrail
+		}
+		return json.Marshal(map[string]any{"Suppressed": sup})
+	case OutcomeRerouted:
+		r := map[string]any{
+			"original_provider": o.OriginalProvider,
+			"new_provider":      o.NewProvider,
+			"response":          outcomeResponse(o.Response),
+		}
+		if o.RerouteReason != "" {
+			r["reason"] = o.RerouteReason
+		}
+		return json.Marshal(map[string]any{"Rerouted": r})
+	case OutcomeThrottled:
+		return json.Marshal(map[string]any{"Throttled": map[string]any{
+			"retry_after": map[string]int64{
+				"secs":  int64(o.RetryAfter / time.Second),
+				"nanos": int64(o.RetryAfter % time.Second),
+			},
+		}})
+	case OutcomeFailed:
+		e := o.Error
+		if e == nil {
+			e = &ActionError{}
+		}
+		return json.Marshal(map[string]any{"Failed": e})
+	case OutcomeDryRun:
+		return json.Marshal(map[string]any{"DryRun": map[string]any{
+			"verdict":           o.Verdict,
+			"matched_rule":      o.MatchedRule,
+			"would_be_provider": o.WouldBeProvider,
+		}})
+	case OutcomeScheduled:
+		return json.Marshal(map[string]any{"Scheduled": map[string]any{
+			"action_id":     o.ActionID,
+			"scheduled_for": o.ScheduledFor,
+		}})
+	case OutcomeQuotaExceeded:
+		return json.Marshal(map[string]any{"QuotaExceeded": map[string]any{
+			"tenant":           o.Tenant,
+			"limit":            o.Limit,
+			"used":             o.Used,
+			"overage_behavior": o.OverageBehavior,
+		}})
+	case OutcomeUnknown:
+		if len(o.Raw) > 0 {
+			return o.Raw, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot marshal outcome of type %q", o.Type)
+}
+
+// outcomeResponse returns r, or an empty response when r is nil, so
+// marshaled Executed and Rerouted outcomes always carry an object.
+func outcomeResponse(r *ProviderResponse) *ProviderResponse {
+	if r == nil {
+		return &ProviderResponse{}
+	}
+	return r
+}
+
 // IsExecuted returns true if the outcome is Executed.
 func (o *ActionOutcome) IsExecuted() bool { return o.Type == OutcomeExecuted }
 
@@ -280,6 +439,12 @@ func (o *ActionOutcome) IsDeduplicated() bool { return o.Type == OutcomeDeduplic
 // IsSuppressed returns true if the outcome is Suppressed.
 func (o *ActionOutcome) IsSuppressed() bool { return o.Type == OutcomeSuppressed }
 
+// IsGuardrailDenied returns true if the LLM guardrail suppressed the
+// action.
+func (o *ActionOutcome) IsGuardrailDenied() bool {
+	return o.Type == OutcomeSuppressed && o.Guardrail != nil
+}
+
 // IsRerouted returns true if the outcome is Rerouted.
 func (o *ActionOutcome) IsRerouted() bool { return o.Type == OutcomeRerouted }
 
@@ -298,6 +463,10 @@ func (o *ActionOutcome) IsScheduled() bool { return o.Type == OutcomeScheduled }
 // IsQuotaExceeded returns true if the outcome is QuotaExceeded.
 func (o *ActionOutcome) IsQuotaExceeded() bool { return o.Type == OutcomeQuotaExceeded }
 
+// IsUnknown returns true if the outcome is a variant this client does
+// not recognize. The server's JSON is preserved in Raw.
+func (o *ActionOutcome) IsUnknown() bool { return o.Type == OutcomeUnknown }
+
 // ErrorResponse represents an error response from the API.
 type ErrorResponse struct {
 	Code      string `json:"code"`
@@ -307,9 +476,14 @@ type ErrorResponse struct {
 
 // BatchResult represents a result from a batch dispatch operation.
 type BatchResult struct {
-	Success bool
-	Outcome *ActionOutcome
-	Error   *ErrorResponse
+	// Index is the position of the corresponding action in the batch
+	// request. The server returns results in request order.
+	Index int
+	// ActionID is the ID of the action at Index.
+	ActionID string
+	Success  bool
+	Outcome  *ActionOutcome
+	Error    *ErrorResponse
 }
 
 // UnmarshalJSON implements custom JSON unmarshaling for BatchResult.
@@ -338,6 +512,85 @@ func (r *BatchResult) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements custom JSON marshaling for BatchResult. A
+// successful result marshals as its outcome and a failed one as
+// {"error": ...}, matching the batch endpoint's response items.
+func (r BatchResult) MarshalJSON() ([]byte, error) {
+	if !r.Success {
+		e := r.Error
+		if e == nil {
+			e = &ErrorResponse{}
+		}
+		return json.Marshal(map[string]any{"error": e})
+	}
+	if r.Outcome == nil {
+		return nil, fmt.Errorf("successful batch result has no outcome")
+	}
+	return json.Marshal(r.Outcome)
+}
+
+// BatchResults is the result of DispatchBatch and DispatchBatchDryRun,
+// one entry per input action.
+type BatchResults []BatchResult
+
+// correlateBatch sets Index and ActionID on each result from the
+// actions that produced it.
+func correlateBatch(results BatchResults, actions []*Action) {
+	for i := range results {
+		results[i].Index = i
+		if i < len(actions) && actions[i] != nil {
+			results[i].ActionID = actions[i].ID
+		}
+	}
+}
+
+// Failed returns the entries that were rejected by the server or
+// whose outcome is Failed.
+func (rs BatchResults) Failed() BatchResults {
+	var failed BatchResults
+	for _, r := range rs {
+		if !r.Success || (r.Outcome != nil && r.Outcome.IsFailed()) {
+			failed = append(failed, r)
+		}
+	}
+	return failed
+}
+
+// ByActionID indexes the results by action ID. Entries without an
+// action ID are left out.
+func (rs BatchResults) ByActionID() map[string]BatchResult {
+	m := make(map[string]BatchResult, len(rs))
+	for _, r := range rs {
+		if r.ActionID != "" {
+			m[r.ActionID] = r
+		}
+	}
+	return m
+}
+
+// Error returns a *BatchError describing every failed entry, or nil
+// when the whole batch succeeded.
+func (rs BatchResults) Error() error {
+	failed := rs.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+	batchErr := &BatchError{Results: rs, Failures: make([]*BatchItemError, 0, len(failed))}
+	for _, r := range failed {
+		var apiErr *APIError
+		switch {
+		case r.Error != nil:
+			apiErr = &APIError{Code: r.Error.Code, Message: r.Error.Message, Retryable: r.Error.Retryable}
+		case r.Outcome != nil && r.Outcome.Error != nil:
+			apiErr = &APIError{Code: r.Outcome.Error.Code, Message: r.Outcome.Error.Message, Retryable: r.Outcome.Error.Retryable}
+		default:
+			apiErr = &APIError{Code: "UNKNOWN", Message: "batch entry failed"}
+		}
+		batchErr.Failures = append(batchErr.Failures, &BatchItemError{Index: r.Index, ActionID: r.ActionID, Err: apiErr})
+	}
+	return batchErr
+}
+
 // RuleInfo contains information about a loaded rule.
 type RuleInfo struct {
 	Name        string  `json:"name"`
@@ -371,20 +624,46 @@ type AuditQuery struct {
 
 // AuditRecord represents an audit record.
 type AuditRecord struct {
-	ID             string  `json:"id"`
-	ActionID       string  `json:"action_id"`
-	Namespace      string  `json:"namespace"`
-	Tenant         string  `json:"tenant"`
-	Provider       string  `json:"provider"`
-	ActionType     string  `json:"action_type"`
-	Verdict        string  `json:"verdict"`
-	Outcome        string  `json:"outcome"`
-	MatchedRule    *string `json:"matched_rule,omitempty"`
-	DurationMs     int64   `json:"duration_ms"`
-	DispatchedAt   string  `json:"dispatched_at"`
-	RecordHash     *string `json:"record_hash,omitempty"`
-	PreviousHash   *string `json:"previous_hash,omitempty"`
-	SequenceNumber *uint64 `json:"sequence_number,omitempty"`
+	ID             string    `json:"id"`
+	ActionID       string    `json:"action_id"`
+	Namespace      string    `json:"namespace"`
+	Tenant         string    `json:"tenant"`
+	Provider       string    `json:"provider"`
+	ActionType     string    `json:"action_type"`
+	Verdict        string    `json:"verdict"`
+	Outcome        string    `json:"outcome"`
+	MatchedRule    *string   `json:"matched_rule,omitempty"`
+	DurationMs     int64     `json:"duration_ms"`
+	DispatchedAt   time.Time `json:"dispatched_at"`
+	RecordHash     *string   `json:"record_hash,omitempty"`
+	PreviousHash   *string   `json:"previous_hash,omitempty"`
+	SequenceNumber *uint64   `json:"sequence_number,omitempty"`
+	// ActionPayload is the dispatched payload. The gateway only stores
+	// it when configured to.
+	ActionPayload map[string]any `json:"action_payload,omitempty"`
+	// Metadata holds the action's labels, flattened, together with
+	// gateway-internal keys such as "__dedup_key".
+	Metadata map[string]any `json:"metadata,omitempty"`
+}
+
+// AuditBatchRequest is the body of the batch audit lookup endpoint.
+type AuditBatchRequest struct {
+	ActionIDs []string `json:"action_ids"`
+}
+
+// AuditBatchResponse is one response from the batch audit lookup
+// endpoint. Missing lists the requested IDs that have no record.
+type AuditBatchResponse struct {
+	Records []AuditRecord `json:"records"`
+	Missing []string      `json:"missing"`
+}
+
+// AuditRecordsResult is the result of GetAuditRecords. Records is keyed
+// by action ID; Missing lists the IDs the gateway has no record for,
+// e.g. because the action is still in flight or was never dispatched.
+type AuditRecordsResult struct {
+	Records map[string]AuditRecord
+	Missing []string
 }
 
 // AuditPage represents paginated audit results.
@@ -414,10 +693,10 @@ type EventQuery struct {
 
 // EventState represents the current state of an event.
 type EventState struct {
-	Fingerprint string  `json:"fingerprint"`
-	State       string  `json:"state"`
-	ActionType  *string `json:"action_type,omitempty"`
-	UpdatedAt   *string `json:"updated_at,omitempty"`
+	Fingerprint string     `json:"fingerprint"`
+	State       string     `json:"state"`
+	ActionType  *string    `json:"action_type,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
 }
 
 // EventListResponse represents the response from listing events.
@@ -438,14 +717,32 @@ type TransitionResponse struct {
 // Group Types (Event Batching)
 // =============================================================================
 
+// GroupState is the lifecycle state of an event group.
+type GroupState string
+
+const (
+	GroupPending  GroupState = "pending"
+	GroupNotified GroupState = "notified"
+	GroupResolved GroupState = "resolved"
+)
+
+// IsValid reports whether s is a group state known to this client.
+func (s GroupState) IsValid() bool {
+	switch s {
+	case GroupPending, GroupNotified, GroupResolved:
+		return true
+	}
+	return false
+}
+
 // GroupSummary represents a summary of an event group.
 type GroupSummary struct {
-	GroupID    string  `json:"group_id"`
-	GroupKey   string  `json:"group_key"`
-	EventCount int     `json:"event_count"`
-	State      string  `json:"state"`
-	NotifyAt   *string `json:"notify_at,omitempty"`
-	CreatedAt  *string `json:"created_at,omitempty"`
+	GroupID    string     `json:"group_id"`
+	GroupKey   string     `json:"group_key"`
+	EventCount int        `json:"event_count"`
+	State      GroupState `json:"state"`
+	NotifyAt   *time.Time `json:"notify_at,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
 }
 
 // GroupListResponse represents the response from listing groups.
@@ -472,22 +769,41 @@ type FlushGroupResponse struct {
 // Approval Types (Human-in-the-Loop)
 // =============================================================================
 
+// ApprovalState is the decision state of an approval.
+type ApprovalState string
+
+const (
+	ApprovalPending  ApprovalState = "pending"
+	ApprovalApproved ApprovalState = "approved"
+	ApprovalRejected ApprovalState = "rejected"
+	ApprovalExpired  ApprovalState = "expired"
+)
+
+// IsValid reports whether s is an approval state known to this client.
+func (s ApprovalState) IsValid() bool {
+	switch s {
+	case ApprovalPending, ApprovalApproved, ApprovalRejected, ApprovalExpired:
+		return true
+	}
+	return false
+}
+
 // ApprovalActionResponse represents the response from approving or rejecting an action.
 type ApprovalActionResponse struct {
 	ID      string         `json:"id"`
-	Status  string         `json:"status"`
+	Status  ApprovalState  `json:"status"`
 	Outcome map[string]any `json:"outcome,omitempty"`
 }
 
 // ApprovalStatus represents the public-facing approval status (no payload exposed).
 type ApprovalStatus struct {
-	Token     string  `json:"token"`
-	Status    string  `json:"status"`
-	Rule      string  `json:"rule"`
-	CreatedAt string  `json:"created_at"`
-	ExpiresAt string  `json:"expires_at"`
-	DecidedAt *string `json:"decided_at,omitempty"`
-	Message   *string `json:"message,omitempty"`
+	Token     string        `json:"token"`
+	Status    ApprovalState `json:"status"`
+	Rule      string        `json:"rule"`
+	CreatedAt time.Time     `json:"created_at"`
+	ExpiresAt time.Time     `json:"expires_at"`
+	DecidedAt *time.Time    `json:"decided_at,omitempty"`
+	Message   *string       `json:"message,omitempty"`
 }
 
 // ApprovalListResponse represents the response from listing pending approvals.
@@ -593,8 +909,8 @@ type ReplayQuery struct {
 	Outcome     string
 	Verdict     string
 	MatchedRule string
-	From        string
-	To          string
+	From        *time.Time
+	To          *time.Time
 	Limit       int
 }
 
@@ -613,50 +929,63 @@ type CreateRecurringAction struct {
 	Name           string            `json:"name,omitempty"`
 	Metadata       map[string]string `json:"metadata,omitempty"`
 	Timezone       string            `json:"timezone,omitempty"`
-	EndDate        string            `json:"end_date,omitempty"`
+	EndDate        *time.Time        `json:"end_date,omitempty"`
 	MaxExecutions  *int              `json:"max_executions,omitempty"`
 	Description    string            `json:"description,omitempty"`
 	DedupKey       string            `json:"dedup_key,omitempty"`
 	Labels         map[string]string `json:"labels,omitempty"`
 }
 
+// RecurringStatus is the scheduling state of a recurring action.
+type RecurringStatus string
+
+const (
+	RecurringActive RecurringStatus = "active"
+	RecurringPaused RecurringStatus = "paused"
+)
+
+// IsValid reports whether s is a recurring status known to this client.
+func (s RecurringStatus) IsValid() bool {
+	return s == RecurringActive || s == RecurringPaused
+}
+
 // CreateRecurringResponse is the response from creating a recurring action.
 type CreateRecurringResponse struct {
-	ID              string  `json:"id"`
-	Status          string  `json:"status"`
-	Name            *string `json:"name,omitempty"`
-	NextExecutionAt *string `json:"next_execution_at,omitempty"`
+	ID              string          `json:"id"`
+	Status          RecurringStatus `json:"status"`
+	Name            *string         `json:"name,omitempty"`
+	NextExecutionAt *time.Time      `json:"next_execution_at,omitempty"`
 }
 
 // RecurringFilter contains query parameters for listing recurring actions.
 type RecurringFilter struct {
 	Namespace string
 	Tenant    string
-	Status    string
+	Status    RecurringStatus
 	Limit     int
 	Offset    int
 }
 
 // RecurringSummary is a summary of a recurring action in list responses.
 type RecurringSummary struct {
-	ID              string  `json:"id"`
-	Namespace       string  `json:"namespace"`
-	Tenant          string  `json:"tenant"`
-	CronExpr        string  `json:"cron_expr"`
-	Timezone        string  `json:"timezone"`
-	Enabled         bool    `json:"enabled"`
-	Provider        string  `json:"provider"`
-	ActionType      string  `json:"action_type"`
-	ExecutionCount  int     `json:"execution_count"`
-	CreatedAt       string  `json:"created_at"`
-	NextExecutionAt *string `json:"next_execution_at,omitempty"`
-	Description     *string `json:"description,omitempty"`
+	ID              string     `json:"id"`
+	Namespace       string     `json:"namespace"`
+	Tenant          string     `json:"tenant"`
+	CronExpr        string     `json:"cron_expr"`
+	Timezone        string     `json:"timezone"`
+	Enabled         bool       `json:"enabled"`
+	Provider        string     `json:"provider"`
+	ActionType      string     `json:"action_type"`
+	ExecutionCount  int        `json:"execution_count"`
+	CreatedAt       time.Time  `json:"created_at"`
+	NextExecutionAt *time.Time `json:"next_execution_at,omitempty"`
+	Description     *string    `json:"description,omitempty"`
 }
 
 // ListRecurringResponse is the response from listing recurring actions.
 type ListRecurringResponse struct {
 	RecurringActions []RecurringSummary `json:"recurring_actions"`
-	Count            int               `json:"count"`
+	Count            int                `json:"count"`
 }
 
 // RecurringDetail is detailed information about a recurring action.
@@ -672,12 +1001,12 @@ type RecurringDetail struct {
 	Payload         map[string]any    `json:"payload"`
 	Metadata        map[string]string `json:"metadata"`
 	ExecutionCount  int               `json:"execution_count"`
-	CreatedAt       string            `json:"created_at"`
-	UpdatedAt       string            `json:"updated_at"`
+	CreatedAt       time.Time         `json:"created_at"`
+	UpdatedAt       time.Time         `json:"updated_at"`
 	Labels          map[string]string `json:"labels"`
-	NextExecutionAt *string           `json:"next_execution_at,omitempty"`
-	LastExecutedAt  *string           `json:"last_executed_at,omitempty"`
-	EndsAt          *string           `json:"ends_at,omitempty"`
+	NextExecutionAt *time.Time        `json:"next_execution_at,omitempty"`
+	LastExecutedAt  *time.Time        `json:"last_executed_at,omitempty"`
+	EndsAt          *time.Time        `json:"ends_at,omitempty"`
 	Description     *string           `json:"description,omitempty"`
 	DedupKey        *string           `json:"dedup_key,omitempty"`
 }
@@ -691,7 +1020,7 @@ type UpdateRecurringAction struct {
 	Metadata       map[string]string `json:"metadata,omitempty"`
 	CronExpression *string           `json:"cron_expression,omitempty"`
 	Timezone       *string           `json:"timezone,omitempty"`
-	EndDate        *string           `json:"end_date,omitempty"`
+	EndDate        *time.Time        `json:"end_date,omitempty"`
 	MaxExecutions  *int              `json:"max_executions,omitempty"`
 	Description    *string           `json:"description,omitempty"`
 	DedupKey       *string           `json:"dedup_key,omitempty"`
@@ -768,8 +1097,8 @@ type QuotaPolicy struct {
 	Window          string            `json:"window"`
 	OverageBehavior string            `json:"overage_behavior"`
 	Enabled         bool              `json:"enabled"`
-	CreatedAt       string            `json:"created_at"`
-	UpdatedAt       string            `json:"updated_at"`
+	CreatedAt       time.Time         `json:"created_at"`
+	UpdatedAt       time.Time         `json:"updated_at"`
 	Description     *string           `json:"description,omitempty"`
 	Labels          map[string]string `json:"labels,omitempty"`
 }
@@ -782,25 +1111,124 @@ type ListQuotasResponse struct {
 
 // QuotaUsage represents current usage statistics for a quota.
 type QuotaUsage struct {
-	Tenant          string `json:"tenant"`
-	Namespace       string `json:"namespace"`
-	Used            int64  `json:"used"`
-	Limit           int64  `json:"limit"`
-	Remaining       int64  `json:"remaining"`
-	Window          string `json:"window"`
-	ResetsAt        string `json:"resets_at"`
-	OverageBehavior string `json:"overage_behavior"`
+	Tenant          string    `json:"tenant"`
+	Namespace       string    `json:"namespace"`
+	Used            int64     `json:"used"`
+	Limit           int64     `json:"limit"`
+	Remaining       int64     `json:"remaining"`
+	Window          string    `json:"window"`
+	ResetsAt        time.Time `json:"resets_at"`
+	OverageBehavior string    `json:"overage_behavior"`
+}
+
+// QuotaCheckRequest is the body for the non-consuming quota pre-check.
+type QuotaCheckRequest struct {
+	Namespace string `json:"namespace"`
+	Tenant    string `json:"tenant"`
+	Count     int    `json:"count"`
+}
+
+// QuotaCheckEntry reports how a single matching quota policy would
+// respond to the checked number of additional actions.
+type QuotaCheckEntry struct {
+	QuotaID         string    `json:"quota_id"`
+	Provider        string    `json:"provider,omitempty"`
+	Limit           int64     `json:"limit"`
+	Used            int64     `json:"used"`
+	Remaining       int64     `json:"remaining"`
+	WouldExceed     bool      `json:"would_exceed"`
+	OverageBehavior string    `json:"overage_behavior"`
+	ResetsAt        time.Time `json:"resets_at"`
+}
+
+// QuotaCheckResult is the response from a quota pre-check. Allowed is
+// false when any matching policy would be exceeded; Quotas lists
+// every policy that matched the (namespace, tenant) pair. Nothing is
+// consumed by the check, so the answer can go stale under concurrent
+// dispatch.
+type QuotaCheckResult struct {
+	Allowed bool              `json:"allowed"`
+	Quotas  []QuotaCheckEntry `json:"quotas"`
+}
+
+// =============================================================================
+// Throttle Types
+// =============================================================================
+
+// CreateThrottleRequest is the request to create a throttle policy.
+//
+// A throttle policy admits at most MaxCount actions per Window
+// seconds for its scope and answers the rest with a Throttled outcome
+// whose RetryAfter says when the window frees up. Unlike a quota,
+// which rejects or flags overage for a whole billing window, a
+// throttle shapes bursts.
+//
+// Provider and ActionType narrow the scope; leave either empty to
+// match every value.
+type CreateThrottleRequest struct {
+	Namespace     string            `json:"namespace"`
+	Tenant        string            `json:"tenant"`
+	Provider      string            `json:"provider,omitempty"`
+	ActionType    string            `json:"action_type,omitempty"`
+	MaxCount      int64             `json:"max_count"`
+	WindowSeconds int64             `json:"window_seconds"`
+	Description   string            `json:"description,omitempty"`
+	Labels        map[string]string `json:"labels,omitempty"`
+}
+
+// UpdateThrottleRequest is the request to update a throttle policy.
+type UpdateThrottleRequest struct {
+	Namespace     string  `json:"namespace"`
+	Tenant        string  `json:"tenant"`
+	MaxCount      *int64  `json:"max_count,omitempty"`
+	WindowSeconds *int64  `json:"window_seconds,omitempty"`
+	Description   *string `json:"description,omitempty"`
+	Enabled       *bool   `json:"enabled,omitempty"`
+}
+
+// ThrottlePolicy represents a throttle policy.
+type ThrottlePolicy struct {
+	ID            string            `json:"id"`
+	Namespace     string            `json:"namespace"`
+	Tenant        string            `json:"tenant"`
+	Provider      string            `json:"provider,omitempty"`
+	ActionType    string            `json:"action_type,omitempty"`
+	MaxCount      int64             `json:"max_count"`
+	WindowSeconds int64             `json:"window_seconds"`
+	Enabled       bool              `json:"enabled"`
+	CreatedAt     time.Time         `json:"created_at"`
+	UpdatedAt     time.Time         `json:"updated_at"`
+	Description   *string           `json:"description,omitempty"`
+	Labels        map[string]string `json:"labels,omitempty"`
+}
+
+// Window returns WindowSeconds as a time.Duration.
+func (p ThrottlePolicy) Window() time.Duration {
+	return time.Duration(p.WindowSeconds) * time.Second
+}
+
+// ListThrottlesResponse is the response from listing throttle policies.
+type ListThrottlesResponse struct {
+	Throttles []ThrottlePolicy `json:"throttles"`
+	Count     int              `json:"count"`
 }
 
 // =============================================================================
 // Silence Types
 // =============================================================================
 
+// Silence matcher operators for SilenceMatcher.Op.
+const (
+	SilenceOpEqual    = "equal"
+	SilenceOpNotEqual = "not_equal"
+	SilenceOpRegex    = "regex"
+	SilenceOpNotRegex = "not_regex"
+)
+
 // SilenceMatcher is a single label matcher inside a silence.
-// Op is one of "equal", "not_equal", "regex", "not_regex". All
-// matchers in a silence are AND-ed. Regex patterns are capped at
-// 256 characters and a 64 KB compiled DFA server-side to prevent
-// ReDoS.
+// Op is one of the SilenceOp constants. All matchers in a silence
+// are AND-ed. Regex patterns are capped at 256 characters and a 64 KB
+// compiled DFA server-side to prevent ReDoS.
 type SilenceMatcher struct {
 	Name  string `json:"name"`
 	Value string `json:"value"`
@@ -816,8 +1244,8 @@ type CreateSilenceRequest struct {
 	Tenant          string           `json:"tenant"`
 	Matchers        []SilenceMatcher `json:"matchers"`
 	Comment         string           `json:"comment"`
-	StartsAt        *string          `json:"starts_at,omitempty"`
-	EndsAt          *string          `json:"ends_at,omitempty"`
+	StartsAt        *time.Time       `json:"starts_at,omitempty"`
+	EndsAt          *time.Time       `json:"ends_at,omitempty"`
 	DurationSeconds *int64           `json:"duration_seconds,omitempty"`
 }
 
@@ -825,8 +1253,8 @@ type CreateSilenceRequest struct {
 // its comment. Matchers are immutable — to change them, expire the
 // silence and create a new one.
 type UpdateSilenceRequest struct {
-	EndsAt  *string `json:"ends_at,omitempty"`
-	Comment *string `json:"comment,omitempty"`
+	EndsAt  *time.Time `json:"ends_at,omitempty"`
+	Comment *string    `json:"comment,omitempty"`
 }
 
 // Silence is a time-bounded label-pattern mute.
@@ -838,12 +1266,12 @@ type Silence struct {
 	Namespace string           `json:"namespace"`
 	Tenant    string           `json:"tenant"`
 	Matchers  []SilenceMatcher `json:"matchers"`
-	StartsAt  string           `json:"starts_at"`
-	EndsAt    string           `json:"ends_at"`
+	StartsAt  time.Time        `json:"starts_at"`
+	EndsAt    time.Time        `json:"ends_at"`
 	CreatedBy string           `json:"created_by"`
 	Comment   string           `json:"comment"`
-	CreatedAt string           `json:"created_at"`
-	UpdatedAt string           `json:"updated_at"`
+	CreatedAt time.Time        `json:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at"`
 	Active    bool             `json:"active"`
 }
 
@@ -905,8 +1333,8 @@ type TimeInterval struct {
 	Location    *string     `json:"location,omitempty"`
 	Description *string     `json:"description,omitempty"`
 	CreatedBy   string      `json:"created_by"`
-	CreatedAt   string      `json:"created_at"`
-	UpdatedAt   string      `json:"updated_at"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
 	MatchesNow  bool        `json:"matches_now"`
 }
 
@@ -916,20 +1344,85 @@ type ListTimeIntervalsResponse struct {
 	Count         int            `json:"count"`
 }
 
+// =============================================================================
+// Notification Preference Types
+// =============================================================================
+
+// QuietHours holds back notifications to a tenant during a daily
+// window. Actions whose severity is in BypassSeverities are delivered
+// anyway.
+type QuietHours struct {
+	Window TimeOfDayInput `json:"window"`
+	// Weekdays limits quiet hours to some days (1=Mon…7=Sun). Empty
+	// means every day.
+	Weekdays []NumericRange `json:"weekdays,omitempty"`
+	// Location is the IANA timezone of Window; defaults to UTC.
+	Location         *string  `json:"location,omitempty"`
+	BypassSeverities []string `json:"bypass_severities,omitempty"`
+}
+
+// ChannelOverride routes matching actions to a different provider.
+// Empty match fields match any value.
+type ChannelOverride struct {
+	ActionType string `json:"action_type,omitempty"`
+	Severity   string `json:"severity,omitempty"`
+	Provider   string `json:"provider"`
+}
+
+// EscalationContact is notified when an action is still unacknowledged
+// DelaySeconds after it was first delivered.
+type EscalationContact struct {
+	Name         string `json:"name"`
+	Provider     string `json:"provider"`
+	Address      string `json:"address"`
+	DelaySeconds int64  `json:"delay_seconds"`
+}
+
+// Delay returns DelaySeconds as a time.Duration.
+func (e EscalationContact) Delay() time.Duration {
+	return time.Duration(e.DelaySeconds) * time.Second
+}
+
+// NotificationPreferences are a tenant's self-service notification
+// settings. The rules engine consults them after rule evaluation, so a
+// rule's reroute or suppression still takes precedence.
+type NotificationPreferences struct {
+	// Tenant is set by the server; it is ignored in requests.
+	Tenant             string              `json:"tenant,omitempty"`
+	QuietHours         *QuietHours         `json:"quiet_hours,omitempty"`
+	ChannelOverrides   []ChannelOverride   `json:"channel_overrides,omitempty"`
+	EscalationContacts []EscalationContact `json:"escalation_contacts,omitempty"`
+	UpdatedAt          *time.Time          `json:"updated_at,omitempty"`
+}
+
 // =============================================================================
 // Retention Policy Types
 // =============================================================================
 
+// RetentionArchiveConfig configures an archive sink that records are
+// exported to before the retention reaper deletes them. Format is one
+// of "jsonl" (default) or "parquet".
+type RetentionArchiveConfig struct {
+	S3Bucket string `json:"s3_bucket"`
+	S3Prefix string `json:"s3_prefix,omitempty"`
+	Format   string `json:"format,omitempty"`
+}
+
 // CreateRetentionRequest is the request to create a retention policy.
+//
+// When Archive is set, expired records are exported to the sink before
+// TTL deletion. A failed export leaves the records in place and the
+// next reaper run retries.
 type CreateRetentionRequest struct {
-	Namespace       string            `json:"namespace"`
-	Tenant          string            `json:"tenant"`
-	AuditTTLSeconds int64             `json:"audit_ttl_seconds"`
-	StateTTLSeconds int64             `json:"state_ttl_seconds"`
-	EventTTLSeconds int64             `json:"event_ttl_seconds"`
-	ComplianceHold  bool              `json:"compliance_hold,omitempty"`
-	Description     string            `json:"description,omitempty"`
-	Labels          map[string]string `json:"labels,omitempty"`
+	Namespace       string                  `json:"namespace"`
+	Tenant          string                  `json:"tenant"`
+	AuditTTLSeconds int64                   `json:"audit_ttl_seconds"`
+	StateTTLSeconds int64                   `json:"state_ttl_seconds"`
+	EventTTLSeconds int64                   `json:"event_ttl_seconds"`
+	ComplianceHold  bool                    `json:"compliance_hold,omitempty"`
+	Description     string                  `json:"description,omitempty"`
+	Labels          map[string]string       `json:"labels,omitempty"`
+	Archive         *RetentionArchiveConfig `json:"archive,omitempty"`
 }
 
 // UpdateRetentionRequest is the request to update a retention policy.
@@ -941,22 +1434,59 @@ type UpdateRetentionRequest struct {
 	ComplianceHold  *bool             `json:"compliance_hold,omitempty"`
 	Description     *string           `json:"description,omitempty"`
 	Labels          map[string]string `json:"labels,omitempty"`
+	// Archive replaces the archive sink. Set RemoveArchive to drop
+	// an existing sink instead.
+	Archive       *RetentionArchiveConfig `json:"archive,omitempty"`
+	RemoveArchive bool                    `json:"remove_archive,omitempty"`
 }
 
 // RetentionPolicy represents a retention policy.
 type RetentionPolicy struct {
-	ID              string            `json:"id"`
-	Namespace       string            `json:"namespace"`
-	Tenant          string            `json:"tenant"`
-	Enabled         bool              `json:"enabled"`
-	AuditTTLSeconds int64             `json:"audit_ttl_seconds"`
-	StateTTLSeconds int64             `json:"state_ttl_seconds"`
-	EventTTLSeconds int64             `json:"event_ttl_seconds"`
-	ComplianceHold  bool              `json:"compliance_hold"`
-	CreatedAt       string            `json:"created_at"`
-	UpdatedAt       string            `json:"updated_at"`
-	Description     *string           `json:"description,omitempty"`
-	Labels          map[string]string `json:"labels,omitempty"`
+	ID              string                  `json:"id"`
+	Namespace       string                  `json:"namespace"`
+	Tenant          string                  `json:"tenant"`
+	Enabled         bool                    `json:"enabled"`
+	AuditTTLSeconds int64                   `json:"audit_ttl_seconds"`
+	StateTTLSeconds int64                   `json:"state_ttl_seconds"`
+	EventTTLSeconds int64                   `json:"event_ttl_seconds"`
+	ComplianceHold  bool                    `json:"compliance_hold"`
+	CreatedAt       time.Time               `json:"created_at"`
+	UpdatedAt       time.Time               `json:"updated_at"`
+	Description     *string                 `json:"description,omitempty"`
+	Labels          map[string]string       `json:"labels,omitempty"`
+	Archive         *RetentionArchiveConfig `json:"archive,omitempty"`
+}
+
+// AuditTTL returns AuditTTLSeconds as a time.Duration.
+func (p *RetentionPolicy) AuditTTL() time.Duration {
+	return time.Duration(p.AuditTTLSeconds) * time.Second
+}
+
+// StateTTL returns StateTTLSeconds as a time.Duration.
+func (p *RetentionPolicy) StateTTL() time.Duration {
+	return time.Duration(p.StateTTLSeconds) * time.Second
+}
+
+// EventTTL returns EventTTLSeconds as a time.Duration.
+func (p *RetentionPolicy) EventTTL() time.Duration {
+	return time.Duration(p.EventTTLSeconds) * time.Second
+}
+
+// RetentionArchiveFailure is a single failed archive run.
+type RetentionArchiveFailure struct {
+	At    time.Time `json:"at"`
+	Error string    `json:"error"`
+}
+
+// RetentionArchiveStatus reports the archive history of a retention
+// policy. LastRunAt is nil when the archiver has never run.
+type RetentionArchiveStatus struct {
+	RetentionID         string                    `json:"retention_id"`
+	LastRunAt           *time.Time                `json:"last_run_at,omitempty"`
+	LastSuccessAt       *time.Time                `json:"last_success_at,omitempty"`
+	RecordsArchived     int64                     `json:"records_archived"`
+	ConsecutiveFailures int                       `json:"consecutive_failures"`
+	RecentFailures      []RetentionArchiveFailure `json:"recent_failures,omitempty"`
 }
 
 // ListRetentionResponse is the response from listing retention policies.
@@ -969,16 +1499,54 @@ type ListRetentionResponse struct {
 // Chain Types
 // =============================================================================
 
+// ChainStatus is the execution state of a chain.
+type ChainStatus string
+
+const (
+	ChainStatusRunning         ChainStatus = "running"
+	ChainStatusCompleted       ChainStatus = "completed"
+	ChainStatusFailed          ChainStatus = "failed"
+	ChainStatusCancelled       ChainStatus = "cancelled"
+	ChainStatusTimedOut        ChainStatus = "timed_out"
+	ChainStatusWaitingSubChain ChainStatus = "waiting_sub_chain"
+	ChainStatusWaitingParallel ChainStatus = "waiting_parallel"
+	ChainStatusWaitingTimer    ChainStatus = "waiting_timer"
+	ChainStatusWaitingSignal   ChainStatus = "waiting_signal"
+	ChainStatusWaitingWorker   ChainStatus = "waiting_worker"
+)
+
+// IsValid reports whether s is a chain status known to this client.
+func (s ChainStatus) IsValid() bool {
+	switch s {
+	case ChainStatusRunning, ChainStatusCompleted, ChainStatusFailed,
+		ChainStatusCancelled, ChainStatusTimedOut, ChainStatusWaitingSubChain,
+		ChainStatusWaitingParallel, ChainStatusWaitingTimer,
+		ChainStatusWaitingSignal, ChainStatusWaitingWorker:
+		return true
+	}
+	return false
+}
+
+// IsTerminal reports whether the chain has finished and will not
+// change status again.
+func (s ChainStatus) IsTerminal() bool {
+	switch s {
+	case ChainStatusCompleted, ChainStatusFailed, ChainStatusCancelled, ChainStatusTimedOut:
+		return true
+	}
+	return false
+}
+
 // ChainSummary is a summary of a chain execution for list responses.
 type ChainSummary struct {
-	ChainID       string  `json:"chain_id"`
-	ChainName     string  `json:"chain_name"`
-	Status        string  `json:"status"`
-	CurrentStep   int     `json:"current_step"`
-	TotalSteps    int     `json:"total_steps"`
-	StartedAt     string  `json:"started_at"`
-	UpdatedAt     string  `json:"updated_at"`
-	ParentChainID *string `json:"parent_chain_id,omitempty"`
+	ChainID       string      `json:"chain_id"`
+	ChainName     string      `json:"chain_name"`
+	Status        ChainStatus `json:"status"`
+	CurrentStep   int         `json:"current_step"`
+	TotalSteps    int         `json:"total_steps"`
+	StartedAt     time.Time   `json:"started_at"`
+	UpdatedAt     time.Time   `json:"updated_at"`
+	ParentChainID *string     `json:"parent_chain_id,omitempty"`
 }
 
 // ListChainsResponse is the response from listing chain executions.
@@ -986,17 +1554,44 @@ type ListChainsResponse struct {
 	Chains []ChainSummary `json:"chains"`
 }
 
+// StepStatus is the state of a single chain step, reported in
+// ChainStepStatus.Status and DagNode.Status.
+type StepStatus string
+
+const (
+	StepStatusPending         StepStatus = "pending"
+	StepStatusRunning         StepStatus = "running"
+	StepStatusCompleted       StepStatus = "completed"
+	StepStatusFailed          StepStatus = "failed"
+	StepStatusSkipped         StepStatus = "skipped"
+	StepStatusWaitingSubChain StepStatus = "waiting_sub_chain"
+	StepStatusWaitingParallel StepStatus = "waiting_parallel"
+	// StepStatusAwaitingInput marks a step blocked until input is
+	// supplied with ProvideChainStepInput.
+	StepStatusAwaitingInput StepStatus = "awaiting_input"
+	// StepStatusCancelled is only reported for parallel sub-steps.
+	StepStatusCancelled StepStatus = "cancelled"
+)
+
+// IsValid reports whether s is a step status known to this client.
+func (s StepStatus) IsValid() bool {
+	switch s {
+	case StepStatusPending, StepStatusRunning, StepStatusCompleted,
+		StepStatusFailed, StepStatusSkipped, StepStatusWaitingSubChain,
+		StepStatusWaitingParallel, StepStatusAwaitingInput, StepStatusCancelled:
+		return true
+	}
+	return false
+}
+
 // ChainStepStatus is the detailed status of a single chain step.
-// Status is one of: "pending", "running", "completed", "failed", "skipped",
-// "waiting_sub_chain", "waiting_parallel". Parallel sub-steps may also
-// report "cancelled".
 type ChainStepStatus struct {
 	Name             string            `json:"name"`
 	Provider         string            `json:"provider"`
-	Status           string            `json:"status"`
+	Status           StepStatus        `json:"status"`
 	ResponseBody     map[string]any    `json:"response_body,omitempty"`
 	Error            *string           `json:"error,omitempty"`
-	CompletedAt      *string           `json:"completed_at,omitempty"`
+	CompletedAt      *time.Time        `json:"completed_at,omitempty"`
 	SubChain         *string           `json:"sub_chain,omitempty"`
 	ChildChainID     *string           `json:"child_chain_id,omitempty"`
 	ParallelSubSteps []ChainStepStatus `json:"parallel_sub_steps,omitempty"`
@@ -1008,13 +1603,13 @@ type ChainStepStatus struct {
 type ChainDetailResponse struct {
 	ChainID       string            `json:"chain_id"`
 	ChainName     string            `json:"chain_name"`
-	Status        string            `json:"status"`
+	Status        ChainStatus       `json:"status"`
 	CurrentStep   int               `json:"current_step"`
 	TotalSteps    int               `json:"total_steps"`
 	Steps         []ChainStepStatus `json:"steps"`
-	StartedAt     string            `json:"started_at"`
-	UpdatedAt     string            `json:"updated_at"`
-	ExpiresAt     *string           `json:"expires_at,omitempty"`
+	StartedAt     time.Time         `json:"started_at"`
+	UpdatedAt     time.Time         `json:"updated_at"`
+	ExpiresAt     *time.Time        `json:"expires_at,omitempty"`
 	CancelReason  *string           `json:"cancel_reason,omitempty"`
 	CancelledBy   *string           `json:"cancelled_by,omitempty"`
 	ExecutionPath []string          `json:"execution_path,omitempty"`
@@ -1033,7 +1628,7 @@ type DagNode struct {
 	Provider         *string      `json:"provider,omitempty"`
 	ActionType       *string      `json:"action_type,omitempty"`
 	SubChainName     *string      `json:"sub_chain_name,omitempty"`
-	Status           *string      `json:"status,omitempty"`
+	Status           *StepStatus  `json:"status,omitempty"`
 	ChildChainID     *string      `json:"child_chain_id,omitempty"`
 	Children         *DagResponse `json:"children,omitempty"`
 	ParallelChildren []DagNode    `json:"parallel_children,omitempty"`
@@ -1052,12 +1647,30 @@ type DagEdge struct {
 
 // DagResponse is the DAG representation of a chain (config or instance).
 type DagResponse struct {
-	ChainName     string    `json:"chain_name"`
-	ChainID       *string   `json:"chain_id,omitempty"`
-	Status        *string   `json:"status,omitempty"`
-	Nodes         []DagNode `json:"nodes"`
-	Edges         []DagEdge `json:"edges"`
-	ExecutionPath []string  `json:"execution_path,omitempty"`
+	ChainName     string       `json:"chain_name"`
+	ChainID       *string      `json:"chain_id,omitempty"`
+	Status        *ChainStatus `json:"status,omitempty"`
+	Nodes         []DagNode    `json:"nodes"`
+	Edges         []DagEdge    `json:"edges"`
+	ExecutionPath []string     `json:"execution_path,omitempty"`
+}
+
+// StartChainRequest is the request body for starting a chain execution
+// from a named chain definition. Input becomes the payload of the
+// chain's first step.
+type StartChainRequest struct {
+	Name      string            `json:"name"`
+	Namespace string            `json:"namespace"`
+	Tenant    string            `json:"tenant"`
+	Input     map[string]any    `json:"input,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+}
+
+// StartChainResponse is the response from starting a chain execution.
+type StartChainResponse struct {
+	ChainID   string      `json:"chain_id"`
+	ChainName string      `json:"chain_name"`
+	Status    ChainStatus `json:"status"`
 }
 
 // CancelChainRequest is the request body for cancelling a chain.
@@ -1068,18 +1681,243 @@ type CancelChainRequest struct {
 	CancelledBy *string `json:"cancelled_by,omitempty"`
 }
 
+// ProvideStepInputRequest is the request body for supplying input to a
+// chain step that is awaiting it. Payload becomes the step's response
+// and is visible to later steps as `{{steps.NAME.*}}`.
+type ProvideStepInputRequest struct {
+	Namespace  string         `json:"namespace"`
+	Tenant     string         `json:"tenant"`
+	Payload    map[string]any `json:"payload"`
+	ProvidedBy *string        `json:"provided_by,omitempty"`
+}
+
+// RetryOptions controls how RetryChain resumes a failed chain.
+//
+// FromStep names the step to resume from; steps before it keep their
+// recorded results and are not re-executed. When empty the chain
+// resumes from the step that failed. OverrideInput, if set, replaces
+// the payload of the resumed step.
+type RetryOptions struct {
+	Namespace     string         `json:"namespace"`
+	Tenant        string         `json:"tenant"`
+	FromStep      string         `json:"from_step,omitempty"`
+	OverrideInput map[string]any `json:"override_input,omitempty"`
+}
+
+// ChainDurationStats summarises a distribution of execution durations
+// in milliseconds.
+type ChainDurationStats struct {
+	P50Ms float64 `json:"p50_ms"`
+	P90Ms float64 `json:"p90_ms"`
+	P99Ms float64 `json:"p99_ms"`
+	MaxMs float64 `json:"max_ms"`
+}
+
+// ChainStepMetrics holds execution statistics for one step of a chain.
+type ChainStepMetrics struct {
+	Name        string             `json:"name"`
+	Executions  int64              `json:"executions"`
+	Failures    int64              `json:"failures"`
+	Retries     int64              `json:"retries"`
+	FailureRate float64            `json:"failure_rate"`
+	Duration    ChainDurationStats `json:"duration"`
+}
+
+// ChainMetricsResponse holds aggregated execution statistics for a
+// chain definition over a trailing window.
+type ChainMetricsResponse struct {
+	ChainName           string             `json:"chain_name"`
+	WindowSeconds       int64              `json:"window_seconds"`
+	Started             int64              `json:"started"`
+	Completed           int64              `json:"completed"`
+	Failed              int64              `json:"failed"`
+	Cancelled           int64              `json:"cancelled"`
+	TimedOut            int64              `json:"timed_out"`
+	ThroughputPerMinute float64            `json:"throughput_per_minute"`
+	Duration            ChainDurationStats `json:"duration"`
+	Steps               []ChainStepMetrics `json:"steps"`
+}
+
+// SlowestStep returns the step with the highest p99 duration, or nil
+// if no step has executed in the window.
+func (m *ChainMetricsResponse) SlowestStep() *ChainStepMetrics {
+	var slowest *ChainStepMetrics
+	for i := range m.Steps {
+		s := &m.Steps[i]
+		if s.Executions == 0 {
+			continue
+		}
+		if slowest == nil || s.Duration.P99Ms > slowest.Duration.P99Ms {
+			slowest = s
+		}
+	}
+	return slowest
+}
+
+// =============================================================================
+// Chain Definition Types
+// =============================================================================
+
+// Chain-level failure policies for ChainDefinition.OnFailure.
+const (
+	ChainFailureAbort      = "abort"
+	ChainFailureAbortNoDlq = "abort_no_dlq"
+)
+
+// Step-level failure policies for ChainStepDefinition.OnFailure.
+const (
+	StepFailureAbort = "abort"
+	StepFailureSkip  = "skip"
+	StepFailureDlq   = "dlq"
+)
+
+// Backoff strategies for ChainRetryPolicy.Strategy.
+const (
+	RetryBackoffFixed       = "fixed"
+	RetryBackoffLinear      = "linear"
+	RetryBackoffExponential = "exponential"
+)
+
+// Join and failure policies for ChainParallelGroup.
+const (
+	ParallelJoinAll           = "all"
+	ParallelJoinAny           = "any"
+	ParallelFailureFailFast   = "fail_fast"
+	ParallelFailureBestEffort = "best_effort"
+)
+
+// Operators for ChainBranchCondition.Operator.
+const (
+	BranchEq       = "eq"
+	BranchNeq      = "neq"
+	BranchContains = "contains"
+	BranchExists   = "exists"
+	BranchGt       = "gt"
+	BranchLt       = "lt"
+	BranchGte      = "gte"
+	BranchLte      = "lte"
+)
+
+// ChainDefinition is a chain configuration in the gateway's wire
+// format, as accepted by PUT /v1/chains/definitions/{name}. The
+// chains subpackage provides a typed builder for these.
+type ChainDefinition struct {
+	Name           string                   `json:"name"`
+	Version        uint64                   `json:"version,omitempty"`
+	Steps          []ChainStepDefinition    `json:"steps"`
+	OnFailure      string                   `json:"on_failure,omitempty"`
+	TimeoutSeconds *uint64                  `json:"timeout_seconds,omitempty"`
+	OnCancel       *ChainNotificationTarget `json:"on_cancel,omitempty"`
+}
+
+// ChainStepDefinition is a single step of a chain definition.
+//
+// A step dispatches to Provider unless exactly one of SubChain,
+// Parallel, Timer, WaitForSignal, or Worker is set, in which case
+// Provider and ActionType are left empty. PayloadTemplate supports
+// `{{origin.*}}`, `{{prev.*}}`, `{{steps.NAME.*}}`, `{{chain_id}}`,
+// and `{{step_index}}` placeholders.
+type ChainStepDefinition struct {
+	Name            string                 `json:"name"`
+	Provider        string                 `json:"provider"`
+	ActionType      string                 `json:"action_type"`
+	PayloadTemplate map[string]any         `json:"payload_template"`
+	OnFailure       string                 `json:"on_failure,omitempty"`
+	DelaySeconds    *uint64                `json:"delay_seconds,omitempty"`
+	Branches        []ChainBranchCondition `json:"branches,omitempty"`
+	DefaultNext     string                 `json:"default_next,omitempty"`
+	SubChain        string                 `json:"sub_chain,omitempty"`
+	Parallel        *ChainParallelGroup    `json:"parallel,omitempty"`
+	Retry           *ChainRetryPolicy      `json:"retry,omitempty"`
+	Timer           *ChainTimerConfig      `json:"timer,omitempty"`
+	WaitForSignal   *ChainSignalConfig     `json:"wait_for_signal,omitempty"`
+	Worker          *ChainWorkerConfig     `json:"worker,omitempty"`
+}
+
+// ChainBranchCondition routes to Target when Field (a path into the
+// step response, e.g. "body.status") satisfies Operator against Value.
+// Value is omitted for the "exists" operator.
+type ChainBranchCondition struct {
+	Field    string `json:"field"`
+	Operator string `json:"operator"`
+	Value    any    `json:"value,omitempty"`
+	Target   string `json:"target"`
+}
+
+// ChainRetryPolicy re-schedules a failed step up to MaxRetries times
+// before the step's failure policy fires.
+type ChainRetryPolicy struct {
+	MaxRetries uint32  `json:"max_retries"`
+	BackoffMs  uint64  `json:"backoff_ms,omitempty"`
+	Strategy   string  `json:"strategy,omitempty"`
+	JitterMs   *uint64 `json:"jitter_ms,omitempty"`
+}
+
+// ChainParallelGroup fans a step out to Steps concurrently.
+type ChainParallelGroup struct {
+	Steps          []ChainStepDefinition `json:"steps"`
+	Join           string                `json:"join,omitempty"`
+	OnFailure      string                `json:"on_failure,omitempty"`
+	TimeoutSeconds *uint64               `json:"timeout_seconds,omitempty"`
+	MaxConcurrency *int                  `json:"max_concurrency,omitempty"`
+}
+
+// ChainTimerConfig pauses the chain for DurationSeconds or until the
+// given instant. Exactly one of the two must be set.
+type ChainTimerConfig struct {
+	DurationSeconds *uint64    `json:"duration_seconds,omitempty"`
+	Until           *time.Time `json:"until,omitempty"`
+}
+
+// ChainSignalConfig pauses the chain until SignalName is delivered.
+// OnTimeout names the step to jump to when TimeoutSeconds elapses.
+type ChainSignalConfig struct {
+	SignalName     string  `json:"signal_name"`
+	TimeoutSeconds *uint64 `json:"timeout_seconds,omitempty"`
+	OnTimeout      string  `json:"on_timeout,omitempty"`
+}
+
+// ChainWorkerConfig hands the step to an external worker via a task queue.
+type ChainWorkerConfig struct {
+	Queue          string  `json:"queue"`
+	ActionType     string  `json:"action_type,omitempty"`
+	TimeoutSeconds *uint64 `json:"timeout_seconds,omitempty"`
+	MaxAttempts    *uint32 `json:"max_attempts,omitempty"`
+}
+
+// ChainNotificationTarget is dispatched when a chain is cancelled.
+type ChainNotificationTarget struct {
+	Provider   string `json:"provider"`
+	ActionType string `json:"action_type"`
+}
+
+// ChainValidationResult is the outcome of validating a chain
+// definition on the gateway without storing it.
+type ChainValidationResult struct {
+	Valid    bool     `json:"valid"`
+	Errors   []string `json:"errors"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// chainValidationErrorResponse is the 422 body returned when the
+// gateway rejects a chain definition.
+type chainValidationErrorResponse struct {
+	Error   string   `json:"error"`
+	Details []string `json:"details"`
+}
+
 // =============================================================================
 // Chain History Types (Retry Attempts)
 // =============================================================================
 
 // StepAttemptResponse is a single execution attempt for a chain step.
 type StepAttemptResponse struct {
-	Attempt     int     `json:"attempt"`
-	StartedAt   string  `json:"started_at"`
-	CompletedAt *string `json:"completed_at,omitempty"`
-	Success     bool    `json:"success"`
-	DurationMs  int     `json:"duration_ms"`
-	Error       *string `json:"error,omitempty"`
+	Attempt     int        `json:"attempt"`
+	StartedAt   time.Time  `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	Success     bool       `json:"success"`
+	DurationMs  int        `json:"duration_ms"`
+	Error       *string    `json:"error,omitempty"`
 }
 
 // StepHistoryEntry is the retry history for a single chain step.
@@ -1109,6 +1947,26 @@ type DlqStatsResponse struct {
 	Count   int  `json:"count"`
 }
 
+// DlqTenantCount is the number of dead-letter entries for one
+// namespace/tenant pair.
+type DlqTenantCount struct {
+	Namespace string `json:"namespace"`
+	Tenant    string `json:"tenant"`
+	Count     int    `json:"count"`
+}
+
+// DlqStatsDetailedResponse breaks the dead-letter count down by
+// provider, namespace/tenant, and error code. OldestTimestamp is the
+// Unix timestamp of the oldest entry, or nil when the queue is empty.
+type DlqStatsDetailedResponse struct {
+	Enabled         bool             `json:"enabled"`
+	Count           int              `json:"count"`
+	ByProvider      map[string]int   `json:"by_provider"`
+	ByTenant        []DlqTenantCount `json:"by_tenant"`
+	ByErrorCode     map[string]int   `json:"by_error_code"`
+	OldestTimestamp *uint64          `json:"oldest_timestamp,omitempty"`
+}
+
 // DlqEntry is a single dead-letter queue entry.
 type DlqEntry struct {
 	ActionID   string `json:"action_id"`
@@ -1127,6 +1985,144 @@ type DlqDrainResponse struct {
 	Count   int        `json:"count"`
 }
 
+// DlqFilter selects dead-letter entries for ListDlq. Empty fields match
+// everything; a zero Limit uses the server default page size.
+type DlqFilter struct {
+	Namespace string
+	Tenant    string
+	Provider  string
+	Limit     int
+	Offset    int
+}
+
+// DlqListResponse is a page of dead-letter entries. Listing does not
+// remove entries from the queue.
+type DlqListResponse struct {
+	Entries []DlqEntry `json:"entries"`
+	Total   int        `json:"total"`
+	Limit   int        `json:"limit"`
+	Offset  int        `json:"offset"`
+}
+
+// DlqAttempt is one failed delivery attempt recorded for a dead-letter
+// entry, oldest first.
+type DlqAttempt struct {
+	Attempt    int       `json:"attempt"`
+	At         time.Time `json:"at"`
+	Error      string    `json:"error"`
+	StatusCode *int      `json:"status_code,omitempty"`
+	DurationMs *int64    `json:"duration_ms,omitempty"`
+	Retryable  *bool     `json:"retryable,omitempty"`
+	Response   *string   `json:"response,omitempty"`
+}
+
+// DlqEntryDetail is a dead-letter entry together with the original
+// action that failed and its per-attempt error history. AuditRecordID,
+// when set, identifies the audit record for the final attempt; fetch it
+// with GetAuditRecord using the entry's ActionID.
+type DlqEntryDetail struct {
+	DlqEntry
+	Action        Action       `json:"action"`
+	History       []DlqAttempt `json:"history"`
+	AuditRecordID *string      `json:"audit_record_id,omitempty"`
+}
+
+// DlqPurgeFilter selects dead-letter entries for PurgeDlq. Entries must
+// match every field that is set; at least one must be.
+type DlqPurgeFilter struct {
+	// OlderThan removes entries dead-lettered more than this long ago.
+	OlderThan time.Duration
+	Provider  string
+	Namespace string
+	Tenant    string
+}
+
+type dlqPurgeRequest struct {
+	OlderThanSeconds uint64 `json:"older_than_seconds,omitempty"`
+	Provider         string `json:"provider,omitempty"`
+	Namespace        string `json:"namespace,omitempty"`
+	Tenant           string `json:"tenant,omitempty"`
+}
+
+type dlqPurgeResponse struct {
+	Purged int `json:"purged"`
+}
+
+// DlqRetryResult is the outcome of re-dispatching one dead-letter entry.
+// A successful retry removes the entry from the queue; a failed one
+// leaves it in place.
+type DlqRetryResult struct {
+	ActionID string `json:"action_id"`
+	Success  bool   `json:"success"`
+	Outcome  string `json:"outcome,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// DlqRetryOptions controls a bulk RetryDlq run.
+type DlqRetryOptions struct {
+	// MaxRate caps retries per second across all workers. Zero means
+	// no limit.
+	MaxRate float64
+	// Concurrency is the number of retries in flight at once.
+	// Defaults to 1.
+	Concurrency int
+}
+
+// DlqRetryReport summarises a bulk RetryDlq run. Results are in the
+// order the entries were listed.
+type DlqRetryReport struct {
+	Results   []DlqRetryResult
+	Succeeded int
+	Failed    int
+}
+
+// DlqPolicy is the gateway's automatic retry policy for dead-lettered
+// actions. Backoff is the delay before the first automatic retry and
+// grows according to BackoffStrategy; entries older than TTL are
+// expired instead of retried. A zero TTL keeps entries until drained.
+type DlqPolicy struct {
+	Enabled         bool
+	MaxRetries      int
+	Backoff         time.Duration
+	BackoffStrategy string
+	TTL             time.Duration
+}
+
+type dlqPolicyWire struct {
+	Enabled         bool   `json:"enabled"`
+	MaxRetries      int    `json:"max_retries"`
+	BackoffSeconds  uint64 `json:"backoff_seconds"`
+	BackoffStrategy string `json:"backoff_strategy,omitempty"`
+	TTLSeconds      uint64 `json:"ttl_seconds,omitempty"`
+}
+
+// MarshalJSON encodes the durations as whole seconds, as the server expects.
+func (p DlqPolicy) MarshalJSON() ([]byte, error) {
+	return json.Marshal(dlqPolicyWire{
+		Enabled:         p.Enabled,
+		MaxRetries:      p.MaxRetries,
+		BackoffSeconds:  uint64(p.Backoff / time.Second),
+		BackoffStrategy: p.BackoffStrategy,
+		TTLSeconds:      uint64(p.TTL / time.Second),
+	})
+}
+
+// UnmarshalJSON decodes the server's whole-second durations.
+func (p *DlqPolicy) UnmarshalJSON(data []byte) error {
+	var w dlqPolicyWire
+	if err := json.Unmarshal(data, &w); err != nil {
+		return err
+	}
+	*p = DlqPolicy{
+		Enabled:         w.Enabled,
+		MaxRetries:      w.MaxRetries,
+		Backoff:         time.Duration(w.BackoffSeconds) * time.Second,
+		BackoffStrategy: w.BackoffStrategy,
+		TTL:             time.Duration(w.TTLSeconds) * time.Second,
+	}
+	return nil
+}
+
 // =============================================================================
 // Rule Evaluation Types (Rule Playground)
 // =============================================================================
@@ -1141,7 +2137,7 @@ type EvaluateRulesRequest struct {
 	Metadata        map[string]string      `json:"metadata,omitempty"`
 	IncludeDisabled bool                   `json:"include_disabled,omitempty"`
 	EvaluateAll     bool                   `json:"evaluate_all,omitempty"`
-	EvaluateAt      *string                `json:"evaluate_at,omitempty"`
+	EvaluateAt      *time.Time             `json:"evaluate_at,omitempty"`
 	MockState       map[string]string      `json:"mock_state,omitempty"`
 }
 
@@ -1192,7 +2188,7 @@ type EvaluateRulesResponse struct {
 	TotalRulesSkipped   int                    `json:"total_rules_skipped"`
 	EvaluationDuration  uint64                 `json:"evaluation_duration_us"`
 	Trace               []RuleTraceEntry       `json:"trace"`
-	Context             TraceContext            `json:"context"`
+	Context             TraceContext           `json:"context"`
 	ModifiedPayload     map[string]interface{} `json:"modified_payload,omitempty"`
 }
 
@@ -1227,62 +2223,355 @@ type SseEvent struct {
 }
 
 // =============================================================================
-// Provider Health Types
+// Provider Catalog Types
 // =============================================================================
 
-// ProviderHealthStatus represents health and metrics for a single provider.
-type ProviderHealthStatus struct {
-	Provider             string   `json:"provider"`
-	Healthy              bool     `json:"healthy"`
-	HealthCheckError     *string  `json:"health_check_error,omitempty"`
-	CircuitBreakerState  string   `json:"circuit_breaker_state"`
-	TotalRequests        int      `json:"total_requests"`
-	Successes            int      `json:"successes"`
-	Failures             int      `json:"failures"`
-	SuccessRate          float64  `json:"success_rate"`
-	AvgLatencyMs         float64  `json:"avg_latency_ms"`
-	P50LatencyMs         float64  `json:"p50_latency_ms"`
-	P95LatencyMs         float64  `json:"p95_latency_ms"`
-	P99LatencyMs         float64  `json:"p99_latency_ms"`
-	LastRequestAt        *int64   `json:"last_request_at,omitempty"`
-	LastError            *string  `json:"last_error,omitempty"`
+// ProviderActionType is an action type a provider accepts, with the JSON
+// Schema its payload must satisfy.
+type ProviderActionType struct {
+	Name          string          `json:"name"`
+	Description   *string         `json:"description,omitempty"`
+	PayloadSchema json.RawMessage `json:"payload_schema,omitempty"`
+}
+
+// ProviderCatalogEntry describes a provider registered on the gateway.
+type ProviderCatalogEntry struct {
+	Name                string               `json:"name"`
+	Type                string               `json:"type"`
+	Description         *string              `json:"description,omitempty"`
+	SupportsAttachments bool                 `json:"supports_attachments"`
+	ActionTypes         []ProviderActionType `json:"action_types"`
+}
+
+// ActionType returns the named action type, or nil if the provider does
+// not accept it.
+func (e *ProviderCatalogEntry) ActionType(name string) *ProviderActionType {
+	for i := range e.ActionTypes {
+		if e.ActionTypes[i].Name == name {
+			return &e.ActionTypes[i]
+		}
+	}
+	return nil
 }
 
-// ListProviderHealthResponse is the response from listing provider health.
-type ListProviderHealthResponse struct {
-	Providers []ProviderHealthStatus `json:"providers"`
+// ListProvidersResponse is the response from listing the provider catalog.
+type ListProvidersResponse struct {
+	Providers []ProviderCatalogEntry `json:"providers"`
+	Count     int                    `json:"count"`
+}
+
+// Provider returns the named provider, or nil if it is not registered.
+func (r *ListProvidersResponse) Provider(name string) *ProviderCatalogEntry {
+	for i := range r.Providers {
+		if r.Providers[i].Name == name {
+			return &r.Providers[i]
+		}
+	}
+	return nil
 }
 
 // =============================================================================
-// WASM Plugin Types
+// Provider Configuration Types
 // =============================================================================
 
-// WasmPluginConfig holds resource configuration for a WASM plugin.
-type WasmPluginConfig struct {
-	MemoryLimitBytes     *int64   `json:"memory_limit_bytes,omitempty"`
-	TimeoutMs            *int64   `json:"timeout_ms,omitempty"`
-	AllowedHostFunctions []string `json:"allowed_host_functions,omitempty"`
+// ProviderConfig is a provider instance registered at runtime through
+// the API. Secret values in Config are redacted in responses.
+type ProviderConfig struct {
+	Name      string         `json:"name"`
+	Type      string         `json:"type"`
+	Tenant    *string        `json:"tenant,omitempty"`
+	Config    map[string]any `json:"config"`
+	Enabled   bool           `json:"enabled"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// CreateProviderConfigRequest registers a new provider instance. Type
+// is a provider type from the catalog, such as "slack" or "email".
+// Tenant scopes the instance to one tenant; empty makes it global.
+type CreateProviderConfigRequest struct {
+	Name   string         `json:"name"`
+	Type   string         `json:"type"`
+	Tenant string         `json:"tenant,omitempty"`
+	Config map[string]any `json:"config"`
+}
+
+// UpdateProviderConfigRequest updates a provider instance. Config
+// replaces the stored configuration when set.
+type UpdateProviderConfigRequest struct {
+	Config  map[string]any `json:"config,omitempty"`
+	Enabled *bool          `json:"enabled,omitempty"`
+}
+
+// ListProviderConfigsResponse is the response from listing provider
+// instances.
+type ListProviderConfigsResponse struct {
+	Configs []ProviderConfig `json:"configs"`
+	Count   int              `json:"count"`
+}
+
+// ProviderTestCheck is one step of a provider test, such as "dns",
+// "tls", "auth", or "send".
+type ProviderTestCheck struct {
+	Name    string  `json:"name"`
+	Passed  bool    `json:"passed"`
+	Message *string `json:"message,omitempty"`
+}
+
+// ProviderTestResult is the outcome of TestProvider.
+type ProviderTestResult struct {
+	Provider string `json:"provider"`
+	// Mode is "connectivity" for a connection and credentials check, or
+	// "test_send" when a sample action was executed in sandbox mode.
+	Mode      string              `json:"mode"`
+	Success   bool                `json:"success"`
+	Checks    []ProviderTestCheck `json:"checks"`
+	LatencyMs float64             `json:"latency_ms"`
+	Response  *ProviderResponse   `json:"response,omitempty"`
+	Error     *string             `json:"error,omitempty"`
+}
+
+// FailedChecks returns the checks that did not pass.
+func (r *ProviderTestResult) FailedChecks() []ProviderTestCheck {
+	var failed []ProviderTestCheck
+	for _, c := range r.Checks {
+		if !c.Passed {
+			failed = append(failed, c)
+		}
+	}
+	return failed
 }
 
-// WasmPlugin represents a registered WASM plugin.
-type WasmPlugin struct {
-	Name            string            `json:"name"`
-	Description     *string           `json:"description,omitempty"`
-	Status          string            `json:"status"`
-	Enabled         bool              `json:"enabled"`
-	Config          *WasmPluginConfig `json:"config,omitempty"`
-	CreatedAt       string            `json:"created_at"`
-	UpdatedAt       string            `json:"updated_at"`
-	InvocationCount int64             `json:"invocation_count"`
+// providerTestRequest is the body of POST /v1/providers/{name}/test.
+type providerTestRequest struct {
+	Action *Action `json:"action,omitempty"`
 }
 
-// RegisterPluginRequest is the request to register a new WASM plugin.
-type RegisterPluginRequest struct {
-	Name        string            `json:"name"`
+// =============================================================================
+// Secret Types
+// =============================================================================
+
+// SecretScopeGlobal is the scope of secrets shared by every tenant.
+const SecretScopeGlobal = "_global"
+
+// SecretInfo describes a managed secret. The value is never returned.
+type SecretInfo struct {
+	Scope     string    `json:"scope"`
+	Name      string    `json:"name"`
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Ref returns the reference string for this secret.
+func (s *SecretInfo) Ref() string {
+	return SecretRef(s.Scope, s.Name)
+}
+
+// ListSecretsResponse is the response from ListSecrets.
+type ListSecretsResponse struct {
+	Secrets []SecretInfo `json:"secrets"`
+	Count   int          `json:"count"`
+}
+
+// SecretRef returns the string that stands for a managed secret in a
+// provider config value or webhook header, e.g.
+//
+//	"Authorization": "Bearer " + acteon.SecretRef("acme", "pagerduty-token")
+//
+// The gateway substitutes the secret's value when the provider runs.
+func SecretRef(scope, name string) string {
+	return "${secret:" + scope + "/" + name + "}"
+}
+
+// ParseSecretRef extracts the scope and name from a string produced by
+// SecretRef. It reports false if s is not exactly one reference.
+func ParseSecretRef(s string) (scope, name string, ok bool) {
+	inner, found := strings.CutPrefix(s, "${secret:")
+	if !found {
+		return "", "", false
+	}
+	inner, found = strings.CutSuffix(inner, "}")
+	if !found {
+		return "", "", false
+	}
+	scope, name, found = strings.Cut(inner, "/")
+	if !found || scope == "" || name == "" || strings.ContainsAny(name, "/{}") {
+		return "", "", false
+	}
+	return scope, name, true
+}
+
+// =============================================================================
+// Provider Health Types
+// =============================================================================
+
+// CircuitState is the state of a provider's circuit breaker.
+type CircuitState string
+
+const (
+	CircuitClosed   CircuitState = "closed"
+	CircuitOpen     CircuitState = "open"
+	CircuitHalfOpen CircuitState = "half_open"
+)
+
+// IsValid reports whether s is a circuit breaker state known to this client.
+func (s CircuitState) IsValid() bool {
+	switch s {
+	case CircuitClosed, CircuitOpen, CircuitHalfOpen:
+		return true
+	}
+	return false
+}
+
+// ProviderHealthStatus represents health and metrics for a single provider.
+type ProviderHealthStatus struct {
+	Provider            string       `json:"provider"`
+	Healthy             bool         `json:"healthy"`
+	HealthCheckError    *string      `json:"health_check_error,omitempty"`
+	CircuitBreakerState CircuitState `json:"circuit_breaker_state"`
+	TotalRequests       int          `json:"total_requests"`
+	Successes           int          `json:"successes"`
+	Failures            int          `json:"failures"`
+	SuccessRate         float64      `json:"success_rate"`
+	AvgLatencyMs        float64      `json:"avg_latency_ms"`
+	P50LatencyMs        float64      `json:"p50_latency_ms"`
+	P95LatencyMs        float64      `json:"p95_latency_ms"`
+	P99LatencyMs        float64      `json:"p99_latency_ms"`
+	LastRequestAt       *int64       `json:"last_request_at,omitempty"`
+	LastError           *string      `json:"last_error,omitempty"`
+}
+
+// ListProviderHealthResponse is the response from listing provider health.
+type ListProviderHealthResponse struct {
+	Providers []ProviderHealthStatus `json:"providers"`
+}
+
+// =============================================================================
+// WASM Plugin Types
+// =============================================================================
+
+// WasmPluginConfig holds resource configuration for a WASM plugin.
+type WasmPluginConfig struct {
+	MemoryLimitBytes     *int64   `json:"memory_limit_bytes,omitempty"`
+	TimeoutMs            *int64   `json:"timeout_ms,omitempty"`
+	AllowedHostFunctions []string `json:"allowed_host_functions,omitempty"`
+}
+
+// WasmPlugin represents a registered WASM plugin.
+type WasmPlugin struct {
+	Name            string            `json:"name"`
+	Description     *string           `json:"description,omitempty"`
+	Status          string            `json:"status"`
+	Enabled         bool              `json:"enabled"`
+	Config          *WasmPluginConfig `json:"config,omitempty"`
+	CreatedAt       time.Time         `json:"created_at"`
+	UpdatedAt       time.Time         `json:"updated_at"`
+	InvocationCount int64             `json:"invocation_count"`
+	// ModuleSha256 is the hex SHA-256 of the stored WASM module.
+	ModuleSha256 *string `json:"module_sha256,omitempty"`
+	// ActiveVersion is the module version currently serving
+	// invocations. See ListPluginVersions and RollbackPlugin.
+	ActiveVersion *int `json:"active_version,omitempty"`
+	// Verification is the result of the most recent signature check.
+	Verification *PluginVerification `json:"verification,omitempty"`
+}
+
+// Plugin signature verification statuses reported in
+// PluginVerification.Status.
+const (
+	PluginVerified   = "verified"
+	PluginUnsigned   = "unsigned"
+	PluginInvalid    = "invalid"
+	PluginKeyUnknown = "key_unknown"
+)
+
+// PluginVerification reports whether a plugin module's signature
+// checks out against its public key.
+type PluginVerification struct {
+	Status       string     `json:"status"`
+	Verified     bool       `json:"verified"`
+	PublicKeyRef *string    `json:"public_key_ref,omitempty"`
+	Signer       *string    `json:"signer,omitempty"`
+	VerifiedAt   *time.Time `json:"verified_at,omitempty"`
+	Error        *string    `json:"error,omitempty"`
+}
+
+// RegisterPluginRequest is the request to register a new WASM plugin.
+type RegisterPluginRequest struct {
+	Name        string            `json:"name"`
 	Description string            `json:"description,omitempty"`
 	WasmBytes   string            `json:"wasm_bytes,omitempty"`
 	WasmPath    string            `json:"wasm_path,omitempty"`
 	Config      *WasmPluginConfig `json:"config,omitempty"`
+	// Signature is a base64 cosign-style signature over the module's
+	// SHA-256 digest. Required when the gateway enforces signed plugins.
+	Signature string `json:"signature,omitempty"`
+	// PublicKeyRef names the key the gateway verifies Signature with,
+	// as configured in its trusted-key set.
+	PublicKeyRef string `json:"public_key_ref,omitempty"`
+}
+
+// PluginVersion is one stored module version of a WASM plugin.
+type PluginVersion struct {
+	Version      int               `json:"version"`
+	ModuleSha256 string            `json:"module_sha256"`
+	Description  *string           `json:"description,omitempty"`
+	Config       *WasmPluginConfig `json:"config,omitempty"`
+	CreatedAt    time.Time         `json:"created_at"`
+	Active       bool              `json:"active"`
+}
+
+// ListPluginVersionsResponse is the response from listing plugin versions.
+type ListPluginVersionsResponse struct {
+	Versions []PluginVersion `json:"versions"`
+	Count    int             `json:"count"`
+}
+
+// RegisterPluginVersionRequest uploads a new module version for an
+// existing plugin. Set Activate to switch invocations to it
+// immediately; otherwise it is stored for a later RollbackPlugin.
+type RegisterPluginVersionRequest struct {
+	Description string            `json:"description,omitempty"`
+	WasmBytes   string            `json:"wasm_bytes,omitempty"`
+	WasmPath    string            `json:"wasm_path,omitempty"`
+	Config      *WasmPluginConfig `json:"config,omitempty"`
+	Activate    bool              `json:"activate,omitempty"`
+}
+
+type rollbackPluginRequest struct {
+	Version int `json:"version"`
+}
+
+// PluginRef points at a WASM plugin artifact in an OCI registry.
+//
+// Set Digest (e.g. "sha256:…") to pin the exact artifact; the gateway
+// refuses an artifact whose digest differs. Tag alone follows whatever
+// the tag currently points at. Name is the plugin name to register the
+// artifact under and defaults to the last path segment of Repo.
+type PluginRef struct {
+	Registry string `json:"registry"`
+	Repo     string `json:"repository"`
+	Tag      string `json:"tag,omitempty"`
+	Digest   string `json:"digest,omitempty"`
+	Name     string `json:"name,omitempty"`
+}
+
+// String returns the reference in `registry/repo:tag@digest` form.
+func (r PluginRef) String() string {
+	s := r.Registry + "/" + r.Repo
+	if r.Tag != "" {
+		s += ":" + r.Tag
+	}
+	if r.Digest != "" {
+		s += "@" + r.Digest
+	}
+	return s
+}
+
+// setPluginEnabledRequest is the body for toggling a plugin on or off.
+type setPluginEnabledRequest struct {
+	Enabled bool `json:"enabled"`
 }
 
 // ListPluginsResponse is the response from listing WASM plugins.
@@ -1305,6 +2594,77 @@ type PluginInvocationResponse struct {
 	DurationMs *float64       `json:"duration_ms,omitempty"`
 }
 
+// PluginExport is a function or memory exported by a WASM module.
+type PluginExport struct {
+	Name    string   `json:"name"`
+	Kind    string   `json:"kind"`
+	Params  []string `json:"params,omitempty"`
+	Results []string `json:"results,omitempty"`
+}
+
+// PluginMemory describes a module's declared linear memory. One WASM
+// page is 64 KiB.
+type PluginMemory struct {
+	InitialPages uint32  `json:"initial_pages"`
+	MaxPages     *uint32 `json:"max_pages,omitempty"`
+}
+
+// PluginInspection describes a plugin module's interface without
+// running it.
+type PluginInspection struct {
+	Name                  string         `json:"name"`
+	Exports               []PluginExport `json:"exports"`
+	RequiredHostFunctions []string       `json:"required_host_functions"`
+	Memory                PluginMemory   `json:"memory"`
+	ModuleSizeBytes       int64          `json:"module_size_bytes"`
+}
+
+// MissingHostFunctions returns the host functions the module imports
+// that cfg does not allow. A plugin with missing host functions fails
+// to instantiate, so check this before enabling it on the dispatch
+// path. A nil cfg allows nothing.
+func (p *PluginInspection) MissingHostFunctions(cfg *WasmPluginConfig) []string {
+	allowed := map[string]bool{}
+	if cfg != nil {
+		for _, fn := range cfg.AllowedHostFunctions {
+			allowed[fn] = true
+		}
+	}
+	var missing []string
+	for _, fn := range p.RequiredHostFunctions {
+		if !allowed[fn] {
+			missing = append(missing, fn)
+		}
+	}
+	return missing
+}
+
+// ExceedsMemoryLimit reports whether the module's initial memory is
+// larger than cfg's memory limit, which would make it fail to load.
+func (p *PluginInspection) ExceedsMemoryLimit(cfg *WasmPluginConfig) bool {
+	if cfg == nil || cfg.MemoryLimitBytes == nil {
+		return false
+	}
+	return int64(p.Memory.InitialPages)*65536 > *cfg.MemoryLimitBytes
+}
+
+// PluginBatchResult is the outcome of one invocation in a batch. Error
+// is set, and the embedded response left zero, when that invocation
+// trapped or timed out; other invocations in the batch still run.
+type PluginBatchResult struct {
+	PluginInvocationResponse
+	Error *string `json:"error,omitempty"`
+}
+
+// PluginBatchInvocationResponse holds batch results in request order.
+type PluginBatchInvocationResponse struct {
+	Results []PluginBatchResult `json:"results"`
+}
+
+type pluginBatchInvocationRequest struct {
+	Invocations []PluginInvocationRequest `json:"invocations"`
+}
+
 // =============================================================================
 // Compliance Types (SOC2/HIPAA)
 // =============================================================================
@@ -1319,19 +2679,82 @@ type ComplianceStatus struct {
 
 // HashChainVerification is the result of verifying an audit hash chain.
 type HashChainVerification struct {
-	Valid          bool    `json:"valid"`
-	RecordsChecked uint64  `json:"records_checked"`
-	FirstBrokenAt  *string `json:"first_broken_at,omitempty"`
-	FirstRecordID  *string `json:"first_record_id,omitempty"`
-	LastRecordID   *string `json:"last_record_id,omitempty"`
+	Valid          bool   `json:"valid"`
+	RecordsChecked uint64 `json:"records_checked"`
+	// FirstBrokenAt is the ID of the record where the chain first
+	// broke, despite the name; nil when the chain is intact.
+	FirstBrokenAt *string `json:"first_broken_at,omitempty"`
+	FirstRecordID *string `json:"first_record_id,omitempty"`
+	LastRecordID  *string `json:"last_record_id,omitempty"`
 }
 
 // VerifyHashChainRequest is the request body for hash chain verification.
 type VerifyHashChainRequest struct {
-	Namespace string  `json:"namespace"`
-	Tenant    string  `json:"tenant"`
-	From      *string `json:"from,omitempty"`
-	To        *string `json:"to,omitempty"`
+	Namespace string     `json:"namespace"`
+	Tenant    string     `json:"tenant"`
+	From      *time.Time `json:"from,omitempty"`
+	To        *time.Time `json:"to,omitempty"`
+}
+
+// SubjectQuery identifies an end user's data for export or erasure.
+type SubjectQuery struct {
+	Tenant string `json:"tenant"`
+	// Namespace restricts the search to one namespace. Empty searches
+	// every namespace of the tenant.
+	Namespace string `json:"namespace,omitempty"`
+	// Identifiers are the values that identify the subject in action
+	// payloads, such as an email address or phone number.
+	Identifiers []string `json:"identifiers"`
+}
+
+// Validate requires a tenant and at least one non-empty identifier, so
+// that an erasure can never match more than the intended subject.
+func (q *SubjectQuery) Validate() error {
+	var problems []ValidationProblem
+	if q.Tenant == "" {
+		problems = append(problems, ValidationProblem{Field: "tenant", Message: "is required"})
+	}
+	if len(q.Identifiers) == 0 {
+		problems = append(problems, ValidationProblem{Field: "identifiers", Message: "at least one identifier is required"})
+	}
+	for i, id := range q.Identifiers {
+		if strings.TrimSpace(id) == "" {
+			problems = append(problems, ValidationProblem{Field: fmt.Sprintf("identifiers[%d]", i), Message: "must not be empty"})
+		}
+	}
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// SubjectDataExport holds every stored record that references a data
+// subject.
+type SubjectDataExport struct {
+	Tenant       string           `json:"tenant"`
+	Identifiers  []string         `json:"identifiers"`
+	AuditRecords []AuditRecord    `json:"audit_records"`
+	EventStates  []EventState     `json:"event_states"`
+	Approvals    []ApprovalStatus `json:"approvals"`
+	ExportedAt   time.Time        `json:"exported_at"`
+}
+
+// SubjectErasureReport is the verification report returned by
+// EraseSubjectData. Audit records are redacted in place rather than
+// deleted so that the hash chain stays verifiable.
+type SubjectErasureReport struct {
+	Tenant               string   `json:"tenant"`
+	Identifiers          []string `json:"identifiers"`
+	AuditRecordsRedacted int      `json:"audit_records_redacted"`
+	EventStatesDeleted   int      `json:"event_states_deleted"`
+	ApprovalsDeleted     int      `json:"approvals_deleted"`
+	// Verified is true when a search run after erasure found no
+	// remaining references to the identifiers.
+	Verified bool `json:"verified"`
+	// Remaining counts references still found per store when Verified
+	// is false, e.g. records under a compliance hold.
+	Remaining map[string]int `json:"remaining,omitempty"`
+	ErasedAt  time.Time      `json:"erased_at"`
 }
 
 // =============================================================================
@@ -1345,8 +2768,8 @@ type TemplateInfo struct {
 	Namespace   string            `json:"namespace"`
 	Tenant      string            `json:"tenant"`
 	Content     string            `json:"content"`
-	CreatedAt   string            `json:"created_at"`
-	UpdatedAt   string            `json:"updated_at"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
 	Description *string           `json:"description,omitempty"`
 	Labels      map[string]string `json:"labels,omitempty"`
 }
@@ -1379,6 +2802,18 @@ type ListTemplatesResponse struct {
 // Use json.RawMessage for flexible deserialization.
 type TemplateProfileField = json.RawMessage
 
+// InlineField returns a profile field holding an inline template string.
+func InlineField(content string) TemplateProfileField {
+	data, _ := json.Marshal(content)
+	return data
+}
+
+// RefField returns a profile field that references the named template.
+func RefField(templateName string) TemplateProfileField {
+	data, _ := json.Marshal(map[string]string{"$ref": templateName})
+	return data
+}
+
 // TemplateProfileInfo represents a template profile that groups multiple templates.
 type TemplateProfileInfo struct {
 	ID          string                          `json:"id"`
@@ -1386,8 +2821,8 @@ type TemplateProfileInfo struct {
 	Namespace   string                          `json:"namespace"`
 	Tenant      string                          `json:"tenant"`
 	Fields      map[string]TemplateProfileField `json:"fields"`
-	CreatedAt   string                          `json:"created_at"`
-	UpdatedAt   string                          `json:"updated_at"`
+	CreatedAt   time.Time                       `json:"created_at"`
+	UpdatedAt   time.Time                       `json:"updated_at"`
 	Description *string                         `json:"description,omitempty"`
 	Labels      map[string]string               `json:"labels,omitempty"`
 }
@@ -1415,6 +2850,68 @@ type ListProfilesResponse struct {
 	Count    int                   `json:"count"`
 }
 
+// TemplateUsageScope is a template's usage within one namespace and
+// tenant.
+type TemplateUsageScope struct {
+	Namespace  string     `json:"namespace"`
+	Tenant     string     `json:"tenant"`
+	Renders    int64      `json:"renders"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+}
+
+// TemplateUsageResponse reports how often a template was rendered by
+// dispatches over a trailing window.
+type TemplateUsageResponse struct {
+	TemplateID    string               `json:"template_id"`
+	TemplateName  string               `json:"template_name"`
+	WindowSeconds int64                `json:"window_seconds"`
+	TotalRenders  int64                `json:"total_renders"`
+	LastUsedAt    *time.Time           `json:"last_used_at,omitempty"`
+	Scopes        []TemplateUsageScope `json:"scopes"`
+}
+
+// Unused reports whether no dispatch rendered the template in the
+// window.
+func (u *TemplateUsageResponse) Unused() bool {
+	return u.TotalRenders == 0
+}
+
+// TemplateValidationIssue is a single problem found while validating a
+// template. Line and Column are 1-based; both are zero when the gateway
+// cannot attribute the issue to a position.
+type TemplateValidationIssue struct {
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Message string `json:"message"`
+}
+
+// TemplateValidationResult is the outcome of validating template
+// content on the gateway without storing it.
+type TemplateValidationResult struct {
+	Valid     bool                      `json:"valid"`
+	Errors    []TemplateValidationIssue `json:"errors"`
+	Warnings  []TemplateValidationIssue `json:"warnings,omitempty"`
+	Variables []string                  `json:"variables"`
+}
+
+// ResolvedProfileField is a profile field with any `$ref` resolved to
+// the referenced template's content.
+type ResolvedProfileField struct {
+	// Content is the template source the field renders.
+	Content string `json:"content"`
+	// Ref names the referenced template; empty for inline fields.
+	Ref string `json:"ref,omitempty"`
+	// Template is the referenced template; nil for inline fields.
+	Template *TemplateInfo `json:"template,omitempty"`
+}
+
+// ResolvedProfile is a template profile with every field materialized,
+// as returned by ResolveProfile.
+type ResolvedProfile struct {
+	Profile TemplateProfileInfo             `json:"profile"`
+	Fields  map[string]ResolvedProfileField `json:"fields"`
+}
+
 // RenderPreviewRequest is the request to render a template profile with payload data.
 type RenderPreviewRequest struct {
 	Profile   string         `json:"profile"`
@@ -1423,251 +2920,917 @@ type RenderPreviewRequest struct {
 	Payload   map[string]any `json:"payload"`
 }
 
-// RenderPreviewResponse is the response from rendering a template profile.
-type RenderPreviewResponse struct {
-	Rendered map[string]string `json:"rendered"`
+// RenderPreviewResponse is the response from rendering a template profile.
+type RenderPreviewResponse struct {
+	Rendered map[string]string `json:"rendered"`
+}
+
+// =============================================================================
+// Provider Payload Helpers
+// =============================================================================
+
+// NewTwilioSmsPayload creates a payload for the Twilio SMS provider.
+func NewTwilioSmsPayload(to, body string) map[string]any {
+	return map[string]any{
+		"to":   to,
+		"body": body,
+	}
+}
+
+// NewTwilioSmsPayloadWithOptions creates a Twilio SMS payload with optional fields.
+func NewTwilioSmsPayloadWithOptions(to, body string, from string, mediaURL string) map[string]any {
+	p := NewTwilioSmsPayload(to, body)
+	if from != "" {
+		p["from"] = from
+	}
+	if mediaURL != "" {
+		p["media_url"] = mediaURL
+	}
+	return p
+}
+
+// NewWhatsAppMessagePayload creates a payload that sends a WhatsApp
+// message through the Twilio provider. The `whatsapp:` channel prefix
+// is added to to if missing.
+func NewWhatsAppMessagePayload(to, body string) map[string]any {
+	return map[string]any{
+		"to":   whatsAppAddress(to),
+		"body": body,
+	}
+}
+
+// NewWhatsAppMessagePayloadWithOptions creates a WhatsApp payload with
+// optional fields. from is the WhatsApp-enabled sender and is prefixed
+// like to.
+func NewWhatsAppMessagePayloadWithOptions(to, body, from, mediaURL string) map[string]any {
+	p := NewWhatsAppMessagePayload(to, body)
+	if from != "" {
+		p["from"] = whatsAppAddress(from)
+	}
+	if mediaURL != "" {
+		p["media_url"] = mediaURL
+	}
+	return p
+}
+
+// NewWhatsAppTemplatePayload creates a WhatsApp payload that sends an
+// approved content template. variables fill the template's numbered
+// placeholders, keyed "1", "2", and so on.
+func NewWhatsAppTemplatePayload(to, contentSID string, variables map[string]string) map[string]any {
+	p := map[string]any{
+		"to":          whatsAppAddress(to),
+		"content_sid": contentSID,
+	}
+	if len(variables) > 0 {
+		// Twilio takes ContentVariables as a JSON-encoded string.
+		data, _ := json.Marshal(variables)
+		p["content_variables"] = string(data)
+	}
+	return p
+}
+
+func whatsAppAddress(number string) string {
+	if strings.HasPrefix(number, "whatsapp:") {
+		return number
+	}
+	return "whatsapp:" + number
+}
+
+// NewTeamsMessagePayload creates a payload for the Microsoft Teams provider.
+func NewTeamsMessagePayload(text string) map[string]any {
+	return map[string]any{
+		"text": text,
+	}
+}
+
+// NewTeamsMessagePayloadWithOptions creates a Teams message payload with optional fields.
+func NewTeamsMessagePayloadWithOptions(text, title, themeColor string) map[string]any {
+	p := NewTeamsMessagePayload(text)
+	if title != "" {
+		p["title"] = title
+	}
+	if themeColor != "" {
+		p["theme_color"] = themeColor
+	}
+	return p
+}
+
+// NewTeamsAdaptiveCardPayload creates a payload for Teams with an Adaptive Card.
+func NewTeamsAdaptiveCardPayload(card map[string]any) map[string]any {
+	return map[string]any{
+		"adaptive_card": card,
+	}
+}
+
+// NewDiscordMessagePayload creates a payload for the Discord webhook provider.
+func NewDiscordMessagePayload(content string) map[string]any {
+	return map[string]any{
+		"content": content,
+	}
+}
+
+// NewDiscordEmbedPayload creates a Discord payload with an embed.
+func NewDiscordEmbedPayload(embeds []map[string]any) map[string]any {
+	return map[string]any{
+		"embeds": embeds,
+	}
+}
+
+// NewDiscordMessagePayloadWithOptions creates a Discord payload with all options.
+func NewDiscordMessagePayloadWithOptions(content, username, avatarURL string, embeds []map[string]any) map[string]any {
+	p := map[string]any{}
+	if content != "" {
+		p["content"] = content
+	}
+	if username != "" {
+		p["username"] = username
+	}
+	if avatarURL != "" {
+		p["avatar_url"] = avatarURL
+	}
+	if len(embeds) > 0 {
+		p["embeds"] = embeds
+	}
+	return p
+}
+
+// =============================================================================
+// Slack Provider Payload Helpers
+// =============================================================================
+
+// Slack attachment colors. Slack also accepts any hex color string.
+const (
+	SlackColorGood    = "good"
+	SlackColorWarning = "warning"
+	SlackColorDanger  = "danger"
+)
+
+// NewSlackMessagePayload creates a payload for the Slack provider. An
+// empty channel uses the provider's default channel.
+func NewSlackMessagePayload(channel, text string) map[string]any {
+	p := map[string]any{
+		"text": text,
+	}
+	if channel != "" {
+		p["channel"] = channel
+	}
+	return p
+}
+
+// NewSlackBlocksPayload creates a Slack payload with Block Kit blocks.
+// text is the notification fallback shown where blocks cannot render.
+func NewSlackBlocksPayload(channel, text string, blocks []map[string]any) map[string]any {
+	p := map[string]any{
+		"blocks": blocks,
+	}
+	if channel != "" {
+		p["channel"] = channel
+	}
+	if text != "" {
+		p["text"] = text
+	}
+	return p
+}
+
+// NewSlackMessagePayloadWithOptions creates a Slack payload with all
+// options. threadTS and attachments are set as Slack's `thread_ts` and
+// `attachments` fields, but the gateway's Slack provider reads only
+// channel, text, and blocks: it ignores both, so the message is not
+// threaded and attachments are not posted. They reach Slack only
+// through a custom provider that forwards them.
+func NewSlackMessagePayloadWithOptions(channel, text, threadTS string, blocks, attachments []map[string]any) map[string]any {
+	p := map[string]any{}
+	if channel != "" {
+		p["channel"] = channel
+	}
+	if text != "" {
+		p["text"] = text
+	}
+	if threadTS != "" {
+		p["thread_ts"] = threadTS
+	}
+	if len(blocks) > 0 {
+		p["blocks"] = blocks
+	}
+	if len(attachments) > 0 {
+		p["attachments"] = attachments
+	}
+	return p
+}
+
+// SlackHeaderBlock creates a Block Kit header block.
+func SlackHeaderBlock(text string) map[string]any {
+	return map[string]any{
+		"type": "header",
+		"text": map[string]any{"type": "plain_text", "text": text},
+	}
+}
+
+// SlackSectionBlock creates a Block Kit section block with mrkdwn text.
+func SlackSectionBlock(text string) map[string]any {
+	return map[string]any{
+		"type": "section",
+		"text": map[string]any{"type": "mrkdwn", "text": text},
+	}
+}
+
+// SlackSectionFieldsBlock creates a section block laid out as a
+// two-column grid of mrkdwn fields.
+func SlackSectionFieldsBlock(fields ...string) map[string]any {
+	items := make([]map[string]any, len(fields))
+	for i, f := range fields {
+		items[i] = map[string]any{"type": "mrkdwn", "text": f}
+	}
+	return map[string]any{
+		"type":   "section",
+		"fields": items,
+	}
+}
+
+// SlackDividerBlock creates a Block Kit divider block.
+func SlackDividerBlock() map[string]any {
+	return map[string]any{"type": "divider"}
+}
+
+// SlackContextBlock creates a context block of small mrkdwn elements.
+func SlackContextBlock(texts ...string) map[string]any {
+	elements := make([]map[string]any, len(texts))
+	for i, t := range texts {
+		elements[i] = map[string]any{"type": "mrkdwn", "text": t}
+	}
+	return map[string]any{
+		"type":     "context",
+		"elements": elements,
+	}
+}
+
+// SlackActionsBlock creates an actions block holding interactive
+// elements such as buttons.
+func SlackActionsBlock(elements ...map[string]any) map[string]any {
+	return map[string]any{
+		"type":     "actions",
+		"elements": elements,
+	}
+}
+
+// SlackButton creates a button element for an actions block.
+func SlackButton(text, actionID, value string) map[string]any {
+	b := map[string]any{
+		"type":      "button",
+		"text":      map[string]any{"type": "plain_text", "text": text},
+		"action_id": actionID,
+	}
+	if value != "" {
+		b["value"] = value
+	}
+	return b
+}
+
+// SlackButtonWithOptions creates a button element with optional fields.
+// style is "primary" or "danger"; url makes it a link button.
+func SlackButtonWithOptions(text, actionID, value, style, url string) map[string]any {
+	b := SlackButton(text, actionID, value)
+	if style != "" {
+		b["style"] = style
+	}
+	if url != "" {
+		b["url"] = url
+	}
+	return b
+}
+
+// SlackAttachment creates a legacy attachment, which renders blocks
+// beside a colored bar. color is one of the SlackColor constants or a
+// hex string. The gateway's Slack provider ignores attachments; see
+// NewSlackMessagePayloadWithOptions.
+func SlackAttachment(color string, blocks []map[string]any) map[string]any {
+	a := map[string]any{
+		"blocks": blocks,
+	}
+	if color != "" {
+		a["color"] = color
+	}
+	return a
+}
+
+// =============================================================================
+// Google Chat Provider Payload Helpers
+// =============================================================================
+
+// NewGoogleChatMessagePayload creates a payload for the Google Chat provider.
+func NewGoogleChatMessagePayload(text string) map[string]any {
+	return map[string]any{
+		"text": text,
+	}
+}
+
+// NewGoogleChatMessagePayloadWithOptions creates a Google Chat payload
+// with all options. Messages sharing a threadKey are posted to the same
+// thread. cards are built with GoogleChatCard.
+func NewGoogleChatMessagePayloadWithOptions(text, threadKey string, cards []map[string]any) map[string]any {
+	p := map[string]any{}
+	if text != "" {
+		p["text"] = text
+	}
+	if threadKey != "" {
+		p["thread_key"] = threadKey
+	}
+	if len(cards) > 0 {
+		p["cards_v2"] = cards
+	}
+	return p
+}
+
+// GoogleChatCard creates a card v2 entry. header may be nil.
+func GoogleChatCard(cardID string, header map[string]any, sections ...map[string]any) map[string]any {
+	card := map[string]any{
+		"sections": sections,
+	}
+	if header != nil {
+		card["header"] = header
+	}
+	return map[string]any{
+		"cardId": cardID,
+		"card":   card,
+	}
+}
+
+// GoogleChatCardHeader creates a card header. subtitle and imageURL are
+// optional.
+func GoogleChatCardHeader(title, subtitle, imageURL string) map[string]any {
+	h := map[string]any{"title": title}
+	if subtitle != "" {
+		h["subtitle"] = subtitle
+	}
+	if imageURL != "" {
+		h["imageUrl"] = imageURL
+	}
+	return h
+}
+
+// GoogleChatSection creates a card section. header is optional.
+func GoogleChatSection(header string, widgets ...map[string]any) map[string]any {
+	s := map[string]any{
+		"widgets": widgets,
+	}
+	if header != "" {
+		s["header"] = header
+	}
+	return s
+}
+
+// GoogleChatTextWidget creates a text paragraph widget. Google Chat
+// accepts a small subset of HTML in text.
+func GoogleChatTextWidget(text string) map[string]any {
+	return map[string]any{
+		"textParagraph": map[string]any{"text": text},
+	}
+}
+
+// GoogleChatButtonsWidget creates a widget holding a row of buttons.
+func GoogleChatButtonsWidget(buttons ...map[string]any) map[string]any {
+	return map[string]any{
+		"buttonList": map[string]any{"buttons": buttons},
+	}
+}
+
+// GoogleChatButton creates a button that opens url.
+func GoogleChatButton(text, url string) map[string]any {
+	return map[string]any{
+		"text": text,
+		"onClick": map[string]any{
+			"openLink": map[string]any{"url": url},
+		},
+	}
+}
+
+// =============================================================================
+// PagerDuty Provider Payload Helpers
+// =============================================================================
+
+// PagerDuty event severities.
+const (
+	PagerDutySeverityCritical = "critical"
+	PagerDutySeverityError    = "error"
+	PagerDutySeverityWarning  = "warning"
+	PagerDutySeverityInfo     = "info"
+)
+
+// NewPagerDutyTriggerPayload creates a payload that triggers a PagerDuty
+// incident. serviceID selects the routing key from the provider's
+// configured services; an empty serviceID uses the default service.
+func NewPagerDutyTriggerPayload(serviceID, summary, severity string) map[string]any {
+	p := map[string]any{
+		"event_action": "trigger",
+		"summary":      summary,
+		"severity":     severity,
+	}
+	if serviceID != "" {
+		p["service_id"] = serviceID
+	}
+	return p
+}
+
+// NewPagerDutyTriggerPayloadWithOptions creates a PagerDuty trigger
+// payload with optional fields. dedupKey groups the event with earlier
+// ones for the same incident; links and images are built with
+// PagerDutyLink and PagerDutyImage.
+func NewPagerDutyTriggerPayloadWithOptions(serviceID, summary, severity, source, component, group, class, dedupKey string, customDetails map[string]any, links, images []map[string]any) map[string]any {
+	p := NewPagerDutyTriggerPayload(serviceID, summary, severity)
+	if source != "" {
+		p["source"] = source
+	}
+	if component != "" {
+		p["component"] = component
+	}
+	if group != "" {
+		p["group"] = group
+	}
+	if class != "" {
+		p["class"] = class
+	}
+	if dedupKey != "" {
+		p["dedup_key"] = dedupKey
+	}
+	if len(customDetails) > 0 {
+		p["custom_details"] = customDetails
+	}
+	if len(links) > 0 {
+		p["links"] = links
+	}
+	if len(images) > 0 {
+		p["images"] = images
+	}
+	return p
+}
+
+// NewPagerDutyAcknowledgePayload creates a payload that acknowledges
+// the incident identified by dedupKey.
+func NewPagerDutyAcknowledgePayload(serviceID, dedupKey string) map[string]any {
+	return newPagerDutyDedupPayload("acknowledge", serviceID, dedupKey)
+}
+
+// NewPagerDutyResolvePayload creates a payload that resolves the
+// incident identified by dedupKey.
+func NewPagerDutyResolvePayload(serviceID, dedupKey string) map[string]any {
+	return newPagerDutyDedupPayload("resolve", serviceID, dedupKey)
+}
+
+func newPagerDutyDedupPayload(eventAction, serviceID, dedupKey string) map[string]any {
+	p := map[string]any{
+		"event_action": eventAction,
+		"dedup_key":    dedupKey,
+	}
+	if serviceID != "" {
+		p["service_id"] = serviceID
+	}
+	return p
+}
+
+// PagerDutyLink creates a link attached to a PagerDuty event.
+func PagerDutyLink(href, text string) map[string]any {
+	l := map[string]any{"href": href}
+	if text != "" {
+		l["text"] = text
+	}
+	return l
+}
+
+// PagerDutyImage creates an image attached to a PagerDuty event. href
+// optionally makes the image a link.
+func PagerDutyImage(src, href, alt string) map[string]any {
+	img := map[string]any{"src": src}
+	if href != "" {
+		img["href"] = href
+	}
+	if alt != "" {
+		img["alt"] = alt
+	}
+	return img
+}
+
+// =============================================================================
+// Command Execution Provider Payload Helpers
+// =============================================================================
+
+// NewCommandExecPayload creates a payload for the command execution
+// provider that runs command on host over SSH.
+func NewCommandExecPayload(host, command string) map[string]any {
+	return map[string]any{
+		"host":    host,
+		"command": command,
+	}
+}
+
+// NewCommandExecPayloadWithOptions creates a command execution payload
+// with optional fields. A zero timeout uses the provider default.
+func NewCommandExecPayloadWithOptions(host, command string, env map[string]string, timeout time.Duration, workingDir string, sudo bool) map[string]any {
+	p := NewCommandExecPayload(host, command)
+	if len(env) > 0 {
+		p["env"] = env
+	}
+	if timeout > 0 {
+		p["timeout_seconds"] = int64(timeout / time.Second)
+	}
+	if workingDir != "" {
+		p["working_dir"] = workingDir
+	}
+	if sudo {
+		p["sudo"] = true
+	}
+	return p
+}
+
+// CommandExecResult is the provider response body of a command
+// execution action.
+type CommandExecResult struct {
+	ExitCode   int    `json:"exit_code"`
+	Stdout     string `json:"stdout"`
+	Stderr     string `json:"stderr"`
+	DurationMs int64  `json:"duration_ms"`
+	TimedOut   bool   `json:"timed_out"`
+}
+
+// Succeeded reports whether the command exited zero within its timeout.
+func (r *CommandExecResult) Succeeded() bool {
+	return r.ExitCode == 0 && !r.TimedOut
+}
+
+// ParseCommandExecResult decodes the body of a command execution
+// provider response.
+func ParseCommandExecResult(resp *ProviderResponse) (*CommandExecResult, error) {
+	if resp == nil {
+		return nil, fmt.Errorf("decode command result: no provider response")
+	}
+	data, err := json.Marshal(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("decode command result: %w", err)
+	}
+	var result CommandExecResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("decode command result: %w", err)
+	}
+	return &result, nil
+}
+
+// =============================================================================
+// GitHub Provider Payload Helpers
+// =============================================================================
+
+// NewGitHubWorkflowDispatchPayload creates a payload for the GitHub
+// provider that triggers a workflow_dispatch run. repo is "owner/name";
+// workflow is the workflow file name or ID; ref is the branch or tag.
+func NewGitHubWorkflowDispatchPayload(repo, workflow, ref string, inputs map[string]string) map[string]any {
+	p := map[string]any{
+		"repo":     repo,
+		"workflow": workflow,
+		"ref":      ref,
+	}
+	if len(inputs) > 0 {
+		p["inputs"] = inputs
+	}
+	return p
+}
+
+// NewGitHubCreateIssuePayload creates a GitHub payload that opens an issue.
+func NewGitHubCreateIssuePayload(repo, title, body string) map[string]any {
+	p := map[string]any{
+		"repo":  repo,
+		"title": title,
+	}
+	if body != "" {
+		p["body"] = body
+	}
+	return p
+}
+
+// NewGitHubCreateIssuePayloadWithOptions creates a GitHub issue payload with optional fields.
+func NewGitHubCreateIssuePayloadWithOptions(repo, title, body string, labels, assignees []string) map[string]any {
+	p := NewGitHubCreateIssuePayload(repo, title, body)
+	if len(labels) > 0 {
+		p["labels"] = labels
+	}
+	if len(assignees) > 0 {
+		p["assignees"] = assignees
+	}
+	return p
+}
+
+// NewGitHubCommentPayload creates a GitHub payload that comments on an
+// issue or pull request. GitHub numbers both from the same sequence.
+func NewGitHubCommentPayload(repo string, number int, body string) map[string]any {
+	return map[string]any{
+		"repo":   repo,
+		"number": number,
+		"body":   body,
+	}
+}
+
+// =============================================================================
+// GitLab Provider Payload Helpers
+// =============================================================================
+
+// NewGitLabPipelineTriggerPayload creates a payload for the GitLab
+// provider that runs a pipeline. project is the numeric ID or the
+// "group/name" path; ref is the branch or tag.
+func NewGitLabPipelineTriggerPayload(project, ref string, variables map[string]string) map[string]any {
+	p := map[string]any{
+		"project": project,
+		"ref":     ref,
+	}
+	if len(variables) > 0 {
+		p["variables"] = variables
+	}
+	return p
+}
+
+// NewGitLabMergeRequestNotePayload creates a GitLab payload that adds a
+// note to a merge request, identified by its project-scoped IID.
+func NewGitLabMergeRequestNotePayload(project string, mergeRequestIID int, body string) map[string]any {
+	return map[string]any{
+		"project":           project,
+		"merge_request_iid": mergeRequestIID,
+		"body":              body,
+	}
+}
+
+// NewGitLabIssueNotePayload creates a GitLab payload that adds a note
+// to an issue, identified by its project-scoped IID.
+func NewGitLabIssueNotePayload(project string, issueIID int, body string) map[string]any {
+	return map[string]any{
+		"project":   project,
+		"issue_iid": issueIID,
+		"body":      body,
+	}
 }
 
 // =============================================================================
-// Provider Payload Helpers
+// Jira Provider Payload Helpers
 // =============================================================================
 
-// NewTwilioSmsPayload creates a payload for the Twilio SMS provider.
-func NewTwilioSmsPayload(to, body string) map[string]any {
+// JiraADFDocument creates an Atlassian Document Format document with
+// one plain-text paragraph per argument, for use as an issue
+// description or comment body.
+func JiraADFDocument(paragraphs ...string) map[string]any {
+	content := make([]map[string]any, len(paragraphs))
+	for i, text := range paragraphs {
+		para := map[string]any{"type": "paragraph"}
+		if text != "" {
+			para["content"] = []map[string]any{{"type": "text", "text": text}}
+		}
+		content[i] = para
+	}
 	return map[string]any{
-		"to":   to,
-		"body": body,
+		"type":    "doc",
+		"version": 1,
+		"content": content,
 	}
 }
 
-// NewTwilioSmsPayloadWithOptions creates a Twilio SMS payload with optional fields.
-func NewTwilioSmsPayloadWithOptions(to, body string, from string, mediaURL string) map[string]any {
-	p := NewTwilioSmsPayload(to, body)
-	if from != "" {
-		p["from"] = from
+// NewJiraCreateIssuePayload creates a payload for the Jira provider
+// that opens an issue. project is the project key and issueType the
+// issue type name, such as "Bug" or "Task".
+func NewJiraCreateIssuePayload(project, issueType, summary string) map[string]any {
+	return map[string]any{
+		"project":    project,
+		"issue_type": issueType,
+		"summary":    summary,
 	}
-	if mediaURL != "" {
-		p["media_url"] = mediaURL
+}
+
+// NewJiraCreateIssuePayloadWithOptions creates a Jira issue payload
+// with optional fields. description is an ADF document (see
+// JiraADFDocument). customFields is keyed by field ID, such as
+// "customfield_10010".
+func NewJiraCreateIssuePayloadWithOptions(project, issueType, summary string, description map[string]any, labels, components []string, priority string, customFields map[string]any) map[string]any {
+	p := NewJiraCreateIssuePayload(project, issueType, summary)
+	if description != nil {
+		p["description"] = description
+	}
+	if len(labels) > 0 {
+		p["labels"] = labels
+	}
+	if len(components) > 0 {
+		p["components"] = components
+	}
+	if priority != "" {
+		p["priority"] = priority
+	}
+	if len(customFields) > 0 {
+		p["custom_fields"] = customFields
 	}
 	return p
 }
 
-// NewTeamsMessagePayload creates a payload for the Microsoft Teams provider.
-func NewTeamsMessagePayload(text string) map[string]any {
+// NewJiraTransitionIssuePayload creates a payload that moves an issue
+// through a workflow transition, given by ID or name.
+func NewJiraTransitionIssuePayload(issueKey, transition string) map[string]any {
 	return map[string]any{
-		"text": text,
+		"issue_key":  issueKey,
+		"transition": transition,
 	}
 }
 
-// NewTeamsMessagePayloadWithOptions creates a Teams message payload with optional fields.
-func NewTeamsMessagePayloadWithOptions(text, title, themeColor string) map[string]any {
-	p := NewTeamsMessagePayload(text)
-	if title != "" {
-		p["title"] = title
+// NewJiraTransitionIssuePayloadWithOptions creates a transition payload
+// that also adds an ADF comment and sets fields on the transition
+// screen, such as "resolution".
+func NewJiraTransitionIssuePayloadWithOptions(issueKey, transition string, comment map[string]any, fields map[string]any) map[string]any {
+	p := NewJiraTransitionIssuePayload(issueKey, transition)
+	if comment != nil {
+		p["comment"] = comment
 	}
-	if themeColor != "" {
-		p["theme_color"] = themeColor
+	if len(fields) > 0 {
+		p["fields"] = fields
 	}
 	return p
 }
 
-// NewTeamsAdaptiveCardPayload creates a payload for Teams with an Adaptive Card.
-func NewTeamsAdaptiveCardPayload(card map[string]any) map[string]any {
+// NewJiraAddCommentPayload creates a payload that comments on an issue.
+// body is an ADF document (see JiraADFDocument).
+func NewJiraAddCommentPayload(issueKey string, body map[string]any) map[string]any {
 	return map[string]any{
-		"adaptive_card": card,
+		"issue_key": issueKey,
+		"body":      body,
 	}
 }
 
-// NewDiscordMessagePayload creates a payload for the Discord webhook provider.
-func NewDiscordMessagePayload(content string) map[string]any {
-	return map[string]any{
-		"content": content,
-	}
-}
+// =============================================================================
+// Datadog Provider Payload Helpers
+// =============================================================================
 
-// NewDiscordEmbedPayload creates a Discord payload with an embed.
-func NewDiscordEmbedPayload(embeds []map[string]any) map[string]any {
+// Datadog event alert types.
+const (
+	DatadogAlertError   = "error"
+	DatadogAlertWarning = "warning"
+	DatadogAlertInfo    = "info"
+	DatadogAlertSuccess = "success"
+)
+
+// Datadog metric types.
+const (
+	DatadogMetricGauge = "gauge"
+	DatadogMetricCount = "count"
+	DatadogMetricRate  = "rate"
+)
+
+// NewDatadogEventPayload creates a payload for the Datadog provider that
+// posts an event.
+func NewDatadogEventPayload(title, text string) map[string]any {
 	return map[string]any{
-		"embeds": embeds,
+		"title": title,
+		"text":  text,
 	}
 }
 
-// NewDiscordMessagePayloadWithOptions creates a Discord payload with all options.
-func NewDiscordMessagePayloadWithOptions(content, username, avatarURL string, embeds []map[string]any) map[string]any {
-	p := map[string]any{}
-	if content != "" {
-		p["content"] = content
+// NewDatadogEventPayloadWithOptions creates a Datadog event payload with
+// optional fields. alertType is one of the DatadogAlert constants and
+// priority is "normal" or "low". Events sharing an aggregationKey are
+// grouped in the event stream.
+func NewDatadogEventPayloadWithOptions(title, text, alertType, priority, host, aggregationKey string, tags []string) map[string]any {
+	p := NewDatadogEventPayload(title, text)
+	if alertType != "" {
+		p["alert_type"] = alertType
 	}
-	if username != "" {
-		p["username"] = username
+	if priority != "" {
+		p["priority"] = priority
 	}
-	if avatarURL != "" {
-		p["avatar_url"] = avatarURL
+	if host != "" {
+		p["host"] = host
 	}
-	if len(embeds) > 0 {
-		p["embeds"] = embeds
+	if aggregationKey != "" {
+		p["aggregation_key"] = aggregationKey
+	}
+	if len(tags) > 0 {
+		p["tags"] = tags
 	}
 	return p
 }
 
+// NewDatadogMetricPayload creates a payload for the Datadog provider
+// that submits custom metrics. Each series is built with
+// DatadogMetricSeries.
+func NewDatadogMetricPayload(series ...map[string]any) map[string]any {
+	return map[string]any{
+		"series": series,
+	}
+}
+
+// DatadogMetricSeries creates one metric series with a single point at
+// timestamp. metricType is one of the DatadogMetric constants.
+func DatadogMetricSeries(metric, metricType string, value float64, timestamp time.Time, tags []string) map[string]any {
+	s := map[string]any{
+		"metric": metric,
+		"type":   metricType,
+		"points": [][2]float64{{float64(timestamp.Unix()), value}},
+	}
+	if len(tags) > 0 {
+		s["tags"] = tags
+	}
+	return s
+}
+
 // =============================================================================
 // AWS Provider Payload Helpers
 // =============================================================================
 
 // NewSnsPublishPayload creates a payload for the AWS SNS provider.
 func NewSnsPublishPayload(message string) map[string]any {
-	return map[string]any{
-		"message": message,
-	}
+	return (&SnsPublishPayload{Message: message}).ToPayload()
 }
 
 // NewSnsPublishPayloadWithOptions creates an SNS payload with optional fields.
 func NewSnsPublishPayloadWithOptions(message, subject, topicArn, messageGroupID, messageDedupID string) map[string]any {
-	p := NewSnsPublishPayload(message)
-	if subject != "" {
-		p["subject"] = subject
-	}
-	if topicArn != "" {
-		p["topic_arn"] = topicArn
-	}
-	if messageGroupID != "" {
-		p["message_group_id"] = messageGroupID
-	}
-	if messageDedupID != "" {
-		p["message_dedup_id"] = messageDedupID
-	}
-	return p
+	return (&SnsPublishPayload{
+		Message:        message,
+		Subject:        subject,
+		TopicArn:       topicArn,
+		MessageGroupID: messageGroupID,
+		MessageDedupID: messageDedupID,
+	}).ToPayload()
 }
 
 // NewLambdaInvokePayload creates a payload for the AWS Lambda provider.
 func NewLambdaInvokePayload(payloadData any) map[string]any {
-	p := map[string]any{}
-	if payloadData != nil {
-		p["payload"] = payloadData
-	}
-	return p
+	return (&LambdaInvokePayload{Payload: payloadData}).ToPayload()
 }
 
 // NewLambdaInvokePayloadWithOptions creates a Lambda payload with optional fields.
 func NewLambdaInvokePayloadWithOptions(payloadData any, functionName, invocationType string) map[string]any {
-	p := NewLambdaInvokePayload(payloadData)
-	if functionName != "" {
-		p["function_name"] = functionName
-	}
-	if invocationType != "" {
-		p["invocation_type"] = invocationType
-	}
-	return p
+	return (&LambdaInvokePayload{
+		Payload:        payloadData,
+		FunctionName:   functionName,
+		InvocationType: invocationType,
+	}).ToPayload()
 }
 
 // NewEventBridgePutEventPayload creates a payload for the AWS EventBridge provider.
 func NewEventBridgePutEventPayload(source, detailType string, detail any) map[string]any {
-	return map[string]any{
-		"source":      source,
-		"detail_type": detailType,
-		"detail":      detail,
-	}
+	return (&EventBridgePutEventPayload{Source: source, DetailType: detailType, Detail: detail}).ToPayload()
 }
 
 // NewEventBridgePutEventPayloadWithOptions creates an EventBridge payload with optional fields.
 func NewEventBridgePutEventPayloadWithOptions(source, detailType string, detail any, eventBusName string, resources []string) map[string]any {
-	p := NewEventBridgePutEventPayload(source, detailType, detail)
-	if eventBusName != "" {
-		p["event_bus_name"] = eventBusName
-	}
-	if len(resources) > 0 {
-		p["resources"] = resources
-	}
-	return p
+	return (&EventBridgePutEventPayload{
+		Source:       source,
+		DetailType:   detailType,
+		Detail:       detail,
+		EventBusName: eventBusName,
+		Resources:    resources,
+	}).ToPayload()
 }
 
 // NewSqsSendMessagePayload creates a payload for the AWS SQS provider.
 func NewSqsSendMessagePayload(messageBody string) map[string]any {
-	return map[string]any{
-		"message_body": messageBody,
-	}
+	return (&SqsSendMessagePayload{MessageBody: messageBody}).ToPayload()
 }
 
 // NewSqsSendMessagePayloadWithOptions creates an SQS payload with optional fields.
 func NewSqsSendMessagePayloadWithOptions(messageBody, queueURL string, delaySeconds int, messageGroupID, messageDedupID string, messageAttributes map[string]string) map[string]any {
-	p := NewSqsSendMessagePayload(messageBody)
-	if queueURL != "" {
-		p["queue_url"] = queueURL
-	}
-	if delaySeconds > 0 {
-		p["delay_seconds"] = delaySeconds
-	}
-	if messageGroupID != "" {
-		p["message_group_id"] = messageGroupID
-	}
-	if messageDedupID != "" {
-		p["message_dedup_id"] = messageDedupID
-	}
-	if len(messageAttributes) > 0 {
-		p["message_attributes"] = messageAttributes
-	}
-	return p
+	return (&SqsSendMessagePayload{
+		MessageBody:       messageBody,
+		QueueURL:          queueURL,
+		DelaySeconds:      delaySeconds,
+		MessageGroupID:    messageGroupID,
+		MessageDedupID:    messageDedupID,
+		MessageAttributes: messageAttributes,
+	}).ToPayload()
 }
 
 // NewS3PutObjectPayload creates a payload for the AWS S3 put-object action.
 func NewS3PutObjectPayload(key, body string) map[string]any {
-	return map[string]any{
-		"key":  key,
-		"body": body,
-	}
+	return (&S3PutObjectPayload{Key: key, Body: body}).ToPayload()
 }
 
 // NewS3PutObjectPayloadWithOptions creates an S3 put-object payload with optional fields.
 func NewS3PutObjectPayloadWithOptions(key, bucket, body, bodyBase64, contentType string, metadata map[string]string) map[string]any {
-	p := map[string]any{"key": key}
-	if bucket != "" {
-		p["bucket"] = bucket
-	}
-	if body != "" {
-		p["body"] = body
-	}
-	if bodyBase64 != "" {
-		p["body_base64"] = bodyBase64
-	}
-	if contentType != "" {
-		p["content_type"] = contentType
-	}
-	if len(metadata) > 0 {
-		p["metadata"] = metadata
-	}
-	return p
+	return (&S3PutObjectPayload{
+		Key:         key,
+		Bucket:      bucket,
+		Body:        body,
+		BodyBase64:  bodyBase64,
+		ContentType: contentType,
+		Metadata:    metadata,
+	}).ToPayload()
 }
 
 // NewS3GetObjectPayload creates a payload for the AWS S3 get-object action.
 func NewS3GetObjectPayload(key string) map[string]any {
-	return map[string]any{
-		"key": key,
-	}
+	return (&S3GetObjectPayload{Key: key}).ToPayload()
 }
 
 // NewS3GetObjectPayloadWithBucket creates an S3 get-object payload with a bucket override.
 func NewS3GetObjectPayloadWithBucket(key, bucket string) map[string]any {
-	p := NewS3GetObjectPayload(key)
-	if bucket != "" {
-		p["bucket"] = bucket
-	}
-	return p
+	return (&S3GetObjectPayload{Key: key, Bucket: bucket}).ToPayload()
 }
 
 // NewS3DeleteObjectPayload creates a payload for the AWS S3 delete-object action.
 func NewS3DeleteObjectPayload(key string) map[string]any {
-	return map[string]any{
-		"key": key,
-	}
+	return (&S3DeleteObjectPayload{Key: key}).ToPayload()
 }
 
 // NewS3DeleteObjectPayloadWithBucket creates an S3 delete-object payload with a bucket override.
 func NewS3DeleteObjectPayloadWithBucket(key, bucket string) map[string]any {
-	p := NewS3DeleteObjectPayload(key)
-	if bucket != "" {
-		p["bucket"] = bucket
-	}
-	return p
+	return (&S3DeleteObjectPayload{Key: key, Bucket: bucket}).ToPayload()
 }
 
 // =============================================================================
@@ -1676,118 +3839,73 @@ func NewS3DeleteObjectPayloadWithBucket(key, bucket string) map[string]any {
 
 // NewEc2StartInstancesPayload creates a payload for the AWS EC2 start-instances action.
 func NewEc2StartInstancesPayload(instanceIDs []string) map[string]any {
-	return map[string]any{
-		"instance_ids": instanceIDs,
-	}
+	return (&Ec2StartInstancesPayload{InstanceIDs: instanceIDs}).ToPayload()
 }
 
 // NewEc2StopInstancesPayload creates a payload for the AWS EC2 stop-instances action.
 func NewEc2StopInstancesPayload(instanceIDs []string) map[string]any {
-	return map[string]any{
-		"instance_ids": instanceIDs,
-	}
+	return (&Ec2StopInstancesPayload{InstanceIDs: instanceIDs}).ToPayload()
 }
 
 // NewEc2StopInstancesPayloadWithOptions creates an EC2 stop-instances payload with optional fields.
 func NewEc2StopInstancesPayloadWithOptions(instanceIDs []string, hibernate, force bool) map[string]any {
-	p := NewEc2StopInstancesPayload(instanceIDs)
-	if hibernate {
-		p["hibernate"] = hibernate
-	}
-	if force {
-		p["force"] = force
-	}
-	return p
+	return (&Ec2StopInstancesPayload{InstanceIDs: instanceIDs, Hibernate: hibernate, Force: force}).ToPayload()
 }
 
 // NewEc2RebootInstancesPayload creates a payload for the AWS EC2 reboot-instances action.
 func NewEc2RebootInstancesPayload(instanceIDs []string) map[string]any {
-	return map[string]any{
-		"instance_ids": instanceIDs,
-	}
+	return (&Ec2RebootInstancesPayload{InstanceIDs: instanceIDs}).ToPayload()
 }
 
 // NewEc2TerminateInstancesPayload creates a payload for the AWS EC2 terminate-instances action.
 func NewEc2TerminateInstancesPayload(instanceIDs []string) map[string]any {
-	return map[string]any{
-		"instance_ids": instanceIDs,
-	}
+	return (&Ec2TerminateInstancesPayload{InstanceIDs: instanceIDs}).ToPayload()
 }
 
 // NewEc2HibernateInstancesPayload creates a payload for the AWS EC2 hibernate-instances action.
 func NewEc2HibernateInstancesPayload(instanceIDs []string) map[string]any {
-	return map[string]any{
-		"instance_ids": instanceIDs,
-	}
+	return (&Ec2HibernateInstancesPayload{InstanceIDs: instanceIDs}).ToPayload()
 }
 
 // NewEc2RunInstancesPayload creates a payload for the AWS EC2 run-instances action.
 func NewEc2RunInstancesPayload(imageID, instanceType string) map[string]any {
-	return map[string]any{
-		"image_id":      imageID,
-		"instance_type": instanceType,
-	}
+	return (&Ec2RunInstancesPayload{ImageID: imageID, InstanceType: instanceType}).ToPayload()
 }
 
 // NewEc2RunInstancesPayloadWithOptions creates an EC2 run-instances payload with optional fields.
 func NewEc2RunInstancesPayloadWithOptions(imageID, instanceType string, minCount, maxCount int, keyName, subnetID, userData, iamProfile string, sgIDs []string, tags map[string]string) map[string]any {
-	p := NewEc2RunInstancesPayload(imageID, instanceType)
-	if minCount > 0 {
-		p["min_count"] = minCount
-	}
-	if maxCount > 0 {
-		p["max_count"] = maxCount
-	}
-	if keyName != "" {
-		p["key_name"] = keyName
-	}
-	if subnetID != "" {
-		p["subnet_id"] = subnetID
-	}
-	if userData != "" {
-		p["user_data"] = userData
-	}
-	if iamProfile != "" {
-		p["iam_instance_profile"] = iamProfile
-	}
-	if len(sgIDs) > 0 {
-		p["security_group_ids"] = sgIDs
-	}
-	if len(tags) > 0 {
-		p["tags"] = tags
-	}
-	return p
+	return (&Ec2RunInstancesPayload{
+		ImageID:            imageID,
+		InstanceType:       instanceType,
+		MinCount:           minCount,
+		MaxCount:           maxCount,
+		KeyName:            keyName,
+		SubnetID:           subnetID,
+		UserData:           userData,
+		IamInstanceProfile: iamProfile,
+		SecurityGroupIDs:   sgIDs,
+		Tags:               tags,
+	}).ToPayload()
 }
 
 // NewEc2AttachVolumePayload creates a payload for the AWS EC2 attach-volume action.
 func NewEc2AttachVolumePayload(volumeID, instanceID, device string) map[string]any {
-	return map[string]any{
-		"volume_id":   volumeID,
-		"instance_id": instanceID,
-		"device":      device,
-	}
+	return (&Ec2AttachVolumePayload{VolumeID: volumeID, InstanceID: instanceID, Device: device}).ToPayload()
 }
 
 // NewEc2DetachVolumePayload creates a payload for the AWS EC2 detach-volume action.
 func NewEc2DetachVolumePayload(volumeID string) map[string]any {
-	return map[string]any{
-		"volume_id": volumeID,
-	}
+	return (&Ec2DetachVolumePayload{VolumeID: volumeID}).ToPayload()
 }
 
 // NewEc2DetachVolumePayloadWithOptions creates an EC2 detach-volume payload with optional fields.
 func NewEc2DetachVolumePayloadWithOptions(volumeID, instanceID, device string, force bool) map[string]any {
-	p := NewEc2DetachVolumePayload(volumeID)
-	if instanceID != "" {
-		p["instance_id"] = instanceID
-	}
-	if device != "" {
-		p["device"] = device
-	}
-	if force {
-		p["force"] = force
-	}
-	return p
+	return (&Ec2DetachVolumePayload{
+		VolumeID:   volumeID,
+		InstanceID: instanceID,
+		Device:     device,
+		Force:      force,
+	}).ToPayload()
 }
 
 // =============================================================================
@@ -1819,11 +3937,11 @@ type AnalyticsQuery struct {
 	// "daily", "weekly", "monthly".
 	Interval string
 
-	// From is an optional start of time range (RFC 3339 datetime string).
-	From string
+	// From is an optional start of the time range.
+	From *time.Time
 
-	// To is an optional end of time range (RFC 3339 datetime string).
-	To string
+	// To is an optional end of the time range.
+	To *time.Time
 
 	// GroupBy is an optional grouping dimension (e.g., "provider", "action_type",
 	// "outcome").
@@ -1835,14 +3953,14 @@ type AnalyticsQuery struct {
 
 // AnalyticsBucket is a single time bucket in an analytics response.
 type AnalyticsBucket struct {
-	Timestamp     string   `json:"timestamp"`
-	Count         int      `json:"count"`
-	Group         *string  `json:"group,omitempty"`
-	AvgDurationMs *float64 `json:"avg_duration_ms,omitempty"`
-	P50DurationMs *float64 `json:"p50_duration_ms,omitempty"`
-	P95DurationMs *float64 `json:"p95_duration_ms,omitempty"`
-	P99DurationMs *float64 `json:"p99_duration_ms,omitempty"`
-	ErrorRate     *float64 `json:"error_rate,omitempty"`
+	Timestamp     time.Time `json:"timestamp"`
+	Count         int       `json:"count"`
+	Group         *string   `json:"group,omitempty"`
+	AvgDurationMs *float64  `json:"avg_duration_ms,omitempty"`
+	P50DurationMs *float64  `json:"p50_duration_ms,omitempty"`
+	P95DurationMs *float64  `json:"p95_duration_ms,omitempty"`
+	P99DurationMs *float64  `json:"p99_duration_ms,omitempty"`
+	ErrorRate     *float64  `json:"error_rate,omitempty"`
 }
 
 // AnalyticsTopEntry is a single entry in a top-N analytics result.
@@ -1856,8 +3974,8 @@ type AnalyticsTopEntry struct {
 type AnalyticsResponse struct {
 	Metric     string              `json:"metric"`
 	Interval   string              `json:"interval"`
-	From       string              `json:"from"`
-	To         string              `json:"to"`
+	From       time.Time           `json:"from"`
+	To         time.Time           `json:"to"`
 	Buckets    []AnalyticsBucket   `json:"buckets"`
 	TopEntries []AnalyticsTopEntry `json:"top_entries"`
 	TotalCount int                 `json:"total_count"`
@@ -1865,11 +3983,7 @@ type AnalyticsResponse struct {
 
 // NewEc2DescribeInstancesPayload creates a payload for the AWS EC2 describe-instances action.
 func NewEc2DescribeInstancesPayload(instanceIDs []string) map[string]any {
-	p := map[string]any{}
-	if len(instanceIDs) > 0 {
-		p["instance_ids"] = instanceIDs
-	}
-	return p
+	return (&Ec2DescribeInstancesPayload{InstanceIDs: instanceIDs}).ToPayload()
 }
 
 // =============================================================================
@@ -1878,59 +3992,58 @@ func NewEc2DescribeInstancesPayload(instanceIDs []string) map[string]any {
 
 // NewAsgDescribeGroupsPayload creates a payload for the AWS Auto Scaling describe-groups action.
 func NewAsgDescribeGroupsPayload(groupNames []string) map[string]any {
-	p := map[string]any{}
-	if len(groupNames) > 0 {
-		p["auto_scaling_group_names"] = groupNames
-	}
-	return p
+	return (&AsgDescribeGroupsPayload{GroupNames: groupNames}).ToPayload()
 }
 
 // NewAsgSetDesiredCapacityPayload creates a payload for the AWS Auto Scaling set-desired-capacity action.
 func NewAsgSetDesiredCapacityPayload(groupName string, desiredCapacity int) map[string]any {
-	return map[string]any{
-		"auto_scaling_group_name": groupName,
-		"desired_capacity":       desiredCapacity,
-	}
+	return (&AsgSetDesiredCapacityPayload{GroupName: groupName, DesiredCapacity: desiredCapacity}).ToPayload()
 }
 
 // NewAsgSetDesiredCapacityPayloadWithOptions creates an Auto Scaling set-desired-capacity payload with optional fields.
 func NewAsgSetDesiredCapacityPayloadWithOptions(groupName string, desiredCapacity int, honorCooldown bool) map[string]any {
-	p := NewAsgSetDesiredCapacityPayload(groupName, desiredCapacity)
-	if honorCooldown {
-		p["honor_cooldown"] = honorCooldown
-	}
-	return p
+	return (&AsgSetDesiredCapacityPayload{
+		GroupName:       groupName,
+		DesiredCapacity: desiredCapacity,
+		HonorCooldown:   honorCooldown,
+	}).ToPayload()
 }
 
 // NewAsgUpdateGroupPayload creates a payload for the AWS Auto Scaling update-group action.
 func NewAsgUpdateGroupPayload(groupName string) map[string]any {
-	return map[string]any{
-		"auto_scaling_group_name": groupName,
-	}
+	return (&AsgUpdateGroupPayload{GroupName: groupName}).ToPayload()
 }
 
 // NewAsgUpdateGroupPayloadWithOptions creates an Auto Scaling update-group payload with optional fields.
 func NewAsgUpdateGroupPayloadWithOptions(groupName string, minSize, maxSize, desiredCapacity, defaultCooldown *int, healthCheckType string, healthCheckGracePeriod *int) map[string]any {
-	p := NewAsgUpdateGroupPayload(groupName)
-	if minSize != nil {
-		p["min_size"] = *minSize
-	}
-	if maxSize != nil {
-		p["max_size"] = *maxSize
-	}
-	if desiredCapacity != nil {
-		p["desired_capacity"] = *desiredCapacity
-	}
-	if defaultCooldown != nil {
-		p["default_cooldown"] = *defaultCooldown
-	}
-	if healthCheckType != "" {
-		p["health_check_type"] = healthCheckType
-	}
-	if healthCheckGracePeriod != nil {
-		p["health_check_grace_period"] = *healthCheckGracePeriod
-	}
-	return p
+	return (&AsgUpdateGroupPayload{
+		GroupName:              groupName,
+		MinSize:                minSize,
+		MaxSize:                maxSize,
+		DesiredCapacity:        desiredCapacity,
+		DefaultCooldown:        defaultCooldown,
+		HealthCheckType:        healthCheckType,
+		HealthCheckGracePeriod: healthCheckGracePeriod,
+	}).ToPayload()
+}
+
+// =============================================================================
+// AWS Step Functions, ECS, and CloudWatch Provider Payload Helpers
+// =============================================================================
+
+// NewSfnStartExecutionPayload creates a payload for the AWS Step Functions start-execution action.
+func NewSfnStartExecutionPayload(stateMachineArn string, input any) map[string]any {
+	return (&SfnStartExecutionPayload{StateMachineArn: stateMachineArn, Input: input}).ToPayload()
+}
+
+// NewEcsRunTaskPayload creates a payload for the AWS ECS run-task action.
+func NewEcsRunTaskPayload(cluster, taskDefinition string, overrides map[string]any) map[string]any {
+	return (&EcsRunTaskPayload{Cluster: cluster, TaskDefinition: taskDefinition, Overrides: overrides}).ToPayload()
+}
+
+// NewCloudWatchPutMetricPayload creates a payload for the AWS CloudWatch put-metric-data action.
+func NewCloudWatchPutMetricPayload(namespace string, metricData []CloudWatchMetricDatum) map[string]any {
+	return (&CloudWatchPutMetricPayload{Namespace: namespace, MetricData: metricData}).ToPayload()
 }
 
 // =============================================================================
@@ -2066,6 +4179,52 @@ func NewGcpPubSubPublishPayloadWithOptions(data, topic, orderingKey string, attr
 	return p
 }
 
+// NewGcpPubSubPublishBinaryPayload creates a GCP Pub/Sub publish payload
+// for binary data, which is sent base64-encoded as `data_base64`.
+func NewGcpPubSubPublishBinaryPayload(data []byte) map[string]any {
+	return map[string]any{
+		"data_base64": base64.StdEncoding.EncodeToString(data),
+	}
+}
+
+// NewGcpPubSubPublishBinaryPayloadWithOptions creates a binary GCP Pub/Sub publish payload with optional fields.
+func NewGcpPubSubPublishBinaryPayloadWithOptions(data []byte, topic, orderingKey string, attributes map[string]string) map[string]any {
+	p := NewGcpPubSubPublishBinaryPayload(data)
+	if topic != "" {
+		p["topic"] = topic
+	}
+	if orderingKey != "" {
+		p["ordering_key"] = orderingKey
+	}
+	if len(attributes) > 0 {
+		p["attributes"] = attributes
+	}
+	return p
+}
+
+// NewGcpPubSubBatchMessage creates one message for a publish-batch payload.
+func NewGcpPubSubBatchMessage(data, orderingKey string, attributes map[string]string) map[string]any {
+	m := map[string]any{
+		"data": data,
+	}
+	if orderingKey != "" {
+		m["ordering_key"] = orderingKey
+	}
+	if len(attributes) > 0 {
+		m["attributes"] = attributes
+	}
+	return m
+}
+
+// NewGcpPubSubBatchBinaryMessage creates one base64-encoded binary
+// message for a publish-batch payload.
+func NewGcpPubSubBatchBinaryMessage(data []byte, orderingKey string, attributes map[string]string) map[string]any {
+	m := NewGcpPubSubBatchMessage("", orderingKey, attributes)
+	delete(m, "data")
+	m["data_base64"] = base64.StdEncoding.EncodeToString(data)
+	return m
+}
+
 // NewGcpPubSubPublishBatchPayload creates a payload for the GCP Pub/Sub publish-batch action.
 func NewGcpPubSubPublishBatchPayload(messages []map[string]any) map[string]any {
 	return map[string]any{
@@ -2214,6 +4373,129 @@ type SigningKeysResponse struct {
 	Count int `json:"count"`
 }
 
+// Server features reported in ServerInfo.Features.
+const (
+	FeatureDLQ          = "dlq"
+	FeatureLLMGuardrail = "llm_guardrail"
+	FeatureWASM         = "wasm"
+	FeatureAtomicBatch  = "atomic_batch"
+)
+
+// ServerInfo describes the build and configuration of a gateway, as
+// returned by GET /v1/info.
+type ServerInfo struct {
+	Version string `json:"version"`
+	GitSHA  string `json:"git_sha"`
+	// Features lists the optional subsystems enabled on this server,
+	// such as FeatureDLQ.
+	Features []string `json:"features"`
+	// StateBackend and AuditBackend name the storage backends, e.g.
+	// "redis" or "postgres". AuditBackend is empty when audit is off.
+	StateBackend string     `json:"state_backend"`
+	AuditBackend string     `json:"audit_backend,omitempty"`
+	BuiltAt      *time.Time `json:"built_at,omitempty"`
+}
+
+// HasFeature reports whether the server has the named feature enabled.
+func (s *ServerInfo) HasFeature(name string) bool {
+	for _, f := range s.Features {
+		if f == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Health states reported in HealthDetail.Status and
+// DependencyHealth.Status.
+const (
+	HealthOK       = "ok"
+	HealthDegraded = "degraded"
+	HealthDown     = "down"
+)
+
+// Dependency kinds reported in DependencyHealth.Kind.
+const (
+	DependencyStateStore = "state_store"
+	DependencyAuditStore = "audit_store"
+	DependencyProvider   = "provider"
+	DependencyProcessor  = "processor"
+)
+
+// DependencyHealth is the status of one thing the gateway depends on:
+// a store, a provider, or a background processor such as the
+// scheduler or the retention reaper.
+type DependencyHealth struct {
+	Name   string `json:"name"`
+	Kind   string `json:"kind"`
+	Status string `json:"status"`
+	// LatencyMs is the duration of the last probe, when one was made.
+	LatencyMs *int64 `json:"latency_ms,omitempty"`
+	// Message explains a status other than HealthOK.
+	Message string `json:"message,omitempty"`
+}
+
+// HealthDetail is the gateway's health broken down by dependency, as
+// returned by GET /health/detail. Status is HealthDown when any
+// required dependency is down and HealthDegraded when only optional
+// ones (e.g. a single provider) are.
+type HealthDetail struct {
+	Status       string             `json:"status"`
+	Version      string             `json:"version"`
+	Dependencies []DependencyHealth `json:"dependencies"`
+	CheckedAt    time.Time          `json:"checked_at"`
+}
+
+// Healthy reports whether Status is HealthOK.
+func (h *HealthDetail) Healthy() bool {
+	return h.Status == HealthOK
+}
+
+// Unhealthy returns the dependencies whose status is not HealthOK.
+func (h *HealthDetail) Unhealthy() []DependencyHealth {
+	var out []DependencyHealth
+	for _, d := range h.Dependencies {
+		if d.Status != HealthOK {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+// Readiness checks reported in ReadinessCheck.Name.
+const (
+	ReadinessRulesLoaded       = "rules_loaded"
+	ReadinessStoresReachable   = "stores_reachable"
+	ReadinessMigrationsApplied = "migrations_applied"
+)
+
+// ReadinessCheck is one condition the gateway requires before it
+// accepts traffic.
+type ReadinessCheck struct {
+	Name    string `json:"name"`
+	Ready   bool   `json:"ready"`
+	Message string `json:"message,omitempty"`
+}
+
+// Readiness is the gateway's answer to GET /ready. Unlike Health,
+// which only says the process is up, Ready is true only once rules
+// are loaded, stores are reachable, and migrations are applied.
+type Readiness struct {
+	Ready  bool             `json:"ready"`
+	Checks []ReadinessCheck `json:"checks"`
+}
+
+// Failing returns the checks that are not ready.
+func (r *Readiness) Failing() []ReadinessCheck {
+	var out []ReadinessCheck
+	for _, c := range r.Checks {
+		if !c.Ready {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
 // -----------------------------------------------------------------------
 // Swarm runs
 // -----------------------------------------------------------------------
@@ -2224,8 +4506,8 @@ type SwarmRunSnapshot struct {
 	PlanID     string         `json:"plan_id"`
 	Objective  string         `json:"objective"`
 	Status     string         `json:"status"`
-	StartedAt  string         `json:"started_at"`
-	FinishedAt *string        `json:"finished_at,omitempty"`
+	StartedAt  time.Time      `json:"started_at"`
+	FinishedAt *time.Time     `json:"finished_at,omitempty"`
 	Metrics    map[string]any `json:"metrics,omitempty"`
 	Error      *string        `json:"error,omitempty"`
 	Namespace  string         `json:"namespace"`
@@ -2,7 +2,10 @@ package acteon
 
 import (
 	"encoding/json"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestSilenceRoundTrip(t *testing.T) {
@@ -335,8 +338,8 @@ func TestWasmPlugin(t *testing.T) {
 		Config: &WasmPluginConfig{
 			MemoryLimitBytes: &memLimit,
 		},
-		CreatedAt:       "2026-02-15T00:00:00Z",
-		UpdatedAt:       "2026-02-15T01:00:00Z",
+		CreatedAt:       time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:       time.Date(2026, 2, 15, 1, 0, 0, 0, time.UTC),
 		InvocationCount: 42,
 	}
 
@@ -364,8 +367,8 @@ func TestWasmPluginMinimal(t *testing.T) {
 	plugin := WasmPlugin{
 		Name:      "minimal-plugin",
 		Status:    "active",
-		CreatedAt: "2026-02-15T00:00:00Z",
-		UpdatedAt: "2026-02-15T00:00:00Z",
+		CreatedAt: time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC),
 	}
 
 	if plugin.Name != "minimal-plugin" {
@@ -408,10 +411,11 @@ func TestRegisterPluginRequest(t *testing.T) {
 }
 
 func TestListPluginsResponse(t *testing.T) {
+	ts := time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC)
 	response := ListPluginsResponse{
 		Plugins: []WasmPlugin{
-			{Name: "plugin-a", Status: "active", Enabled: true, CreatedAt: "2026-02-15T00:00:00Z", UpdatedAt: "2026-02-15T00:00:00Z"},
-			{Name: "plugin-b", Status: "disabled", Enabled: false, CreatedAt: "2026-02-15T00:00:00Z", UpdatedAt: "2026-02-15T00:00:00Z"},
+			{Name: "plugin-a", Status: "active", Enabled: true, CreatedAt: ts, UpdatedAt: ts},
+			{Name: "plugin-b", Status: "disabled", Enabled: false, CreatedAt: ts, UpdatedAt: ts},
 		},
 		Count: 2,
 	}
@@ -832,3 +836,428 @@ func TestSigningKeysResponseRejectsMalformedJSON(t *testing.T) {
 		t.Fatalf("expected unmarshal error on HTML body, got nil")
 	}
 }
+
+func TestProfileFieldConstructors(t *testing.T) {
+	inline := InlineField(`say "hi" {{ name }}`)
+	if string(inline) != `"say \"hi\" {{ name }}"` {
+		t.Errorf("InlineField = %s", inline)
+	}
+	ref := RefField("body")
+	if string(ref) != `{"$ref":"body"}` {
+		t.Errorf("RefField = %s", ref)
+	}
+	req := CreateProfileRequest{Name: "p", Fields: map[string]TemplateProfileField{"a": inline, "b": ref}}
+	data, err := json.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !json.Valid(data) {
+		t.Errorf("invalid JSON: %s", data)
+	}
+}
+
+func TestNewSlackMessagePayload(t *testing.T) {
+	p := NewSlackMessagePayload("", "hello")
+	if p["text"] != "hello" {
+		t.Errorf("expected text hello, got %v", p["text"])
+	}
+	if _, ok := p["channel"]; ok {
+		t.Error("expected no channel when empty")
+	}
+}
+
+func TestNewSlackMessagePayloadWithOptions(t *testing.T) {
+	blocks := []map[string]any{
+		SlackHeaderBlock("Deploy"),
+		SlackSectionFieldsBlock("*Env*\nprod", "*Version*\n1.2.3"),
+		SlackActionsBlock(SlackButtonWithOptions("Roll back", "rollback", "v1.2.2", "danger", "")),
+		SlackContextBlock("by acteon"),
+	}
+	p := NewSlackMessagePayloadWithOptions("#deploys", "Deployed 1.2.3", "1712345678.000100", nil,
+		[]map[string]any{SlackAttachment(SlackColorGood, blocks)})
+	if p["thread_ts"] != "1712345678.000100" {
+		t.Errorf("expected thread_ts, got %v", p["thread_ts"])
+	}
+	if _, ok := p["blocks"]; ok {
+		t.Error("expected no blocks when nil")
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`"color":"good"`,
+		`{"text":{"text":"Deploy","type":"plain_text"},"type":"header"}`,
+		`"fields":[{"text":"*Env*\nprod","type":"mrkdwn"}`,
+		`"style":"danger"`,
+		`"action_id":"rollback"`,
+	} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("payload missing %s\n%s", want, data)
+		}
+	}
+}
+
+func TestNewPagerDutyTriggerPayloadWithOptions(t *testing.T) {
+	p := NewPagerDutyTriggerPayloadWithOptions(
+		"SVC_A", "Disk full on db-1", PagerDutySeverityCritical,
+		"db-1", "postgres", "storage", "disk", "disk-db-1",
+		map[string]any{"usage": 97},
+		[]map[string]any{PagerDutyLink("https://runbooks/disk", "Runbook")},
+		[]map[string]any{PagerDutyImage("https://graphs/disk.png", "", "usage")},
+	)
+	if p["event_action"] != "trigger" || p["service_id"] != "SVC_A" || p["dedup_key"] != "disk-db-1" {
+		t.Errorf("unexpected payload: %v", p)
+	}
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`"links":[{"href":"https://runbooks/disk","text":"Runbook"}]`,
+		`"images":[{"alt":"usage","src":"https://graphs/disk.png"}]`,
+		`"custom_details":{"usage":97}`,
+	} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("payload missing %s\n%s", want, data)
+		}
+	}
+}
+
+func TestNewPagerDutyResolvePayload(t *testing.T) {
+	p := NewPagerDutyResolvePayload("", "disk-db-1")
+	if p["event_action"] != "resolve" || p["dedup_key"] != "disk-db-1" {
+		t.Errorf("unexpected payload: %v", p)
+	}
+	if _, ok := p["service_id"]; ok {
+		t.Error("expected no service_id when empty")
+	}
+}
+
+func TestNewJiraCreateIssuePayloadWithOptions(t *testing.T) {
+	p := NewJiraCreateIssuePayloadWithOptions("OPS", "Bug", "Disk full",
+		JiraADFDocument("db-1 at 97%", ""), []string{"disk"}, nil, "High",
+		map[string]any{"customfield_10010": "sev1"})
+	if p["project"] != "OPS" || p["issue_type"] != "Bug" || p["priority"] != "High" {
+		t.Errorf("unexpected payload: %v", p)
+	}
+	if _, ok := p["components"]; ok {
+		t.Error("expected no components when nil")
+	}
+	data, err := json.Marshal(p["description"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"content":[{"content":[{"text":"db-1 at 97%","type":"text"}],"type":"paragraph"},{"type":"paragraph"}],"type":"doc","version":1}`
+	if string(data) != want {
+		t.Errorf("description = %s", data)
+	}
+}
+
+func TestNewJiraTransitionIssuePayloadWithOptions(t *testing.T) {
+	p := NewJiraTransitionIssuePayloadWithOptions("OPS-12", "Done", JiraADFDocument("fixed"), map[string]any{"resolution": map[string]any{"name": "Fixed"}})
+	if p["issue_key"] != "OPS-12" || p["transition"] != "Done" || p["comment"] == nil || p["fields"] == nil {
+		t.Errorf("unexpected payload: %v", p)
+	}
+}
+
+func TestNewGcpPubSubPublishBinaryPayloadWithOptions(t *testing.T) {
+	p := NewGcpPubSubPublishBinaryPayloadWithOptions([]byte{0xde, 0xad, 0xbe, 0xef}, "events", "order-1", map[string]string{"kind": "raw"})
+	if p["data_base64"] != "3q2+7w==" {
+		t.Errorf("expected data_base64 3q2+7w==, got %v", p["data_base64"])
+	}
+	if _, ok := p["data"]; ok {
+		t.Error("expected no data alongside data_base64")
+	}
+	if p["topic"] != "events" || p["ordering_key"] != "order-1" {
+		t.Errorf("unexpected payload: %v", p)
+	}
+}
+
+func TestNewGcpPubSubBatchMessages(t *testing.T) {
+	p := NewGcpPubSubPublishBatchPayload([]map[string]any{
+		NewGcpPubSubBatchMessage("hello", "", nil),
+		NewGcpPubSubBatchBinaryMessage([]byte("hi"), "k", nil),
+	})
+	msgs := p["messages"].([]map[string]any)
+	if msgs[0]["data"] != "hello" || msgs[1]["data_base64"] != "aGk=" || msgs[1]["ordering_key"] != "k" {
+		t.Errorf("unexpected messages: %v", msgs)
+	}
+	if _, ok := msgs[1]["data"]; ok {
+		t.Error("binary message should not carry data")
+	}
+}
+
+func TestNewGoogleChatMessagePayloadWithOptions(t *testing.T) {
+	card := GoogleChatCard("deploy",
+		GoogleChatCardHeader("Deploy finished", "prod", ""),
+		GoogleChatSection("", GoogleChatTextWidget("<b>1.2.3</b> is live"),
+			GoogleChatButtonsWidget(GoogleChatButton("Open", "https://ci/run/1"))),
+	)
+	p := NewGoogleChatMessagePayloadWithOptions("", "deploys-2026-10", []map[string]any{card})
+	if p["thread_key"] != "deploys-2026-10" {
+		t.Errorf("expected thread_key, got %v", p["thread_key"])
+	}
+	if _, ok := p["text"]; ok {
+		t.Error("expected no text when empty")
+	}
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`"cardId":"deploy"`,
+		`"header":{"subtitle":"prod","title":"Deploy finished"}`,
+		`{"textParagraph":{"text":"\u003cb\u003e1.2.3\u003c/b\u003e is live"}}`,
+		`"onClick":{"openLink":{"url":"https://ci/run/1"}}`,
+	} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("payload missing %s\n%s", want, data)
+		}
+	}
+}
+
+func TestNewDatadogEventPayloadWithOptions(t *testing.T) {
+	p := NewDatadogEventPayloadWithOptions("Deploy", "1.2.3 to prod", DatadogAlertSuccess, "", "ci-1", "deploy-prod", []string{"env:prod"})
+	if p["alert_type"] != "success" || p["aggregation_key"] != "deploy-prod" || p["host"] != "ci-1" {
+		t.Errorf("unexpected payload: %v", p)
+	}
+	if _, ok := p["priority"]; ok {
+		t.Error("expected no priority when empty")
+	}
+}
+
+func TestNewDatadogMetricPayload(t *testing.T) {
+	ts := time.Unix(1_700_000_000, 0)
+	p := NewDatadogMetricPayload(DatadogMetricSeries("acteon.queue.depth", DatadogMetricGauge, 42, ts, []string{"queue:email"}))
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"series":[{"metric":"acteon.queue.depth","points":[[1700000000,42]],"tags":["queue:email"],"type":"gauge"}]}`
+	if string(data) != want {
+		t.Errorf("payload = %s", data)
+	}
+}
+
+func TestNewWhatsAppMessagePayloadWithOptions(t *testing.T) {
+	p := NewWhatsAppMessagePayloadWithOptions("+15551234567", "hi", "whatsapp:+15557654321", "https://img/1.png")
+	if p["to"] != "whatsapp:+15551234567" || p["from"] != "whatsapp:+15557654321" || p["media_url"] != "https://img/1.png" {
+		t.Errorf("unexpected payload: %v", p)
+	}
+}
+
+func TestNewWhatsAppTemplatePayload(t *testing.T) {
+	p := NewWhatsAppTemplatePayload("+15551234567", "HX123", map[string]string{"1": "Ada", "2": "10:00"})
+	if p["content_sid"] != "HX123" || p["content_variables"] != `{"1":"Ada","2":"10:00"}` {
+		t.Errorf("unexpected payload: %v", p)
+	}
+	if _, ok := p["body"]; ok {
+		t.Error("template payload should not carry a body")
+	}
+}
+
+func TestNewGitHubWorkflowDispatchPayload(t *testing.T) {
+	p := NewGitHubWorkflowDispatchPayload("acme/api", "deploy.yml", "main", map[string]string{"env": "prod"})
+	if p["repo"] != "acme/api" || p["workflow"] != "deploy.yml" || p["ref"] != "main" {
+		t.Errorf("unexpected payload: %v", p)
+	}
+	if p["inputs"].(map[string]string)["env"] != "prod" {
+		t.Errorf("expected inputs env=prod, got %v", p["inputs"])
+	}
+	if _, ok := NewGitHubWorkflowDispatchPayload("a/b", "w", "main", nil)["inputs"]; ok {
+		t.Error("expected no inputs when nil")
+	}
+}
+
+func TestNewGitHubIssueAndCommentPayloads(t *testing.T) {
+	p := NewGitHubCreateIssuePayloadWithOptions("acme/api", "Flaky test", "", []string{"ci"}, nil)
+	if _, ok := p["body"]; ok {
+		t.Error("expected no body when empty")
+	}
+	if _, ok := p["assignees"]; ok {
+		t.Error("expected no assignees when nil")
+	}
+	c := NewGitHubCommentPayload("acme/api", 42, "LGTM")
+	if c["number"] != 42 || c["body"] != "LGTM" {
+		t.Errorf("unexpected comment payload: %v", c)
+	}
+}
+
+func TestNewGitLabPipelineTriggerPayload(t *testing.T) {
+	p := NewGitLabPipelineTriggerPayload("acme/api", "main", map[string]string{"DEPLOY_ENV": "prod"})
+	if p["project"] != "acme/api" || p["ref"] != "main" {
+		t.Errorf("unexpected payload: %v", p)
+	}
+	if p["variables"].(map[string]string)["DEPLOY_ENV"] != "prod" {
+		t.Errorf("expected variables DEPLOY_ENV=prod, got %v", p["variables"])
+	}
+}
+
+func TestNewGitLabMergeRequestNotePayload(t *testing.T) {
+	p := NewGitLabMergeRequestNotePayload("42", 7, "Pipeline passed")
+	if p["merge_request_iid"] != 7 || p["body"] != "Pipeline passed" {
+		t.Errorf("unexpected payload: %v", p)
+	}
+}
+
+func TestNewCommandExecPayloadWithOptions(t *testing.T) {
+	p := NewCommandExecPayloadWithOptions("web-1", "systemctl restart nginx", map[string]string{"LANG": "C"}, 90*time.Second, "/srv", true)
+	if p["host"] != "web-1" || p["timeout_seconds"] != int64(90) || p["working_dir"] != "/srv" || p["sudo"] != true {
+		t.Errorf("unexpected payload: %v", p)
+	}
+	if _, ok := NewCommandExecPayloadWithOptions("h", "c", nil, 0, "", false)["sudo"]; ok {
+		t.Error("expected no sudo when false")
+	}
+}
+
+func TestParseCommandExecResult(t *testing.T) {
+	resp := &ProviderResponse{Status: "success", Body: map[string]any{
+		"exit_code": float64(3), "stdout": "", "stderr": "unit not found", "duration_ms": float64(12),
+	}}
+	r, err := ParseCommandExecResult(resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.ExitCode != 3 || r.Stderr != "unit not found" || r.Succeeded() {
+		t.Errorf("unexpected result: %+v", r)
+	}
+	if _, err := ParseCommandExecResult(nil); err == nil {
+		t.Error("expected error for nil response")
+	}
+}
+
+func TestActionWithFallbackProviders(t *testing.T) {
+	a := NewAction("ns", "t", "slack", "post", map[string]any{}).WithFallbackProviders([]string{"teams", "email"})
+	data, err := json.Marshal(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"fallback_providers":["teams","email"]`) {
+		t.Errorf("action JSON = %s", data)
+	}
+	data, _ = json.Marshal(NewAction("ns", "t", "slack", "post", nil))
+	if strings.Contains(string(data), "fallback_providers") {
+		t.Errorf("expected no fallback_providers when unset: %s", data)
+	}
+}
+
+func TestActionOutcomeReroutedToFallback(t *testing.T) {
+	var o ActionOutcome
+	body := `{"Rerouted":{"original_provider":"slack","new_provider":"teams","reason":"circuit_open","response":{"status":"success","body":{}}}}`
+	if err := json.Unmarshal([]byte(body), &o); err != nil {
+		t.Fatal(err)
+	}
+	if !o.IsRerouted() || o.NewProvider != "teams" || o.RerouteReason != "circuit_open" {
+		t.Errorf("outcome = %+v", o)
+	}
+}
+
+func TestTimestampFieldsDecodeServerFormats(t *testing.T) {
+	// chrono's to_rfc3339 writes "+00:00"; serde writes "Z" with
+	// fractional seconds. Both must decode.
+	body := `{"token":"tok","status":"pending","rule":"r",
+		"created_at":"2026-02-15T10:00:00+00:00",
+		"expires_at":"2026-02-15T11:30:00.123456789Z"}`
+	var s ApprovalStatus
+	if err := json.Unmarshal([]byte(body), &s); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.ExpiresAt.Sub(s.CreatedAt); got != 90*time.Minute+123456789*time.Nanosecond {
+		t.Errorf("ExpiresAt - CreatedAt = %v", got)
+	}
+	if s.DecidedAt != nil {
+		t.Errorf("DecidedAt = %v, want nil", s.DecidedAt)
+	}
+
+	policy := RetentionPolicy{AuditTTLSeconds: 86400}
+	if policy.AuditTTL() != 24*time.Hour || policy.StateTTL() != 0 {
+		t.Errorf("AuditTTL = %v, StateTTL = %v", policy.AuditTTL(), policy.StateTTL())
+	}
+}
+
+func TestStatusEnumsIsValid(t *testing.T) {
+	var detail ChainDetailResponse
+	body := `{"chain_id":"c","chain_name":"n","status":"waiting_signal","current_step":0,"total_steps":1,
+		"steps":[{"name":"s","provider":"p","status":"awaiting_input"}],
+		"started_at":"2026-02-15T00:00:00Z","updated_at":"2026-02-15T00:00:00Z"}`
+	if err := json.Unmarshal([]byte(body), &detail); err != nil {
+		t.Fatal(err)
+	}
+	if detail.Status != ChainStatusWaitingSignal || !detail.Status.IsValid() || detail.Status.IsTerminal() {
+		t.Errorf("chain status = %q", detail.Status)
+	}
+	if detail.Steps[0].Status != StepStatusAwaitingInput || !detail.Steps[0].Status.IsValid() {
+		t.Errorf("step status = %q", detail.Steps[0].Status)
+	}
+
+	for _, unknown := range []interface{ IsValid() bool }{
+		ChainStatus("paused"), StepStatus("queued"), ApprovalState("vetoed"),
+		RecurringStatus("disabled"), GroupState("flushed"), CircuitState("tripped"),
+	} {
+		if unknown.IsValid() {
+			t.Errorf("%v should not be valid", unknown)
+		}
+	}
+	if !ApprovalExpired.IsValid() || !RecurringPaused.IsValid() || !GroupNotified.IsValid() || !CircuitHalfOpen.IsValid() {
+		t.Error("known states should be valid")
+	}
+}
+
+func TestActionOutcomeMarshalRoundTrip(t *testing.T) {
+	rule := "block-spam"
+	outcomes := []ActionOutcome{
+		{Type: OutcomeExecuted, Response: &ProviderResponse{Status: "success", Body: map[string]any{"id": "m1"}}},
+		{Type: OutcomeDeduplicated},
+		{Type: OutcomeSuppressed, Rule: "quiet"},
+		{Type: OutcomeRerouted, OriginalProvider: "slack", NewProvider: "teams", RerouteReason: "circuit_open", Response: &ProviderResponse{Status: "success"}},
+		{Type: OutcomeThrottled, RetryAfter: 1500 * time.Millisecond},
+		{Type: OutcomeFailed, Error: &ActionError{Code: "TIMEOUT", Message: "slow", Retryable: true, Attempts: 3}},
+		{Type: OutcomeDryRun, Verdict: "suppress", MatchedRule: &rule, WouldBeProvider: "email"},
+		{Type: OutcomeScheduled, ActionID: "a1", ScheduledFor: "2026-02-15T00:00:00Z"},
+		{Type: OutcomeQuotaExceeded, Tenant: "t", Limit: 10, Used: 11, OverageBehavior: "block"},
+	}
+	for _, want := range outcomes {
+		data, err := json.Marshal(want)
+		if err != nil {
+			t.Fatalf("%s: marshal: %v", want.Type, err)
+		}
+		var got ActionOutcome
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("%s: unmarshal %s: %v", want.Type, data, err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: round trip through %s\ngot  %+v\nwant %+v", want.Type, data, got, want)
+		}
+	}
+}
+
+func TestBatchResultMarshalRoundTrip(t *testing.T) {
+	results := []BatchResult{
+		{Success: true, Outcome: &ActionOutcome{Type: OutcomeSuppressed, Rule: "r"}},
+		{Success: false, Error: &ErrorResponse{Code: "INVALID", Message: "bad"}},
+	}
+	data, err := json.Marshal(results)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []BatchResult
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, results) {
+		t.Errorf("round trip through %s\ngot  %+v\nwant %+v", data, got, results)
+	}
+}
+
+func TestHashChainVerificationDecodesBrokenRecordID(t *testing.T) {
+	var v HashChainVerification
+	raw := `{"valid":false,"records_checked":25,"first_broken_at":"rec-025","first_record_id":"rec-001","last_record_id":"rec-100"}`
+	if err := json.Unmarshal([]byte(raw), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Valid || v.FirstBrokenAt == nil || *v.FirstBrokenAt != "rec-025" {
+		t.Errorf("verification = %+v", v)
+	}
+}
@@ -0,0 +1,183 @@
+// Package outbox implements the transactional outbox pattern for
+// Acteon dispatch.
+//
+// A service that must dispatch an action if and only if its own
+// database write commits cannot call the gateway inside the
+// transaction: the call may succeed and the commit fail, or the other
+// way round. Instead it writes the action to an outbox table in the
+// same transaction, and a Relay dispatches committed entries
+// afterwards:
+//
+//	store := outbox.NewSQLStore(db, "acteon_outbox", outbox.Dollar)
+//
+//	tx, _ := db.BeginTx(ctx, nil)
+//	// ... the service's own writes ...
+//	if err := store.Enqueue(ctx, tx, action); err != nil { ... }
+//	tx.Commit()
+//
+//	relay := outbox.NewRelay(store, client)
+//	go relay.Run(ctx)
+//
+// Delivery is at least once: a relay that crashes between dispatching
+// and marking an entry sent dispatches it again on restart. Each
+// dispatch carries the action ID as its idempotency key and, unless
+// the action sets its own, as its dedup key, so a gateway with a
+// dedup rule turns the repeat into a Deduplicated outcome.
+package outbox
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+// Entry is an action waiting in the outbox. Its key is the action ID.
+type Entry struct {
+	Action *acteon.Action
+	// Attempts counts earlier dispatch attempts that failed.
+	Attempts  int
+	CreatedAt time.Time
+}
+
+// Store is where pending actions wait. Implementations must be safe
+// for use by one Relay goroutine while other goroutines enqueue.
+type Store interface {
+	// Pending returns up to limit entries that are neither sent nor
+	// abandoned, oldest first.
+	Pending(ctx context.Context, limit int) ([]Entry, error)
+	// MarkSent records that the action with id was dispatched.
+	MarkSent(ctx context.Context, id string, outcome *acteon.ActionOutcome) error
+	// MarkFailed records a failed dispatch of the action with id. When
+	// retry is false the relay has given up on it and it must not be
+	// returned by Pending again.
+	MarkFailed(ctx context.Context, id string, cause error, retry bool) error
+}
+
+// Dispatcher is the subset of *acteon.Client a Relay needs.
+type Dispatcher interface {
+	Dispatch(ctx context.Context, action *acteon.Action) (*acteon.ActionOutcome, error)
+}
+
+// Relay moves actions from a Store to the gateway.
+type Relay struct {
+	store        Store
+	client       Dispatcher
+	batchSize    int
+	pollInterval time.Duration
+	maxAttempts  int
+	onError      func(Entry, error)
+}
+
+// Option configures a Relay.
+type Option func(*Relay)
+
+// BatchSize sets how many entries each poll reads. The default is 100.
+func BatchSize(n int) Option {
+	return func(r *Relay) { r.batchSize = n }
+}
+
+// PollInterval sets how long Run waits after a poll that did not send
+// a full batch. The default is one second.
+func PollInterval(d time.Duration) Option {
+	return func(r *Relay) { r.pollInterval = d }
+}
+
+// MaxAttempts sets how many times a retryable failure is attempted
+// before the entry is abandoned. The default is 10. Failures that are
+// not retryable, such as validation errors, are abandoned at once.
+func MaxAttempts(n int) Option {
+	return func(r *Relay) { r.maxAttempts = n }
+}
+
+// OnError sets a function called for every failed dispatch, for
+// logging. It must not block.
+func OnError(fn func(Entry, error)) Option {
+	return func(r *Relay) { r.onError = fn }
+}
+
+// NewRelay returns a relay dispatching store's entries through client.
+func NewRelay(store Store, client Dispatcher, opts ...Option) *Relay {
+	r := &Relay{
+		store:        store,
+		client:       client,
+		batchSize:    100,
+		pollInterval: time.Second,
+		maxAttempts:  10,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Run relays entries until ctx is done. It polls again at once after a
+// full batch was sent, and after PollInterval otherwise, so an empty
+// outbox or a gateway outage is not polled in a tight loop. Store
+// errors end the run; dispatch errors are recorded on the entry.
+func (r *Relay) Run(ctx context.Context) error {
+	for {
+		n, err := r.RunOnce(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		if n == r.batchSize {
+			continue
+		}
+		timer := time.NewTimer(r.pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// RunOnce dispatches one batch of pending entries in order and
+// returns how many were sent.
+func (r *Relay) RunOnce(ctx context.Context) (int, error) {
+	entries, err := r.store.Pending(ctx, r.batchSize)
+	if err != nil {
+		return 0, err
+	}
+	sent := 0
+	for _, e := range entries {
+		ok, err := r.relay(ctx, e)
+		if err != nil {
+			return sent, err
+		}
+		if ok {
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+// relay dispatches one entry, records the result in the store, and
+// reports whether it was sent.
+func (r *Relay) relay(ctx context.Context, e Entry) (bool, error) {
+	action := *e.Action
+	if action.DedupKey == "" {
+		action.DedupKey = action.ID
+	}
+	callCtx := acteon.WithRequestOptions(ctx, acteon.IdempotencyKey(action.ID))
+
+	outcome, err := r.client.Dispatch(callCtx, &action)
+	if err == nil {
+		return true, r.store.MarkSent(ctx, action.ID, outcome)
+	}
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+	if r.onError != nil {
+		r.onError(e, err)
+	}
+	var ae acteon.ActeonError
+	retry := errors.As(err, &ae) && ae.IsRetryable() && e.Attempts+1 < r.maxAttempts
+	return false, r.store.MarkFailed(ctx, action.ID, err, retry)
+}
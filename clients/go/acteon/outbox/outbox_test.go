@@ -0,0 +1,162 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+// memStore is an in-memory Store.
+type memStore struct {
+	mu      sync.Mutex
+	entries map[string]*memEntry
+}
+
+type memEntry struct {
+	Entry
+	status string
+	err    string
+}
+
+func newMemStore(actions ...*acteon.Action) *memStore {
+	s := &memStore{entries: map[string]*memEntry{}}
+	for i, a := range actions {
+		s.entries[a.ID] = &memEntry{Entry: Entry{Action: a, CreatedAt: time.Unix(int64(i), 0)}, status: StatusPending}
+	}
+	return s
+}
+
+func (s *memStore) Pending(_ context.Context, limit int) ([]Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []Entry
+	for _, e := range s.entries {
+		if e.status == StatusPending {
+			out = append(out, e.Entry)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
+	return out[:min(limit, len(out))], nil
+}
+
+func (s *memStore) MarkSent(_ context.Context, id string, _ *acteon.ActionOutcome) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[id].status = StatusSent
+	return nil
+}
+
+func (s *memStore) MarkFailed(_ context.Context, id string, cause error, retry bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e := s.entries[id]
+	e.Attempts++
+	e.err = cause.Error()
+	if !retry {
+		e.status = StatusAbandoned
+	}
+	return nil
+}
+
+func (s *memStore) status(id string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.entries[id].status
+}
+
+// fakeDispatcher fails actions listed in errs and records the rest.
+type fakeDispatcher struct {
+	mu   sync.Mutex
+	errs map[string]error
+	sent []*acteon.Action
+}
+
+func (d *fakeDispatcher) Dispatch(_ context.Context, a *acteon.Action) (*acteon.ActionOutcome, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.errs[a.ID]; err != nil {
+		return nil, err
+	}
+	d.sent = append(d.sent, a)
+	return &acteon.ActionOutcome{Type: acteon.OutcomeExecuted}, nil
+}
+
+func TestRelaySendsInOrderWithDedupKeys(t *testing.T) {
+	a := acteon.NewAction("ns", "t", "email", "send", nil)
+	b := acteon.NewAction("ns", "t", "email", "send", nil).WithDedupKey("order-7")
+	store := newMemStore(a, b)
+	d := &fakeDispatcher{}
+
+	sent, err := NewRelay(store, d).RunOnce(context.Background())
+	if err != nil || sent != 2 {
+		t.Fatalf("RunOnce = %d, %v", sent, err)
+	}
+	if len(d.sent) != 2 || d.sent[0].ID != a.ID || d.sent[0].DedupKey != a.ID || d.sent[1].DedupKey != "order-7" {
+		t.Errorf("sent = %+v", d.sent)
+	}
+	if a.DedupKey != "" {
+		t.Error("relay modified the stored action")
+	}
+	if store.status(a.ID) != StatusSent || store.status(b.ID) != StatusSent {
+		t.Error("entries not marked sent")
+	}
+	if sent, _ := NewRelay(store, d).RunOnce(context.Background()); sent != 0 || len(d.sent) != 2 {
+		t.Errorf("second run sent %d", sent)
+	}
+}
+
+func TestRelayRetriesThenAbandons(t *testing.T) {
+	flaky := acteon.NewAction("ns", "t", "email", "send", nil)
+	invalid := acteon.NewAction("ns", "t", "email", "send", nil)
+	store := newMemStore(flaky, invalid)
+	d := &fakeDispatcher{errs: map[string]error{
+		flaky.ID:   &acteon.ConnectionError{Message: "refused"},
+		invalid.ID: &acteon.APIError{Code: "INVALID", Message: "bad payload"},
+	}}
+	var reported int
+	relay := NewRelay(store, d, MaxAttempts(2), OnError(func(Entry, error) { reported++ }))
+
+	if _, err := relay.RunOnce(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if store.status(flaky.ID) != StatusPending || store.status(invalid.ID) != StatusAbandoned {
+		t.Errorf("after one run: flaky %s, invalid %s", store.status(flaky.ID), store.status(invalid.ID))
+	}
+	if _, err := relay.RunOnce(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if store.status(flaky.ID) != StatusAbandoned || reported != 3 {
+		t.Errorf("after two runs: flaky %s, reported %d", store.status(flaky.ID), reported)
+	}
+}
+
+func TestRunStopsOnCancel(t *testing.T) {
+	store := newMemStore(acteon.NewAction("ns", "t", "email", "send", nil))
+	d := &fakeDispatcher{}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- NewRelay(store, d, PollInterval(time.Millisecond)).Run(ctx) }()
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		d.mu.Lock()
+		n := len(d.sent)
+		d.mu.Unlock()
+		if n == 1 || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Run = %v", err)
+	}
+	if len(d.sent) != 1 {
+		t.Errorf("sent %d actions", len(d.sent))
+	}
+}
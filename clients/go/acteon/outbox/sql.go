@@ -0,0 +1,153 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+// Entry statuses stored in the status column.
+const (
+	StatusPending   = "pending"
+	StatusSent      = "sent"
+	StatusAbandoned = "abandoned"
+)
+
+// Placeholder is the bind parameter style of a SQL driver.
+type Placeholder int
+
+const (
+	// QuestionMark binds parameters as ?, for MySQL and SQLite.
+	QuestionMark Placeholder = iota
+	// Dollar binds parameters as $1, $2, ..., for PostgreSQL.
+	Dollar
+)
+
+// Schema returns a CREATE TABLE statement for an outbox table that
+// SQLStore can use. The column types are portable across PostgreSQL,
+// MySQL, and SQLite; adjust them to taste, keeping the names. MySQL
+// connections need parseTime=true so created_at scans into a time.
+func Schema(table string) string {
+	return fmt.Sprintf(`CREATE TABLE %s (
+	id VARCHAR(64) PRIMARY KEY,
+	action TEXT NOT NULL,
+	status VARCHAR(16) NOT NULL,
+	attempts INTEGER NOT NULL DEFAULT 0,
+	last_error TEXT,
+	outcome TEXT,
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL
+)`, table)
+}
+
+// Execer is satisfied by *sql.DB, *sql.Tx, and *sql.Conn, so Enqueue
+// can join the caller's transaction.
+type Execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// SQLStore is a Store backed by a database/sql table created with
+// Schema.
+type SQLStore struct {
+	db          *sql.DB
+	table       string
+	placeholder Placeholder
+}
+
+// NewSQLStore returns a store using table in db.
+func NewSQLStore(db *sql.DB, table string, placeholder Placeholder) *SQLStore {
+	return &SQLStore{db: db, table: table, placeholder: placeholder}
+}
+
+// Enqueue writes action to the outbox through tx, normally the
+// transaction holding the writes the action belongs to. The action ID
+// is the entry's key, so enqueueing the same action twice fails.
+func (s *SQLStore) Enqueue(ctx context.Context, tx Execer, action *acteon.Action) error {
+	data, err := json.Marshal(action)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	_, err = tx.ExecContext(ctx, s.query(
+		"INSERT INTO %s (id, action, status, attempts, created_at, updated_at) VALUES (?, ?, ?, 0, ?, ?)"),
+		action.ID, string(data), StatusPending, now, now)
+	return err
+}
+
+// Pending implements Store.
+func (s *SQLStore) Pending(ctx context.Context, limit int) ([]Entry, error) {
+	rows, err := s.db.QueryContext(ctx, s.query(
+		"SELECT action, attempts, created_at FROM %s WHERE status = ? ORDER BY created_at, id LIMIT ?"),
+		StatusPending, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []Entry
+	for rows.Next() {
+		var data string
+		var e Entry
+		if err := rows.Scan(&data, &e.Attempts, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(data), &e.Action); err != nil {
+			return nil, fmt.Errorf("outbox: decode action: %w", err)
+		}
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+// MarkSent implements Store.
+func (s *SQLStore) MarkSent(ctx context.Context, id string, outcome *acteon.ActionOutcome) error {
+	var recorded any
+	if outcome != nil {
+		data, err := json.Marshal(outcome)
+		if err != nil {
+			return err
+		}
+		recorded = string(data)
+	}
+	_, err := s.db.ExecContext(ctx, s.query(
+		"UPDATE %s SET status = ?, outcome = ?, updated_at = ? WHERE id = ?"),
+		StatusSent, recorded, time.Now().UTC(), id)
+	return err
+}
+
+// MarkFailed implements Store.
+func (s *SQLStore) MarkFailed(ctx context.Context, id string, cause error, retry bool) error {
+	status := StatusPending
+	if !retry {
+		status = StatusAbandoned
+	}
+	_, err := s.db.ExecContext(ctx, s.query(
+		"UPDATE %s SET status = ?, attempts = attempts + 1, last_error = ?, updated_at = ? WHERE id = ?"),
+		status, cause.Error(), time.Now().UTC(), id)
+	return err
+}
+
+// query fills in the table name and rewrites ? placeholders for the
+// store's driver.
+func (s *SQLStore) query(format string) string {
+	q := fmt.Sprintf(format, s.table)
+	if s.placeholder != Dollar {
+		return q
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range q {
+		if r == '?' {
+			n++
+			fmt.Fprintf(&b, "$%d", n)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,133 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+// recordingDriver is a database/sql driver that records statements and
+// answers queries with the rows it was given.
+type recordingDriver struct {
+	mu    sync.Mutex
+	stmts []string
+	args  [][]driver.NamedValue
+	rows  [][]driver.Value
+}
+
+func (d *recordingDriver) Open(string) (driver.Conn, error) { return &recordingConn{d}, nil }
+
+type recordingConn struct{ d *recordingDriver }
+
+func (c *recordingConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c *recordingConn) Close() error                        { return nil }
+func (c *recordingConn) Begin() (driver.Tx, error)           { return c, nil }
+func (c *recordingConn) Commit() error                       { return nil }
+func (c *recordingConn) Rollback() error                     { return nil }
+
+func (c *recordingConn) record(query string, args []driver.NamedValue) {
+	c.d.mu.Lock()
+	defer c.d.mu.Unlock()
+	c.d.stmts = append(c.d.stmts, query)
+	c.d.args = append(c.d.args, args)
+}
+
+func (c *recordingConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.record(query, args)
+	return driver.RowsAffected(1), nil
+}
+
+func (c *recordingConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.record(query, args)
+	return &recordingRows{rows: c.d.rows}, nil
+}
+
+type recordingRows struct{ rows [][]driver.Value }
+
+func (r *recordingRows) Columns() []string { return []string{"action", "attempts", "created_at"} }
+func (r *recordingRows) Close() error      { return nil }
+func (r *recordingRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+var registerOnce sync.Once
+var testDriver = &recordingDriver{}
+
+func openTestDB(t *testing.T) (*sql.DB, *recordingDriver) {
+	t.Helper()
+	registerOnce.Do(func() { sql.Register("outboxtest", testDriver) })
+	testDriver.mu.Lock()
+	testDriver.stmts, testDriver.args, testDriver.rows = nil, nil, nil
+	testDriver.mu.Unlock()
+	db, err := sql.Open("outboxtest", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db, testDriver
+}
+
+func TestSQLStoreStatements(t *testing.T) {
+	db, d := openTestDB(t)
+	store := NewSQLStore(db, "acteon_outbox", Dollar)
+	ctx := context.Background()
+	action := acteon.NewAction("ns", "t", "email", "send", map[string]any{"to": "a@b.c"})
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Enqueue(ctx, tx, action); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.MarkFailed(ctx, action.ID, errors.New("boom"), false); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"INSERT INTO acteon_outbox (id, action, status, attempts, created_at, updated_at) VALUES ($1, $2, $3, 0, $4, $5)",
+		"UPDATE acteon_outbox SET status = $1, attempts = attempts + 1, last_error = $2, updated_at = $3 WHERE id = $4",
+	}
+	if strings.Join(d.stmts, "\n") != strings.Join(want, "\n") {
+		t.Errorf("statements =\n%s", strings.Join(d.stmts, "\n"))
+	}
+	if d.args[0][0].Value != action.ID || d.args[1][0].Value != StatusAbandoned || d.args[1][1].Value != "boom" {
+		t.Errorf("args = %v", d.args)
+	}
+}
+
+func TestSQLStorePending(t *testing.T) {
+	db, d := openTestDB(t)
+	store := NewSQLStore(db, "outbox", QuestionMark)
+	created := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	d.rows = [][]driver.Value{
+		{`{"id":"a-1","namespace":"ns","tenant":"t","provider":"email","action_type":"send","payload":{}}`, int64(2), created},
+	}
+
+	entries, err := store.Pending(context.Background(), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.stmts[0] != "SELECT action, attempts, created_at FROM outbox WHERE status = ? ORDER BY created_at, id LIMIT ?" {
+		t.Errorf("query = %s", d.stmts[0])
+	}
+	if len(entries) != 1 || entries[0].Action.ID != "a-1" || entries[0].Attempts != 2 || !entries[0].CreatedAt.Equal(created) {
+		t.Errorf("entries = %+v", entries)
+	}
+}
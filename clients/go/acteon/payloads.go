@@ -0,0 +1,876 @@
+// Typed provider payloads for the Go ActeonClient.
+//
+// The New*Payload helpers in models.go build loosely typed maps. The
+// structs here cover the same provider fields with compile-time names,
+// a Validate method that catches mistakes before dispatch, and
+// ToPayload to produce the map the gateway expects. The map helpers
+// are thin wrappers over these structs.
+
+package acteon
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+// PayloadBuilder is implemented by every typed provider payload.
+type PayloadBuilder interface {
+	// Validate reports the first problem that would make the provider
+	// reject the payload, as a *PayloadValidationError.
+	Validate() error
+	// ToPayload returns the action payload map, omitting unset
+	// optional fields.
+	ToPayload() map[string]any
+}
+
+var (
+	_ PayloadBuilder = (*EmailPayload)(nil)
+	_ PayloadBuilder = (*SnsPublishPayload)(nil)
+	_ PayloadBuilder = (*LambdaInvokePayload)(nil)
+	_ PayloadBuilder = (*EventBridgePutEventPayload)(nil)
+	_ PayloadBuilder = (*SqsSendMessagePayload)(nil)
+	_ PayloadBuilder = (*S3PutObjectPayload)(nil)
+	_ PayloadBuilder = (*S3GetObjectPayload)(nil)
+	_ PayloadBuilder = (*S3DeleteObjectPayload)(nil)
+	_ PayloadBuilder = (*Ec2StartInstancesPayload)(nil)
+	_ PayloadBuilder = (*Ec2StopInstancesPayload)(nil)
+	_ PayloadBuilder = (*Ec2RebootInstancesPayload)(nil)
+	_ PayloadBuilder = (*Ec2TerminateInstancesPayload)(nil)
+	_ PayloadBuilder = (*Ec2HibernateInstancesPayload)(nil)
+	_ PayloadBuilder = (*Ec2RunInstancesPayload)(nil)
+	_ PayloadBuilder = (*Ec2AttachVolumePayload)(nil)
+	_ PayloadBuilder = (*Ec2DetachVolumePayload)(nil)
+	_ PayloadBuilder = (*Ec2DescribeInstancesPayload)(nil)
+	_ PayloadBuilder = (*AsgDescribeGroupsPayload)(nil)
+	_ PayloadBuilder = (*AsgSetDesiredCapacityPayload)(nil)
+	_ PayloadBuilder = (*AsgUpdateGroupPayload)(nil)
+	_ PayloadBuilder = (*SfnStartExecutionPayload)(nil)
+	_ PayloadBuilder = (*EcsRunTaskPayload)(nil)
+	_ PayloadBuilder = (*CloudWatchPutMetricPayload)(nil)
+)
+
+// EmailPayload is a typed payload for the email provider. The
+// provider delivers to a single mailbox per recipient field, so To, Cc,
+// and Bcc each hold one address, such as "Bob <bob@example.com>".
+type EmailPayload struct {
+	To       string
+	Cc       string
+	Bcc      string
+	Subject  string
+	TextBody string
+	HTMLBody string
+	ReplyTo  string
+	// Attachments travel on the action rather than in the payload;
+	// ToAction attaches them.
+	Attachments []Attachment
+}
+
+// Validate checks that the email has a recipient, a subject, a body,
+// one well-formed address per address field, and complete attachments.
+func (p *EmailPayload) Validate() error {
+	if p.To == "" {
+		return &PayloadValidationError{Provider: "email", Field: "to", Message: "a recipient is required"}
+	}
+	for _, f := range []struct{ field, addr string }{{"to", p.To}, {"cc", p.Cc}, {"bcc", p.Bcc}, {"reply_to", p.ReplyTo}} {
+		if f.addr == "" {
+			continue
+		}
+		if _, err := mail.ParseAddress(f.addr); err != nil {
+			return &PayloadValidationError{Provider: "email", Field: f.field, Message: "must be a single valid address, got " + f.addr}
+		}
+	}
+	if p.Subject == "" {
+		return &PayloadValidationError{Provider: "email", Field: "subject", Message: "is required"}
+	}
+	if p.TextBody == "" && p.HTMLBody == "" {
+		return &PayloadValidationError{Provider: "email", Field: "body", Message: "a text or HTML body is required"}
+	}
+	for _, a := range p.Attachments {
+		if a.Filename == "" || a.DataBase64 == "" {
+			return &PayloadValidationError{Provider: "email", Field: "attachments", Message: "attachment " + a.ID + " needs a filename and data"}
+		}
+	}
+	return nil
+}
+
+// ToPayload returns the action payload for the email provider. When
+// both bodies are set the provider sends a multipart message.
+func (p *EmailPayload) ToPayload() map[string]any {
+	m := map[string]any{
+		"to":      p.To,
+		"subject": p.Subject,
+	}
+	setString(m, "cc", p.Cc)
+	setString(m, "bcc", p.Bcc)
+	setString(m, "body", p.TextBody)
+	setString(m, "html_body", p.HTMLBody)
+	setString(m, "reply_to", p.ReplyTo)
+	return m
+}
+
+// ToAction validates the email and returns a `send_email` action for
+// the email provider carrying its attachments.
+func (p *EmailPayload) ToAction(namespace, tenant string) (*Action, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	a := NewAction(namespace, tenant, "email", "send_email", p.ToPayload())
+	if len(p.Attachments) > 0 {
+		a.WithAttachments(p.Attachments)
+	}
+	return a, nil
+}
+
+// =============================================================================
+// AWS
+// =============================================================================
+
+// SnsPublishPayload is a typed payload for the AWS SNS provider.
+type SnsPublishPayload struct {
+	Message        string
+	Subject        string
+	TopicArn       string
+	MessageGroupID string
+	MessageDedupID string
+}
+
+// Validate checks that the message is set and FIFO fields are paired.
+func (p *SnsPublishPayload) Validate() error {
+	if p.Message == "" {
+		return &PayloadValidationError{Provider: "sns", Field: "message", Message: "is required"}
+	}
+	if len(p.Subject) > 100 {
+		return &PayloadValidationError{Provider: "sns", Field: "subject", Message: "must be at most 100 characters"}
+	}
+	if p.MessageDedupID != "" && p.MessageGroupID == "" {
+		return &PayloadValidationError{Provider: "sns", Field: "message_dedup_id", Message: "requires message_group_id"}
+	}
+	return nil
+}
+
+// ToPayload returns the action payload for the SNS provider.
+func (p *SnsPublishPayload) ToPayload() map[string]any {
+	m := map[string]any{
+		"message": p.Message,
+	}
+	setString(m, "subject", p.Subject)
+	setString(m, "topic_arn", p.TopicArn)
+	setString(m, "message_group_id", p.MessageGroupID)
+	setString(m, "message_dedup_id", p.MessageDedupID)
+	return m
+}
+
+// LambdaInvokePayload is a typed payload for the AWS Lambda provider.
+type LambdaInvokePayload struct {
+	// Payload is the JSON-serializable event passed to the function.
+	Payload      any
+	FunctionName string
+	// InvocationType is "RequestResponse", "Event", or "DryRun"; empty
+	// uses the provider default.
+	InvocationType string
+}
+
+// Validate checks the invocation type.
+func (p *LambdaInvokePayload) Validate() error {
+	switch p.InvocationType {
+	case "", "RequestResponse", "Event", "DryRun":
+		return nil
+	}
+	return &PayloadValidationError{Provider: "lambda", Field: "invocation_type", Message: fmt.Sprintf("unknown invocation type %q", p.InvocationType)}
+}
+
+// ToPayload returns the action payload for the Lambda provider.
+func (p *LambdaInvokePayload) ToPayload() map[string]any {
+	m := map[string]any{}
+	if p.Payload != nil {
+		m["payload"] = p.Payload
+	}
+	setString(m, "function_name", p.FunctionName)
+	setString(m, "invocation_type", p.InvocationType)
+	return m
+}
+
+// EventBridgePutEventPayload is a typed payload for the AWS EventBridge provider.
+type EventBridgePutEventPayload struct {
+	Source       string
+	DetailType   string
+	Detail       any
+	EventBusName string
+	Resources    []string
+}
+
+// Validate checks that the source and detail type are set.
+func (p *EventBridgePutEventPayload) Validate() error {
+	if p.Source == "" {
+		return &PayloadValidationError{Provider: "eventbridge", Field: "source", Message: "is required"}
+	}
+	if p.DetailType == "" {
+		return &PayloadValidationError{Provider: "eventbridge", Field: "detail_type", Message: "is required"}
+	}
+	return nil
+}
+
+// ToPayload returns the action payload for the EventBridge provider.
+func (p *EventBridgePutEventPayload) ToPayload() map[string]any {
+	m := map[string]any{
+		"source":      p.Source,
+		"detail_type": p.DetailType,
+		"detail":      p.Detail,
+	}
+	setString(m, "event_bus_name", p.EventBusName)
+	if len(p.Resources) > 0 {
+		m["resources"] = p.Resources
+	}
+	return m
+}
+
+// SqsSendMessagePayload is a typed payload for the AWS SQS provider.
+type SqsSendMessagePayload struct {
+	MessageBody       string
+	QueueURL          string
+	DelaySeconds      int
+	MessageGroupID    string
+	MessageDedupID    string
+	MessageAttributes map[string]string
+}
+
+// Validate checks the body and the SQS delay range.
+func (p *SqsSendMessagePayload) Validate() error {
+	if p.MessageBody == "" {
+		return &PayloadValidationError{Provider: "sqs", Field: "message_body", Message: "is required"}
+	}
+	if p.DelaySeconds < 0 || p.DelaySeconds > 900 {
+		return &PayloadValidationError{Provider: "sqs", Field: "delay_seconds", Message: "must be between 0 and 900"}
+	}
+	if p.MessageDedupID != "" && p.MessageGroupID == "" {
+		return &PayloadValidationError{Provider: "sqs", Field: "message_dedup_id", Message: "requires message_group_id"}
+	}
+	return nil
+}
+
+// ToPayload returns the action payload for the SQS provider.
+func (p *SqsSendMessagePayload) ToPayload() map[string]any {
+	m := map[string]any{
+		"message_body": p.MessageBody,
+	}
+	setString(m, "queue_url", p.QueueURL)
+	if p.DelaySeconds > 0 {
+		m["delay_seconds"] = p.DelaySeconds
+	}
+	setString(m, "message_group_id", p.MessageGroupID)
+	setString(m, "message_dedup_id", p.MessageDedupID)
+	if len(p.MessageAttributes) > 0 {
+		m["message_attributes"] = p.MessageAttributes
+	}
+	return m
+}
+
+// S3PutObjectPayload is a typed payload for the AWS S3 put-object
+// action. Set Body for text or BodyBase64 for binary content, not both.
+type S3PutObjectPayload struct {
+	Key         string
+	Bucket      string
+	Body        string
+	BodyBase64  string
+	ContentType string
+	Metadata    map[string]string
+}
+
+// Validate checks the key and that exactly one well-formed body is set.
+func (p *S3PutObjectPayload) Validate() error {
+	if p.Key == "" {
+		return &PayloadValidationError{Provider: "s3", Field: "key", Message: "is required"}
+	}
+	return validateObjectBody("s3", p.Body, p.BodyBase64)
+}
+
+// ToPayload returns the action payload for the S3 put-object action.
+func (p *S3PutObjectPayload) ToPayload() map[string]any {
+	m := map[string]any{
+		"key": p.Key,
+	}
+	setString(m, "bucket", p.Bucket)
+	setString(m, "body", p.Body)
+	setString(m, "body_base64", p.BodyBase64)
+	setString(m, "content_type", p.ContentType)
+	if len(p.Metadata) > 0 {
+		m["metadata"] = p.Metadata
+	}
+	return m
+}
+
+// S3GetObjectPayload is a typed payload for the AWS S3 get-object action.
+type S3GetObjectPayload struct {
+	Key    string
+	Bucket string
+}
+
+// Validate checks that the key is set.
+func (p *S3GetObjectPayload) Validate() error {
+	if p.Key == "" {
+		return &PayloadValidationError{Provider: "s3", Field: "key", Message: "is required"}
+	}
+	return nil
+}
+
+// ToPayload returns the action payload for the S3 get-object action.
+func (p *S3GetObjectPayload) ToPayload() map[string]any {
+	m := map[string]any{
+		"key": p.Key,
+	}
+	setString(m, "bucket", p.Bucket)
+	return m
+}
+
+// S3DeleteObjectPayload is a typed payload for the AWS S3 delete-object action.
+type S3DeleteObjectPayload struct {
+	Key    string
+	Bucket string
+}
+
+// Validate checks that the key is set.
+func (p *S3DeleteObjectPayload) Validate() error {
+	if p.Key == "" {
+		return &PayloadValidationError{Provider: "s3", Field: "key", Message: "is required"}
+	}
+	return nil
+}
+
+// ToPayload returns the action payload for the S3 delete-object action.
+func (p *S3DeleteObjectPayload) ToPayload() map[string]any {
+	m := map[string]any{
+		"key": p.Key,
+	}
+	setString(m, "bucket", p.Bucket)
+	return m
+}
+
+// =============================================================================
+// AWS EC2
+// =============================================================================
+
+// Ec2StartInstancesPayload is a typed payload for the EC2 start-instances action.
+type Ec2StartInstancesPayload struct {
+	InstanceIDs []string
+}
+
+// Validate checks the instance IDs.
+func (p *Ec2StartInstancesPayload) Validate() error {
+	return validateInstanceIDs(p.InstanceIDs)
+}
+
+// ToPayload returns the action payload for the EC2 start-instances action.
+func (p *Ec2StartInstancesPayload) ToPayload() map[string]any {
+	return map[string]any{"instance_ids": p.InstanceIDs}
+}
+
+// Ec2StopInstancesPayload is a typed payload for the EC2 stop-instances action.
+type Ec2StopInstancesPayload struct {
+	InstanceIDs []string
+	Hibernate   bool
+	Force       bool
+}
+
+// Validate checks the instance IDs.
+func (p *Ec2StopInstancesPayload) Validate() error {
+	return validateInstanceIDs(p.InstanceIDs)
+}
+
+// ToPayload returns the action payload for the EC2 stop-instances action.
+func (p *Ec2StopInstancesPayload) ToPayload() map[string]any {
+	m := map[string]any{"instance_ids": p.InstanceIDs}
+	if p.Hibernate {
+		m["hibernate"] = true
+	}
+	if p.Force {
+		m["force"] = true
+	}
+	return m
+}
+
+// Ec2RebootInstancesPayload is a typed payload for the EC2 reboot-instances action.
+type Ec2RebootInstancesPayload struct {
+	InstanceIDs []string
+}
+
+// Validate checks the instance IDs.
+func (p *Ec2RebootInstancesPayload) Validate() error {
+	return validateInstanceIDs(p.InstanceIDs)
+}
+
+// ToPayload returns the action payload for the EC2 reboot-instances action.
+func (p *Ec2RebootInstancesPayload) ToPayload() map[string]any {
+	return map[string]any{"instance_ids": p.InstanceIDs}
+}
+
+// Ec2TerminateInstancesPayload is a typed payload for the EC2 terminate-instances action.
+type Ec2TerminateInstancesPayload struct {
+	InstanceIDs []string
+}
+
+// Validate checks the instance IDs.
+func (p *Ec2TerminateInstancesPayload) Validate() error {
+	return validateInstanceIDs(p.InstanceIDs)
+}
+
+// ToPayload returns the action payload for the EC2 terminate-instances action.
+func (p *Ec2TerminateInstancesPayload) ToPayload() map[string]any {
+	return map[string]any{"instance_ids": p.InstanceIDs}
+}
+
+// Ec2HibernateInstancesPayload is a typed payload for the EC2 hibernate-instances action.
+type Ec2HibernateInstancesPayload struct {
+	InstanceIDs []string
+}
+
+// Validate checks the instance IDs.
+func (p *Ec2HibernateInstancesPayload) Validate() error {
+	return validateInstanceIDs(p.InstanceIDs)
+}
+
+// ToPayload returns the action payload for the EC2 hibernate-instances action.
+func (p *Ec2HibernateInstancesPayload) ToPayload() map[string]any {
+	return map[string]any{"instance_ids": p.InstanceIDs}
+}
+
+// Ec2RunInstancesPayload is a typed payload for the EC2 run-instances action.
+type Ec2RunInstancesPayload struct {
+	ImageID            string
+	InstanceType       string
+	MinCount           int
+	MaxCount           int
+	KeyName            string
+	SubnetID           string
+	UserData           string
+	IamInstanceProfile string
+	SecurityGroupIDs   []string
+	Tags               map[string]string
+}
+
+// Validate checks the image, instance type, and instance counts.
+func (p *Ec2RunInstancesPayload) Validate() error {
+	if !strings.HasPrefix(p.ImageID, "ami-") {
+		return &PayloadValidationError{Provider: "ec2", Field: "image_id", Message: fmt.Sprintf("%q is not an AMI ID", p.ImageID)}
+	}
+	if p.InstanceType == "" {
+		return &PayloadValidationError{Provider: "ec2", Field: "instance_type", Message: "is required"}
+	}
+	if p.MinCount < 0 || p.MaxCount < 0 {
+		return &PayloadValidationError{Provider: "ec2", Field: "min_count", Message: "counts must not be negative"}
+	}
+	if p.MinCount > 0 && p.MaxCount > 0 && p.MinCount > p.MaxCount {
+		return &PayloadValidationError{Provider: "ec2", Field: "min_count", Message: "must not exceed max_count"}
+	}
+	return nil
+}
+
+// ToPayload returns the action payload for the EC2 run-instances action.
+func (p *Ec2RunInstancesPayload) ToPayload() map[string]any {
+	m := map[string]any{
+		"image_id":      p.ImageID,
+		"instance_type": p.InstanceType,
+	}
+	if p.MinCount > 0 {
+		m["min_count"] = p.MinCount
+	}
+	if p.MaxCount > 0 {
+		m["max_count"] = p.MaxCount
+	}
+	setString(m, "key_name", p.KeyName)
+	setString(m, "subnet_id", p.SubnetID)
+	setString(m, "user_data", p.UserData)
+	setString(m, "iam_instance_profile", p.IamInstanceProfile)
+	if len(p.SecurityGroupIDs) > 0 {
+		m["security_group_ids"] = p.SecurityGroupIDs
+	}
+	if len(p.Tags) > 0 {
+		m["tags"] = p.Tags
+	}
+	return m
+}
+
+// Ec2AttachVolumePayload is a typed payload for the EC2 attach-volume action.
+type Ec2AttachVolumePayload struct {
+	VolumeID   string
+	InstanceID string
+	Device     string
+}
+
+// Validate checks that the volume, instance, and device are set.
+func (p *Ec2AttachVolumePayload) Validate() error {
+	if !strings.HasPrefix(p.VolumeID, "vol-") {
+		return &PayloadValidationError{Provider: "ec2", Field: "volume_id", Message: fmt.Sprintf("%q is not a volume ID", p.VolumeID)}
+	}
+	if !strings.HasPrefix(p.InstanceID, "i-") {
+		return &PayloadValidationError{Provider: "ec2", Field: "instance_id", Message: fmt.Sprintf("%q is not an instance ID", p.InstanceID)}
+	}
+	if p.Device == "" {
+		return &PayloadValidationError{Provider: "ec2", Field: "device", Message: "is required"}
+	}
+	return nil
+}
+
+// ToPayload returns the action payload for the EC2 attach-volume action.
+func (p *Ec2AttachVolumePayload) ToPayload() map[string]any {
+	return map[string]any{
+		"volume_id":   p.VolumeID,
+		"instance_id": p.InstanceID,
+		"device":      p.Device,
+	}
+}
+
+// Ec2DetachVolumePayload is a typed payload for the EC2 detach-volume action.
+type Ec2DetachVolumePayload struct {
+	VolumeID   string
+	InstanceID string
+	Device     string
+	Force      bool
+}
+
+// Validate checks the volume ID.
+func (p *Ec2DetachVolumePayload) Validate() error {
+	if !strings.HasPrefix(p.VolumeID, "vol-") {
+		return &PayloadValidationError{Provider: "ec2", Field: "volume_id", Message: fmt.Sprintf("%q is not a volume ID", p.VolumeID)}
+	}
+	return nil
+}
+
+// ToPayload returns the action payload for the EC2 detach-volume action.
+func (p *Ec2DetachVolumePayload) ToPayload() map[string]any {
+	m := map[string]any{
+		"volume_id": p.VolumeID,
+	}
+	setString(m, "instance_id", p.InstanceID)
+	setString(m, "device", p.Device)
+	if p.Force {
+		m["force"] = true
+	}
+	return m
+}
+
+// Ec2DescribeInstancesPayload is a typed payload for the EC2
+// describe-instances action. No IDs describes every instance.
+type Ec2DescribeInstancesPayload struct {
+	InstanceIDs []string
+}
+
+// Validate checks any instance IDs given.
+func (p *Ec2DescribeInstancesPayload) Validate() error {
+	if len(p.InstanceIDs) == 0 {
+		return nil
+	}
+	return validateInstanceIDs(p.InstanceIDs)
+}
+
+// ToPayload returns the action payload for the EC2 describe-instances action.
+func (p *Ec2DescribeInstancesPayload) ToPayload() map[string]any {
+	m := map[string]any{}
+	if len(p.InstanceIDs) > 0 {
+		m["instance_ids"] = p.InstanceIDs
+	}
+	return m
+}
+
+// =============================================================================
+// AWS Auto Scaling
+// =============================================================================
+
+// AsgDescribeGroupsPayload is a typed payload for the Auto Scaling
+// describe-groups action. No names describes every group.
+type AsgDescribeGroupsPayload struct {
+	GroupNames []string
+}
+
+// Validate always succeeds; every field is optional.
+func (p *AsgDescribeGroupsPayload) Validate() error {
+	return nil
+}
+
+// ToPayload returns the action payload for the Auto Scaling describe-groups action.
+func (p *AsgDescribeGroupsPayload) ToPayload() map[string]any {
+	m := map[string]any{}
+	if len(p.GroupNames) > 0 {
+		m["auto_scaling_group_names"] = p.GroupNames
+	}
+	return m
+}
+
+// AsgSetDesiredCapacityPayload is a typed payload for the Auto Scaling
+// set-desired-capacity action.
+type AsgSetDesiredCapacityPayload struct {
+	GroupName       string
+	DesiredCapacity int
+	HonorCooldown   bool
+}
+
+// Validate checks the group name and capacity.
+func (p *AsgSetDesiredCapacityPayload) Validate() error {
+	if p.GroupName == "" {
+		return &PayloadValidationError{Provider: "autoscaling", Field: "auto_scaling_group_name", Message: "is required"}
+	}
+	if p.DesiredCapacity < 0 {
+		return &PayloadValidationError{Provider: "autoscaling", Field: "desired_capacity", Message: "must not be negative"}
+	}
+	return nil
+}
+
+// ToPayload returns the action payload for the Auto Scaling set-desired-capacity action.
+func (p *AsgSetDesiredCapacityPayload) ToPayload() map[string]any {
+	m := map[string]any{
+		"auto_scaling_group_name": p.GroupName,
+		"desired_capacity":        p.DesiredCapacity,
+	}
+	if p.HonorCooldown {
+		m["honor_cooldown"] = true
+	}
+	return m
+}
+
+// AsgUpdateGroupPayload is a typed payload for the Auto Scaling
+// update-group action. Nil fields are left unchanged.
+type AsgUpdateGroupPayload struct {
+	GroupName              string
+	MinSize                *int
+	MaxSize                *int
+	DesiredCapacity        *int
+	DefaultCooldown        *int
+	HealthCheckType        string
+	HealthCheckGracePeriod *int
+}
+
+// Validate checks the group name, size bounds, and health check type.
+func (p *AsgUpdateGroupPayload) Validate() error {
+	if p.GroupName == "" {
+		return &PayloadValidationError{Provider: "autoscaling", Field: "auto_scaling_group_name", Message: "is required"}
+	}
+	if p.MinSize != nil && p.MaxSize != nil && *p.MinSize > *p.MaxSize {
+		return &PayloadValidationError{Provider: "autoscaling", Field: "min_size", Message: "must not exceed max_size"}
+	}
+	if p.DesiredCapacity != nil {
+		if p.MinSize != nil && *p.DesiredCapacity < *p.MinSize {
+			return &PayloadValidationError{Provider: "autoscaling", Field: "desired_capacity", Message: "must not be below min_size"}
+		}
+		if p.MaxSize != nil && *p.DesiredCapacity > *p.MaxSize {
+			return &PayloadValidationError{Provider: "autoscaling", Field: "desired_capacity", Message: "must not exceed max_size"}
+		}
+	}
+	switch p.HealthCheckType {
+	case "", "EC2", "ELB":
+	default:
+		return &PayloadValidationError{Provider: "autoscaling", Field: "health_check_type", Message: `must be "EC2" or "ELB"`}
+	}
+	return nil
+}
+
+// ToPayload returns the action payload for the Auto Scaling update-group action.
+func (p *AsgUpdateGroupPayload) ToPayload() map[string]any {
+	m := map[string]any{
+		"auto_scaling_group_name": p.GroupName,
+	}
+	setInt(m, "min_size", p.MinSize)
+	setInt(m, "max_size", p.MaxSize)
+	setInt(m, "desired_capacity", p.DesiredCapacity)
+	setInt(m, "default_cooldown", p.DefaultCooldown)
+	setString(m, "health_check_type", p.HealthCheckType)
+	setInt(m, "health_check_grace_period", p.HealthCheckGracePeriod)
+	return m
+}
+
+// =============================================================================
+// AWS Step Functions, ECS, and CloudWatch
+// =============================================================================
+
+// SfnStartExecutionPayload is a typed payload for the AWS Step Functions
+// start-execution action.
+type SfnStartExecutionPayload struct {
+	StateMachineArn string
+	// Input is the JSON-serializable execution input.
+	Input any
+	// Name is the execution name; empty lets AWS generate one.
+	Name string
+}
+
+// Validate checks the state machine ARN and execution name length.
+func (p *SfnStartExecutionPayload) Validate() error {
+	if !strings.HasPrefix(p.StateMachineArn, "arn:") {
+		return &PayloadValidationError{Provider: "sfn", Field: "state_machine_arn", Message: "must be a state machine ARN"}
+	}
+	if len(p.Name) > 80 {
+		return &PayloadValidationError{Provider: "sfn", Field: "name", Message: "must be at most 80 characters"}
+	}
+	return nil
+}
+
+// ToPayload returns the action payload for the Step Functions provider.
+func (p *SfnStartExecutionPayload) ToPayload() map[string]any {
+	m := map[string]any{
+		"state_machine_arn": p.StateMachineArn,
+	}
+	if p.Input != nil {
+		m["input"] = p.Input
+	}
+	setString(m, "name", p.Name)
+	return m
+}
+
+// EcsRunTaskPayload is a typed payload for the AWS ECS run-task action.
+type EcsRunTaskPayload struct {
+	Cluster        string
+	TaskDefinition string
+	// Overrides is passed through as the ECS task overrides object,
+	// e.g. {"containerOverrides": [...]}.
+	Overrides map[string]any
+	// Count is the number of tasks to start; zero uses the provider
+	// default of one.
+	Count int
+	// LaunchType is "EC2", "FARGATE", or "EXTERNAL"; empty uses the
+	// cluster default.
+	LaunchType     string
+	Subnets        []string
+	SecurityGroups []string
+}
+
+// Validate checks the task definition, count, and launch type.
+func (p *EcsRunTaskPayload) Validate() error {
+	if p.TaskDefinition == "" {
+		return &PayloadValidationError{Provider: "ecs", Field: "task_definition", Message: "is required"}
+	}
+	if p.Count < 0 || p.Count > 10 {
+		return &PayloadValidationError{Provider: "ecs", Field: "count", Message: "must be between 1 and 10"}
+	}
+	switch p.LaunchType {
+	case "", "EC2", "FARGATE", "EXTERNAL":
+	default:
+		return &PayloadValidationError{Provider: "ecs", Field: "launch_type", Message: fmt.Sprintf("unknown launch type %q", p.LaunchType)}
+	}
+	return nil
+}
+
+// ToPayload returns the action payload for the ECS run-task action.
+func (p *EcsRunTaskPayload) ToPayload() map[string]any {
+	m := map[string]any{
+		"task_definition": p.TaskDefinition,
+	}
+	setString(m, "cluster", p.Cluster)
+	if len(p.Overrides) > 0 {
+		m["overrides"] = p.Overrides
+	}
+	if p.Count > 0 {
+		m["count"] = p.Count
+	}
+	setString(m, "launch_type", p.LaunchType)
+	if len(p.Subnets) > 0 {
+		m["subnets"] = p.Subnets
+	}
+	if len(p.SecurityGroups) > 0 {
+		m["security_groups"] = p.SecurityGroups
+	}
+	return m
+}
+
+// CloudWatchMetricDatum is one data point in a CloudWatchPutMetricPayload.
+type CloudWatchMetricDatum struct {
+	MetricName string
+	Value      float64
+	// Unit is a CloudWatch unit such as "Count" or "Seconds"; empty
+	// means "None".
+	Unit       string
+	Dimensions map[string]string
+	// Timestamp defaults to the time the gateway receives the action.
+	Timestamp time.Time
+}
+
+// CloudWatchPutMetricPayload is a typed payload for the AWS CloudWatch
+// put-metric-data action.
+type CloudWatchPutMetricPayload struct {
+	Namespace  string
+	MetricData []CloudWatchMetricDatum
+}
+
+// Validate checks the namespace and that every datum is named.
+func (p *CloudWatchPutMetricPayload) Validate() error {
+	if p.Namespace == "" {
+		return &PayloadValidationError{Provider: "cloudwatch", Field: "namespace", Message: "is required"}
+	}
+	if strings.HasPrefix(p.Namespace, "AWS/") {
+		return &PayloadValidationError{Provider: "cloudwatch", Field: "namespace", Message: `must not use the reserved "AWS/" prefix`}
+	}
+	if len(p.MetricData) == 0 {
+		return &PayloadValidationError{Provider: "cloudwatch", Field: "metric_data", Message: "at least one datum is required"}
+	}
+	for i, d := range p.MetricData {
+		if d.MetricName == "" {
+			return &PayloadValidationError{Provider: "cloudwatch", Field: "metric_data", Message: fmt.Sprintf("datum %d has no metric name", i)}
+		}
+		if len(d.Dimensions) > 30 {
+			return &PayloadValidationError{Provider: "cloudwatch", Field: "metric_data", Message: fmt.Sprintf("datum %d has more than 30 dimensions", i)}
+		}
+	}
+	return nil
+}
+
+// ToPayload returns the action payload for the CloudWatch provider.
+func (p *CloudWatchPutMetricPayload) ToPayload() map[string]any {
+	data := make([]map[string]any, 0, len(p.MetricData))
+	for _, d := range p.MetricData {
+		datum := map[string]any{
+			"metric_name": d.MetricName,
+			"value":       d.Value,
+		}
+		setString(datum, "unit", d.Unit)
+		if len(d.Dimensions) > 0 {
+			datum["dimensions"] = d.Dimensions
+		}
+		if !d.Timestamp.IsZero() {
+			datum["timestamp"] = d.Timestamp.UTC().Format(time.RFC3339)
+		}
+		data = append(data, datum)
+	}
+	return map[string]any{
+		"namespace":   p.Namespace,
+		"metric_data": data,
+	}
+}
+
+// =============================================================================
+// Helpers
+// =============================================================================
+
+func validateInstanceIDs(ids []string) error {
+	if len(ids) == 0 {
+		return &PayloadValidationError{Provider: "ec2", Field: "instance_ids", Message: "at least one instance ID is required"}
+	}
+	for _, id := range ids {
+		if !strings.HasPrefix(id, "i-") {
+			return &PayloadValidationError{Provider: "ec2", Field: "instance_ids", Message: fmt.Sprintf("%q is not an instance ID", id)}
+		}
+	}
+	return nil
+}
+
+func validateObjectBody(provider, body, bodyBase64 string) error {
+	if body != "" && bodyBase64 != "" {
+		return &PayloadValidationError{Provider: provider, Field: "body", Message: "set body or body_base64, not both"}
+	}
+	if bodyBase64 != "" {
+		if _, err := base64.StdEncoding.DecodeString(bodyBase64); err != nil {
+			return &PayloadValidationError{Provider: provider, Field: "body_base64", Message: "is not valid base64"}
+		}
+	}
+	return nil
+}
+
+func setString(m map[string]any, key, value string) {
+	if value != "" {
+		m[key] = value
+	}
+}
+
+func setInt(m map[string]any, key string, value *int) {
+	if value != nil {
+		m[key] = *value
+	}
+}
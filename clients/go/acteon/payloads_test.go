@@ -0,0 +1,120 @@
+package acteon
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEmailPayloadToAction(t *testing.T) {
+	p := &EmailPayload{
+		To:          "Bob <b@example.com>",
+		Cc:          "c@example.com",
+		Subject:     "Report",
+		TextBody:    "see attached",
+		HTMLBody:    "<p>see attached</p>",
+		Attachments: []Attachment{NewAttachment("att-1", "report", "report.pdf", "application/pdf", "JVBERi0=")},
+	}
+	a, err := p.ToAction("ns", "t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Provider != "email" || a.ActionType != "send_email" || len(a.Attachments) != 1 {
+		t.Errorf("unexpected action: %+v", a)
+	}
+	if a.Payload["to"] != "Bob <b@example.com>" || a.Payload["html_body"] != "<p>see attached</p>" {
+		t.Errorf("unexpected payload: %v", a.Payload)
+	}
+	if _, ok := a.Payload["bcc"]; ok {
+		t.Error("expected no bcc when empty")
+	}
+}
+
+func TestEmailPayloadValidate(t *testing.T) {
+	cases := map[string]*EmailPayload{
+		"to":          {Subject: "s", TextBody: "b"},
+		"cc":          {To: "a@example.com", Cc: "not an address", Subject: "s", TextBody: "b"},
+		"bcc":         {To: "a@example.com", Bcc: "b@example.com, c@example.com", Subject: "s", TextBody: "b"},
+		"subject":     {To: "a@example.com", TextBody: "b"},
+		"body":        {To: "a@example.com", Subject: "s"},
+		"attachments": {To: "a@example.com", Subject: "s", TextBody: "b", Attachments: []Attachment{{ID: "x"}}},
+	}
+	for field, p := range cases {
+		var verr *PayloadValidationError
+		if err := p.Validate(); !errors.As(err, &verr) || verr.Field != field {
+			t.Errorf("%s: err = %v", field, err)
+		}
+	}
+}
+
+func TestPayloadBuildersValidate(t *testing.T) {
+	three, two, five := 3, 2, 5
+	cases := []struct {
+		name  string
+		p     PayloadBuilder
+		field string // empty when the payload is valid
+	}{
+		{"sns ok", &SnsPublishPayload{Message: "hi"}, ""},
+		{"sns fifo", &SnsPublishPayload{Message: "hi", MessageDedupID: "d"}, "message_dedup_id"},
+		{"lambda type", &LambdaInvokePayload{InvocationType: "Async"}, "invocation_type"},
+		{"eventbridge source", &EventBridgePutEventPayload{DetailType: "x"}, "source"},
+		{"sqs delay", &SqsSendMessagePayload{MessageBody: "b", DelaySeconds: 901}, "delay_seconds"},
+		{"s3 both bodies", &S3PutObjectPayload{Key: "k", Body: "a", BodyBase64: "YQ=="}, "body"},
+		{"s3 bad base64", &S3PutObjectPayload{Key: "k", BodyBase64: "not base64!"}, "body_base64"},
+		{"s3 get key", &S3GetObjectPayload{}, "key"},
+		{"ec2 stop ids", &Ec2StopInstancesPayload{InstanceIDs: []string{"web-1"}}, "instance_ids"},
+		{"ec2 run ok", &Ec2RunInstancesPayload{ImageID: "ami-123", InstanceType: "t3.micro", MinCount: 1, MaxCount: 2}, ""},
+		{"ec2 run counts", &Ec2RunInstancesPayload{ImageID: "ami-123", InstanceType: "t3.micro", MinCount: 3, MaxCount: 2}, "min_count"},
+		{"ec2 attach", &Ec2AttachVolumePayload{VolumeID: "vol-1", InstanceID: "i-1"}, "device"},
+		{"ec2 describe all", &Ec2DescribeInstancesPayload{}, ""},
+		{"asg desired", &AsgSetDesiredCapacityPayload{GroupName: "g", DesiredCapacity: -1}, "desired_capacity"},
+		{"asg bounds", &AsgUpdateGroupPayload{GroupName: "g", MinSize: &three, MaxSize: &five, DesiredCapacity: &two}, "desired_capacity"},
+		{"asg health", &AsgUpdateGroupPayload{GroupName: "g", HealthCheckType: "TCP"}, "health_check_type"},
+		{"sfn arn", &SfnStartExecutionPayload{StateMachineArn: "orders"}, "state_machine_arn"},
+		{"ecs launch type", &EcsRunTaskPayload{TaskDefinition: "web:3", LaunchType: "LAMBDA"}, "launch_type"},
+		{"cloudwatch reserved", &CloudWatchPutMetricPayload{Namespace: "AWS/EC2", MetricData: []CloudWatchMetricDatum{{MetricName: "m"}}}, "namespace"},
+		{"cloudwatch unnamed", &CloudWatchPutMetricPayload{Namespace: "App", MetricData: []CloudWatchMetricDatum{{Value: 1}}}, "metric_data"},
+	}
+	for _, tc := range cases {
+		err := tc.p.Validate()
+		if tc.field == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error %v", tc.name, err)
+			}
+			continue
+		}
+		var verr *PayloadValidationError
+		if !errors.As(err, &verr) || verr.Field != tc.field {
+			t.Errorf("%s: err = %v, want field %s", tc.name, err, tc.field)
+		}
+	}
+}
+
+func TestMapHelpersWrapTypedPayloads(t *testing.T) {
+	typed := (&SqsSendMessagePayload{MessageBody: "b", QueueURL: "q", DelaySeconds: 5}).ToPayload()
+	helper := NewSqsSendMessagePayloadWithOptions("b", "q", 5, "", "", nil)
+	if !reflect.DeepEqual(typed, helper) {
+		t.Errorf("typed %v != helper %v", typed, helper)
+	}
+	if p := NewEc2StopInstancesPayloadWithOptions([]string{"i-1"}, true, false); p["hibernate"] != true {
+		t.Errorf("unexpected payload: %v", p)
+	}
+	ts := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	cw := NewCloudWatchPutMetricPayload("App", []CloudWatchMetricDatum{{
+		MetricName: "queue_depth", Value: 12, Unit: "Count", Dimensions: map[string]string{"queue": "jobs"}, Timestamp: ts,
+	}})
+	want := map[string]any{
+		"namespace": "App",
+		"metric_data": []map[string]any{{
+			"metric_name": "queue_depth",
+			"value":       12.0,
+			"unit":        "Count",
+			"dimensions":  map[string]string{"queue": "jobs"},
+			"timestamp":   "2026-01-02T03:04:05Z",
+		}},
+	}
+	if !reflect.DeepEqual(cw, want) {
+		t.Errorf("cloudwatch payload = %v", cw)
+	}
+}
@@ -0,0 +1,187 @@
+// Streaming WASM plugin upload for the Go ActeonClient.
+//
+// RegisterPlugin carries the module base64-encoded inside a JSON body,
+// which holds the whole module (plus a third again for the encoding) in
+// memory. The functions here stream the module to the gateway's binary
+// upload endpoint as multipart/form-data over chunked transfer encoding
+// instead: a `metadata` JSON part, the raw `module` part, and a
+// trailing `sha256` part computed while the module streams. The
+// gateway rejects the upload if its digest differs, and the client
+// double-checks the digest the gateway reports back.
+
+package acteon
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+)
+
+// PluginUploadOptions configures RegisterPluginFromFile and
+// RegisterPluginFromReader. All fields are optional.
+type PluginUploadOptions struct {
+	Description string
+	Config      *WasmPluginConfig
+	// Signature and PublicKeyRef are as on RegisterPluginRequest.
+	Signature    string
+	PublicKeyRef string
+	// Progress, if set, is called as module bytes are sent. total is
+	// -1 when the size is not known up front.
+	Progress func(sent, total int64)
+}
+
+type pluginUploadMetadata struct {
+	Name         string            `json:"name"`
+	Description  string            `json:"description,omitempty"`
+	Config       *WasmPluginConfig `json:"config,omitempty"`
+	Signature    string            `json:"signature,omitempty"`
+	PublicKeyRef string            `json:"public_key_ref,omitempty"`
+}
+
+// RegisterPluginFromFile registers a WASM plugin by streaming the
+// module at path to the gateway.
+func (c *Client) RegisterPluginFromFile(ctx context.Context, name, path string, opts *PluginUploadOptions) (*WasmPlugin, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	defer f.Close()
+
+	size := int64(-1)
+	if info, err := f.Stat(); err == nil {
+		size = info.Size()
+	}
+	return c.RegisterPluginFromReader(ctx, name, f, size, opts)
+}
+
+// RegisterPluginFromReader registers a WASM plugin by streaming the
+// module read from r. size is used only for progress reporting; pass
+// -1 if it is unknown. The module is never buffered in full.
+func (c *Client) RegisterPluginFromReader(ctx context.Context, name string, r io.Reader, size int64, opts *PluginUploadOptions) (*WasmPlugin, error) {
+	if opts == nil {
+		opts = &PluginUploadOptions{}
+	}
+	meta, err := json.Marshal(pluginUploadMetadata{
+		Name:         name,
+		Description:  opts.Description,
+		Config:       opts.Config,
+		Signature:    opts.Signature,
+		PublicKeyRef: opts.PublicKeyRef,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	digest := make(chan string, 1)
+	go func() {
+		sum, err := writePluginUpload(mw, meta, r, size, opts.Progress)
+		pw.CloseWithError(err)
+		digest <- sum
+	}()
+
+	resp, err := c.doRequestExt(ctx, http.MethodPost, "/v1/plugins/upload", nil, requestOpts{
+		rawBody:     pr,
+		contentType: mw.FormDataContentType(),
+	})
+	// Unblock the writer goroutine if the request ended early.
+	pr.Close()
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated {
+		var result WasmPlugin
+		if err := c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		var sum string
+		select {
+		case sum = <-digest:
+		case <-ctx.Done():
+			return nil, &ConnectionError{Message: ctx.Err().Error()}
+		}
+		if result.ModuleSha256 != nil && sum != "" && *result.ModuleSha256 != sum {
+			return nil, &APIError{
+				Code:    "CHECKSUM_MISMATCH",
+				Message: fmt.Sprintf("gateway stored module with sha256 %s, uploaded %s", *result.ModuleSha256, sum),
+			}
+		}
+		return &result, nil
+	}
+
+	var errResp ErrorResponse
+	if err := json.Unmarshal(body, &errResp); err != nil {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to upload plugin"}
+	}
+	return nil, &APIError{Code: errResp.Code, Message: errResp.Message, Retryable: errResp.Retryable}
+}
+
+// writePluginUpload streams the multipart body and returns the hex
+// digest of the module, or "" if streaming failed. The sha256 part
+// comes last so the digest is computed in the same pass as the upload.
+func writePluginUpload(mw *multipart.Writer, meta []byte, r io.Reader, size int64, progress func(sent, total int64)) (string, error) {
+	metaHeader := textproto.MIMEHeader{}
+	metaHeader.Set("Content-Disposition", `form-data; name="metadata"`)
+	metaHeader.Set("Content-Type", "application/json")
+	part, err := mw.CreatePart(metaHeader)
+	if err != nil {
+		return "", err
+	}
+	if _, err := part.Write(meta); err != nil {
+		return "", err
+	}
+
+	moduleHeader := textproto.MIMEHeader{}
+	moduleHeader.Set("Content-Disposition", `form-data; name="module"; filename="module.wasm"`)
+	moduleHeader.Set("Content-Type", "application/wasm")
+	part, err = mw.CreatePart(moduleHeader)
+	if err != nil {
+		return "", err
+	}
+	hasher := sha256.New()
+	dst := io.MultiWriter(part, hasher)
+	if progress != nil {
+		dst = &progressWriter{w: dst, total: size, fn: progress}
+	}
+	if _, err := io.Copy(dst, r); err != nil {
+		return "", err
+	}
+
+	sum := hex.EncodeToString(hasher.Sum(nil))
+	if err := mw.WriteField("sha256", sum); err != nil {
+		return "", err
+	}
+	if err := mw.Close(); err != nil {
+		return "", err
+	}
+	return sum, nil
+}
+
+type progressWriter struct {
+	w     io.Writer
+	sent  int64
+	total int64
+	fn    func(sent, total int64)
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.sent += int64(n)
+	p.fn(p.sent, p.total)
+	return n, err
+}
@@ -0,0 +1,98 @@
+package acteon
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// uploadServer parses the multipart upload the way the gateway does and
+// replies with the digest it computed over the received module.
+func uploadServer(t *testing.T, reportSum func(actual string) string) (*httptest.Server, *map[string]string) {
+	t.Helper()
+	fields := map[string]string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/plugins/upload" {
+			t.Errorf("request = %s %s", r.Method, r.URL.Path)
+		}
+		mr, err := r.MultipartReader()
+		if err != nil {
+			t.Errorf("multipart: %v", err)
+			return
+		}
+		var module []byte
+		for {
+			p, err := mr.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Errorf("next part: %v", err)
+				return
+			}
+			data, _ := io.ReadAll(p)
+			if p.FormName() == "module" {
+				module = data
+			}
+			fields[p.FormName()] = string(data)
+		}
+		h := sha256.Sum256(module)
+		sum := reportSum(hex.EncodeToString(h[:]))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]any{"name": "guard", "status": "active", "enabled": true, "module_sha256": sum})
+	}))
+	return srv, &fields
+}
+
+func TestRegisterPluginFromFileStreamsModule(t *testing.T) {
+	srv, fields := uploadServer(t, func(actual string) string { return actual })
+	defer srv.Close()
+
+	module := bytes.Repeat([]byte{0x00, 0x61, 0x73, 0x6d}, 10_000)
+	path := filepath.Join(t.TempDir(), "guard.wasm")
+	if err := os.WriteFile(path, module, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var lastSent, lastTotal int64
+	plugin, err := NewClient(srv.URL).RegisterPluginFromFile(context.Background(), "guard", path, &PluginUploadOptions{
+		Description: "PII guard",
+		Progress:    func(sent, total int64) { lastSent, lastTotal = sent, total },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plugin.Name != "guard" {
+		t.Errorf("plugin = %+v", plugin)
+	}
+	if lastSent != int64(len(module)) || lastTotal != int64(len(module)) {
+		t.Errorf("progress = %d/%d, want %d", lastSent, lastTotal, len(module))
+	}
+	want := sha256.Sum256(module)
+	if (*fields)["sha256"] != hex.EncodeToString(want[:]) {
+		t.Errorf("sha256 field = %q", (*fields)["sha256"])
+	}
+	if (*fields)["metadata"] != `{"name":"guard","description":"PII guard"}` {
+		t.Errorf("metadata = %s", (*fields)["metadata"])
+	}
+}
+
+func TestRegisterPluginFromReaderDetectsChecksumMismatch(t *testing.T) {
+	srv, _ := uploadServer(t, func(string) string { return "deadbeef" })
+	defer srv.Close()
+
+	_, err := NewClient(srv.URL).RegisterPluginFromReader(context.Background(), "guard", bytes.NewReader([]byte("wasm")), -1, nil)
+	apiErr, ok := err.(*APIError)
+	if !ok || apiErr.Code != "CHECKSUM_MISMATCH" {
+		t.Fatalf("expected checksum mismatch, got %v", err)
+	}
+}
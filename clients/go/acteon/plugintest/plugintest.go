@@ -0,0 +1,208 @@
+// Package plugintest runs golden-vector fixtures against a WASM plugin
+// registered on an Acteon gateway, reporting mismatches as test
+// failures.
+//
+// A fixture directory holds one pair of files per case:
+//
+//	pii-email.input.json     {"function": "evaluate", "input": {...}}
+//	pii-email.expected.json  {"verdict": false, "message": "contains email"}
+//
+// The input file is an acteon.PluginInvocationRequest. The expected
+// file lists the outcome the plugin must produce: Verdict always
+// compared, Message compared when present, and Metadata compared key
+// by key so plugins may add fields without breaking fixtures. An
+// expected file may instead set "error" to assert the invocation
+// fails with a message containing that text.
+//
+// Typical use from a plugin's CI test:
+//
+//	func TestGuardPlugin(t *testing.T) {
+//		client := acteon.NewClient(os.Getenv("ACTEON_URL"))
+//		plugintest.Run(t, client, "pii-guard", "testdata/pii-guard")
+//	}
+//
+// Set ACTEON_PLUGINTEST_UPDATE=1 to rewrite the expected files from
+// the plugin's current output instead of comparing.
+package plugintest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+// UpdateEnv is the environment variable that switches Run into golden
+// file update mode.
+const UpdateEnv = "ACTEON_PLUGINTEST_UPDATE"
+
+const (
+	inputSuffix    = ".input.json"
+	expectedSuffix = ".expected.json"
+)
+
+// Invoker is the subset of *acteon.Client that Run needs.
+type Invoker interface {
+	InvokePluginBatch(ctx context.Context, name string, reqs []acteon.PluginInvocationRequest) (*acteon.PluginBatchInvocationResponse, error)
+}
+
+// Expectation is the contents of a `.expected.json` file.
+type Expectation struct {
+	Verdict  bool           `json:"verdict"`
+	Message  *string        `json:"message,omitempty"`
+	Metadata map[string]any `json:"metadata,omitempty"`
+	Error    string         `json:"error,omitempty"`
+}
+
+// Fixture is one golden-vector case loaded from a directory.
+type Fixture struct {
+	Name         string
+	Request      acteon.PluginInvocationRequest
+	Expect       Expectation
+	ExpectedPath string
+}
+
+// LoadFixtures reads every `<case>.input.json` in dir together with its
+// `<case>.expected.json`, sorted by case name. A missing expected file
+// is an error unless update mode is on.
+func LoadFixtures(dir string) ([]Fixture, error) {
+	inputs, err := filepath.Glob(filepath.Join(dir, "*"+inputSuffix))
+	if err != nil {
+		return nil, err
+	}
+	if len(inputs) == 0 {
+		return nil, fmt.Errorf("plugintest: no %s fixtures in %s", inputSuffix, dir)
+	}
+	sort.Strings(inputs)
+
+	update := os.Getenv(UpdateEnv) != ""
+	fixtures := make([]Fixture, 0, len(inputs))
+	for _, in := range inputs {
+		name := strings.TrimSuffix(filepath.Base(in), inputSuffix)
+		f := Fixture{Name: name, ExpectedPath: filepath.Join(dir, name+expectedSuffix)}
+		if err := readJSON(in, &f.Request); err != nil {
+			return nil, err
+		}
+		if err := readJSON(f.ExpectedPath, &f.Expect); err != nil && !(update && os.IsNotExist(err)) {
+			return nil, err
+		}
+		fixtures = append(fixtures, f)
+	}
+	return fixtures, nil
+}
+
+// Run loads the fixtures in dir, invokes plugin once per fixture in a
+// single batch, and reports each case as a subtest of t.
+func Run(t *testing.T, client Invoker, plugin, dir string) {
+	t.Helper()
+	fixtures, err := LoadFixtures(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reqs := make([]acteon.PluginInvocationRequest, len(fixtures))
+	for i, f := range fixtures {
+		reqs[i] = f.Request
+	}
+	resp, err := client.InvokePluginBatch(context.Background(), plugin, reqs)
+	if err != nil {
+		t.Fatalf("plugintest: invoke %s: %v", plugin, err)
+	}
+	if len(resp.Results) != len(fixtures) {
+		t.Fatalf("plugintest: %d results for %d fixtures", len(resp.Results), len(fixtures))
+	}
+
+	update := os.Getenv(UpdateEnv) != ""
+	for i, f := range fixtures {
+		result := resp.Results[i]
+		t.Run(f.Name, func(t *testing.T) {
+			if update {
+				if err := writeExpected(f.ExpectedPath, result); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			for _, problem := range Compare(f.Expect, result) {
+				t.Error(problem)
+			}
+		})
+	}
+}
+
+// Compare returns a description of each way result differs from want.
+// It is exported for callers that drive fixtures themselves.
+func Compare(want Expectation, result acteon.PluginBatchResult) []string {
+	var problems []string
+	if want.Error != "" {
+		if result.Error == nil {
+			problems = append(problems, fmt.Sprintf("expected error containing %q, got verdict %v", want.Error, result.Verdict))
+		} else if !strings.Contains(*result.Error, want.Error) {
+			problems = append(problems, fmt.Sprintf("error = %q, want it to contain %q", *result.Error, want.Error))
+		}
+		return problems
+	}
+	if result.Error != nil {
+		return []string{fmt.Sprintf("invocation failed: %s", *result.Error)}
+	}
+
+	if result.Verdict != want.Verdict {
+		problems = append(problems, fmt.Sprintf("verdict = %v, want %v", result.Verdict, want.Verdict))
+	}
+	if want.Message != nil {
+		got := "<nil>"
+		if result.Message != nil {
+			got = *result.Message
+		}
+		if result.Message == nil || *result.Message != *want.Message {
+			problems = append(problems, fmt.Sprintf("message = %q, want %q", got, *want.Message))
+		}
+	}
+	keys := make([]string, 0, len(want.Metadata))
+	for k := range want.Metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		got, ok := result.Metadata[k]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("metadata[%q] missing, want %v", k, want.Metadata[k]))
+		} else if !reflect.DeepEqual(got, want.Metadata[k]) {
+			problems = append(problems, fmt.Sprintf("metadata[%q] = %v, want %v", k, got, want.Metadata[k]))
+		}
+	}
+	return problems
+}
+
+func writeExpected(path string, result acteon.PluginBatchResult) error {
+	exp := Expectation{
+		Verdict:  result.Verdict,
+		Message:  result.Message,
+		Metadata: result.Metadata,
+	}
+	if result.Error != nil {
+		exp = Expectation{Error: *result.Error}
+	}
+	data, err := json.MarshalIndent(exp, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+func readJSON(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("plugintest: %s: %w", path, err)
+	}
+	return nil
+}
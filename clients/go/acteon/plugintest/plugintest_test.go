@@ -0,0 +1,96 @@
+package plugintest
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+type fakeInvoker struct {
+	got     []acteon.PluginInvocationRequest
+	results []acteon.PluginBatchResult
+}
+
+func (f *fakeInvoker) InvokePluginBatch(_ context.Context, _ string, reqs []acteon.PluginInvocationRequest) (*acteon.PluginBatchInvocationResponse, error) {
+	f.got = reqs
+	return &acteon.PluginBatchInvocationResponse{Results: f.results}, nil
+}
+
+func writeFixture(t *testing.T, dir, name, input, expected string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name+inputSuffix), []byte(input), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if expected != "" {
+		if err := os.WriteFile(filepath.Join(dir, name+expectedSuffix), []byte(expected), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func strPtr(s string) *string { return &s }
+
+func TestRunPassesMatchingFixtures(t *testing.T) {
+	dir := t.TempDir()
+	writeFixture(t, dir, "a-clean", `{"input":{"text":"hi"}}`, `{"verdict":true}`)
+	writeFixture(t, dir, "b-email", `{"function":"evaluate","input":{"text":"x@y.z"}}`,
+		`{"verdict":false,"message":"contains email","metadata":{"score":0.9}}`)
+
+	inv := &fakeInvoker{results: []acteon.PluginBatchResult{
+		{PluginInvocationResponse: acteon.PluginInvocationResponse{Verdict: true}},
+		{PluginInvocationResponse: acteon.PluginInvocationResponse{
+			Verdict:  false,
+			Message:  strPtr("contains email"),
+			Metadata: map[string]any{"score": 0.9, "extra": "ignored"},
+		}},
+	}}
+	Run(t, inv, "pii-guard", dir)
+
+	if len(inv.got) != 2 || inv.got[1].Function != "evaluate" {
+		t.Errorf("requests = %+v", inv.got)
+	}
+}
+
+func TestCompareReportsMismatches(t *testing.T) {
+	problems := Compare(
+		Expectation{Verdict: true, Message: strPtr("ok"), Metadata: map[string]any{"score": 1.0}},
+		acteon.PluginBatchResult{PluginInvocationResponse: acteon.PluginInvocationResponse{Verdict: false}},
+	)
+	joined := strings.Join(problems, "\n")
+	for _, want := range []string{"verdict = false, want true", `message = "<nil>", want "ok"`, `metadata["score"] missing`} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("problems missing %q:\n%s", want, joined)
+		}
+	}
+}
+
+func TestCompareExpectedError(t *testing.T) {
+	if p := Compare(Expectation{Error: "out of fuel"}, acteon.PluginBatchResult{Error: strPtr("wasm trap: out of fuel")}); len(p) != 0 {
+		t.Errorf("unexpected problems: %v", p)
+	}
+	if p := Compare(Expectation{Error: "out of fuel"}, acteon.PluginBatchResult{}); len(p) != 1 {
+		t.Errorf("expected one problem, got %v", p)
+	}
+}
+
+func TestUpdateModeWritesExpectedFiles(t *testing.T) {
+	t.Setenv(UpdateEnv, "1")
+	dir := t.TempDir()
+	writeFixture(t, dir, "new-case", `{"input":{}}`, "")
+
+	Run(t, &fakeInvoker{results: []acteon.PluginBatchResult{
+		{PluginInvocationResponse: acteon.PluginInvocationResponse{Verdict: true, Message: strPtr("fine")}},
+	}}, "p", dir)
+
+	data, err := os.ReadFile(filepath.Join(dir, "new-case"+expectedSuffix))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"message": "fine"`) {
+		t.Errorf("expected file = %s", data)
+	}
+}
@@ -206,7 +206,7 @@ func (c *Client) queueDoJSON(
 		return resp, &HTTPError{Status: resp.StatusCode, Message: string(respBody)}
 	}
 	if out != nil && len(respBody) > 0 {
-		if err := json.Unmarshal(respBody, out); err != nil {
+		if err := c.decodeJSON(respBody, out); err != nil {
 			return resp, &ConnectionError{Message: err.Error()}
 		}
 	}
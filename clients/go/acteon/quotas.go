@@ -0,0 +1,276 @@
+// Quota policy surface for the Go client.
+
+package acteon
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// QuotaService manages quota policies and reports their usage.
+type QuotaService struct {
+	c *Client
+}
+
+// Quotas returns the client's QuotaService.
+func (c *Client) Quotas() *QuotaService {
+	return &QuotaService{c: c}
+}
+
+// Create creates a quota policy.
+func (s *QuotaService) Create(ctx context.Context, req *CreateQuotaRequest) (*QuotaPolicy, error) {
+	resp, err := s.c.doRequest(ctx, http.MethodPost, "/v1/quotas", req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusCreated {
+		var result QuotaPolicy
+		if err := s.c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to create quota")
+}
+
+// List lists quota policies with optional namespace, tenant,
+// provider, and principal filters. Pass "generic" as the provider
+// filter to match only policies without a provider scope; pass a
+// provider name (e.g. "slack") to match only per-provider policies.
+// principal filters to policies scoped to a given caller.
+func (s *QuotaService) List(ctx context.Context, namespace, tenant, provider, principal *string) (*ListQuotasResponse, error) {
+	if selector, ok := labelSelectorFrom(ctx); ok {
+		quotas, err := s.all(ctx, namespace, tenant, provider, principal)
+		if err != nil {
+			return nil, err
+		}
+		quotas = selectLabels(quotas, selector, func(q QuotaPolicy) map[string]string { return q.Labels })
+		return &ListQuotasResponse{Quotas: quotas, Count: len(quotas)}, nil
+	}
+	return s.list(ctx, namespace, tenant, provider, principal, 0, 0)
+}
+
+// all lists every quota policy matching the filters, page by page.
+func (s *QuotaService) all(ctx context.Context, namespace, tenant, provider, principal *string) ([]QuotaPolicy, error) {
+	return listAll(func(limit, offset int) ([]QuotaPolicy, error) {
+		list, err := s.list(ctx, namespace, tenant, provider, principal, limit, offset)
+		if err != nil {
+			return nil, err
+		}
+		return list.Quotas, nil
+	})
+}
+
+// list fetches one page of quota policies; a zero limit leaves the
+// page size to the gateway.
+func (s *QuotaService) list(ctx context.Context, namespace, tenant, provider, principal *string, limit, offset int) (*ListQuotasResponse, error) {
+	params := url.Values{}
+	if namespace != nil {
+		params.Set("namespace", *namespace)
+	}
+	if tenant != nil {
+		params.Set("tenant", *tenant)
+	}
+	if provider != nil {
+		params.Set("provider", *provider)
+	}
+	if principal != nil {
+		params.Set("principal", *principal)
+	}
+	setPage(params, limit, offset)
+
+	path := "/v1/quotas"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	resp, err := s.c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list quotas"}
+	}
+
+	var result ListQuotasResponse
+	if err := s.c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &result, nil
+}
+
+// Get gets a single quota policy by ID.
+func (s *QuotaService) Get(ctx context.Context, quotaID string) (*QuotaPolicy, error) {
+	path := fmt.Sprintf("/v1/quotas/%s", quotaID)
+
+	resp, err := s.c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get quota"}
+	}
+
+	var result QuotaPolicy
+	if err := s.c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &result, nil
+}
+
+// Update updates a quota policy.
+func (s *QuotaService) Update(ctx context.Context, quotaID string, update *UpdateQuotaRequest) (*QuotaPolicy, error) {
+	resp, err := s.c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/v1/quotas/%s", quotaID), update)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var result QuotaPolicy
+		if err := s.c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Quota not found: %s", quotaID)}
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to update quota")
+}
+
+// Delete deletes a quota policy.
+func (s *QuotaService) Delete(ctx context.Context, quotaID, namespace, tenant string) error {
+	params := url.Values{}
+	params.Set("namespace", namespace)
+	params.Set("tenant", tenant)
+	path := fmt.Sprintf("/v1/quotas/%s?%s", quotaID, params.Encode())
+
+	resp, err := s.c.doRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Quota not found: %s", quotaID)}
+	}
+	return &HTTPError{Status: resp.StatusCode, Message: "Failed to delete quota"}
+}
+
+// Usage gets current usage statistics for a quota policy.
+func (s *QuotaService) Usage(ctx context.Context, quotaID string) (*QuotaUsage, error) {
+	path := fmt.Sprintf("/v1/quotas/%s/usage", quotaID)
+
+	resp, err := s.c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Quota not found: %s", quotaID)}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get quota usage"}
+	}
+
+	var result QuotaUsage
+	if err := s.c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &result, nil
+}
+
+// Check asks the gateway whether n more actions for the given
+// namespace and tenant would exceed any matching quota, without
+// consuming it. Batch jobs can use this to defer work up front rather
+// than burning part of a batch into QuotaExceeded outcomes.
+func (s *QuotaService) Check(ctx context.Context, namespace, tenant string, n int) (*QuotaCheckResult, error) {
+	body := &QuotaCheckRequest{Namespace: namespace, Tenant: tenant, Count: n}
+	resp, err := s.c.doRequest(ctx, http.MethodPost, "/v1/quotas/check", body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var result QuotaCheckResult
+		if err := s.c.decodeJSON(respBody, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	return nil, errorFromResponse(resp, respBody, "Failed to check quota")
+}
+
+// CreateQuota is shorthand for c.Quotas().Create.
+func (c *Client) CreateQuota(ctx context.Context, req *CreateQuotaRequest) (*QuotaPolicy, error) {
+	return c.Quotas().Create(ctx, req)
+}
+
+// ListQuotas is shorthand for c.Quotas().List.
+func (c *Client) ListQuotas(ctx context.Context, namespace, tenant, provider, principal *string) (*ListQuotasResponse, error) {
+	return c.Quotas().List(ctx, namespace, tenant, provider, principal)
+}
+
+// GetQuota is shorthand for c.Quotas().Get.
+func (c *Client) GetQuota(ctx context.Context, quotaID string) (*QuotaPolicy, error) {
+	return c.Quotas().Get(ctx, quotaID)
+}
+
+// UpdateQuota is shorthand for c.Quotas().Update.
+func (c *Client) UpdateQuota(ctx context.Context, quotaID string, update *UpdateQuotaRequest) (*QuotaPolicy, error) {
+	return c.Quotas().Update(ctx, quotaID, update)
+}
+
+// DeleteQuota is shorthand for c.Quotas().Delete.
+func (c *Client) DeleteQuota(ctx context.Context, quotaID, namespace, tenant string) error {
+	return c.Quotas().Delete(ctx, quotaID, namespace, tenant)
+}
+
+// GetQuotaUsage is shorthand for c.Quotas().Usage.
+func (c *Client) GetQuotaUsage(ctx context.Context, quotaID string) (*QuotaUsage, error) {
+	return c.Quotas().Usage(ctx, quotaID)
+}
+
+// CheckQuota is shorthand for c.Quotas().Check.
+func (c *Client) CheckQuota(ctx context.Context, namespace, tenant string, n int) (*QuotaCheckResult, error) {
+	return c.Quotas().Check(ctx, namespace, tenant, n)
+}
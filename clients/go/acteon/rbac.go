@@ -0,0 +1,235 @@
+// Role-based access control surface for the Go client.
+//
+// The gateway authorizes every caller by a role (admin, operator, or
+// viewer), which picks the endpoint groups it may call, and a list of
+// grants, which scope it to tenants, namespaces, providers, and action
+// types. Role bindings attach a role and grants to a principal; this
+// file manages them over `/v1/rbac` and reports the caller's own
+// effective access via WhoAmI.
+
+package acteon
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Role names understood by the gateway.
+const (
+	RoleAdmin    = "admin"
+	RoleOperator = "operator"
+	RoleViewer   = "viewer"
+)
+
+// RoleInfo describes one role and the permissions it carries.
+type RoleInfo struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Permissions []string `json:"permissions"`
+}
+
+// Grant scopes a principal to a set of tenants, namespaces, providers,
+// and action types. Each list accepts "*" as a wildcard, and tenant
+// matching is hierarchical: a grant on "acme" also covers "acme.prod".
+type Grant struct {
+	Tenants    []string `json:"tenants"`
+	Namespaces []string `json:"namespaces"`
+	Providers  []string `json:"providers,omitempty"`
+	Actions    []string `json:"actions"`
+	// AgentID binds a bus agent identity to this grant's scope.
+	AgentID string `json:"agent_id,omitempty"`
+}
+
+// RoleBinding attaches a role and grants to a user or API key.
+type RoleBinding struct {
+	ID string `json:"id"`
+	// Principal is the user name or API key ID the binding applies to.
+	Principal string  `json:"principal"`
+	Role      string  `json:"role"`
+	Grants    []Grant `json:"grants"`
+	// Namespace and Tenant are the scope the binding was created in.
+	Namespace string    `json:"namespace"`
+	Tenant    string    `json:"tenant"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CreateRoleBindingRequest is the request body for CreateRoleBinding.
+type CreateRoleBindingRequest struct {
+	Principal string  `json:"principal"`
+	Role      string  `json:"role"`
+	Grants    []Grant `json:"grants"`
+	Namespace string  `json:"namespace"`
+	Tenant    string  `json:"tenant"`
+}
+
+// UpdateRoleBindingRequest is the request body for UpdateRoleBinding.
+// Nil fields are left unchanged.
+type UpdateRoleBindingRequest struct {
+	Role   *string `json:"role,omitempty"`
+	Grants []Grant `json:"grants,omitempty"`
+}
+
+// ListRoleBindingsResponse is the response from ListRoleBindings.
+type ListRoleBindingsResponse struct {
+	Bindings []RoleBinding `json:"bindings"`
+	Count    int           `json:"count"`
+}
+
+// CallerIdentity is the authenticated caller as the gateway sees it.
+type CallerIdentity struct {
+	// ID is the user name or API key ID.
+	ID   string `json:"id"`
+	Role string `json:"role"`
+	// Grants are the caller's effective scopes.
+	Grants []Grant `json:"grants"`
+	// AuthMethod is how the caller authenticated, e.g. "jwt" or "api_key".
+	AuthMethod string `json:"auth_method"`
+}
+
+// ListRoles lists the roles defined on the gateway.
+func (c *Client) ListRoles(ctx context.Context) ([]RoleInfo, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/v1/rbac/roles", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list roles"}
+	}
+
+	var result struct {
+		Roles []RoleInfo `json:"roles"`
+	}
+	if err := c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return result.Roles, nil
+}
+
+// ListRoleBindings lists role bindings, optionally filtered by
+// namespace and tenant.
+func (c *Client) ListRoleBindings(ctx context.Context, namespace, tenant *string) (*ListRoleBindingsResponse, error) {
+	params := url.Values{}
+	if namespace != nil {
+		params.Set("namespace", *namespace)
+	}
+	if tenant != nil {
+		params.Set("tenant", *tenant)
+	}
+
+	path := "/v1/rbac/bindings"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list role bindings"}
+	}
+
+	var result ListRoleBindingsResponse
+	if err := c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &result, nil
+}
+
+// CreateRoleBinding binds a role and grants to a principal within a
+// namespace and tenant.
+func (c *Client) CreateRoleBinding(ctx context.Context, req *CreateRoleBindingRequest) (*RoleBinding, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/v1/rbac/bindings", req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusCreated {
+		var result RoleBinding
+		if err := c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to create role binding")
+}
+
+// UpdateRoleBinding changes the role or grants of an existing binding.
+func (c *Client) UpdateRoleBinding(ctx context.Context, bindingID string, update *UpdateRoleBindingRequest) (*RoleBinding, error) {
+	resp, err := c.doRequest(ctx, http.MethodPut, "/v1/rbac/bindings/"+url.PathEscape(bindingID), update)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var result RoleBinding
+		if err := c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Role binding not found: %s", bindingID)}
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to update role binding")
+}
+
+// DeleteRoleBinding removes a role binding.
+func (c *Client) DeleteRoleBinding(ctx context.Context, bindingID string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, "/v1/rbac/bindings/"+url.PathEscape(bindingID), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Role binding not found: %s", bindingID)}
+	}
+	return &HTTPError{Status: resp.StatusCode, Message: "Failed to delete role binding"}
+}
+
+// WhoAmI returns the caller's identity, role, and effective grants.
+func (c *Client) WhoAmI(ctx context.Context) (*CallerIdentity, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/v1/auth/whoami", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get caller identity"}
+	}
+
+	var result CallerIdentity
+	if err := c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &result, nil
+}
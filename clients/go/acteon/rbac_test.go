@@ -0,0 +1,73 @@
+package acteon
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestCreateRoleBinding(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 201, map[string]any{
+		"id": "rb-1", "principal": "onboarding-bot", "role": "operator",
+		"grants":    []map[string]any{{"tenants": []string{"acme"}, "namespaces": []string{"*"}, "actions": []string{"*"}}},
+		"namespace": "alerts", "tenant": "acme",
+		"created_at": "2026-03-01T10:00:00Z", "updated_at": "2026-03-01T10:00:00Z",
+	})
+	defer teardown()
+
+	binding, err := NewClient(url).CreateRoleBinding(context.Background(), &CreateRoleBindingRequest{
+		Principal: "onboarding-bot",
+		Role:      RoleOperator,
+		Grants:    []Grant{{Tenants: []string{"acme"}, Namespaces: []string{"*"}, Actions: []string{"*"}}},
+		Namespace: "alerts",
+		Tenant:    "acme",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.method != "POST" || captured.path != "/v1/rbac/bindings" {
+		t.Errorf("request = %s %s", captured.method, captured.path)
+	}
+	var sent map[string]any
+	if err := json.Unmarshal(captured.body, &sent); err != nil {
+		t.Fatal(err)
+	}
+	if sent["principal"] != "onboarding-bot" || sent["role"] != "operator" {
+		t.Errorf("body = %s", captured.body)
+	}
+	if binding.ID != "rb-1" || len(binding.Grants) != 1 || binding.Grants[0].Tenants[0] != "acme" {
+		t.Errorf("binding = %+v", binding)
+	}
+}
+
+func TestListRoleBindingsFilters(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{"bindings": []any{}, "count": 0})
+	defer teardown()
+
+	ns, tenant := "alerts", "acme"
+	if _, err := NewClient(url).ListRoleBindings(context.Background(), &ns, &tenant); err != nil {
+		t.Fatal(err)
+	}
+	if captured.path != "/v1/rbac/bindings" || captured.query != "namespace=alerts&tenant=acme" {
+		t.Errorf("request = %s?%s", captured.path, captured.query)
+	}
+}
+
+func TestWhoAmI(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{
+		"id": "ci-key", "role": "viewer", "auth_method": "api_key",
+		"grants": []map[string]any{{"tenants": []string{"acme"}, "namespaces": []string{"alerts"}, "providers": []string{"*"}, "actions": []string{"*"}}},
+	})
+	defer teardown()
+
+	me, err := NewClient(url).WhoAmI(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.path != "/v1/auth/whoami" {
+		t.Errorf("path = %s", captured.path)
+	}
+	if me.Role != RoleViewer || me.AuthMethod != "api_key" || me.Grants[0].Namespaces[0] != "alerts" {
+		t.Errorf("identity = %+v", me)
+	}
+}
@@ -0,0 +1,269 @@
+// Record/replay of gateway interactions for the Go client.
+//
+// WithRecorder captures the requests a client makes and the responses
+// the gateway sends to a cassette file, and later serves the same
+// responses from that file without a gateway. Tests written against a
+// real gateway once stay fast and hermetic afterwards, without
+// hand-written mock responses.
+
+package acteon
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// RecordMode selects whether WithRecorder records or replays.
+type RecordMode int
+
+const (
+	// RecordModeReplay serves every request from the cassette and
+	// fails a request it has no recorded interaction for.
+	RecordModeReplay RecordMode = iota
+	// RecordModeRecord sends requests to the gateway and rewrites the
+	// cassette with the interactions.
+	RecordModeRecord
+	// RecordModeAuto replays when the cassette exists and records a
+	// new one otherwise.
+	RecordModeAuto
+)
+
+// sanitizedHeaders are left out of cassettes so that fixtures can be
+// committed.
+var sanitizedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie", "X-Api-Key"}
+
+// CassetteError is returned for a request WithRecorder cannot serve:
+// the cassette could not be read or written, or in replay it holds no
+// interaction for the request.
+type CassetteError struct {
+	Path string
+	Err  error
+}
+
+func (e *CassetteError) Error() string {
+	return fmt.Sprintf("cassette %s: %v", e.Path, e.Err)
+}
+
+func (e *CassetteError) Unwrap() error { return e.Err }
+
+func (e *CassetteError) IsRetryable() bool {
+	return false
+}
+
+// WithRecorder records the client's HTTP interactions to the JSON
+// cassette at cassettePath, or replays them from it, depending on mode:
+//
+//	mode := acteon.RecordModeReplay
+//	if os.Getenv("ACTEON_RECORD") != "" {
+//		mode = acteon.RecordModeRecord
+//	}
+//	client := acteon.NewClient(url, acteon.WithRecorder("testdata/dispatch.json", mode))
+//
+// Replay matches requests by method, path, and query, ignoring bodies,
+// which carry fresh action IDs and timestamps on every run. Requests
+// with the same method and URL are answered with their recorded
+// responses in order, each once.
+//
+// Credentials and cookies are not written to the cassette. Payload
+// fields are recorded as sent, so combine WithRecorder with
+// WithPayloadScrubber when payloads hold anything that must not be
+// committed. In record mode the cassette is rewritten as each response
+// body is closed; an event stream is recorded up to the point it was
+// closed.
+func WithRecorder(cassettePath string, mode RecordMode) ClientOption {
+	return WithInterceptor((&recorder{path: cassettePath, mode: mode}).intercept)
+}
+
+// cassette is the file format of WithRecorder.
+type cassette struct {
+	Interactions []*interaction `json:"interactions"`
+}
+
+type interaction struct {
+	Request  recordedRequest   `json:"request"`
+	Response *recordedResponse `json:"response"`
+	used     bool
+}
+
+type recordedRequest struct {
+	Method string              `json:"method"`
+	URL    string              `json:"url"`
+	Header map[string][]string `json:"header,omitempty"`
+	Body   string              `json:"body,omitempty"`
+}
+
+type recordedResponse struct {
+	Status int                 `json:"status"`
+	Header map[string][]string `json:"header,omitempty"`
+	Body   string              `json:"body,omitempty"`
+}
+
+// recorder is the interceptor behind WithRecorder.
+type recorder struct {
+	path string
+	mode RecordMode
+
+	once      sync.Once
+	err       error
+	recording bool
+
+	mu       sync.Mutex
+	cassette cassette
+}
+
+func (r *recorder) intercept(next RoundTripFunc) RoundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		r.once.Do(r.load)
+		if r.err != nil {
+			return nil, &CassetteError{Path: r.path, Err: r.err}
+		}
+		if r.recording {
+			return r.record(next, req)
+		}
+		return r.replay(req)
+	}
+}
+
+// load decides between recording and replaying and reads the cassette
+// for the latter.
+func (r *recorder) load() {
+	data, err := os.ReadFile(r.path)
+	switch {
+	case r.mode == RecordModeRecord, r.mode == RecordModeAuto && errors.Is(err, fs.ErrNotExist):
+		r.recording = true
+	case err != nil:
+		r.err = err
+	default:
+		r.err = json.Unmarshal(data, &r.cassette)
+	}
+}
+
+func (r *recorder) replay(req *http.Request) (*http.Response, error) {
+	url := req.URL.RequestURI()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, in := range r.cassette.Interactions {
+		if in.used || in.Request.Method != req.Method || in.Request.URL != url {
+			continue
+		}
+		in.used = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.Status, http.StatusText(in.Response.Status)),
+			StatusCode:    in.Response.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header(in.Response.Header).Clone(),
+			Body:          io.NopCloser(strings.NewReader(in.Response.Body)),
+			ContentLength: int64(len(in.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, &CassetteError{Path: r.path, Err: fmt.Errorf("no recorded interaction for %s %s", req.Method, url)}
+}
+
+func (r *recorder) record(next RoundTripFunc, req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	in := &interaction{Request: recordedRequest{
+		Method: req.Method,
+		URL:    req.URL.RequestURI(),
+		Header: sanitize(req.Header),
+		Body:   string(body),
+	}}
+	// Reserving the slot now keeps the cassette in request order even
+	// when bodies are closed out of order.
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, in)
+	r.mu.Unlock()
+
+	resp, err := next(req)
+	if err != nil {
+		return nil, err
+	}
+	stream := strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream")
+	resp.Body = &recordingBody{ReadCloser: resp.Body, drain: !stream, done: func(data []byte) error {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		in.Response = &recordedResponse{Status: resp.StatusCode, Header: sanitize(resp.Header), Body: string(data)}
+		return r.save()
+	}}
+	return resp, nil
+}
+
+// save writes the interactions that have a response. r.mu is held.
+func (r *recorder) save() error {
+	out := cassette{Interactions: []*interaction{}}
+	for _, in := range r.cassette.Interactions {
+		if in.Response != nil {
+			out.Interactions = append(out.Interactions, in)
+		}
+	}
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, append(data, '\n'), 0o644)
+}
+
+// recordingBody keeps a copy of everything read through it and hands
+// it to done when closed. With drain set, Close first reads what the
+// caller left unread, so that the cassette has the whole body.
+type recordingBody struct {
+	io.ReadCloser
+	buf   bytes.Buffer
+	drain bool
+	once  sync.Once
+	done  func([]byte) error
+}
+
+func (b *recordingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.buf.Write(p[:n])
+	return n, err
+}
+
+// Close closes the body and records the interaction, returning any
+// error writing the cassette.
+func (b *recordingBody) Close() error {
+	if b.drain {
+		_, _ = io.Copy(&b.buf, b.ReadCloser)
+	}
+	err := b.ReadCloser.Close()
+	b.once.Do(func() {
+		if saveErr := b.done(b.buf.Bytes()); saveErr != nil {
+			err = saveErr
+		}
+	})
+	return err
+}
+
+func sanitize(h http.Header) map[string][]string {
+	out := h.Clone()
+	for _, name := range sanitizedHeaders {
+		out.Del(name)
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
@@ -0,0 +1,69 @@
+package acteon
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecorderRecordsAndReplays(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=abc")
+		switch r.URL.Path {
+		case "/v1/dispatch":
+			_, _ = w.Write([]byte(`{"Executed":{"status":"success","body":{}}}`))
+		case "/v1/audit/a1":
+			_, _ = w.Write([]byte(`{"id":"r1","action_id":"a1","outcome":"executed"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	path := filepath.Join(t.TempDir(), "fixtures", "cassette.json")
+	ctx := context.Background()
+
+	rec := NewClient(srv.URL, WithAPIKey("top-secret"), WithRecorder(path, RecordModeAuto))
+	if outcome, err := rec.Dispatch(ctx, NewAction("ns", "t1", "email", "send", map[string]any{"to": "a@b.c"})); err != nil || !outcome.IsExecuted() {
+		t.Fatalf("Dispatch = %+v, %v", outcome, err)
+	}
+	if _, err := rec.GetAuditRecord(ctx, "a1"); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "top-secret") || strings.Contains(string(data), "session=abc") {
+		t.Errorf("cassette not sanitized: %s", data)
+	}
+	srv.Close()
+
+	replay := NewClient("http://gateway.invalid", WithRecorder(path, RecordModeAuto))
+	if outcome, err := replay.Dispatch(ctx, NewAction("ns", "t1", "email", "send", nil)); err != nil || !outcome.IsExecuted() {
+		t.Errorf("replayed Dispatch = %+v, %v", outcome, err)
+	}
+	if rec, err := replay.GetAuditRecord(ctx, "a1"); err != nil || rec.ID != "r1" {
+		t.Errorf("replayed GetAuditRecord = %+v, %v", rec, err)
+	}
+	_, err = replay.GetAuditRecord(ctx, "a1")
+	var cassetteErr *CassetteError
+	if !errors.As(err, &cassetteErr) {
+		t.Errorf("second replay of a1 = %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("gateway saw %d calls, want 2", calls)
+	}
+
+	missing := NewClient("http://gateway.invalid", WithRecorder(filepath.Join(t.TempDir(), "none.json"), RecordModeReplay))
+	if _, err := missing.Health(ctx); !errors.As(err, &cassetteErr) {
+		t.Errorf("replay without cassette = %v", err)
+	}
+}
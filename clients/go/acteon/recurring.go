@@ -0,0 +1,305 @@
+// Recurring action surface for the Go client.
+
+package acteon
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// RecurringService manages recurring actions.
+type RecurringService struct {
+	c *Client
+}
+
+// Recurring returns the client's RecurringService.
+func (c *Client) Recurring() *RecurringService {
+	return &RecurringService{c: c}
+}
+
+// Create creates a recurring action.
+func (s *RecurringService) Create(ctx context.Context, recurring *CreateRecurringAction) (*CreateRecurringResponse, error) {
+	resp, err := s.c.doRequest(ctx, http.MethodPost, "/v1/recurring", recurring)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusCreated {
+		var result CreateRecurringResponse
+		if err := s.c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to create recurring action")
+}
+
+// List lists recurring actions with optional filters.
+func (s *RecurringService) List(ctx context.Context, filter *RecurringFilter) (*ListRecurringResponse, error) {
+	if selector, ok := labelSelectorFrom(ctx); ok {
+		var f RecurringFilter
+		if filter != nil {
+			f = *filter
+		}
+		actions, err := s.all(ctx, f)
+		if err != nil {
+			return nil, err
+		}
+		// List entries carry no labels, so each action is fetched.
+		matched := actions[:0]
+		for _, a := range actions {
+			detail, err := s.Get(ctx, a.ID, a.Namespace, a.Tenant)
+			if err != nil {
+				return nil, err
+			}
+			// An action deleted since it was listed is left out.
+			if detail != nil && selector.Matches(detail.Labels) {
+				matched = append(matched, a)
+			}
+		}
+		matched = pageOf(matched, f.Limit, f.Offset)
+		return &ListRecurringResponse{RecurringActions: matched, Count: len(matched)}, nil
+	}
+	return s.list(ctx, filter)
+}
+
+// all lists every recurring action matching filter, page by page,
+// ignoring its Limit and Offset.
+func (s *RecurringService) all(ctx context.Context, filter RecurringFilter) ([]RecurringSummary, error) {
+	return listAll(func(limit, offset int) ([]RecurringSummary, error) {
+		filter.Limit, filter.Offset = limit, offset
+		list, err := s.list(ctx, &filter)
+		if err != nil {
+			return nil, err
+		}
+		return list.RecurringActions, nil
+	})
+}
+
+func (s *RecurringService) list(ctx context.Context, filter *RecurringFilter) (*ListRecurringResponse, error) {
+	params := url.Values{}
+	if filter != nil {
+		if filter.Namespace != "" {
+			params.Set("namespace", filter.Namespace)
+		}
+		if filter.Tenant != "" {
+			params.Set("tenant", filter.Tenant)
+		}
+		if filter.Status != "" {
+			params.Set("status", string(filter.Status))
+		}
+		if filter.Limit > 0 {
+			params.Set("limit", strconv.Itoa(filter.Limit))
+		}
+		if filter.Offset > 0 {
+			params.Set("offset", strconv.Itoa(filter.Offset))
+		}
+	}
+
+	path := "/v1/recurring"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	resp, err := s.c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list recurring actions"}
+	}
+
+	var result ListRecurringResponse
+	if err := s.c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &result, nil
+}
+
+// Get gets details of a specific recurring action.
+func (s *RecurringService) Get(ctx context.Context, recurringID, namespace, tenant string) (*RecurringDetail, error) {
+	params := url.Values{}
+	params.Set("namespace", namespace)
+	params.Set("tenant", tenant)
+	path := fmt.Sprintf("/v1/recurring/%s?%s", recurringID, params.Encode())
+
+	resp, err := s.c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get recurring action"}
+	}
+
+	var detail RecurringDetail
+	if err := s.c.decodeBody(resp.Body, &detail); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &detail, nil
+}
+
+// Update updates a recurring action.
+func (s *RecurringService) Update(ctx context.Context, recurringID string, update *UpdateRecurringAction) (*RecurringDetail, error) {
+	resp, err := s.c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/v1/recurring/%s", recurringID), update)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var detail RecurringDetail
+		if err := s.c.decodeJSON(body, &detail); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &detail, nil
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Recurring action not found: %s", recurringID)}
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to update recurring action")
+}
+
+// Delete deletes a recurring action.
+func (s *RecurringService) Delete(ctx context.Context, recurringID, namespace, tenant string) error {
+	params := url.Values{}
+	params.Set("namespace", namespace)
+	params.Set("tenant", tenant)
+	path := fmt.Sprintf("/v1/recurring/%s?%s", recurringID, params.Encode())
+
+	resp, err := s.c.doRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Recurring action not found: %s", recurringID)}
+	}
+	return &HTTPError{Status: resp.StatusCode, Message: "Failed to delete recurring action"}
+}
+
+// Pause pauses a recurring action.
+func (s *RecurringService) Pause(ctx context.Context, recurringID, namespace, tenant string) (*RecurringDetail, error) {
+	body := &RecurringLifecycleRequest{Namespace: namespace, Tenant: tenant}
+	resp, err := s.c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/v1/recurring/%s/pause", recurringID), body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var detail RecurringDetail
+		if err := s.c.decodeJSON(respBody, &detail); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &detail, nil
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Recurring action not found: %s", recurringID)}
+	}
+	if resp.StatusCode == http.StatusConflict {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Recurring action is already paused"}
+	}
+	return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to pause recurring action"}
+}
+
+// Resume resumes a paused recurring action.
+func (s *RecurringService) Resume(ctx context.Context, recurringID, namespace, tenant string) (*RecurringDetail, error) {
+	body := &RecurringLifecycleRequest{Namespace: namespace, Tenant: tenant}
+	resp, err := s.c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/v1/recurring/%s/resume", recurringID), body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var detail RecurringDetail
+		if err := s.c.decodeJSON(respBody, &detail); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &detail, nil
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Recurring action not found: %s", recurringID)}
+	}
+	if resp.StatusCode == http.StatusConflict {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Recurring action is already active"}
+	}
+	return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to resume recurring action"}
+}
+
+// CreateRecurring is shorthand for c.Recurring().Create.
+func (c *Client) CreateRecurring(ctx context.Context, recurring *CreateRecurringAction) (*CreateRecurringResponse, error) {
+	return c.Recurring().Create(ctx, recurring)
+}
+
+// ListRecurring is shorthand for c.Recurring().List.
+func (c *Client) ListRecurring(ctx context.Context, filter *RecurringFilter) (*ListRecurringResponse, error) {
+	return c.Recurring().List(ctx, filter)
+}
+
+// GetRecurring is shorthand for c.Recurring().Get.
+func (c *Client) GetRecurring(ctx context.Context, recurringID, namespace, tenant string) (*RecurringDetail, error) {
+	return c.Recurring().Get(ctx, recurringID, namespace, tenant)
+}
+
+// UpdateRecurring is shorthand for c.Recurring().Update.
+func (c *Client) UpdateRecurring(ctx context.Context, recurringID string, update *UpdateRecurringAction) (*RecurringDetail, error) {
+	return c.Recurring().Update(ctx, recurringID, update)
+}
+
+// DeleteRecurring is shorthand for c.Recurring().Delete.
+func (c *Client) DeleteRecurring(ctx context.Context, recurringID, namespace, tenant string) error {
+	return c.Recurring().Delete(ctx, recurringID, namespace, tenant)
+}
+
+// PauseRecurring is shorthand for c.Recurring().Pause.
+func (c *Client) PauseRecurring(ctx context.Context, recurringID, namespace, tenant string) (*RecurringDetail, error) {
+	return c.Recurring().Pause(ctx, recurringID, namespace, tenant)
+}
+
+// ResumeRecurring is shorthand for c.Recurring().Resume.
+func (c *Client) ResumeRecurring(ctx context.Context, recurringID, namespace, tenant string) (*RecurringDetail, error) {
+	return c.Recurring().Resume(ctx, recurringID, namespace, tenant)
+}
@@ -0,0 +1,146 @@
+// Per-call request options for the Go ActeonClient.
+//
+// Client options apply to every call. WithRequestOptions attaches
+// options to a context instead, so a single Client can serve mixed
+// workloads — a five-minute timeout for an audit export next to a
+// two-second one for dispatch — and callers can add headers, query
+// parameters, or an idempotency key to one call without building a
+// second Client.
+
+package acteon
+
+import (
+	"context"
+	"io"
+	"maps"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// IdempotencyKeyHeader is the header IdempotencyKey sets.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// RequestOption adjusts calls made with a context from
+// WithRequestOptions. Each option applies to every HTTP request such a
+// call sends, so a method that pages through a list or retries carries
+// it on each request.
+type RequestOption func(*callOptions)
+
+type callOptions struct {
+	timeout time.Duration
+	headers map[string]string
+	query   url.Values
+}
+
+// RequestTimeout bounds each HTTP request, including its retries and
+// reading the response body, by d. A method that sends several
+// requests, such as one that pages through a list, may take longer
+// than d in total. It replaces the client-wide WithTimeout for those
+// requests, so it may be longer or shorter.
+func RequestTimeout(d time.Duration) RequestOption {
+	return func(o *callOptions) { o.timeout = d }
+}
+
+// RequestHeader sets a header on each call, overriding the client's
+// own value for the same header.
+func RequestHeader(key, value string) RequestOption {
+	return func(o *callOptions) {
+		if o.headers == nil {
+			o.headers = map[string]string{}
+		}
+		o.headers[key] = value
+	}
+}
+
+// IdempotencyKey sends key in the Idempotency-Key header so the
+// gateway can recognise a repeated write. The same key goes on every
+// request made with the context, including retries of a write, so it
+// suits calls that make one write. Apply, ImportTemplates, and
+// RetryDlq make one write per resource or entry and drop the key
+// rather than send it on each of them.
+func IdempotencyKey(key string) RequestOption {
+	return RequestHeader(IdempotencyKeyHeader, key)
+}
+
+// QueryParam adds a query parameter to each call, alongside the ones
+// the method sets itself.
+func QueryParam(key, value string) RequestOption {
+	return func(o *callOptions) {
+		if o.query == nil {
+			o.query = url.Values{}
+		}
+		o.query.Add(key, value)
+	}
+}
+
+type requestOptionsContext struct{}
+
+// WithRequestOptions returns a context that applies opts to every call
+// made with it, on top of any options already attached to ctx.
+func WithRequestOptions(ctx context.Context, opts ...RequestOption) context.Context {
+	call := &callOptions{}
+	if prev, ok := ctx.Value(requestOptionsContext{}).(*callOptions); ok {
+		call.timeout = prev.timeout
+		call.headers = maps.Clone(prev.headers)
+		if prev.query != nil {
+			call.query = url.Values{}
+			for k, v := range prev.query {
+				call.query[k] = append([]string(nil), v...)
+			}
+		}
+	}
+	for _, opt := range opts {
+		opt(call)
+	}
+	return context.WithValue(ctx, requestOptionsContext{}, call)
+}
+
+// withoutIdempotencyKey returns ctx with any IdempotencyKey removed,
+// for methods whose writes are distinct and must not share one key.
+func withoutIdempotencyKey(ctx context.Context) context.Context {
+	call, ok := ctx.Value(requestOptionsContext{}).(*callOptions)
+	if !ok {
+		return ctx
+	}
+	headers := maps.Clone(call.headers)
+	maps.DeleteFunc(headers, func(k, _ string) bool { return strings.EqualFold(k, IdempotencyKeyHeader) })
+	if len(headers) == len(call.headers) {
+		return ctx
+	}
+	stripped := *call
+	stripped.headers = headers
+	return context.WithValue(ctx, requestOptionsContext{}, &stripped)
+}
+
+// apply folds the headers and query parameters into path and opts.
+func (o *callOptions) apply(path string, opts requestOpts) (string, requestOpts) {
+	if len(o.query) > 0 {
+		sep := "?"
+		if strings.Contains(path, "?") {
+			sep = "&"
+		}
+		path += sep + o.query.Encode()
+	}
+	if len(o.headers) > 0 {
+		headers := maps.Clone(opts.extraHeaders)
+		if headers == nil {
+			headers = make(map[string]string, len(o.headers))
+		}
+		maps.Copy(headers, o.headers)
+		opts.extraHeaders = headers
+	}
+	return path, opts
+}
+
+// cancelBody releases a per-call timeout once the body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,94 @@
+package acteon
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRequestOptionsHeadersAndQuery(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, http.StatusOK, map[string]any{"records": []any{}})
+	defer teardown()
+	client := NewClient(url, WithAPIKey("secret"))
+
+	ctx := WithRequestOptions(context.Background(), IdempotencyKey("job-42"), QueryParam("trace", "1"))
+	ctx = WithRequestOptions(ctx, RequestHeader("X-Team", "payments"))
+	if _, err := client.QueryAudit(ctx, &AuditQuery{Tenant: "acme"}); err != nil {
+		t.Fatal(err)
+	}
+	if captured.headers.Get(IdempotencyKeyHeader) != "job-42" || captured.headers.Get("X-Team") != "payments" {
+		t.Errorf("headers = %v", captured.headers)
+	}
+	if captured.headers.Get("Authorization") != "Bearer secret" {
+		t.Errorf("authorization lost: %v", captured.headers)
+	}
+	if captured.query != "tenant=acme&trace=1" {
+		t.Errorf("query = %q", captured.query)
+	}
+
+	if _, err := client.GetAuditRecord(context.Background(), "act-1"); err != nil {
+		t.Fatal(err)
+	}
+	if captured.headers.Get(IdempotencyKeyHeader) != "" || captured.query != "" {
+		t.Errorf("options leaked into a plain call: %v %q", captured.headers, captured.query)
+	}
+}
+
+func TestIdempotencyKeyIsDroppedForMultiWriteCalls(t *testing.T) {
+	var keys []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`{"templates":[],"profiles":[],"count":0}`))
+			return
+		}
+		keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+	client := NewClient(srv.URL)
+	ctx := WithRequestOptions(context.Background(), IdempotencyKey("job-42"))
+
+	bundle := `{"version":1,"templates":[` +
+		`{"name":"a","namespace":"ns","tenant":"t","content":"A"},` +
+		`{"name":"b","namespace":"ns","tenant":"t","content":"B"}]}`
+	if _, err := client.ImportTemplates(ctx, strings.NewReader(bundle), ImportOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreateTemplate(ctx, &CreateTemplateRequest{Name: "c", Namespace: "ns", Tenant: "t", Content: "C"}); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(keys, ",") != ",,job-42" {
+		t.Errorf("idempotency keys sent = %q", keys)
+	}
+}
+
+func TestRequestTimeoutOverridesClientTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"records":[],"limit":0,"offset":0}`))
+	}))
+	defer srv.Close()
+	client := NewClient(srv.URL, WithTimeout(20*time.Millisecond))
+
+	if _, err := client.QueryAudit(context.Background(), &AuditQuery{}); err == nil {
+		t.Fatal("expected client timeout")
+	}
+	long := WithRequestOptions(context.Background(), RequestTimeout(2*time.Second))
+	if _, err := client.QueryAudit(long, &AuditQuery{}); err != nil {
+		t.Errorf("longer per-call timeout: %v", err)
+	}
+
+	client = NewClient(srv.URL)
+	short := WithRequestOptions(context.Background(), RequestTimeout(20*time.Millisecond))
+	_, err := client.QueryAudit(short, &AuditQuery{})
+	var connErr *ConnectionError
+	if !errors.As(err, &connErr) {
+		t.Errorf("shorter per-call timeout: err = %v", err)
+	}
+}
@@ -0,0 +1,174 @@
+// Conditional-GET response cache for the Go ActeonClient.
+//
+// Rules, templates, profiles, and the provider catalog change rarely
+// but are read on hot paths, e.g. a template lookup per dispatch. With
+// WithResponseCache the client remembers the body and ETag of each such
+// list response and revalidates with If-None-Match; a 304 is answered
+// from the cached body, so the gateway skips serializing the list and
+// the client skips downloading it. Every call still reaches the
+// gateway, so cached results are never stale.
+
+package acteon
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// defaultResponseCacheEntries bounds the cache when WithResponseCache
+// is given no size.
+const defaultResponseCacheEntries = 256
+
+// WithResponseCache enables the conditional-GET cache for ListRules,
+// ListTemplates, ListProfiles, and ListProviders. maxEntries bounds the
+// number of distinct request paths (filters included) kept; zero or
+// less uses a default of 256. The oldest entry is evicted first.
+func WithResponseCache(maxEntries int) ClientOption {
+	return func(c *Client) {
+		if maxEntries <= 0 {
+			maxEntries = defaultResponseCacheEntries
+		}
+		c.cache = &responseCache{max: maxEntries, entries: map[string]cacheEntry{}}
+	}
+}
+
+// CacheStats reports response cache activity.
+type CacheStats struct {
+	// Hits counts responses served from the cache after a 304.
+	Hits int64 `json:"hits"`
+	// Misses counts cacheable requests that returned a full body.
+	Misses int64 `json:"misses"`
+	// Entries is the number of responses currently cached.
+	Entries int `json:"entries"`
+}
+
+// HitRatio returns Hits / (Hits + Misses), or 0 before any request.
+func (s CacheStats) HitRatio() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// CacheStats returns the response cache counters. It returns a zero
+// value when the cache is not enabled.
+func (c *Client) CacheStats() CacheStats {
+	if c.cache == nil {
+		return CacheStats{}
+	}
+	c.cache.mu.Lock()
+	defer c.cache.mu.Unlock()
+	return CacheStats{Hits: c.cache.hits, Misses: c.cache.misses, Entries: len(c.cache.entries)}
+}
+
+// PurgeCache drops every cached response. Counters are kept.
+func (c *Client) PurgeCache() {
+	if c.cache == nil {
+		return
+	}
+	c.cache.mu.Lock()
+	defer c.cache.mu.Unlock()
+	c.cache.entries = map[string]cacheEntry{}
+	c.cache.order = nil
+}
+
+type cacheEntry struct {
+	etag string
+	body []byte
+}
+
+type responseCache struct {
+	mu      sync.Mutex
+	max     int
+	entries map[string]cacheEntry
+	// order holds keys oldest first for eviction.
+	order  []string
+	hits   int64
+	misses int64
+}
+
+func (rc *responseCache) get(key string) (cacheEntry, bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	e, ok := rc.entries[key]
+	return e, ok
+}
+
+func (rc *responseCache) put(key string, e cacheEntry) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.misses++
+	if _, ok := rc.entries[key]; !ok {
+		rc.order = append(rc.order, key)
+		for len(rc.order) > rc.max {
+			delete(rc.entries, rc.order[0])
+			rc.order = rc.order[1:]
+		}
+	}
+	rc.entries[key] = e
+}
+
+func (rc *responseCache) drop(key string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if _, ok := rc.entries[key]; !ok {
+		return
+	}
+	delete(rc.entries, key)
+	for i, k := range rc.order {
+		if k == key {
+			rc.order = append(rc.order[:i], rc.order[i+1:]...)
+			break
+		}
+	}
+}
+
+func (rc *responseCache) hit() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.hits++
+}
+
+// getCached issues a GET for path, revalidating against the response
+// cache when it is enabled. A 304 is turned into a 200 carrying the
+// cached body, so callers handle the response exactly as they would
+// an uncached one.
+func (c *Client) getCached(ctx context.Context, path string) (*http.Response, error) {
+	if c.cache == nil {
+		return c.doRequest(ctx, http.MethodGet, path, nil)
+	}
+
+	var opts requestOpts
+	entry, cached := c.cache.get(path)
+	if cached {
+		opts.extraHeaders = map[string]string{"If-None-Match": entry.etag}
+	}
+	resp, err := c.doRequestExt(ctx, http.MethodGet, path, nil, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && cached:
+		resp.Body.Close()
+		c.cache.hit()
+		resp.StatusCode = http.StatusOK
+		resp.Status = "200 OK"
+		resp.Body = io.NopCloser(bytes.NewReader(entry.body))
+		resp.ContentLength = int64(len(entry.body))
+	case resp.StatusCode == http.StatusOK && resp.Header.Get("ETag") != "":
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		c.cache.put(path, cacheEntry{etag: resp.Header.Get("ETag"), body: body})
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+	default:
+		c.cache.drop(path)
+	}
+	return resp, nil
+}
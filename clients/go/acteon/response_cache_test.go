@@ -0,0 +1,117 @@
+package acteon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// etagServer serves body for every GET with the given ETag, answering
+// 304 when If-None-Match matches. It counts full responses.
+func etagServer(t *testing.T, etag *atomic.Value, body string) (*httptest.Server, *atomic.Int64) {
+	t.Helper()
+	var full atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tag := etag.Load().(string)
+		if tag != "" && r.Header.Get("If-None-Match") == tag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		full.Add(1)
+		if tag != "" {
+			w.Header().Set("ETag", tag)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	return srv, &full
+}
+
+func TestResponseCacheServesNotModified(t *testing.T) {
+	var etag atomic.Value
+	etag.Store(`"v1"`)
+	srv, full := etagServer(t, &etag, `[{"name":"block-spam","priority":1,"enabled":true}]`)
+	defer srv.Close()
+
+	client := NewClient(srv.URL, WithResponseCache(0))
+	for i := 0; i < 3; i++ {
+		rules, err := client.ListRules(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rules) != 1 || rules[0].Name != "block-spam" {
+			t.Fatalf("call %d: rules = %+v", i, rules)
+		}
+	}
+	if full.Load() != 1 {
+		t.Errorf("server sent %d full responses, want 1", full.Load())
+	}
+	stats := client.CacheStats()
+	if stats.Hits != 2 || stats.Misses != 1 || stats.Entries != 1 {
+		t.Errorf("stats = %+v", stats)
+	}
+	if r := stats.HitRatio(); r < 0.66 || r > 0.67 {
+		t.Errorf("hit ratio = %v", r)
+	}
+
+	// A new ETag means the resource changed; the full body is refetched.
+	etag.Store(`"v2"`)
+	if _, err := client.ListRules(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if full.Load() != 2 || client.CacheStats().Misses != 2 {
+		t.Errorf("full = %d, stats = %+v", full.Load(), client.CacheStats())
+	}
+}
+
+func TestResponseCacheKeysByPathAndEvicts(t *testing.T) {
+	var etag atomic.Value
+	etag.Store(`"t"`)
+	srv, full := etagServer(t, &etag, `{"templates":[],"count":0}`)
+	defer srv.Close()
+
+	client := NewClient(srv.URL, WithResponseCache(1))
+	a, b := "a", "b"
+	ctx := context.Background()
+	for _, ns := range []*string{&a, &b, &a} {
+		if _, err := client.ListTemplates(ctx, ns, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// With room for one entry, namespace b evicts a, so a is refetched.
+	if full.Load() != 3 || client.CacheStats().Entries != 1 {
+		t.Errorf("full = %d, stats = %+v", full.Load(), client.CacheStats())
+	}
+
+	client.PurgeCache()
+	if client.CacheStats().Entries != 0 {
+		t.Errorf("entries after purge = %d", client.CacheStats().Entries)
+	}
+}
+
+func TestResponseCacheIgnoresResponsesWithoutETag(t *testing.T) {
+	var etag atomic.Value
+	etag.Store("")
+	srv, full := etagServer(t, &etag, `{"providers":[]}`)
+	defer srv.Close()
+
+	client := NewClient(srv.URL, WithResponseCache(0))
+	for i := 0; i < 2; i++ {
+		if _, err := client.ListProviders(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if full.Load() != 2 || client.CacheStats() != (CacheStats{}) {
+		t.Errorf("full = %d, stats = %+v", full.Load(), client.CacheStats())
+	}
+}
+
+func TestCacheStatsWithoutCache(t *testing.T) {
+	client := NewClient("http://localhost")
+	if client.CacheStats() != (CacheStats{}) {
+		t.Error("expected zero stats")
+	}
+	client.PurgeCache()
+}
@@ -0,0 +1,243 @@
+// Data retention surface for the Go client.
+
+package acteon
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// RetentionService manages data retention policies.
+type RetentionService struct {
+	c *Client
+}
+
+// Retention returns the client's RetentionService.
+func (c *Client) Retention() *RetentionService {
+	return &RetentionService{c: c}
+}
+
+// Create creates a retention policy.
+func (s *RetentionService) Create(ctx context.Context, req *CreateRetentionRequest) (*RetentionPolicy, error) {
+	resp, err := s.c.doRequest(ctx, http.MethodPost, "/v1/retention", req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusCreated {
+		var result RetentionPolicy
+		if err := s.c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to create retention policy")
+}
+
+// List lists retention policies with optional namespace, tenant, limit, and offset filters.
+func (s *RetentionService) List(ctx context.Context, namespace, tenant *string, limit, offset *int) (*ListRetentionResponse, error) {
+	if selector, ok := labelSelectorFrom(ctx); ok {
+		policies, err := s.all(ctx, namespace, tenant)
+		if err != nil {
+			return nil, err
+		}
+		policies = selectLabels(policies, selector, func(p RetentionPolicy) map[string]string { return p.Labels })
+		var lim, off int
+		if limit != nil {
+			lim = *limit
+		}
+		if offset != nil {
+			off = *offset
+		}
+		policies = pageOf(policies, lim, off)
+		return &ListRetentionResponse{Policies: policies, Count: len(policies)}, nil
+	}
+	return s.list(ctx, namespace, tenant, limit, offset)
+}
+
+// all lists every retention policy matching the filters, page by page.
+func (s *RetentionService) all(ctx context.Context, namespace, tenant *string) ([]RetentionPolicy, error) {
+	return listAll(func(limit, offset int) ([]RetentionPolicy, error) {
+		list, err := s.list(ctx, namespace, tenant, &limit, &offset)
+		if err != nil {
+			return nil, err
+		}
+		return list.Policies, nil
+	})
+}
+
+func (s *RetentionService) list(ctx context.Context, namespace, tenant *string, limit, offset *int) (*ListRetentionResponse, error) {
+	params := url.Values{}
+	if namespace != nil {
+		params.Set("namespace", *namespace)
+	}
+	if tenant != nil {
+		params.Set("tenant", *tenant)
+	}
+	if limit != nil {
+		params.Set("limit", strconv.Itoa(*limit))
+	}
+	if offset != nil {
+		params.Set("offset", strconv.Itoa(*offset))
+	}
+
+	path := "/v1/retention"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	resp, err := s.c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list retention policies"}
+	}
+
+	var result ListRetentionResponse
+	if err := s.c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &result, nil
+}
+
+// Get gets a single retention policy by ID.
+func (s *RetentionService) Get(ctx context.Context, retentionID string) (*RetentionPolicy, error) {
+	path := fmt.Sprintf("/v1/retention/%s", retentionID)
+
+	resp, err := s.c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get retention policy"}
+	}
+
+	var result RetentionPolicy
+	if err := s.c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &result, nil
+}
+
+// Update updates a retention policy.
+func (s *RetentionService) Update(ctx context.Context, retentionID string, update *UpdateRetentionRequest) (*RetentionPolicy, error) {
+	resp, err := s.c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/v1/retention/%s", retentionID), update)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var result RetentionPolicy
+		if err := s.c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Retention policy not found: %s", retentionID)}
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to update retention policy")
+}
+
+// Delete deletes a retention policy.
+func (s *RetentionService) Delete(ctx context.Context, retentionID string) error {
+	path := fmt.Sprintf("/v1/retention/%s", retentionID)
+
+	resp, err := s.c.doRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Retention policy not found: %s", retentionID)}
+	}
+	return &HTTPError{Status: resp.StatusCode, Message: "Failed to delete retention policy"}
+}
+
+// ArchiveStatus reports the last archive run and recent
+// failures for a retention policy with an archive sink configured.
+func (s *RetentionService) ArchiveStatus(ctx context.Context, retentionID string) (*RetentionArchiveStatus, error) {
+	path := fmt.Sprintf("/v1/retention/%s/archive/status", retentionID)
+
+	resp, err := s.c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Retention policy not found: %s", retentionID)}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get retention archive status"}
+	}
+
+	var result RetentionArchiveStatus
+	if err := s.c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &result, nil
+}
+
+// CreateRetention is shorthand for c.Retention().Create.
+func (c *Client) CreateRetention(ctx context.Context, req *CreateRetentionRequest) (*RetentionPolicy, error) {
+	return c.Retention().Create(ctx, req)
+}
+
+// ListRetention is shorthand for c.Retention().List.
+func (c *Client) ListRetention(ctx context.Context, namespace, tenant *string, limit, offset *int) (*ListRetentionResponse, error) {
+	return c.Retention().List(ctx, namespace, tenant, limit, offset)
+}
+
+// GetRetention is shorthand for c.Retention().Get.
+func (c *Client) GetRetention(ctx context.Context, retentionID string) (*RetentionPolicy, error) {
+	return c.Retention().Get(ctx, retentionID)
+}
+
+// UpdateRetention is shorthand for c.Retention().Update.
+func (c *Client) UpdateRetention(ctx context.Context, retentionID string, update *UpdateRetentionRequest) (*RetentionPolicy, error) {
+	return c.Retention().Update(ctx, retentionID, update)
+}
+
+// DeleteRetention is shorthand for c.Retention().Delete.
+func (c *Client) DeleteRetention(ctx context.Context, retentionID string) error {
+	return c.Retention().Delete(ctx, retentionID)
+}
+
+// GetRetentionArchiveStatus is shorthand for c.Retention().ArchiveStatus.
+func (c *Client) GetRetentionArchiveStatus(ctx context.Context, retentionID string) (*RetentionArchiveStatus, error) {
+	return c.Retention().ArchiveStatus(ctx, retentionID)
+}
@@ -0,0 +1,198 @@
+// Automatic retries for the Go ActeonClient.
+//
+// WithRetryPolicy makes every call retry failures that its error would
+// report as retryable — connection errors, 5xx responses, and API
+// errors whose envelope sets `retryable` — with capped exponential
+// backoff and jitter. Retries happen below the public methods, so a
+// caller sees either a successful response or the error from the last
+// attempt.
+//
+// WithThrottleRetry separately waits out the gateway's own throttling:
+// 429 and 503 responses carrying Retry-After, and Throttled dispatch
+// outcomes carrying RetryAfter.
+
+package acteon
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures automatic retries.
+//
+// Zero-value defaults: 3 attempts, 200ms initial backoff doubling up
+// to a 10s cap, and half of each delay randomized.
+type RetryPolicy struct {
+	// MaxAttempts counts the first attempt, so 3 means up to two
+	// retries. 1 disables retries.
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Multiplier scales the backoff after each attempt.
+	Multiplier float64
+	// Jitter is the fraction of each delay, from 0 to 1, that is
+	// randomized so clients retrying together spread out. A negative
+	// value disables jitter.
+	Jitter float64
+}
+
+// WithRetryPolicy retries calls that fail with a ConnectionError, a
+// 5xx HTTPError, or an APIError with Retryable set. A cancelled or
+// expired context stops retrying at once.
+//
+// Dispatch and the batch methods are retried too, so give actions a
+// DedupKey when a retry after a lost response must not send twice.
+// Per-action failures inside a successful batch response are not
+// retried. Streaming uploads, whose bodies cannot be replayed, are
+// sent once.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		if policy.MaxAttempts == 0 {
+			policy.MaxAttempts = 3
+		}
+		if policy.InitialBackoff == 0 {
+			policy.InitialBackoff = 200 * time.Millisecond
+		}
+		if policy.MaxBackoff == 0 {
+			policy.MaxBackoff = 10 * time.Second
+		}
+		if policy.Multiplier == 0 {
+			policy.Multiplier = 2
+		}
+		if policy.Jitter == 0 {
+			policy.Jitter = 0.5
+		}
+		c.retry = &policy
+	}
+}
+
+// ThrottleRetry configures waiting out throttling.
+//
+// Zero-value defaults: 5 attempts and a 1 minute cap on any one wait.
+type ThrottleRetry struct {
+	// MaxAttempts counts the first attempt.
+	MaxAttempts int
+	// MaxWait is the longest single wait honoured. When the server
+	// asks for longer, the throttled response or outcome is returned
+	// to the caller instead.
+	MaxWait time.Duration
+}
+
+// WithThrottleRetry makes the client sleep for the time the gateway
+// asks and try again when it is throttled: on a 429 or 503 response
+// with a Retry-After header, and on a Throttled outcome from Dispatch
+// or DispatchBatch, where only the throttled actions are sent again.
+// It combines with WithRetryPolicy, whose backoff then also stretches
+// to any Retry-After the server sends.
+func WithThrottleRetry(cfg ThrottleRetry) ClientOption {
+	return func(c *Client) {
+		if cfg.MaxAttempts == 0 {
+			cfg.MaxAttempts = 5
+		}
+		if cfg.MaxWait == 0 {
+			cfg.MaxWait = time.Minute
+		}
+		c.throttle = &cfg
+	}
+}
+
+// throttleWait returns how long to wait before retrying an action the
+// gateway throttled for after, following attempt (counted from 1), or
+// false if the caller should get the throttled outcome.
+func (c *Client) throttleWait(attempt int, after time.Duration) (time.Duration, bool) {
+	if c.throttle == nil || attempt >= c.throttle.MaxAttempts || after > c.throttle.MaxWait {
+		return 0, false
+	}
+	return after, true
+}
+
+// retryDelay decides whether a doRequestExt attempt that produced resp
+// or err is tried again, and after how long.
+func (c *Client) retryDelay(resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	after, hasAfter := retryAfter(resp)
+	if hasAfter && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable) {
+		if d, ok := c.throttleWait(attempt, after); ok {
+			return d, true
+		}
+	}
+	if c.retry == nil || attempt >= c.retry.MaxAttempts || !shouldRetry(resp, err) {
+		return 0, false
+	}
+	d := c.retry.backoff(attempt)
+	if hasAfter {
+		d = max(d, min(after, c.retry.MaxBackoff))
+	}
+	return d, true
+}
+
+// retryAfter parses the Retry-After header of resp, given either as
+// seconds or as an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if at, err := http.ParseTime(v); err == nil {
+		return max(time.Until(at), 0), true
+	}
+	return 0, false
+}
+
+// backoff returns the delay before the retry that follows attempt
+// (counted from 1).
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	d := float64(p.InitialBackoff)
+	for i := 1; i < attempt && d < float64(p.MaxBackoff); i++ {
+		d *= p.Multiplier
+	}
+	d = min(d, float64(p.MaxBackoff))
+	if p.Jitter > 0 {
+		d -= d * min(p.Jitter, 1) * rand.Float64()
+	}
+	return time.Duration(d)
+}
+
+// shouldRetry reports whether a request that produced resp or err is
+// worth retrying. For error statuses it reads the body to classify it
+// the way errorFromResponse would, then puts the body back so the
+// caller can still read it.
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		ae, ok := err.(ActeonError)
+		return ok && ae.IsRetryable()
+	}
+	if resp.StatusCode < http.StatusBadRequest {
+		return false
+	}
+	body, readErr := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if readErr != nil {
+		return true
+	}
+	ae, ok := errorFromResponse(resp, body, "").(ActeonError)
+	return ok && ae.IsRetryable()
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return &ConnectionError{Message: ctx.Err().Error()}
+	}
+}
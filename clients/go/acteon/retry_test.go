@@ -0,0 +1,253 @@
+package acteon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// flakyServer fails the first failures requests with status and body,
+// then answers 200 with ok. It counts requests and keeps the last body
+// it received.
+func flakyServer(t *testing.T, failures int32, status int, body, ok string) (*httptest.Server, *atomic.Int32, *atomic.Value) {
+	t.Helper()
+	var calls atomic.Int32
+	var lastBody atomic.Value
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		lastBody.Store(string(b))
+		w.Header().Set("Content-Type", "application/json")
+		if calls.Add(1) <= failures {
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(body))
+			return
+		}
+		_, _ = w.Write([]byte(ok))
+	}))
+	return srv, &calls, &lastBody
+}
+
+var fastRetry = RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, Jitter: -1}
+
+func TestRetryOn5xxReplaysBody(t *testing.T) {
+	srv, calls, lastBody := flakyServer(t, 2, http.StatusBadGateway, "<html>bad gateway</html>",
+		`{"Executed":{"status":"success","body":{},"headers":{}}}`)
+	defer srv.Close()
+
+	outcome, err := NewClient(srv.URL, WithRetryPolicy(fastRetry)).
+		Dispatch(context.Background(), NewAction("alerts", "acme", "email", "send", map[string]any{"to": "a@b.c"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls.Load() != 3 || outcome.Type != OutcomeExecuted {
+		t.Errorf("calls = %d, outcome = %+v", calls.Load(), outcome)
+	}
+	if got := lastBody.Load().(string); got == "" {
+		t.Error("retried request was sent without a body")
+	}
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	srv, calls, _ := flakyServer(t, 10, http.StatusServiceUnavailable,
+		`{"code":"OVERLOADED","message":"busy","retryable":true}`, `{}`)
+	defer srv.Close()
+
+	_, err := NewClient(srv.URL, WithRetryPolicy(fastRetry)).GetAuditRecord(context.Background(), "act-1")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if calls.Load() != 3 {
+		t.Errorf("calls = %d, want 3", calls.Load())
+	}
+}
+
+func TestRetryHonoursAPIErrorRetryable(t *testing.T) {
+	cases := []struct {
+		name   string
+		status int
+		body   string
+		want   int32
+	}{
+		{"retryable 4xx", http.StatusTooManyRequests, `{"code":"RATE_LIMITED","message":"slow down","retryable":true}`, 2},
+		{"non-retryable 5xx", http.StatusInternalServerError, `{"code":"BAD_CONFIG","message":"no","retryable":false}`, 1},
+		{"plain 4xx", http.StatusBadRequest, `{"code":"INVALID","message":"no"}`, 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, calls, _ := flakyServer(t, 1, tc.status, tc.body, `{"rules":[],"count":0}`)
+			defer srv.Close()
+
+			_, err := NewClient(srv.URL, WithRetryPolicy(fastRetry)).ListRules(context.Background())
+			if calls.Load() != tc.want {
+				t.Errorf("calls = %d, want %d (err %v)", calls.Load(), tc.want, err)
+			}
+		})
+	}
+}
+
+func TestRetryStopsOnContextCancel(t *testing.T) {
+	srv, calls, _ := flakyServer(t, 10, http.StatusBadGateway, "down", `{}`)
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	client := NewClient(srv.URL, WithRetryPolicy(RetryPolicy{MaxAttempts: 10, InitialBackoff: time.Second}))
+	start := time.Now()
+	_, err := client.GetAuditRecord(ctx, "act-1")
+	var connErr *ConnectionError
+	if !errors.As(err, &connErr) {
+		t.Errorf("err = %v", err)
+	}
+	if calls.Load() != 1 || time.Since(start) > 500*time.Millisecond {
+		t.Errorf("calls = %d after %v", calls.Load(), time.Since(start))
+	}
+}
+
+func TestReadyIsNotRetried(t *testing.T) {
+	srv, calls, _ := flakyServer(t, 10, http.StatusServiceUnavailable,
+		`{"status":"not_ready","checks":[{"name":"state_store","ready":false}]}`, `{}`)
+	defer srv.Close()
+
+	if _, err := NewClient(srv.URL, WithRetryPolicy(fastRetry)).Ready(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("calls = %d, want 1", calls.Load())
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	p := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second, Multiplier: 2, Jitter: -1}
+	want := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second}
+	for i, w := range want {
+		if got := p.backoff(i + 1); got != w {
+			t.Errorf("backoff(%d) = %v, want %v", i+1, got, w)
+		}
+	}
+
+	p.Jitter = 0.5
+	for range 100 {
+		if got := p.backoff(2); got < 100*time.Millisecond || got > 200*time.Millisecond {
+			t.Fatalf("jittered backoff = %v", got)
+		}
+	}
+}
+
+func TestThrottleRetryHonoursRetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"Executed":{"status":"success","body":{},"headers":{}}}`))
+	}))
+	defer srv.Close()
+
+	action := NewAction("alerts", "acme", "email", "send", map[string]any{})
+	if _, err := NewClient(srv.URL).Dispatch(context.Background(), action); err == nil {
+		t.Fatal("expected 429 error without WithThrottleRetry")
+	}
+	calls.Store(0)
+	outcome, err := NewClient(srv.URL, WithThrottleRetry(ThrottleRetry{})).Dispatch(context.Background(), action)
+	if err != nil || outcome.Type != OutcomeExecuted || calls.Load() != 2 {
+		t.Errorf("outcome = %+v, err = %v, calls = %d", outcome, err, calls.Load())
+	}
+}
+
+func TestThrottleRetryOnThrottledOutcome(t *testing.T) {
+	throttled := `{"Throttled":{"retry_after":{"secs":0,"nanos":1000000}}}`
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= 2 {
+			_, _ = w.Write([]byte(throttled))
+			return
+		}
+		_, _ = w.Write([]byte(`{"Executed":{"status":"success","body":{},"headers":{}}}`))
+	}))
+	defer srv.Close()
+	action := NewAction("alerts", "acme", "email", "send", map[string]any{})
+
+	outcome, err := NewClient(srv.URL, WithThrottleRetry(ThrottleRetry{})).Dispatch(context.Background(), action)
+	if err != nil || outcome.Type != OutcomeExecuted || calls.Load() != 3 {
+		t.Errorf("outcome = %+v, err = %v, calls = %d", outcome, err, calls.Load())
+	}
+
+	calls.Store(0)
+	outcome, err = NewClient(srv.URL, WithThrottleRetry(ThrottleRetry{MaxWait: time.Nanosecond})).Dispatch(context.Background(), action)
+	if err != nil || !outcome.IsThrottled() || calls.Load() != 1 {
+		t.Errorf("over MaxWait: outcome = %+v, err = %v, calls = %d", outcome, err, calls.Load())
+	}
+}
+
+func TestThrottleRetryResendsOnlyThrottledBatchEntries(t *testing.T) {
+	var mu sync.Mutex
+	var sizes []int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var actions []Action
+		_ = json.NewDecoder(r.Body).Decode(&actions)
+		mu.Lock()
+		sizes = append(sizes, len(actions))
+		first := len(sizes) == 1
+		mu.Unlock()
+		out := make([]json.RawMessage, len(actions))
+		for i, a := range actions {
+			if first && a.Tenant == "slow" {
+				out[i] = json.RawMessage(`{"Throttled":{"retry_after":{"secs":0,"nanos":1000000}}}`)
+				continue
+			}
+			out[i] = json.RawMessage(`{"Executed":{"status":"success","body":{"tenant":"` + a.Tenant + `"},"headers":{}}}`)
+		}
+		_ = json.NewEncoder(w).Encode(out)
+	}))
+	defer srv.Close()
+
+	actions := []*Action{
+		NewAction("alerts", "fast", "email", "send", map[string]any{}),
+		NewAction("alerts", "slow", "email", "send", map[string]any{}),
+		NewAction("alerts", "fast", "email", "send", map[string]any{}),
+	}
+	results, err := NewClient(srv.URL, WithThrottleRetry(ThrottleRetry{})).DispatchBatch(context.Background(), actions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sizes) != 2 || sizes[0] != 3 || sizes[1] != 1 {
+		t.Errorf("batch sizes = %v", sizes)
+	}
+	for i, r := range results {
+		if r.Index != i || r.ActionID != actions[i].ID || r.Outcome == nil || r.Outcome.Response.Body["tenant"] != actions[i].Tenant {
+			t.Errorf("result %d = %+v", i, r)
+		}
+	}
+}
+
+func TestRetryAfterHeader(t *testing.T) {
+	cases := []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{"", 0, false},
+		{"3", 3 * time.Second, true},
+		{"soon", 0, false},
+		{"Mon, 02 Jan 2006 15:04:05 GMT", 0, true},
+	}
+	for _, tc := range cases {
+		resp := &http.Response{Header: http.Header{}}
+		if tc.value != "" {
+			resp.Header.Set("Retry-After", tc.value)
+		}
+		got, ok := retryAfter(resp)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("retryAfter(%q) = %v, %v", tc.value, got, ok)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+// Regional endpoint routing for the Go ActeonClient.
+//
+// Deployments that shard namespaces or tenants across regional
+// gateways configure WithRouting with a key → base URL map; one Client
+// then sends each request to the gateway owning its scope. The scope
+// is read from the request itself: the `namespace` and `tenant` query
+// parameters, or the `namespace` and `tenant` fields of the JSON body.
+// Requests with no scope — health checks, lookups by ID — go to the
+// client's base URL unless the caller pins them with WithRouteKey.
+
+package acteon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+)
+
+// WithRouting routes requests by namespace or tenant. Each key of
+// routes is a namespace or tenant name and each value the base URL of
+// the gateway serving it. A namespace match wins over a tenant match;
+// tenants match hierarchically, so a route for "acme" also covers
+// "acme.prod". Everything else goes to the base URL passed to
+// NewClient.
+//
+// DispatchBatch and DispatchBatchDryRun split a batch whose actions
+// route to different gateways into one request per gateway and merge
+// the results back into request order.
+func WithRouting(routes map[string]string) ClientOption {
+	return func(c *Client) {
+		c.routes = make(map[string]string, len(routes))
+		for key, base := range routes {
+			c.routes[key] = strings.TrimSuffix(base, "/")
+		}
+	}
+}
+
+type routeKeyContext struct{}
+
+// WithRouteKey returns a context that sends every call made with it to
+// the gateway routed for key, a namespace or tenant. Use it for calls
+// that carry no scope of their own, such as GetAuditRecord by action
+// ID. It has no effect unless the client was built with WithRouting.
+func WithRouteKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, routeKeyContext{}, key)
+}
+
+// Endpoint returns the base URL requests for namespace and tenant are
+// sent to. Either may be empty.
+func (c *Client) Endpoint(namespace, tenant string) string {
+	if namespace != "" {
+		if base, ok := c.routes[namespace]; ok {
+			return base
+		}
+	}
+	for t := tenant; t != ""; {
+		if base, ok := c.routes[t]; ok {
+			return base
+		}
+		i := strings.LastIndexByte(t, '.')
+		if i < 0 {
+			break
+		}
+		t = t[:i]
+	}
+	return c.baseURL
+}
+
+// endpointFor picks the base URL for a request to path with the given
+// JSON body (nil for none).
+func (c *Client) endpointFor(ctx context.Context, path string, body []byte) string {
+	if len(c.routes) == 0 {
+		return c.baseURL
+	}
+	if key, ok := ctx.Value(routeKeyContext{}).(string); ok {
+		return c.Endpoint(key, key)
+	}
+	return c.Endpoint(requestScope(path, body))
+}
+
+// requestScope extracts the namespace and tenant a request targets,
+// from its query string first and then from its JSON body. For an
+// array body, such as a batch, the first element decides.
+func requestScope(path string, body []byte) (namespace, tenant string) {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		if q, err := url.ParseQuery(path[i+1:]); err == nil {
+			namespace, tenant = q.Get("namespace"), q.Get("tenant")
+		}
+	}
+	if namespace != "" || tenant != "" {
+		return namespace, tenant
+	}
+
+	type scoped struct {
+		Namespace string `json:"namespace"`
+		Tenant    string `json:"tenant"`
+	}
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return "", ""
+	}
+	if body[0] == '[' {
+		var items []scoped
+		if json.Unmarshal(body, &items) != nil || len(items) == 0 {
+			return "", ""
+		}
+		return items[0].Namespace, items[0].Tenant
+	}
+	var s scoped
+	if json.Unmarshal(body, &s) != nil {
+		return "", ""
+	}
+	return s.Namespace, s.Tenant
+}
+
+// sendBatch posts actions to path, splitting them by gateway when
+// routing sends them to more than one. If a gateway fails, the results
+// from the others are still returned, the entries that were not
+// dispatched carry a NOT_DISPATCHED error, and the first failure is
+// returned alongside.
+func (c *Client) sendBatch(ctx context.Context, path string, actions []*Action) (BatchResults, error) {
+	if len(c.routes) == 0 {
+		return c.postBatch(ctx, path, "", actions)
+	}
+	if key, ok := ctx.Value(routeKeyContext{}).(string); ok {
+		return c.postBatch(ctx, path, c.Endpoint(key, key), actions)
+	}
+
+	var order []string
+	groups := map[string][]int{}
+	for i, a := range actions {
+		base := c.baseURL
+		if a != nil {
+			base = c.Endpoint(a.Namespace, a.Tenant)
+		}
+		if _, ok := groups[base]; !ok {
+			order = append(order, base)
+		}
+		groups[base] = append(groups[base], i)
+	}
+	if len(order) <= 1 {
+		return c.postBatch(ctx, path, "", actions)
+	}
+
+	results := make(BatchResults, len(actions))
+	var firstErr error
+	for _, base := range order {
+		idx := groups[base]
+		sub := make([]*Action, len(idx))
+		for j, i := range idx {
+			sub[j] = actions[i]
+		}
+		res, err := c.postBatch(ctx, path, base, sub)
+		if err == nil && len(res) != len(sub) {
+			err = &ConnectionError{Message: "batch response length does not match request from " + base}
+		}
+		for j, i := range idx {
+			if err != nil {
+				results[i] = BatchResult{Error: &ErrorResponse{Code: "NOT_DISPATCHED", Message: err.Error(), Retryable: true}}
+				continue
+			}
+			results[i] = res[j]
+		}
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	correlateBatch(results, actions)
+	return results, firstErr
+}
@@ -0,0 +1,139 @@
+package acteon
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// regionServer answers every request, echoing batch bodies as executed
+// outcomes, and records "METHOD path?query" per request.
+type regionServer struct {
+	*httptest.Server
+	mu   sync.Mutex
+	seen []string
+	fail bool
+}
+
+func newRegionServer(t *testing.T) *regionServer {
+	t.Helper()
+	rs := &regionServer{}
+	rs.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rs.mu.Lock()
+		rs.seen = append(rs.seen, r.Method+" "+r.URL.RequestURI())
+		fail := rs.fail
+		rs.mu.Unlock()
+		if fail {
+			http.Error(w, "down", http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasPrefix(r.URL.Path, "/v1/dispatch/batch") {
+			body, _ := io.ReadAll(r.Body)
+			var actions []Action
+			_ = json.Unmarshal(body, &actions)
+			out := make([]json.RawMessage, len(actions))
+			for i := range actions {
+				out[i] = json.RawMessage(`{"Executed":{"status":"success","body":{"region":"` + rs.URL + `"},"headers":{}}}`)
+			}
+			_ = json.NewEncoder(w).Encode(out)
+			return
+		}
+		_, _ = w.Write([]byte(`{"records":[],"limit":0,"offset":0}`))
+	}))
+	return rs
+}
+
+func (rs *regionServer) requests() []string {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	return append([]string(nil), rs.seen...)
+}
+
+func TestEndpointMatching(t *testing.T) {
+	c := NewClient("http://default", WithRouting(map[string]string{
+		"alerts": "http://us/",
+		"acme":   "http://eu",
+	}))
+	cases := []struct{ namespace, tenant, want string }{
+		{"alerts", "acme", "http://us"},
+		{"other", "acme", "http://eu"},
+		{"other", "acme.prod.blue", "http://eu"},
+		{"other", "acmeco", "http://default"},
+		{"", "", "http://default"},
+	}
+	for _, tc := range cases {
+		if got := c.Endpoint(tc.namespace, tc.tenant); got != tc.want {
+			t.Errorf("Endpoint(%q, %q) = %q, want %q", tc.namespace, tc.tenant, got, tc.want)
+		}
+	}
+}
+
+func TestRoutingByBodyQueryAndContext(t *testing.T) {
+	def, eu := newRegionServer(t), newRegionServer(t)
+	defer def.Close()
+	defer eu.Close()
+	c := NewClient(def.URL, WithRouting(map[string]string{"acme": eu.URL}))
+	ctx := context.Background()
+
+	if _, err := c.Dispatch(ctx, NewAction("alerts", "acme", "email", "send", map[string]any{})); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.QueryAudit(ctx, &AuditQuery{Tenant: "acme"}); err != nil {
+		t.Fatal(err)
+	}
+	_, _ = c.GetAuditRecord(WithRouteKey(ctx, "acme"), "act-1")
+	_, _ = c.GetAuditRecord(ctx, "act-2")
+
+	if got := eu.requests(); len(got) != 3 || got[0] != "POST /v1/dispatch" || !strings.HasPrefix(got[1], "GET /v1/audit?") || got[2] != "GET /v1/audit/act-1" {
+		t.Errorf("eu requests = %v", got)
+	}
+	if got := def.requests(); len(got) != 1 || got[0] != "GET /v1/audit/act-2" {
+		t.Errorf("default requests = %v", got)
+	}
+}
+
+func TestDispatchBatchSplitsAcrossRegions(t *testing.T) {
+	us, eu := newRegionServer(t), newRegionServer(t)
+	defer us.Close()
+	defer eu.Close()
+	c := NewClient(us.URL, WithRouting(map[string]string{"acme": eu.URL}))
+
+	actions := []*Action{
+		NewAction("alerts", "globex", "email", "send", map[string]any{}),
+		NewAction("alerts", "acme", "email", "send", map[string]any{}),
+		NewAction("alerts", "initech", "email", "send", map[string]any{}),
+	}
+	results, err := c.DispatchBatch(context.Background(), actions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(us.requests()) != 1 || len(eu.requests()) != 1 {
+		t.Fatalf("us = %v, eu = %v", us.requests(), eu.requests())
+	}
+	wantRegion := []string{us.URL, eu.URL, us.URL}
+	for i, r := range results {
+		if r.Index != i || r.ActionID != actions[i].ID {
+			t.Errorf("result %d not correlated: %+v", i, r)
+		}
+		if !r.Success || r.Outcome.Response.Body["region"] != wantRegion[i] {
+			t.Errorf("result %d = %+v, want region %s", i, r.Outcome, wantRegion[i])
+		}
+	}
+
+	eu.mu.Lock()
+	eu.fail = true
+	eu.mu.Unlock()
+	results, err = c.DispatchBatch(context.Background(), actions)
+	if err == nil {
+		t.Fatal("expected error from failed region")
+	}
+	if !results[0].Success || results[1].Success || results[1].Error.Code != "NOT_DISPATCHED" || !results[2].Success {
+		t.Errorf("results = %+v", results)
+	}
+}
@@ -0,0 +1,228 @@
+// Payload scrubbing for the Go client.
+//
+// WithPayloadScrubber runs caller-supplied hooks over a copy of every
+// action before it is marshalled, so that values which must never end
+// up in the audit trail — card numbers, bearer tokens, cloud keys — are
+// masked at the SDK layer even when application code forgets to. The
+// Scrubber type bundles the common case: always-masked payload fields
+// plus pattern rules applied to every string.
+
+package acteon
+
+import (
+	"encoding/json"
+	"regexp"
+)
+
+// DefaultMask replaces payload fields masked by Scrubber.Fields.
+const DefaultMask = "[REDACTED]"
+
+// WithPayloadScrubber makes Dispatch, DispatchDryRun, the batch
+// dispatch methods, and DumpAction pass each action to scrub before
+// marshalling it. scrub receives a copy whose payload maps and slices
+// may be modified freely; the caller's action is not changed. The
+// option may be given more than once, and scrubbers run in order,
+// before any WithPayloadEncryption sealing.
+//
+// Scrubbing a signed action invalidates its signature, so sign after
+// scrubbing or leave signed actions' payloads alone.
+func WithPayloadScrubber(scrub func(action *Action)) ClientOption {
+	return func(c *Client) {
+		c.scrubbers = append(c.scrubbers, scrub)
+	}
+}
+
+// ScrubRule masks every match of Pattern in payload strings with
+// "[REDACTED:<Name>]".
+type ScrubRule struct {
+	Name    string
+	Pattern *regexp.Regexp
+	// Valid, when set, filters matches; a match it rejects is kept.
+	Valid func(match string) bool
+}
+
+// Built-in rules. DefaultScrubRules returns all of them.
+var (
+	// CreditCardRule matches 13 to 19 digit card numbers, optionally
+	// grouped by spaces or dashes, that pass the Luhn check.
+	CreditCardRule = ScrubRule{
+		Name:    "credit_card",
+		Pattern: regexp.MustCompile(`\b\d(?:[ -]?\d){12,18}\b`),
+		Valid:   luhnValid,
+	}
+	// BearerTokenRule matches the credential of a Bearer authorization
+	// value.
+	BearerTokenRule = ScrubRule{
+		Name:    "bearer_token",
+		Pattern: regexp.MustCompile(`(?i)\bbearer\s+[A-Za-z0-9\-._~+/]+=*`),
+	}
+	// JWTRule matches JSON Web Tokens.
+	JWTRule = ScrubRule{
+		Name:    "jwt",
+		Pattern: regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`),
+	}
+	// AWSAccessKeyRule matches AWS access key IDs.
+	AWSAccessKeyRule = ScrubRule{
+		Name:    "aws_access_key",
+		Pattern: regexp.MustCompile(`\b(?:AKIA|ASIA)[A-Z0-9]{16}\b`),
+	}
+)
+
+// DefaultScrubRules returns the built-in rules.
+func DefaultScrubRules() []ScrubRule {
+	return []ScrubRule{CreditCardRule, BearerTokenRule, JWTRule, AWSAccessKeyRule}
+}
+
+// Scrubber masks sensitive payload values. Pass its Scrub method to
+// WithPayloadScrubber:
+//
+//	s := &acteon.Scrubber{
+//		Fields: []string{"patient.ssn", "auth.password"},
+//		Rules:  acteon.DefaultScrubRules(),
+//	}
+//	client := acteon.NewClient(url, acteon.WithPayloadScrubber(s.Scrub))
+type Scrubber struct {
+	// Fields are dotted payload paths, such as "patient.ssn", whose
+	// values are replaced by Mask whatever their type.
+	Fields []string
+	// Rules are applied to every string in the payload, at any depth.
+	Rules []ScrubRule
+	// Mask replaces Fields values; DefaultMask when empty.
+	Mask string
+}
+
+// Scrub masks action's payload in place.
+func (s *Scrubber) Scrub(action *Action) {
+	if action == nil || action.Payload == nil {
+		return
+	}
+	mask := s.Mask
+	if mask == "" {
+		mask = DefaultMask
+	}
+	for _, field := range s.Fields {
+		parent, key := lookupField(action.Payload, field)
+		if _, ok := parent[key]; ok {
+			parent[key] = mask
+		}
+	}
+	if len(s.Rules) > 0 {
+		for k, v := range action.Payload {
+			action.Payload[k] = s.scrubValue(v)
+		}
+	}
+}
+
+func (s *Scrubber) scrubValue(v any) any {
+	switch v := v.(type) {
+	case string:
+		return s.scrubString(v)
+	case map[string]any:
+		for k, e := range v {
+			v[k] = s.scrubValue(e)
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = s.scrubValue(e)
+		}
+	case []string:
+		for i, e := range v {
+			v[i] = s.scrubString(e)
+		}
+	case map[string]string:
+		for k, e := range v {
+			v[k] = s.scrubString(e)
+		}
+	}
+	return v
+}
+
+func (s *Scrubber) scrubString(str string) string {
+	for _, r := range s.Rules {
+		str = r.Pattern.ReplaceAllStringFunc(str, func(m string) string {
+			if r.Valid != nil && !r.Valid(m) {
+				return m
+			}
+			return "[REDACTED:" + r.Name + "]"
+		})
+	}
+	return str
+}
+
+// luhnValid reports whether the digits of s pass the Luhn checksum.
+func luhnValid(s string) bool {
+	sum, double := 0, false
+	for i := len(s) - 1; i >= 0; i-- {
+		c := s[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if double {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// scrubAction returns a scrubbed copy of action, or action itself when
+// no scrubber is configured.
+func (c *Client) scrubAction(action *Action) *Action {
+	if len(c.scrubbers) == 0 || action == nil {
+		return action
+	}
+	out := *action
+	if action.Payload != nil {
+		out.Payload = copyValue(action.Payload).(map[string]any)
+	}
+	if action.Metadata != nil {
+		out.Metadata = &ActionMetadata{Labels: copyValue(action.Metadata.Labels).(map[string]string)}
+	}
+	out.Attachments = append([]Attachment(nil), action.Attachments...)
+	for _, scrub := range c.scrubbers {
+		scrub(&out)
+	}
+	return &out
+}
+
+// DumpAction returns the indented JSON of action as the client would
+// send it, after any WithPayloadScrubber hooks but before encryption,
+// for logging and debugging.
+func (c *Client) DumpAction(action *Action) ([]byte, error) {
+	return json.MarshalIndent(c.scrubAction(action), "", "  ")
+}
+
+// copyValue deep-copies the maps and slices of a decoded JSON value,
+// sharing everything else.
+func copyValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, e := range v {
+			out[k] = copyValue(e)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, e := range v {
+			out[i] = copyValue(e)
+		}
+		return out
+	case []string:
+		return append([]string(nil), v...)
+	case map[string]string:
+		if v == nil {
+			return v
+		}
+		out := make(map[string]string, len(v))
+		for k, e := range v {
+			out[k] = e
+		}
+		return out
+	}
+	return v
+}
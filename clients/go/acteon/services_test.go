@@ -0,0 +1,59 @@
+package acteon
+
+import (
+	"context"
+	"testing"
+)
+
+func TestServiceClientsShareRootClient(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 200, map[string]any{"quotas": []any{}, "count": 0})
+	defer teardown()
+	c := NewClient(url, WithAPIKey("k"))
+	ns := "alerts"
+
+	if _, err := c.Quotas().List(context.Background(), &ns, nil, nil, nil); err != nil {
+		t.Fatalf("Quotas().List: %v", err)
+	}
+	if captured.path != "/v1/quotas" || captured.query != "namespace=alerts" {
+		t.Errorf("request: got %s?%s", captured.path, captured.query)
+	}
+	if got := captured.headers.Get("Authorization"); got != "Bearer k" {
+		t.Errorf("Authorization: got %q", got)
+	}
+
+	// The root method is a wrapper over the same service call.
+	if _, err := c.ListQuotas(context.Background(), nil, nil, nil, nil); err != nil {
+		t.Fatalf("ListQuotas: %v", err)
+	}
+	if captured.path != "/v1/quotas" || captured.query != "" {
+		t.Errorf("wrapper request: got %s?%s", captured.path, captured.query)
+	}
+}
+
+func TestServiceClientPaths(t *testing.T) {
+	url, captured, teardown := newCapturingServer(t, 204, nil)
+	defer teardown()
+	c := NewClient(url)
+	ctx := context.Background()
+
+	cases := []struct {
+		name string
+		call func() error
+		path string
+	}{
+		{"Throttles", func() error { return c.Throttles().Delete(ctx, "th-1", "ns", "t") }, "/v1/throttles/th-1"},
+		{"Silences", func() error { return c.Silences().Delete(ctx, "s-1") }, "/v1/silences/s-1"},
+		{"Retention", func() error { return c.Retention().Delete(ctx, "r-1") }, "/v1/retention/r-1"},
+		{"Recurring", func() error { return c.Recurring().Delete(ctx, "rc-1", "ns", "t") }, "/v1/recurring/rc-1"},
+		{"Dlq", func() error { return c.Dlq().Delete(ctx, "d-1") }, "/v1/dlq/d-1"},
+	}
+	for _, tc := range cases {
+		if err := tc.call(); err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		if captured.method != "DELETE" || captured.path != tc.path {
+			t.Errorf("%s: got %s %s, want DELETE %s", tc.name, captured.method, captured.path, tc.path)
+		}
+	}
+}
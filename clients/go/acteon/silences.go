@@ -0,0 +1,184 @@
+// Silence surface for the Go client.
+
+package acteon
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// SilenceService manages silences.
+type SilenceService struct {
+	c *Client
+}
+
+// Silences returns the client's SilenceService.
+func (c *Client) Silences() *SilenceService {
+	return &SilenceService{c: c}
+}
+
+// Create creates a silence. Supply either EndsAt or
+// DurationSeconds on the request.
+func (s *SilenceService) Create(ctx context.Context, req *CreateSilenceRequest) (*Silence, error) {
+	resp, err := s.c.doRequest(ctx, http.MethodPost, "/v1/silences", req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusCreated {
+		var result Silence
+		if err := s.c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to create silence")
+}
+
+// List lists silences, optionally filtered by namespace and
+// tenant. Pass includeExpired=true to include silences whose end
+// time is in the past.
+func (s *SilenceService) List(ctx context.Context, namespace, tenant *string, includeExpired bool) (*ListSilencesResponse, error) {
+	params := url.Values{}
+	if namespace != nil {
+		params.Set("namespace", *namespace)
+	}
+	if tenant != nil {
+		params.Set("tenant", *tenant)
+	}
+	if includeExpired {
+		params.Set("include_expired", "true")
+	}
+
+	path := "/v1/silences"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	resp, err := s.c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list silences"}
+	}
+
+	var result ListSilencesResponse
+	if err := s.c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &result, nil
+}
+
+// Get fetches a single silence by ID. Returns (nil, nil) if
+// the silence does not exist (404).
+func (s *SilenceService) Get(ctx context.Context, silenceID string) (*Silence, error) {
+	path := fmt.Sprintf("/v1/silences/%s", silenceID)
+
+	resp, err := s.c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get silence"}
+	}
+
+	var result Silence
+	if err := s.c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &result, nil
+}
+
+// Update extends a silence or edits its comment. Matchers
+// are immutable — to change them, expire the silence and create a
+// new one.
+func (s *SilenceService) Update(ctx context.Context, silenceID string, update *UpdateSilenceRequest) (*Silence, error) {
+	resp, err := s.c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/v1/silences/%s", silenceID), update)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var result Silence
+		if err := s.c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Silence not found: %s", silenceID)}
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to update silence")
+}
+
+// Delete expires a silence immediately (soft-expire). The
+// record remains queryable for audit-trail purposes.
+func (s *SilenceService) Delete(ctx context.Context, silenceID string) error {
+	path := fmt.Sprintf("/v1/silences/%s", silenceID)
+
+	resp, err := s.c.doRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Silence not found: %s", silenceID)}
+	}
+	return &HTTPError{Status: resp.StatusCode, Message: "Failed to delete silence"}
+}
+
+// CreateSilence is shorthand for c.Silences().Create.
+func (c *Client) CreateSilence(ctx context.Context, req *CreateSilenceRequest) (*Silence, error) {
+	return c.Silences().Create(ctx, req)
+}
+
+// ListSilences is shorthand for c.Silences().List.
+func (c *Client) ListSilences(ctx context.Context, namespace, tenant *string, includeExpired bool) (*ListSilencesResponse, error) {
+	return c.Silences().List(ctx, namespace, tenant, includeExpired)
+}
+
+// GetSilence is shorthand for c.Silences().Get.
+func (c *Client) GetSilence(ctx context.Context, silenceID string) (*Silence, error) {
+	return c.Silences().Get(ctx, silenceID)
+}
+
+// UpdateSilence is shorthand for c.Silences().Update.
+func (c *Client) UpdateSilence(ctx context.Context, silenceID string, update *UpdateSilenceRequest) (*Silence, error) {
+	return c.Silences().Update(ctx, silenceID, update)
+}
+
+// DeleteSilence is shorthand for c.Silences().Delete.
+func (c *Client) DeleteSilence(ctx context.Context, silenceID string) error {
+	return c.Silences().Delete(ctx, silenceID)
+}
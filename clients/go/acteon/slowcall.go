@@ -0,0 +1,65 @@
+// Slow-call reporting for the Go client.
+//
+// WithSlowCallThreshold reports every call that takes longer than a
+// threshold to a caller-supplied hook, so that latency regressions in
+// the gateway show up in the consumer's own logs without tracing
+// infrastructure.
+
+package acteon
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// CallInfo describes one client call for a WithSlowCallThreshold hook.
+type CallInfo struct {
+	Method string
+	// Path is the request path without its query string.
+	Path string
+	// Duration runs from the start of the call until the response
+	// headers arrived or the call failed, including any retries.
+	Duration time.Duration
+	// Status is the final response status, or 0 when there was none.
+	Status int
+	// Err is the error the call failed with, if any.
+	Err error
+}
+
+// slowCallHook is the client's WithSlowCallThreshold setting.
+type slowCallHook struct {
+	threshold time.Duration
+	fn        func(CallInfo)
+}
+
+// WithSlowCallThreshold makes the client call fn after every request
+// that took longer than threshold:
+//
+//	client := acteon.NewClient(url, acteon.WithSlowCallThreshold(2*time.Second, func(ci acteon.CallInfo) {
+//		log.Printf("slow acteon call: %s %s took %v (status %d)", ci.Method, ci.Path, ci.Duration, ci.Status)
+//	}))
+//
+// fn runs on the calling goroutine before the call returns, so it
+// should be quick. Reading the response body is not timed, which keeps
+// long-lived streams from being reported.
+func WithSlowCallThreshold(threshold time.Duration, fn func(CallInfo)) ClientOption {
+	return func(c *Client) {
+		c.slowCall = &slowCallHook{threshold: threshold, fn: fn}
+	}
+}
+
+// observe reports a call that started at start to the hook if it was
+// slow.
+func (h *slowCallHook) observe(method, path string, start time.Time, resp *http.Response, err error) {
+	d := time.Since(start)
+	if d <= h.threshold {
+		return
+	}
+	path, _, _ = strings.Cut(path, "?")
+	info := CallInfo{Method: method, Path: path, Duration: d, Err: err}
+	if resp != nil {
+		info.Status = resp.StatusCode
+	}
+	h.fn(info)
+}
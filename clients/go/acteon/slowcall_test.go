@@ -0,0 +1,42 @@
+package acteon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSlowCallThreshold(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/audit/slow" {
+			time.Sleep(30 * time.Millisecond)
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	var calls []CallInfo
+	c := NewClient(srv.URL, WithSlowCallThreshold(20*time.Millisecond, func(ci CallInfo) {
+		calls = append(calls, ci)
+	}))
+	ctx := WithRequestOptions(context.Background(), QueryParam("trace", "1"))
+	_, _ = c.GetAuditRecord(ctx, "fast")
+	_, _ = c.GetAuditRecord(ctx, "slow")
+
+	if len(calls) != 1 {
+		t.Fatalf("calls = %+v", calls)
+	}
+	ci := calls[0]
+	if ci.Method != http.MethodGet || ci.Path != "/v1/audit/slow" || ci.Status != http.StatusNotFound || ci.Duration < 20*time.Millisecond || ci.Err != nil {
+		t.Errorf("call = %+v", ci)
+	}
+
+	srv.Close()
+	c = NewClient(srv.URL, WithSlowCallThreshold(-1, func(ci CallInfo) { calls = append(calls, ci) }))
+	_, _ = c.GetAuditRecord(context.Background(), "down")
+	if last := calls[len(calls)-1]; last.Status != 0 || last.Err == nil {
+		t.Errorf("failed call = %+v", last)
+	}
+}
@@ -0,0 +1,299 @@
+// Template and profile bundles for the Go ActeonClient.
+//
+// ExportTemplates writes every template and profile matching a filter
+// as a single JSON document; ImportTemplates applies such a document to
+// another gateway. Entries are keyed by (namespace, tenant, name) rather
+// than ID, so a bundle exported from staging can be promoted to
+// production from CI. Before anything is written, ImportTemplates checks
+// that every profile `$ref` resolves to a template in the bundle or
+// already on the target gateway.
+
+package acteon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// TemplateBundleVersion is the bundle format version written by
+// ExportTemplates.
+const TemplateBundleVersion = 1
+
+// TemplateBundle is the document produced by ExportTemplates and
+// consumed by ImportTemplates.
+type TemplateBundle struct {
+	Version   int                     `json:"version"`
+	Templates []CreateTemplateRequest `json:"templates"`
+	Profiles  []CreateProfileRequest  `json:"profiles"`
+}
+
+// TemplateBundleFilter selects what ExportTemplates writes. Nil fields
+// match everything.
+type TemplateBundleFilter struct {
+	Namespace *string
+	Tenant    *string
+}
+
+// ImportOptions configures ImportTemplates.
+type ImportOptions struct {
+	// DryRun reports what would change without writing anything.
+	DryRun bool
+	// Overwrite updates templates and profiles that already exist on
+	// the gateway. Without it they are skipped.
+	Overwrite bool
+}
+
+// Bundle import actions reported in ImportedItem.Action.
+const (
+	ImportCreated = "created"
+	ImportUpdated = "updated"
+	ImportSkipped = "skipped"
+)
+
+// ImportedItem records what ImportTemplates did, or would do on a dry
+// run, with one bundle entry.
+type ImportedItem struct {
+	Kind      string `json:"kind"` // "template" or "profile"
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Tenant    string `json:"tenant"`
+	Action    string `json:"action"`
+}
+
+// ImportResult is the outcome of ImportTemplates.
+type ImportResult struct {
+	DryRun bool           `json:"dry_run"`
+	Items  []ImportedItem `json:"items"`
+}
+
+// ExportTemplates writes the templates and profiles matching filter to
+// w as an indented JSON TemplateBundle. filter may be nil.
+func (c *Client) ExportTemplates(ctx context.Context, filter *TemplateBundleFilter, w io.Writer) error {
+	ctx = withoutLabelSelector(ctx)
+	if filter == nil {
+		filter = &TemplateBundleFilter{}
+	}
+	templates, err := c.allTemplates(ctx, filter.Namespace, filter.Tenant)
+	if err != nil {
+		return err
+	}
+	profiles, err := c.allProfiles(ctx, filter.Namespace, filter.Tenant)
+	if err != nil {
+		return err
+	}
+
+	bundle := TemplateBundle{
+		Version:   TemplateBundleVersion,
+		Templates: make([]CreateTemplateRequest, 0, len(templates)),
+		Profiles:  make([]CreateProfileRequest, 0, len(profiles)),
+	}
+	for _, t := range templates {
+		entry := CreateTemplateRequest{
+			Name:      t.Name,
+			Namespace: t.Namespace,
+			Tenant:    t.Tenant,
+			Content:   t.Content,
+			Labels:    t.Labels,
+		}
+		if t.Description != nil {
+			entry.Description = *t.Description
+		}
+		bundle.Templates = append(bundle.Templates, entry)
+	}
+	for _, p := range profiles {
+		entry := CreateProfileRequest{
+			Name:      p.Name,
+			Namespace: p.Namespace,
+			Tenant:    p.Tenant,
+			Fields:    p.Fields,
+			Labels:    p.Labels,
+		}
+		if p.Description != nil {
+			entry.Description = *p.Description
+		}
+		bundle.Profiles = append(bundle.Profiles, entry)
+	}
+	sort.Slice(bundle.Templates, func(i, j int) bool {
+		a, b := bundle.Templates[i], bundle.Templates[j]
+		return bundleKey{a.Namespace, a.Tenant, a.Name}.less(bundleKey{b.Namespace, b.Tenant, b.Name})
+	})
+	sort.Slice(bundle.Profiles, func(i, j int) bool {
+		a, b := bundle.Profiles[i], bundle.Profiles[j]
+		return bundleKey{a.Namespace, a.Tenant, a.Name}.less(bundleKey{b.Namespace, b.Tenant, b.Name})
+	})
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(bundle)
+}
+
+// ImportTemplates reads a TemplateBundle from r and creates its
+// templates, then its profiles, on the gateway. Entries that already
+// exist are updated when opts.Overwrite is set and skipped otherwise.
+// A profile `$ref` that resolves neither within the bundle nor on the
+// gateway fails the whole import before anything is written.
+func (c *Client) ImportTemplates(ctx context.Context, r io.Reader, opts ImportOptions) (*ImportResult, error) {
+	ctx = withoutLabelSelector(ctx)
+	ctx = withoutIdempotencyKey(ctx)
+	var bundle TemplateBundle
+	if err := json.NewDecoder(r).Decode(&bundle); err != nil {
+		return nil, fmt.Errorf("decode template bundle: %w", err)
+	}
+	if bundle.Version != TemplateBundleVersion {
+		return nil, fmt.Errorf("unsupported template bundle version %d", bundle.Version)
+	}
+
+	// Index what already exists on the gateway, one list call per scope.
+	existingTemplates := map[bundleKey]string{}
+	existingProfiles := map[bundleKey]string{}
+	listed := map[bundleScope]bool{}
+	var scopes []bundleScope
+	for _, t := range bundle.Templates {
+		scopes = append(scopes, bundleScope{t.Namespace, t.Tenant})
+	}
+	for _, p := range bundle.Profiles {
+		scopes = append(scopes, bundleScope{p.Namespace, p.Tenant})
+	}
+	for _, s := range scopes {
+		if listed[s] {
+			continue
+		}
+		listed[s] = true
+		ns, tenant := s.namespace, s.tenant
+		templates, err := c.allTemplates(ctx, &ns, &tenant)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range templates {
+			existingTemplates[bundleKey{t.Namespace, t.Tenant, t.Name}] = t.ID
+		}
+		profiles, err := c.allProfiles(ctx, &ns, &tenant)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range profiles {
+			existingProfiles[bundleKey{p.Namespace, p.Tenant, p.Name}] = p.ID
+		}
+	}
+
+	if err := checkBundleRefs(&bundle, existingTemplates); err != nil {
+		return nil, err
+	}
+
+	result := &ImportResult{DryRun: opts.DryRun}
+	for i := range bundle.Templates {
+		t := &bundle.Templates[i]
+		item := ImportedItem{Kind: "template", Name: t.Name, Namespace: t.Namespace, Tenant: t.Tenant}
+		id, exists := existingTemplates[bundleKey{t.Namespace, t.Tenant, t.Name}]
+		switch {
+		case !exists:
+			item.Action = ImportCreated
+			if !opts.DryRun {
+				if _, err := c.CreateTemplate(ctx, t); err != nil {
+					return result, fmt.Errorf("template %q: %w", t.Name, err)
+				}
+			}
+		case opts.Overwrite:
+			item.Action = ImportUpdated
+			if !opts.DryRun {
+				update := &UpdateTemplateRequest{Content: &t.Content, Description: &t.Description, Labels: t.Labels}
+				if _, err := c.UpdateTemplate(ctx, id, update); err != nil {
+					return result, fmt.Errorf("template %q: %w", t.Name, err)
+				}
+			}
+		default:
+			item.Action = ImportSkipped
+		}
+		result.Items = append(result.Items, item)
+	}
+	for i := range bundle.Profiles {
+		p := &bundle.Profiles[i]
+		item := ImportedItem{Kind: "profile", Name: p.Name, Namespace: p.Namespace, Tenant: p.Tenant}
+		id, exists := existingProfiles[bundleKey{p.Namespace, p.Tenant, p.Name}]
+		switch {
+		case !exists:
+			item.Action = ImportCreated
+			if !opts.DryRun {
+				if _, err := c.CreateProfile(ctx, p); err != nil {
+					return result, fmt.Errorf("profile %q: %w", p.Name, err)
+				}
+			}
+		case opts.Overwrite:
+			item.Action = ImportUpdated
+			if !opts.DryRun {
+				update := &UpdateProfileRequest{Fields: p.Fields, Description: &p.Description, Labels: p.Labels}
+				if _, err := c.UpdateProfile(ctx, id, update); err != nil {
+					return result, fmt.Errorf("profile %q: %w", p.Name, err)
+				}
+			}
+		default:
+			item.Action = ImportSkipped
+		}
+		result.Items = append(result.Items, item)
+	}
+	return result, nil
+}
+
+// checkBundleRefs reports every profile `$ref` that names a template
+// neither in the bundle nor in existing, within the profile's own
+// namespace and tenant.
+func checkBundleRefs(bundle *TemplateBundle, existing map[bundleKey]string) error {
+	inBundle := make(map[bundleKey]bool, len(bundle.Templates))
+	for _, t := range bundle.Templates {
+		inBundle[bundleKey{t.Namespace, t.Tenant, t.Name}] = true
+	}
+
+	var errs []error
+	for _, p := range bundle.Profiles {
+		fields := make([]string, 0, len(p.Fields))
+		for name := range p.Fields {
+			fields = append(fields, name)
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			ref, ok := profileFieldRef(p.Fields[field])
+			if !ok {
+				continue
+			}
+			key := bundleKey{p.Namespace, p.Tenant, ref}
+			if _, onGateway := existing[key]; !inBundle[key] && !onGateway {
+				errs = append(errs, fmt.Errorf("profile %q field %q references unknown template %q", p.Name, field, ref))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// profileFieldRef returns the template name a `{"$ref": ...}` profile
+// field points at.
+func profileFieldRef(raw TemplateProfileField) (string, bool) {
+	var ref struct {
+		Ref string `json:"$ref"`
+	}
+	if err := json.Unmarshal(raw, &ref); err != nil || ref.Ref == "" {
+		return "", false
+	}
+	return ref.Ref, true
+}
+
+type bundleScope struct {
+	namespace, tenant string
+}
+
+type bundleKey struct {
+	namespace, tenant, name string
+}
+
+func (k bundleKey) less(o bundleKey) bool {
+	if k.namespace != o.namespace {
+		return k.namespace < o.namespace
+	}
+	if k.tenant != o.tenant {
+		return k.tenant < o.tenant
+	}
+	return k.name < o.name
+}
@@ -0,0 +1,162 @@
+package acteon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// bundleServer serves fixed template and profile lists, paged like the
+// gateway's, and records every write it receives as "METHOD path".
+func bundleServer(t *testing.T, templates []TemplateInfo, profiles []TemplateProfileInfo) (*httptest.Server, *[]string) {
+	t.Helper()
+	var writes []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/templates":
+			page := servePage(templates, r)
+			_ = json.NewEncoder(w).Encode(ListTemplatesResponse{Templates: page, Count: len(page)})
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/templates/profiles":
+			page := servePage(profiles, r)
+			_ = json.NewEncoder(w).Encode(ListProfilesResponse{Profiles: page, Count: len(page)})
+		case r.Method == http.MethodPost:
+			writes = append(writes, r.Method+" "+r.URL.Path)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			writes = append(writes, r.Method+" "+r.URL.Path)
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	return srv, &writes
+}
+
+// servePage returns the page of items r asks for, defaulting to the
+// gateway's limit of 100.
+func servePage[T any](items []T, r *http.Request) []T {
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil {
+		limit = 100
+	}
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	return items[min(offset, len(items)):min(offset+limit, len(items))]
+}
+
+func TestExportTemplatesPagesThroughEveryTemplate(t *testing.T) {
+	var templates []TemplateInfo
+	for i := range 250 {
+		templates = append(templates, TemplateInfo{ID: fmt.Sprint(i), Name: fmt.Sprintf("t%03d", i), Namespace: "ns", Tenant: "t"})
+	}
+	srv, _ := bundleServer(t, templates, nil)
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	if err := NewClient(srv.URL).ExportTemplates(context.Background(), nil, &buf); err != nil {
+		t.Fatal(err)
+	}
+	var bundle TemplateBundle
+	if err := json.Unmarshal(buf.Bytes(), &bundle); err != nil {
+		t.Fatal(err)
+	}
+	if len(bundle.Templates) != 250 {
+		t.Errorf("exported %d of 250 templates", len(bundle.Templates))
+	}
+}
+
+func TestExportTemplatesWritesSortedBundle(t *testing.T) {
+	srv, _ := bundleServer(t,
+		[]TemplateInfo{
+			{ID: "t2", Name: "body", Namespace: "ns", Tenant: "t", Content: "{{ msg }}"},
+			{ID: "t1", Name: "alpha", Namespace: "ns", Tenant: "t", Content: "a", Description: ptr("first")},
+		},
+		[]TemplateProfileInfo{{ID: "p1", Name: "alert", Namespace: "ns", Tenant: "t",
+			Fields: map[string]TemplateProfileField{"body": json.RawMessage(`{"$ref":"body"}`)}}},
+	)
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	if err := NewClient(srv.URL).ExportTemplates(context.Background(), nil, &buf); err != nil {
+		t.Fatal(err)
+	}
+	var bundle TemplateBundle
+	if err := json.Unmarshal(buf.Bytes(), &bundle); err != nil {
+		t.Fatal(err)
+	}
+	if bundle.Version != TemplateBundleVersion || len(bundle.Templates) != 2 || len(bundle.Profiles) != 1 {
+		t.Fatalf("bundle = %+v", bundle)
+	}
+	if bundle.Templates[0].Name != "alpha" || bundle.Templates[0].Description != "first" {
+		t.Errorf("templates not sorted by name: %+v", bundle.Templates)
+	}
+	if strings.Contains(buf.String(), `"id"`) {
+		t.Errorf("bundle should not carry gateway IDs:\n%s", buf.String())
+	}
+}
+
+func TestImportTemplatesCreatesSkipsAndUpdates(t *testing.T) {
+	bundle := `{"version":1,
+		"templates":[
+			{"name":"body","namespace":"ns","tenant":"t","content":"{{ msg }}"},
+			{"name":"fresh","namespace":"ns","tenant":"t","content":"x"}],
+		"profiles":[
+			{"name":"alert","namespace":"ns","tenant":"t","fields":{"body":{"$ref":"body"},"title":"hi"}}]}`
+	existing := []TemplateInfo{{ID: "t-body", Name: "body", Namespace: "ns", Tenant: "t"}}
+
+	srv, writes := bundleServer(t, existing, nil)
+	defer srv.Close()
+	c := NewClient(srv.URL)
+
+	res, err := c.ImportTemplates(context.Background(), strings.NewReader(bundle), ImportOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	actions := map[string]string{}
+	for _, it := range res.Items {
+		actions[it.Kind+"/"+it.Name] = it.Action
+	}
+	if actions["template/body"] != ImportSkipped || actions["template/fresh"] != ImportCreated || actions["profile/alert"] != ImportCreated {
+		t.Errorf("actions = %v", actions)
+	}
+	if got := strings.Join(*writes, ","); got != "POST /v1/templates,POST /v1/templates/profiles" {
+		t.Errorf("writes = %s", got)
+	}
+
+	*writes = nil
+	if _, err := c.ImportTemplates(context.Background(), strings.NewReader(bundle), ImportOptions{Overwrite: true}); err != nil {
+		t.Fatal(err)
+	}
+	if (*writes)[0] != "PUT /v1/templates/t-body" {
+		t.Errorf("writes = %v", *writes)
+	}
+
+	*writes = nil
+	res, err = c.ImportTemplates(context.Background(), strings.NewReader(bundle), ImportOptions{DryRun: true, Overwrite: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*writes) != 0 || !res.DryRun || len(res.Items) != 3 {
+		t.Errorf("dry run wrote %v, result %+v", *writes, res)
+	}
+}
+
+func TestImportTemplatesRejectsDanglingRef(t *testing.T) {
+	bundle := `{"version":1,"templates":[],
+		"profiles":[{"name":"alert","namespace":"ns","tenant":"t","fields":{"body":{"$ref":"missing"}}}]}`
+	srv, writes := bundleServer(t, nil, nil)
+	defer srv.Close()
+
+	_, err := NewClient(srv.URL).ImportTemplates(context.Background(), strings.NewReader(bundle), ImportOptions{})
+	if err == nil || !strings.Contains(err.Error(), `unknown template "missing"`) {
+		t.Fatalf("err = %v", err)
+	}
+	if len(*writes) != 0 {
+		t.Errorf("nothing should be written, got %v", *writes)
+	}
+}
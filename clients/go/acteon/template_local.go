@@ -0,0 +1,2485 @@
+// Offline template rendering for the Go ActeonClient.
+//
+// The gateway renders payload templates with MiniJinja (Jinja2
+// syntax). RenderTemplateLocal implements the subset templates use in
+// practice, so CI and developer tooling can check templates without a
+// running server: `{{ }}` output with attribute/index access and
+// slicing, arithmetic, comparisons, and the common filters and tests;
+// `{% if %}`/`{% elif %}`/`{% else %}`, `{% for %}` (with `loop.*`,
+// an `if` filter, and `{% else %}`), `{% set %}`, `{% raw %}`, `{# #}`
+// comments, and `-` whitespace control.
+//
+// Anything that needs server context — `{% include %}`, `{% extends %}`,
+// macros, imports, or a filter/test/function this renderer does not
+// know, recursive loops, or integers beyond 64 bits — fails with an
+// error wrapping ErrTemplateNeedsServer. Callers
+// should fall back to RenderPreview in that case. Syntax errors are
+// reported as *TemplateSyntaxError with a line and column.
+
+package acteon
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ErrTemplateNeedsServer is wrapped by RenderTemplateLocal when a
+// template uses a feature only the gateway can render.
+var ErrTemplateNeedsServer = errors.New("template requires server-side rendering")
+
+// TemplateSyntaxError reports a template that does not parse. Line and
+// Column are 1-based.
+type TemplateSyntaxError struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e *TemplateSyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at line %d, column %d: %s", e.Line, e.Column, e.Message)
+}
+
+// maxLocalRenderBytes mirrors the gateway's per-field output limit.
+const maxLocalRenderBytes = 1024 * 1024
+
+// maxLocalRangeLen caps the lists range() builds.
+const maxLocalRangeLen = 10000
+
+// RenderTemplateLocal renders template content against payload the way
+// the gateway would, without contacting it. As on the gateway, payload
+// fields are available at the root of the context alongside empty
+// `attachments` and `attachments_by_id` values.
+func RenderTemplateLocal(content string, payload map[string]any) (string, error) {
+	nodes, err := parseTemplate(content)
+	if err != nil {
+		return "", err
+	}
+	root := make(map[string]any, len(payload)+2)
+	for k, v := range payload {
+		root[k] = normalizeTplValue(v)
+	}
+	root["attachments"] = []any{}
+	root["attachments_by_id"] = map[string]any{}
+
+	r := &tplRenderer{scopes: []map[string]any{root}}
+	if err := r.render(nodes); err != nil {
+		return "", err
+	}
+	return r.out.String(), nil
+}
+
+// RenderProfileLocal renders every field of a template profile. Fields
+// are inline template strings or `{"$ref": "name"}` objects resolved
+// against templates (name to content).
+func RenderProfileLocal(fields map[string]TemplateProfileField, templates map[string]string, payload map[string]any) (map[string]string, error) {
+	out := make(map[string]string, len(fields))
+	for name, raw := range fields {
+		content, err := profileFieldContent(raw, templates)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", name, err)
+		}
+		rendered, err := RenderTemplateLocal(content, payload)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", name, err)
+		}
+		out[name] = rendered
+	}
+	return out, nil
+}
+
+// RenderLocal renders every field of a resolved profile with
+// RenderTemplateLocal, giving the same preview as RenderPreview for
+// templates the local renderer supports.
+func (p *ResolvedProfile) RenderLocal(payload map[string]any) (map[string]string, error) {
+	out := make(map[string]string, len(p.Fields))
+	for name, field := range p.Fields {
+		rendered, err := RenderTemplateLocal(field.Content, payload)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", name, err)
+		}
+		out[name] = rendered
+	}
+	return out, nil
+}
+
+func profileFieldContent(raw TemplateProfileField, templates map[string]string) (string, error) {
+	var inline string
+	if err := json.Unmarshal(raw, &inline); err == nil {
+		return inline, nil
+	}
+	var ref struct {
+		Ref string `json:"$ref"`
+	}
+	if err := json.Unmarshal(raw, &ref); err != nil || ref.Ref == "" {
+		return "", fmt.Errorf("field is neither a string nor a $ref object")
+	}
+	content, ok := templates[ref.Ref]
+	if !ok {
+		return "", fmt.Errorf("references unknown template %q", ref.Ref)
+	}
+	return content, nil
+}
+
+// =============================================================================
+// Lexing
+// =============================================================================
+
+type tplSegKind int
+
+const (
+	segText tplSegKind = iota
+	segOutput
+	segBlock
+)
+
+type tplSegment struct {
+	kind       tplSegKind
+	text       string
+	offset     int // byte offset of the segment body in the source
+	stripLeft  bool
+	stripRight bool
+}
+
+type tplSource struct {
+	src        string
+	lineStarts []int
+}
+
+func newTplSource(src string) *tplSource {
+	s := &tplSource{src: src, lineStarts: []int{0}}
+	for i, c := range src {
+		if c == '\n' {
+			s.lineStarts = append(s.lineStarts, i+1)
+		}
+	}
+	return s
+}
+
+func (s *tplSource) errorAt(offset int, format string, args ...any) *TemplateSyntaxError {
+	line := sort.Search(len(s.lineStarts), func(i int) bool { return s.lineStarts[i] > offset })
+	col := offset - s.lineStarts[line-1] + 1
+	return &TemplateSyntaxError{Line: line, Column: col, Message: fmt.Sprintf(format, args...)}
+}
+
+func lexTemplate(s *tplSource) ([]tplSegment, error) {
+	src := s.src
+	var segs []tplSegment
+	i := 0
+	for i < len(src) {
+		start := indexTagOpen(src, i)
+		if start < 0 {
+			segs = append(segs, tplSegment{kind: segText, text: src[i:], offset: i})
+			break
+		}
+		if start > i {
+			segs = append(segs, tplSegment{kind: segText, text: src[i:start], offset: i})
+		}
+		open := src[start : start+2]
+		bodyStart := start + 2
+		stripLeft := bodyStart < len(src) && src[bodyStart] == '-'
+		if stripLeft {
+			bodyStart++
+		}
+
+		var closeTok string
+		switch open {
+		case "{{":
+			closeTok = "}}"
+		case "{%":
+			closeTok = "%}"
+		default:
+			closeTok = "#}"
+		}
+		end := indexTagClose(src, bodyStart, closeTok, open != "{#")
+		if end < 0 {
+			return nil, s.errorAt(start, "unclosed %q", open)
+		}
+		bodyEnd := end
+		stripRight := bodyEnd > bodyStart && src[bodyEnd-1] == '-'
+		if stripRight {
+			bodyEnd--
+		}
+		next := end + 2
+
+		if open == "{#" {
+			// Comments still honour whitespace control.
+			segs = append(segs, tplSegment{kind: segText, text: "", offset: start, stripLeft: stripLeft, stripRight: stripRight})
+			i = next
+			continue
+		}
+
+		seg := tplSegment{kind: segOutput, text: src[bodyStart:bodyEnd], offset: bodyStart, stripLeft: stripLeft, stripRight: stripRight}
+		if open == "{%" {
+			seg.kind = segBlock
+			if strings.TrimSpace(seg.text) == "raw" {
+				rawEnd, after, rawStripRight, ok := findEndRaw(src, next)
+				if !ok {
+					return nil, s.errorAt(start, "unclosed raw block")
+				}
+				segs = append(segs, tplSegment{kind: segText, text: src[next:rawEnd], offset: next, stripLeft: stripLeft, stripRight: rawStripRight})
+				i = after
+				continue
+			}
+		}
+		segs = append(segs, seg)
+		i = next
+	}
+
+	// Apply `-` whitespace control to neighbouring text.
+	for k := range segs {
+		if segs[k].stripLeft && k > 0 && segs[k-1].kind == segText {
+			segs[k-1].text = strings.TrimRightFunc(segs[k-1].text, unicode.IsSpace)
+		}
+		if segs[k].stripRight && k+1 < len(segs) && segs[k+1].kind == segText {
+			segs[k+1].text = strings.TrimLeftFunc(segs[k+1].text, unicode.IsSpace)
+		}
+	}
+	return segs, nil
+}
+
+func indexTagOpen(src string, from int) int {
+	for i := from; i+1 < len(src); i++ {
+		if src[i] == '{' && (src[i+1] == '{' || src[i+1] == '%' || src[i+1] == '#') {
+			return i
+		}
+	}
+	return -1
+}
+
+// indexTagClose finds closeTok, skipping over quoted strings when the
+// tag contains an expression.
+func indexTagClose(src string, from int, closeTok string, quoted bool) int {
+	var quote byte
+	for i := from; i+1 < len(src); i++ {
+		c := src[i]
+		if quote != 0 {
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		if quoted && (c == '\'' || c == '"') {
+			quote = c
+			continue
+		}
+		if src[i:i+2] == closeTok {
+			return i
+		}
+	}
+	return -1
+}
+
+func findEndRaw(src string, from int) (rawEnd, after int, stripRight, ok bool) {
+	for i := from; ; {
+		j := strings.Index(src[i:], "{%")
+		if j < 0 {
+			return 0, 0, false, false
+		}
+		j += i
+		body := j + 2
+		stripLeft := body < len(src) && src[body] == '-'
+		if stripLeft {
+			body++
+		}
+		end := strings.Index(src[body:], "%}")
+		if end < 0 {
+			return 0, 0, false, false
+		}
+		end += body
+		inner := src[body:end]
+		sr := strings.HasSuffix(inner, "-")
+		if strings.TrimSpace(strings.TrimSuffix(inner, "-")) == "endraw" {
+			text := src[from:j]
+			if stripLeft {
+				text = strings.TrimRightFunc(text, unicode.IsSpace)
+			}
+			return from + len(text), end + 2, sr, true
+		}
+		i = end + 2
+	}
+}
+
+// =============================================================================
+// Parsing
+// =============================================================================
+
+type tplNode interface{}
+
+type tplTextNode struct{ text string }
+
+type tplOutputNode struct{ expr tplExpr }
+
+type tplIfNode struct {
+	conds  []tplExpr
+	bodies [][]tplNode
+	els    []tplNode
+}
+
+type tplForNode struct {
+	targets []string
+	iter    tplExpr
+	filter  tplExpr
+	body    []tplNode
+	els     []tplNode
+}
+
+type tplSetNode struct {
+	name string
+	expr tplExpr
+}
+
+type tplParser struct {
+	src  *tplSource
+	segs []tplSegment
+	pos  int
+}
+
+func parseTemplate(content string) ([]tplNode, error) {
+	// MiniJinja drops a single trailing newline by default.
+	content = strings.TrimSuffix(content, "\n")
+	src := newTplSource(content)
+	segs, err := lexTemplate(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &tplParser{src: src, segs: segs}
+	nodes, end, err := p.parseBody()
+	if err != nil {
+		return nil, err
+	}
+	if end != nil {
+		return nil, src.errorAt(end.offset, "unexpected {%% %s %%}", strings.TrimSpace(end.text))
+	}
+	return nodes, nil
+}
+
+// parseBody parses nodes until an end-like block tag (endif, else,
+// elif, endfor) or the end of input. The terminating segment is
+// returned without being consumed into a node.
+func (p *tplParser) parseBody() ([]tplNode, *tplSegment, error) {
+	var nodes []tplNode
+	for p.pos < len(p.segs) {
+		seg := &p.segs[p.pos]
+		p.pos++
+		switch seg.kind {
+		case segText:
+			if seg.text != "" {
+				nodes = append(nodes, &tplTextNode{text: seg.text})
+			}
+		case segOutput:
+			expr, err := p.parseExpr(seg)
+			if err != nil {
+				return nil, nil, err
+			}
+			nodes = append(nodes, &tplOutputNode{expr: expr})
+		case segBlock:
+			lx := newExprLexer(p.src, seg.text, seg.offset)
+			if lx.err != nil {
+				return nil, nil, lx.err
+			}
+			tag := lx.peek()
+			if tag.kind != etName {
+				return nil, nil, p.src.errorAt(tag.offset, "expected a block tag")
+			}
+			switch tag.val {
+			case "endif", "else", "elif", "endfor":
+				return nodes, seg, nil
+			case "if":
+				node, err := p.parseIf(seg)
+				if err != nil {
+					return nil, nil, err
+				}
+				nodes = append(nodes, node)
+			case "for":
+				node, err := p.parseFor(seg)
+				if err != nil {
+					return nil, nil, err
+				}
+				nodes = append(nodes, node)
+			case "set":
+				node, err := p.parseSet(seg)
+				if err != nil {
+					return nil, nil, err
+				}
+				nodes = append(nodes, node)
+			case "include", "extends", "import", "from", "macro", "call", "block", "filter", "with", "autoescape":
+				return nil, nil, fmt.Errorf("{%% %s %%}: %w", tag.val, ErrTemplateNeedsServer)
+			default:
+				return nil, nil, p.src.errorAt(tag.offset, "unknown block tag %q", tag.val)
+			}
+		}
+	}
+	return nodes, nil, nil
+}
+
+func (p *tplParser) parseIf(seg *tplSegment) (tplNode, error) {
+	node := &tplIfNode{}
+	cur := seg
+	keyword := "if"
+	for {
+		lx := newExprLexer(p.src, cur.text, cur.offset)
+		if lx.err != nil {
+			return nil, lx.err
+		}
+		lx.next() // if / elif
+		cond, err := lx.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if err := lx.expectEnd(); err != nil {
+			return nil, err
+		}
+		body, end, err := p.parseBody()
+		if err != nil {
+			return nil, err
+		}
+		node.conds = append(node.conds, cond)
+		node.bodies = append(node.bodies, body)
+		if end == nil {
+			return nil, p.src.errorAt(seg.offset, "unclosed {%% %s %%}", keyword)
+		}
+		switch tagName(end) {
+		case "elif":
+			cur = end
+			keyword = "elif"
+			continue
+		case "else":
+			els, end2, err := p.parseBody()
+			if err != nil {
+				return nil, err
+			}
+			if end2 == nil || tagName(end2) != "endif" {
+				return nil, p.src.errorAt(seg.offset, "unclosed {%% if %%}")
+			}
+			node.els = els
+			return node, nil
+		case "endif":
+			return node, nil
+		default:
+			return nil, p.src.errorAt(end.offset, "unexpected {%% %s %%} inside if", tagName(end))
+		}
+	}
+}
+
+func (p *tplParser) parseFor(seg *tplSegment) (tplNode, error) {
+	lx := newExprLexer(p.src, seg.text, seg.offset)
+	if lx.err != nil {
+		return nil, lx.err
+	}
+	lx.next() // for
+	node := &tplForNode{}
+	for {
+		t := lx.next()
+		if t.kind != etName {
+			return nil, p.src.errorAt(t.offset, "expected loop variable")
+		}
+		node.targets = append(node.targets, t.val)
+		if lx.peek().val != "," {
+			break
+		}
+		lx.next()
+	}
+	if t := lx.next(); t.kind != etName || t.val != "in" {
+		return nil, p.src.errorAt(t.offset, "expected 'in'")
+	}
+	iter, err := lx.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	node.iter = iter
+	if lx.accept("if") {
+		if node.filter, err = lx.parseExpression(); err != nil {
+			return nil, err
+		}
+	}
+	if lx.accept("recursive") {
+		return nil, fmt.Errorf("recursive loop: %w", ErrTemplateNeedsServer)
+	}
+	if err := lx.expectEnd(); err != nil {
+		return nil, err
+	}
+	body, end, err := p.parseBody()
+	if err != nil {
+		return nil, err
+	}
+	node.body = body
+	if end != nil && tagName(end) == "else" {
+		node.els, end, err = p.parseBody()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if end == nil || tagName(end) != "endfor" {
+		return nil, p.src.errorAt(seg.offset, "unclosed {%% for %%}")
+	}
+	return node, nil
+}
+
+func (p *tplParser) parseSet(seg *tplSegment) (tplNode, error) {
+	lx := newExprLexer(p.src, seg.text, seg.offset)
+	if lx.err != nil {
+		return nil, lx.err
+	}
+	lx.next() // set
+	name := lx.next()
+	if name.kind != etName {
+		return nil, p.src.errorAt(name.offset, "expected variable name")
+	}
+	if t := lx.next(); t.val != "=" {
+		if t.kind == etEOF {
+			return nil, fmt.Errorf("block {%% set %%}: %w", ErrTemplateNeedsServer)
+		}
+		return nil, p.src.errorAt(t.offset, "expected '='")
+	}
+	expr, err := lx.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if err := lx.expectEnd(); err != nil {
+		return nil, err
+	}
+	return &tplSetNode{name: name.val, expr: expr}, nil
+}
+
+func (p *tplParser) parseExpr(seg *tplSegment) (tplExpr, error) {
+	lx := newExprLexer(p.src, seg.text, seg.offset)
+	if lx.err != nil {
+		return nil, lx.err
+	}
+	expr, err := lx.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if err := lx.expectEnd(); err != nil {
+		return nil, err
+	}
+	return expr, nil
+}
+
+func tagName(seg *tplSegment) string {
+	fields := strings.Fields(seg.text)
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[0]
+}
+
+// =============================================================================
+// Expressions
+// =============================================================================
+
+type etKind int
+
+const (
+	etEOF etKind = iota
+	etName
+	etNumber
+	etString
+	etOp
+)
+
+type etok struct {
+	kind   etKind
+	val    string
+	offset int
+}
+
+type exprLexer struct {
+	src  *tplSource
+	toks []etok
+	pos  int
+	err  error
+}
+
+func newExprLexer(src *tplSource, text string, base int) *exprLexer {
+	lx := &exprLexer{src: src}
+	i := 0
+	for i < len(text) {
+		c := text[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '_' || unicode.IsLetter(rune(c)):
+			j := i
+			for j < len(text) && (text[j] == '_' || unicode.IsLetter(rune(text[j])) || unicode.IsDigit(rune(text[j]))) {
+				j++
+			}
+			lx.toks = append(lx.toks, etok{etName, text[i:j], base + i})
+			i = j
+		case unicode.IsDigit(rune(c)):
+			j := i
+			for j < len(text) && (unicode.IsDigit(rune(text[j])) || text[j] == '.' || text[j] == '_') {
+				if text[j] == '.' && (j+1 >= len(text) || !unicode.IsDigit(rune(text[j+1]))) {
+					break
+				}
+				j++
+			}
+			if j < len(text) && (text[j] == 'e' || text[j] == 'E') {
+				k := j + 1
+				if k < len(text) && (text[k] == '+' || text[k] == '-') {
+					k++
+				}
+				if k < len(text) && unicode.IsDigit(rune(text[k])) {
+					for k < len(text) && unicode.IsDigit(rune(text[k])) {
+						k++
+					}
+					j = k
+				}
+			}
+			lx.toks = append(lx.toks, etok{etNumber, strings.ReplaceAll(text[i:j], "_", ""), base + i})
+			i = j
+		case c == '\'' || c == '"':
+			var b strings.Builder
+			j := i + 1
+			for j < len(text) && text[j] != c {
+				if text[j] == '\\' && j+1 < len(text) {
+					j++
+					switch text[j] {
+					case 'n':
+						b.WriteByte('\n')
+					case 't':
+						b.WriteByte('\t')
+					default:
+						b.WriteByte(text[j])
+					}
+				} else {
+					b.WriteByte(text[j])
+				}
+				j++
+			}
+			if j >= len(text) {
+				lx.err = src.errorAt(base+i, "unterminated string")
+				return lx
+			}
+			lx.toks = append(lx.toks, etok{etString, b.String(), base + i})
+			i = j + 1
+		default:
+			op := string(c)
+			if i+1 < len(text) {
+				two := text[i : i+2]
+				switch two {
+				case "==", "!=", "<=", ">=", "//", "**":
+					op = two
+				}
+			}
+			if !strings.Contains("+-*/%~<>=!.,|:()[]{}", string(c)) || op == "!" {
+				lx.err = src.errorAt(base+i, "unexpected character %q", c)
+				return lx
+			}
+			lx.toks = append(lx.toks, etok{etOp, op, base + i})
+			i += len(op)
+		}
+	}
+	lx.toks = append(lx.toks, etok{etEOF, "", base + len(text)})
+	return lx
+}
+
+func (lx *exprLexer) peek() etok { return lx.at(lx.pos) }
+
+// at returns token i, or an EOF token past the end, which a lexer that
+// stopped on an error has no token for.
+func (lx *exprLexer) at(i int) etok {
+	if i >= len(lx.toks) {
+		return etok{kind: etEOF, offset: len(lx.src.src)}
+	}
+	return lx.toks[i]
+}
+
+func (lx *exprLexer) next() etok {
+	t := lx.peek()
+	if t.kind != etEOF {
+		lx.pos++
+	}
+	return t
+}
+
+func (lx *exprLexer) accept(val string) bool {
+	t := lx.peek()
+	if (t.kind == etOp || t.kind == etName) && t.val == val {
+		lx.pos++
+		return true
+	}
+	return false
+}
+
+func (lx *exprLexer) expect(val string) error {
+	if !lx.accept(val) {
+		t := lx.peek()
+		return lx.src.errorAt(t.offset, "expected %q, found %q", val, t.val)
+	}
+	return nil
+}
+
+func (lx *exprLexer) expectEnd() error {
+	if t := lx.peek(); t.kind != etEOF {
+		return lx.src.errorAt(t.offset, "unexpected %q", t.val)
+	}
+	return nil
+}
+
+func (lx *exprLexer) parseExpression() (tplExpr, error) {
+	if lx.err != nil {
+		return nil, lx.err
+	}
+	if lx.peek().kind == etEOF {
+		return nil, lx.src.errorAt(lx.peek().offset, "expected an expression")
+	}
+	expr, err := lx.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if lx.accept("if") {
+		cond, err := lx.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		var els tplExpr = &tplLiteral{val: tplUndefined}
+		if lx.accept("else") {
+			if els, err = lx.parseExpression(); err != nil {
+				return nil, err
+			}
+		}
+		return &tplCondExpr{cond: cond, then: expr, els: els}, nil
+	}
+	return expr, nil
+}
+
+func (lx *exprLexer) parseOr() (tplExpr, error) {
+	left, err := lx.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for lx.accept("or") {
+		right, err := lx.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &tplLogicExpr{and: false, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (lx *exprLexer) parseAnd() (tplExpr, error) {
+	left, err := lx.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for lx.accept("and") {
+		right, err := lx.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &tplLogicExpr{and: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (lx *exprLexer) parseNot() (tplExpr, error) {
+	if lx.accept("not") {
+		inner, err := lx.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &tplNotExpr{inner: inner}, nil
+	}
+	return lx.parseCompare()
+}
+
+func (lx *exprLexer) parseCompare() (tplExpr, error) {
+	left, err := lx.parseConcat()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := lx.peek()
+		switch {
+		case t.kind == etOp && (t.val == "==" || t.val == "!=" || t.val == "<" || t.val == ">" || t.val == "<=" || t.val == ">="):
+			lx.next()
+			right, err := lx.parseConcat()
+			if err != nil {
+				return nil, err
+			}
+			left = &tplBinExpr{op: t.val, left: left, right: right}
+		case t.kind == etName && t.val == "in":
+			lx.next()
+			right, err := lx.parseConcat()
+			if err != nil {
+				return nil, err
+			}
+			left = &tplBinExpr{op: "in", left: left, right: right}
+		case t.kind == etName && t.val == "not" && lx.at(lx.pos+1).val == "in":
+			lx.next()
+			lx.next()
+			right, err := lx.parseConcat()
+			if err != nil {
+				return nil, err
+			}
+			left = &tplNotExpr{inner: &tplBinExpr{op: "in", left: left, right: right}}
+		case t.kind == etName && t.val == "is":
+			lx.next()
+			negate := lx.accept("not")
+			name := lx.next()
+			if name.kind != etName {
+				return nil, lx.src.errorAt(name.offset, "expected test name")
+			}
+			test := &tplTestExpr{name: name.val, subject: left}
+			if lx.peek().val == "(" {
+				if test.args, err = lx.parseArgs(); err != nil {
+					return nil, err
+				}
+			} else if k := lx.peek().kind; k == etNumber || k == etString {
+				arg, err := lx.parsePrimary()
+				if err != nil {
+					return nil, err
+				}
+				test.args = []tplExpr{arg}
+			}
+			left = test
+			if negate {
+				left = &tplNotExpr{inner: test}
+			}
+		default:
+			return left, nil
+		}
+	}
+}
+
+func (lx *exprLexer) parseConcat() (tplExpr, error) {
+	left, err := lx.parseAdd()
+	if err != nil {
+		return nil, err
+	}
+	for lx.peek().kind == etOp && lx.peek().val == "~" {
+		lx.next()
+		right, err := lx.parseAdd()
+		if err != nil {
+			return nil, err
+		}
+		left = &tplBinExpr{op: "~", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (lx *exprLexer) parseAdd() (tplExpr, error) {
+	left, err := lx.parseMul()
+	if err != nil {
+		return nil, err
+	}
+	for t := lx.peek(); t.kind == etOp && (t.val == "+" || t.val == "-"); t = lx.peek() {
+		lx.next()
+		right, err := lx.parseMul()
+		if err != nil {
+			return nil, err
+		}
+		left = &tplBinExpr{op: t.val, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (lx *exprLexer) parseMul() (tplExpr, error) {
+	left, err := lx.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for t := lx.peek(); t.kind == etOp && (t.val == "*" || t.val == "/" || t.val == "//" || t.val == "%" || t.val == "**"); t = lx.peek() {
+		lx.next()
+		right, err := lx.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &tplBinExpr{op: t.val, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (lx *exprLexer) parseUnary() (tplExpr, error) {
+	if t := lx.peek(); t.kind == etOp && (t.val == "-" || t.val == "+") {
+		lx.next()
+		inner, err := lx.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if t.val == "+" {
+			return inner, nil
+		}
+		return &tplBinExpr{op: "-", left: &tplLiteral{val: int64(0)}, right: inner}, nil
+	}
+	return lx.parseFiltered()
+}
+
+func (lx *exprLexer) parseFiltered() (tplExpr, error) {
+	expr, err := lx.parsePostfix()
+	if err != nil {
+		return nil, err
+	}
+	for lx.peek().kind == etOp && lx.peek().val == "|" {
+		lx.next()
+		name := lx.next()
+		if name.kind != etName {
+			return nil, lx.src.errorAt(name.offset, "expected filter name")
+		}
+		f := &tplFilterExpr{name: name.val, subject: expr}
+		if lx.peek().val == "(" {
+			if f.args, err = lx.parseArgs(); err != nil {
+				return nil, err
+			}
+		}
+		expr = f
+	}
+	return expr, nil
+}
+
+func (lx *exprLexer) parsePostfix() (tplExpr, error) {
+	expr, err := lx.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := lx.peek()
+		if t.kind != etOp {
+			return expr, nil
+		}
+		switch t.val {
+		case ".":
+			lx.next()
+			name := lx.next()
+			if name.kind != etName && name.kind != etNumber {
+				return nil, lx.src.errorAt(name.offset, "expected attribute name")
+			}
+			expr = &tplGetExpr{subject: expr, key: &tplLiteral{val: name.val}}
+		case "[":
+			lx.next()
+			if expr, err = lx.parseSubscript(expr); err != nil {
+				return nil, err
+			}
+		case "(":
+			args, err := lx.parseArgs()
+			if err != nil {
+				return nil, err
+			}
+			expr = &tplCallExpr{callee: expr, args: args}
+		default:
+			return expr, nil
+		}
+	}
+}
+
+// parseSubscript parses what follows the `[` of an index, `[key]`, or
+// a slice, `[start:stop:step]` with any part omitted.
+func (lx *exprLexer) parseSubscript(subject tplExpr) (tplExpr, error) {
+	open := lx.peek()
+	var bounds []tplExpr
+	for {
+		var bound tplExpr
+		if t := lx.peek(); t.kind != etOp || (t.val != ":" && t.val != "]") {
+			var err error
+			if bound, err = lx.parseExpression(); err != nil {
+				return nil, err
+			}
+		}
+		bounds = append(bounds, bound)
+		if len(bounds) == 3 || !lx.accept(":") {
+			break
+		}
+	}
+	if err := lx.expect("]"); err != nil {
+		return nil, err
+	}
+	if len(bounds) == 1 {
+		if bounds[0] == nil {
+			return nil, lx.src.errorAt(open.offset, "expected index")
+		}
+		return &tplGetExpr{subject: subject, key: bounds[0]}, nil
+	}
+	slice := &tplSliceExpr{subject: subject, start: bounds[0], stop: bounds[1]}
+	if len(bounds) == 3 {
+		slice.step = bounds[2]
+	}
+	return slice, nil
+}
+
+// parseArgs parses a parenthesised argument list. Keyword arguments
+// are accepted and passed positionally, which matches how the
+// supported filters use them.
+func (lx *exprLexer) parseArgs() ([]tplExpr, error) {
+	if err := lx.expect("("); err != nil {
+		return nil, err
+	}
+	var args []tplExpr
+	for !lx.accept(")") {
+		if len(args) > 0 {
+			if err := lx.expect(","); err != nil {
+				return nil, err
+			}
+			if lx.accept(")") {
+				break
+			}
+		}
+		if lx.peek().kind == etName && lx.at(lx.pos+1).val == "=" {
+			lx.next()
+			lx.next()
+		}
+		arg, err := lx.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	return args, nil
+}
+
+func (lx *exprLexer) parsePrimary() (tplExpr, error) {
+	t := lx.next()
+	switch t.kind {
+	case etNumber:
+		if strings.ContainsAny(t.val, ".eE") {
+			f, err := strconv.ParseFloat(t.val, 64)
+			if err != nil {
+				return nil, lx.src.errorAt(t.offset, "invalid number %q", t.val)
+			}
+			return &tplLiteral{val: f}, nil
+		}
+		n, err := strconv.ParseInt(t.val, 10, 64)
+		if errors.Is(err, strconv.ErrRange) {
+			return nil, fmt.Errorf("integer literal %s: %w", t.val, ErrTemplateNeedsServer)
+		}
+		if err != nil {
+			return nil, lx.src.errorAt(t.offset, "invalid number %q", t.val)
+		}
+		return &tplLiteral{val: n}, nil
+	case etString:
+		s := t.val
+		// Adjacent string literals concatenate.
+		for lx.peek().kind == etString {
+			s += lx.next().val
+		}
+		return &tplLiteral{val: s}, nil
+	case etName:
+		switch t.val {
+		case "true", "True":
+			return &tplLiteral{val: true}, nil
+		case "false", "False":
+			return &tplLiteral{val: false}, nil
+		case "none", "None":
+			return &tplLiteral{val: nil}, nil
+		}
+		return &tplVarExpr{name: t.val}, nil
+	case etOp:
+		switch t.val {
+		case "(":
+			expr, err := lx.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			if err := lx.expect(")"); err != nil {
+				return nil, err
+			}
+			return expr, nil
+		case "[":
+			list := &tplListExpr{}
+			for !lx.accept("]") {
+				if len(list.items) > 0 {
+					if err := lx.expect(","); err != nil {
+						return nil, err
+					}
+					if lx.accept("]") {
+						break
+					}
+				}
+				item, err := lx.parseExpression()
+				if err != nil {
+					return nil, err
+				}
+				list.items = append(list.items, item)
+			}
+			return list, nil
+		case "{":
+			m := &tplMapExpr{}
+			for !lx.accept("}") {
+				if len(m.keys) > 0 {
+					if err := lx.expect(","); err != nil {
+						return nil, err
+					}
+					if lx.accept("}") {
+						break
+					}
+				}
+				key, err := lx.parseExpression()
+				if err != nil {
+					return nil, err
+				}
+				if err := lx.expect(":"); err != nil {
+					return nil, err
+				}
+				val, err := lx.parseExpression()
+				if err != nil {
+					return nil, err
+				}
+				m.keys = append(m.keys, key)
+				m.vals = append(m.vals, val)
+			}
+			return m, nil
+		}
+	}
+	if t.kind == etEOF {
+		return nil, lx.src.errorAt(t.offset, "unexpected end of expression")
+	}
+	return nil, lx.src.errorAt(t.offset, "unexpected %q", t.val)
+}
+
+// =============================================================================
+// Evaluation
+// =============================================================================
+
+type tplUndefinedType struct{}
+
+// tplUndefined is the value of a missing variable or key. It prints as
+// the empty string and is falsy, as on the gateway.
+var tplUndefined = tplUndefinedType{}
+
+type tplExpr interface {
+	eval(r *tplRenderer) (any, error)
+}
+
+type tplLiteral struct{ val any }
+
+func (e *tplLiteral) eval(*tplRenderer) (any, error) { return e.val, nil }
+
+type tplVarExpr struct{ name string }
+
+func (e *tplVarExpr) eval(r *tplRenderer) (any, error) { return r.lookup(e.name), nil }
+
+type tplListExpr struct{ items []tplExpr }
+
+func (e *tplListExpr) eval(r *tplRenderer) (any, error) {
+	out := make([]any, len(e.items))
+	for i, item := range e.items {
+		v, err := item.eval(r)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = v
+	}
+	return out, nil
+}
+
+type tplMapExpr struct{ keys, vals []tplExpr }
+
+func (e *tplMapExpr) eval(r *tplRenderer) (any, error) {
+	out := make(map[string]any, len(e.keys))
+	for i := range e.keys {
+		k, err := e.keys[i].eval(r)
+		if err != nil {
+			return nil, err
+		}
+		v, err := e.vals[i].eval(r)
+		if err != nil {
+			return nil, err
+		}
+		out[tplString(k)] = v
+	}
+	return out, nil
+}
+
+type tplGetExpr struct {
+	subject tplExpr
+	key     tplExpr
+}
+
+func (e *tplGetExpr) eval(r *tplRenderer) (any, error) {
+	subject, err := e.subject.eval(r)
+	if err != nil {
+		return nil, err
+	}
+	key, err := e.key.eval(r)
+	if err != nil {
+		return nil, err
+	}
+	switch s := subject.(type) {
+	case tplUndefinedType:
+		return nil, fmt.Errorf("template render: cannot look up %q on an undefined value", tplString(key))
+	case nil:
+		return nil, fmt.Errorf("template render: cannot look up %q on none", tplString(key))
+	case map[string]any:
+		if v, ok := s[tplString(key)]; ok {
+			return v, nil
+		}
+	case []any:
+		idx, ok := tplInt(key)
+		if !ok {
+			if str, isStr := key.(string); isStr {
+				if n, err := strconv.ParseInt(str, 10, 64); err == nil {
+					idx, ok = n, true
+				}
+			}
+		}
+		if ok {
+			if idx < 0 {
+				idx += int64(len(s))
+			}
+			if idx >= 0 && idx < int64(len(s)) {
+				return s[idx], nil
+			}
+		}
+	case string:
+		if idx, ok := tplInt(key); ok {
+			runes := []rune(s)
+			if idx < 0 {
+				idx += int64(len(runes))
+			}
+			if idx >= 0 && idx < int64(len(runes)) {
+				return string(runes[idx]), nil
+			}
+		}
+	}
+	return tplUndefined, nil
+}
+
+type tplSliceExpr struct {
+	subject           tplExpr
+	start, stop, step tplExpr
+}
+
+func (e *tplSliceExpr) eval(r *tplRenderer) (any, error) {
+	subject, err := e.subject.eval(r)
+	if err != nil {
+		return nil, err
+	}
+	var bounds [3]*int64
+	for i, b := range []tplExpr{e.start, e.stop, e.step} {
+		if b == nil {
+			continue
+		}
+		v, err := b.eval(r)
+		if err != nil {
+			return nil, err
+		}
+		switch v.(type) {
+		case nil, tplUndefinedType:
+			continue
+		}
+		n, ok := tplInt(v)
+		if !ok {
+			return nil, fmt.Errorf("template render: slice indices must be integers, not %s", tplTypeName(v))
+		}
+		bounds[i] = &n
+	}
+	step := int64(1)
+	if bounds[2] != nil {
+		step = *bounds[2]
+	}
+	if step == 0 {
+		return nil, fmt.Errorf("template render: slice step cannot be zero")
+	}
+
+	switch s := subject.(type) {
+	case string:
+		runes := []rune(s)
+		var b strings.Builder
+		for _, i := range tplSliceIndices(int64(len(runes)), bounds[0], bounds[1], step) {
+			b.WriteRune(runes[i])
+		}
+		return b.String(), nil
+	case []any:
+		out := []any{}
+		for _, i := range tplSliceIndices(int64(len(s)), bounds[0], bounds[1], step) {
+			out = append(out, s[i])
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("slice of %s: %w", tplTypeName(subject), ErrTemplateNeedsServer)
+}
+
+// tplSliceIndices returns the indices a Python-style slice selects
+// from a sequence of length n. Nil bounds take their defaults.
+func tplSliceIndices(n int64, start, stop *int64, step int64) []int64 {
+	clamp := func(b *int64, def, lo, hi int64) int64 {
+		if b == nil {
+			return def
+		}
+		v := *b
+		if v < 0 {
+			v += n
+		}
+		return max(lo, min(v, hi))
+	}
+	var out []int64
+	if step > 0 {
+		for i := clamp(start, 0, 0, n); i < clamp(stop, n, 0, n); i += step {
+			out = append(out, i)
+		}
+		return out
+	}
+	for i := clamp(start, n-1, -1, n-1); i > clamp(stop, -1, -1, n-1); i += step {
+		out = append(out, i)
+	}
+	return out
+}
+
+type tplCallExpr struct {
+	callee tplExpr
+	args   []tplExpr
+}
+
+func (e *tplCallExpr) eval(r *tplRenderer) (any, error) {
+	v, ok := e.callee.(*tplVarExpr)
+	if !ok || v.name != "range" || r.lookup("range") != tplUndefined {
+		return nil, fmt.Errorf("function call: %w", ErrTemplateNeedsServer)
+	}
+	args, err := evalArgs(r, e.args)
+	if err != nil {
+		return nil, err
+	}
+	ints := make([]int64, len(args))
+	for i, a := range args {
+		n, ok := tplInt(a)
+		if !ok {
+			return nil, fmt.Errorf("template render: range() expects integers")
+		}
+		ints[i] = n
+	}
+	var start, stop, step int64 = 0, 0, 1
+	switch len(ints) {
+	case 1:
+		stop = ints[0]
+	case 2:
+		start, stop = ints[0], ints[1]
+	case 3:
+		start, stop, step = ints[0], ints[1], ints[2]
+	default:
+		return nil, fmt.Errorf("template render: range() takes 1 to 3 arguments")
+	}
+	if step == 0 {
+		return nil, fmt.Errorf("template render: range() step must not be zero")
+	}
+	var n uint64
+	if step > 0 && stop > start {
+		n = (uint64(stop)-uint64(start)-1)/uint64(step) + 1
+	} else if step < 0 && stop < start {
+		n = (uint64(start)-uint64(stop)-1)/(-uint64(step)) + 1
+	}
+	if n > maxLocalRangeLen {
+		return nil, fmt.Errorf("template render: range() too large")
+	}
+	out := make([]any, n)
+	for i := range out {
+		out[i] = start + int64(i)*step
+	}
+	return out, nil
+}
+
+type tplCondExpr struct{ cond, then, els tplExpr }
+
+func (e *tplCondExpr) eval(r *tplRenderer) (any, error) {
+	c, err := e.cond.eval(r)
+	if err != nil {
+		return nil, err
+	}
+	if tplTruthy(c) {
+		return e.then.eval(r)
+	}
+	return e.els.eval(r)
+}
+
+type tplLogicExpr struct {
+	and         bool
+	left, right tplExpr
+}
+
+func (e *tplLogicExpr) eval(r *tplRenderer) (any, error) {
+	l, err := e.left.eval(r)
+	if err != nil {
+		return nil, err
+	}
+	if tplTruthy(l) != e.and {
+		return l, nil
+	}
+	return e.right.eval(r)
+}
+
+type tplNotExpr struct{ inner tplExpr }
+
+func (e *tplNotExpr) eval(r *tplRenderer) (any, error) {
+	v, err := e.inner.eval(r)
+	if err != nil {
+		return nil, err
+	}
+	return !tplTruthy(v), nil
+}
+
+type tplBinExpr struct {
+	op          string
+	left, right tplExpr
+}
+
+func (e *tplBinExpr) eval(r *tplRenderer) (any, error) {
+	l, err := e.left.eval(r)
+	if err != nil {
+		return nil, err
+	}
+	rv, err := e.right.eval(r)
+	if err != nil {
+		return nil, err
+	}
+	switch e.op {
+	case "~":
+		ls, rs := tplString(l), tplString(rv)
+		if err := tplCheckSize(int64(len(ls)) + int64(len(rs))); err != nil {
+			return nil, err
+		}
+		return ls + rs, nil
+	case "==":
+		return tplEqual(l, rv), nil
+	case "!=":
+		return !tplEqual(l, rv), nil
+	case "in":
+		return tplContains(rv, l), nil
+	case "<", ">", "<=", ">=":
+		c, err := tplCompare(l, rv)
+		if err != nil {
+			return nil, err
+		}
+		switch e.op {
+		case "<":
+			return c < 0, nil
+		case ">":
+			return c > 0, nil
+		case "<=":
+			return c <= 0, nil
+		default:
+			return c >= 0, nil
+		}
+	}
+	return tplArith(e.op, l, rv)
+}
+
+type tplFilterExpr struct {
+	name    string
+	subject tplExpr
+	args    []tplExpr
+}
+
+func (e *tplFilterExpr) eval(r *tplRenderer) (any, error) {
+	v, err := e.subject.eval(r)
+	if err != nil {
+		return nil, err
+	}
+	args, err := evalArgs(r, e.args)
+	if err != nil {
+		return nil, err
+	}
+	return applyTplFilter(e.name, v, args)
+}
+
+type tplTestExpr struct {
+	name    string
+	subject tplExpr
+	args    []tplExpr
+}
+
+func (e *tplTestExpr) eval(r *tplRenderer) (any, error) {
+	v, err := e.subject.eval(r)
+	if err != nil {
+		return nil, err
+	}
+	args, err := evalArgs(r, e.args)
+	if err != nil {
+		return nil, err
+	}
+	return applyTplTest(e.name, v, args)
+}
+
+func evalArgs(r *tplRenderer, exprs []tplExpr) ([]any, error) {
+	args := make([]any, len(exprs))
+	for i, a := range exprs {
+		v, err := a.eval(r)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+	return args, nil
+}
+
+// =============================================================================
+// Rendering
+// =============================================================================
+
+type tplRenderer struct {
+	scopes []map[string]any
+	out    strings.Builder
+}
+
+func (r *tplRenderer) lookup(name string) any {
+	for i := len(r.scopes) - 1; i >= 0; i-- {
+		if v, ok := r.scopes[i][name]; ok {
+			return v
+		}
+	}
+	return tplUndefined
+}
+
+// tplCheckSize fails a value of n bytes before it is built, since it
+// could not be written within maxLocalRenderBytes anyway.
+func tplCheckSize(n int64) error {
+	if n > maxLocalRenderBytes {
+		return fmt.Errorf("template render: value exceeds size limit")
+	}
+	return nil
+}
+
+func (r *tplRenderer) write(s string) error {
+	if r.out.Len()+len(s) > maxLocalRenderBytes {
+		return fmt.Errorf("template render: rendered output exceeds size limit")
+	}
+	r.out.WriteString(s)
+	return nil
+}
+
+func (r *tplRenderer) render(nodes []tplNode) error {
+	for _, n := range nodes {
+		switch n := n.(type) {
+		case *tplTextNode:
+			if err := r.write(n.text); err != nil {
+				return err
+			}
+		case *tplOutputNode:
+			v, err := n.expr.eval(r)
+			if err != nil {
+				return err
+			}
+			if err := r.write(tplString(v)); err != nil {
+				return err
+			}
+		case *tplSetNode:
+			v, err := n.expr.eval(r)
+			if err != nil {
+				return err
+			}
+			r.scopes[len(r.scopes)-1][n.name] = v
+		case *tplIfNode:
+			matched := false
+			for i, cond := range n.conds {
+				v, err := cond.eval(r)
+				if err != nil {
+					return err
+				}
+				if tplTruthy(v) {
+					matched = true
+					if err := r.render(n.bodies[i]); err != nil {
+						return err
+					}
+					break
+				}
+			}
+			if !matched {
+				if err := r.render(n.els); err != nil {
+					return err
+				}
+			}
+		case *tplForNode:
+			if err := r.renderFor(n); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *tplRenderer) renderFor(n *tplForNode) error {
+	iter, err := n.iter.eval(r)
+	if err != nil {
+		return err
+	}
+	var items []any
+	switch v := iter.(type) {
+	case []any:
+		items = v
+	case map[string]any:
+		// Iterating a map yields its keys in sorted order.
+		for _, k := range sortedTplKeys(v) {
+			items = append(items, k)
+		}
+	case string:
+		for _, c := range v {
+			items = append(items, string(c))
+		}
+	case tplUndefinedType, nil:
+	default:
+		return fmt.Errorf("template render: %s is not iterable", tplTypeName(iter))
+	}
+
+	if n.filter != nil {
+		// Filtered-out items do not count towards loop.*.
+		var kept []any
+		for _, item := range items {
+			scope := map[string]any{}
+			if err := bindLoopTargets(scope, n.targets, item); err != nil {
+				return err
+			}
+			r.scopes = append(r.scopes, scope)
+			ok, err := n.filter.eval(r)
+			r.scopes = r.scopes[:len(r.scopes)-1]
+			if err != nil {
+				return err
+			}
+			if tplTruthy(ok) {
+				kept = append(kept, item)
+			}
+		}
+		items = kept
+	}
+
+	if len(items) == 0 {
+		return r.render(n.els)
+	}
+	for i, item := range items {
+		scope := map[string]any{
+			"loop": map[string]any{
+				"index":     int64(i + 1),
+				"index0":    int64(i),
+				"revindex":  int64(len(items) - i),
+				"revindex0": int64(len(items) - i - 1),
+				"first":     i == 0,
+				"last":      i == len(items)-1,
+				"length":    int64(len(items)),
+			},
+		}
+		if err := bindLoopTargets(scope, n.targets, item); err != nil {
+			return err
+		}
+		r.scopes = append(r.scopes, scope)
+		err := r.render(n.body)
+		r.scopes = r.scopes[:len(r.scopes)-1]
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bindLoopTargets sets a for loop's variables in scope to item,
+// unpacking it when the loop names several.
+func bindLoopTargets(scope map[string]any, targets []string, item any) error {
+	if len(targets) == 1 {
+		scope[targets[0]] = item
+		return nil
+	}
+	seq, ok := item.([]any)
+	if !ok || len(seq) != len(targets) {
+		return fmt.Errorf("template render: cannot unpack %s into %d variables", tplTypeName(item), len(targets))
+	}
+	for j, name := range targets {
+		scope[name] = seq[j]
+	}
+	return nil
+}
+
+// =============================================================================
+// Filters and tests
+// =============================================================================
+
+func applyTplFilter(name string, v any, args []any) (any, error) {
+	arg := func(i int, def any) any {
+		if i < len(args) {
+			return args[i]
+		}
+		return def
+	}
+	switch name {
+	case "upper":
+		return strings.ToUpper(tplString(v)), nil
+	case "lower":
+		return strings.ToLower(tplString(v)), nil
+	case "capitalize":
+		s := strings.ToLower(tplString(v))
+		for i, c := range s {
+			return string(unicode.ToUpper(c)) + s[i+len(string(c)):], nil
+		}
+		return s, nil
+	case "title":
+		s := tplString(v)
+		var b strings.Builder
+		prev := ' '
+		for _, c := range s {
+			if unicode.IsLetter(c) && !unicode.IsLetter(prev) && !unicode.IsDigit(prev) {
+				b.WriteRune(unicode.ToUpper(c))
+			} else {
+				b.WriteRune(unicode.ToLower(c))
+			}
+			prev = c
+		}
+		return b.String(), nil
+	case "trim":
+		if chars, ok := arg(0, nil).(string); ok {
+			return strings.Trim(tplString(v), chars), nil
+		}
+		return strings.TrimSpace(tplString(v)), nil
+	case "length", "count":
+		switch x := v.(type) {
+		case string:
+			return int64(len([]rune(x))), nil
+		case []any:
+			return int64(len(x)), nil
+		case map[string]any:
+			return int64(len(x)), nil
+		}
+		return nil, fmt.Errorf("template render: %s has no length", tplTypeName(v))
+	case "default", "d":
+		def := arg(0, "")
+		if _, undef := v.(tplUndefinedType); undef {
+			return def, nil
+		}
+		if tplTruthy(arg(1, false)) && !tplTruthy(v) {
+			return def, nil
+		}
+		return v, nil
+	case "join":
+		sep := tplString(arg(0, ""))
+		seq, ok := v.([]any)
+		if !ok {
+			return tplString(v), nil
+		}
+		parts := make([]string, len(seq))
+		for i, item := range seq {
+			parts[i] = tplString(item)
+		}
+		return strings.Join(parts, sep), nil
+	case "replace":
+		if len(args) < 2 {
+			return nil, fmt.Errorf("template render: replace expects 2 arguments")
+		}
+		count := -1
+		if n, ok := tplInt(arg(2, nil)); ok {
+			count = int(n)
+		}
+		return strings.Replace(tplString(v), tplString(args[0]), tplString(args[1]), count), nil
+	case "first", "last":
+		switch x := v.(type) {
+		case []any:
+			if len(x) == 0 {
+				return tplUndefined, nil
+			}
+			if name == "first" {
+				return x[0], nil
+			}
+			return x[len(x)-1], nil
+		case string:
+			runes := []rune(x)
+			if len(runes) == 0 {
+				return tplUndefined, nil
+			}
+			if name == "first" {
+				return string(runes[0]), nil
+			}
+			return string(runes[len(runes)-1]), nil
+		}
+		return tplUndefined, nil
+	case "reverse":
+		switch x := v.(type) {
+		case []any:
+			out := make([]any, len(x))
+			for i := range x {
+				out[len(x)-1-i] = x[i]
+			}
+			return out, nil
+		case string:
+			runes := []rune(x)
+			for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+				runes[i], runes[j] = runes[j], runes[i]
+			}
+			return string(runes), nil
+		}
+		return v, nil
+	case "sort":
+		seq, ok := v.([]any)
+		if !ok {
+			return v, nil
+		}
+		out := append([]any(nil), seq...)
+		var sortErr error
+		sort.SliceStable(out, func(i, j int) bool {
+			c, err := tplCompare(out[i], out[j])
+			if err != nil {
+				sortErr = err
+			}
+			return c < 0
+		})
+		if tplTruthy(arg(0, false)) {
+			for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+				out[i], out[j] = out[j], out[i]
+			}
+		}
+		return out, sortErr
+	case "unique":
+		seq, ok := v.([]any)
+		if !ok {
+			return v, nil
+		}
+		var out []any
+		for _, item := range seq {
+			if !tplContains(out, item) {
+				out = append(out, item)
+			}
+		}
+		return out, nil
+	case "list":
+		switch x := v.(type) {
+		case []any:
+			return x, nil
+		case string:
+			out := []any{}
+			for _, c := range x {
+				out = append(out, string(c))
+			}
+			return out, nil
+		case map[string]any:
+			out := []any{}
+			for _, k := range sortedTplKeys(x) {
+				out = append(out, k)
+			}
+			return out, nil
+		}
+		return nil, fmt.Errorf("template render: cannot convert %s to a list", tplTypeName(v))
+	case "items", "dictsort":
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("template render: %s is not a mapping", tplTypeName(v))
+		}
+		out := []any{}
+		for _, k := range sortedTplKeys(m) {
+			out = append(out, []any{k, m[k]})
+		}
+		return out, nil
+	case "int":
+		switch x := v.(type) {
+		case int64:
+			return x, nil
+		case float64:
+			return tplFloatToInt(x)
+		case bool:
+			if x {
+				return int64(1), nil
+			}
+			return int64(0), nil
+		case string:
+			n, err := strconv.ParseInt(strings.TrimSpace(x), 10, 64)
+			if err == nil {
+				return n, nil
+			}
+			if errors.Is(err, strconv.ErrRange) {
+				return nil, errTplOverflow
+			}
+			if f, err := strconv.ParseFloat(strings.TrimSpace(x), 64); err == nil {
+				return tplFloatToInt(f)
+			}
+		}
+		return nil, fmt.Errorf("template render: cannot convert %s to int", tplTypeName(v))
+	case "float":
+		if f, ok := tplFloat(v); ok {
+			return f, nil
+		}
+		if s, ok := v.(string); ok {
+			if f, err := strconv.ParseFloat(strings.TrimSpace(s), 64); err == nil {
+				return f, nil
+			}
+		}
+		return nil, fmt.Errorf("template render: cannot convert %s to float", tplTypeName(v))
+	case "abs":
+		switch x := v.(type) {
+		case int64:
+			if x == math.MinInt64 {
+				return nil, errTplOverflow
+			}
+			if x < 0 {
+				return -x, nil
+			}
+			return x, nil
+		case float64:
+			return math.Abs(x), nil
+		}
+		return nil, fmt.Errorf("template render: abs expects a number")
+	case "round":
+		f, ok := tplFloat(v)
+		if !ok {
+			return nil, fmt.Errorf("template render: round expects a number")
+		}
+		precision, _ := tplInt(arg(0, int64(0)))
+		p := math.Pow(10, float64(precision))
+		return tplFinite(math.Round(f*p) / p)
+	case "string":
+		return tplString(v), nil
+	case "safe":
+		return v, nil
+	case "escape", "e":
+		return tplEscapeHTML(tplString(v)), nil
+	case "tojson":
+		data, err := json.Marshal(tplToJSON(v))
+		if err != nil {
+			return nil, err
+		}
+		s := strings.ReplaceAll(string(data), "'", `\u0027`)
+		if indent, ok := tplInt(arg(0, nil)); ok && indent > 0 {
+			// Indenting by one space adds one byte per indent level, so
+			// the size at the requested width follows from it.
+			var pretty bytes.Buffer
+			if err := json.Indent(&pretty, []byte(s), "", " "); err != nil {
+				return s, nil
+			}
+			var flat bytes.Buffer
+			_ = json.Indent(&flat, []byte(s), "", "")
+			levels := int64(pretty.Len() - flat.Len())
+			if levels > 0 && indent > (maxLocalRenderBytes-int64(flat.Len()))/levels {
+				return nil, tplCheckSize(maxLocalRenderBytes + 1)
+			}
+			pretty.Reset()
+			if err := json.Indent(&pretty, []byte(s), "", strings.Repeat(" ", int(indent))); err == nil {
+				return pretty.String(), nil
+			}
+		}
+		return s, nil
+	case "indent":
+		width := int64(4)
+		if n, ok := tplInt(arg(0, nil)); ok {
+			width = n
+		}
+		if width < 0 {
+			return nil, fmt.Errorf("template render: indent width must not be negative")
+		}
+		text := tplString(v)
+		lines := strings.Split(text, "\n")
+		if width > (maxLocalRenderBytes-int64(len(text)))/int64(len(lines)) {
+			return nil, tplCheckSize(maxLocalRenderBytes + 1)
+		}
+		pad := strings.Repeat(" ", int(width))
+		for i := 1; i < len(lines); i++ {
+			if lines[i] != "" || tplTruthy(arg(2, false)) {
+				lines[i] = pad + lines[i]
+			}
+		}
+		if tplTruthy(arg(1, false)) {
+			lines[0] = pad + lines[0]
+		}
+		return strings.Join(lines, "\n"), nil
+	}
+	return nil, fmt.Errorf("filter %q: %w", name, ErrTemplateNeedsServer)
+}
+
+func applyTplTest(name string, v any, args []any) (any, error) {
+	_, undefined := v.(tplUndefinedType)
+	switch name {
+	case "defined":
+		return !undefined, nil
+	case "undefined":
+		return undefined, nil
+	case "none":
+		return v == nil, nil
+	case "true":
+		return v == true, nil
+	case "false":
+		return v == false, nil
+	case "string":
+		_, ok := v.(string)
+		return ok, nil
+	case "number":
+		switch v.(type) {
+		case int64, float64:
+			return true, nil
+		}
+		return false, nil
+	case "sequence", "iterable":
+		switch v.(type) {
+		case []any, string:
+			return true, nil
+		case map[string]any:
+			return name == "iterable", nil
+		}
+		return false, nil
+	case "mapping":
+		_, ok := v.(map[string]any)
+		return ok, nil
+	case "even", "odd":
+		n, ok := tplInt(v)
+		if !ok {
+			return false, nil
+		}
+		return (n%2 == 0) == (name == "even"), nil
+	case "divisibleby":
+		n, ok1 := tplInt(v)
+		d, ok2 := tplInt(firstArg(args))
+		if !ok1 || !ok2 || d == 0 {
+			return false, nil
+		}
+		return n%d == 0, nil
+	case "eq", "equalto", "==":
+		return tplEqual(v, firstArg(args)), nil
+	case "ne", "!=":
+		return !tplEqual(v, firstArg(args)), nil
+	case "in":
+		return tplContains(firstArg(args), v), nil
+	case "startingwith":
+		return strings.HasPrefix(tplString(v), tplString(firstArg(args))), nil
+	case "endingwith":
+		return strings.HasSuffix(tplString(v), tplString(firstArg(args))), nil
+	case "lower":
+		s, ok := v.(string)
+		return ok && s == strings.ToLower(s), nil
+	case "upper":
+		s, ok := v.(string)
+		return ok && s == strings.ToUpper(s), nil
+	}
+	return nil, fmt.Errorf("test %q: %w", name, ErrTemplateNeedsServer)
+}
+
+func firstArg(args []any) any {
+	if len(args) == 0 {
+		return tplUndefined
+	}
+	return args[0]
+}
+
+// =============================================================================
+// Value helpers
+// =============================================================================
+
+// normalizeTplValue converts payload values to the small set of types
+// the renderer works with: nil, bool, int64, float64, string, []any,
+// and map[string]any. Integral float64s (as produced by encoding/json)
+// become int64 so they print like JSON integers.
+func normalizeTplValue(v any) any {
+	switch x := v.(type) {
+	case nil, bool, string, int64:
+		return x
+	case float64:
+		if x == math.Trunc(x) && math.Abs(x) < 1<<53 {
+			return int64(x)
+		}
+		return x
+	case float32:
+		return normalizeTplValue(float64(x))
+	case int:
+		return int64(x)
+	case int8:
+		return int64(x)
+	case int16:
+		return int64(x)
+	case int32:
+		return int64(x)
+	case uint:
+		return normalizeTplValue(uint64(x))
+	case uint8:
+		return int64(x)
+	case uint16:
+		return int64(x)
+	case uint32:
+		return int64(x)
+	case uint64:
+		if x > math.MaxInt64 {
+			return float64(x)
+		}
+		return int64(x)
+	case json.Number:
+		if n, err := x.Int64(); err == nil {
+			return n
+		}
+		f, _ := x.Float64()
+		return f
+	case []any:
+		out := make([]any, len(x))
+		for i, item := range x {
+			out[i] = normalizeTplValue(item)
+		}
+		return out
+	case map[string]any:
+		out := make(map[string]any, len(x))
+		for k, item := range x {
+			out[k] = normalizeTplValue(item)
+		}
+		return out
+	}
+	// Anything else (structs, typed slices and maps) goes through JSON.
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	var generic any
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return fmt.Sprint(v)
+	}
+	return normalizeTplValue(generic)
+}
+
+func tplTruthy(v any) bool {
+	switch x := v.(type) {
+	case nil, tplUndefinedType:
+		return false
+	case bool:
+		return x
+	case int64:
+		return x != 0
+	case float64:
+		return x != 0
+	case string:
+		return x != ""
+	case []any:
+		return len(x) > 0
+	case map[string]any:
+		return len(x) > 0
+	}
+	return true
+}
+
+func tplString(v any) string {
+	switch x := v.(type) {
+	case tplUndefinedType:
+		return ""
+	case nil:
+		return "none"
+	case string:
+		return x
+	case bool:
+		if x {
+			return "true"
+		}
+		return "false"
+	case int64:
+		return strconv.FormatInt(x, 10)
+	case float64:
+		if math.IsInf(x, 0) || math.IsNaN(x) {
+			return strconv.FormatFloat(x, 'f', -1, 64)
+		}
+		s := strconv.FormatFloat(x, 'f', -1, 64)
+		if !strings.Contains(s, ".") {
+			s += ".0"
+		}
+		return s
+	case []any:
+		parts := make([]string, len(x))
+		for i, item := range x {
+			parts[i] = tplRepr(item)
+		}
+		return "[" + strings.Join(parts, ", ") + "]"
+	case map[string]any:
+		keys := sortedTplKeys(x)
+		parts := make([]string, len(keys))
+		for i, k := range keys {
+			parts[i] = strconv.Quote(k) + ": " + tplRepr(x[k])
+		}
+		return "{" + strings.Join(parts, ", ") + "}"
+	}
+	return fmt.Sprint(v)
+}
+
+func tplRepr(v any) string {
+	if s, ok := v.(string); ok {
+		return strconv.Quote(s)
+	}
+	return tplString(v)
+}
+
+func tplToJSON(v any) any {
+	switch x := v.(type) {
+	case tplUndefinedType:
+		return nil
+	case []any:
+		out := make([]any, len(x))
+		for i, item := range x {
+			out[i] = tplToJSON(item)
+		}
+		return out
+	case map[string]any:
+		out := make(map[string]any, len(x))
+		for k, item := range x {
+			out[k] = tplToJSON(item)
+		}
+		return out
+	}
+	return v
+}
+
+func tplTypeName(v any) string {
+	switch v.(type) {
+	case tplUndefinedType:
+		return "undefined"
+	case nil:
+		return "none"
+	case bool:
+		return "bool"
+	case int64, float64:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "sequence"
+	case map[string]any:
+		return "map"
+	}
+	return reflect.TypeOf(v).String()
+}
+
+func tplInt(v any) (int64, bool) {
+	switch x := v.(type) {
+	case int64:
+		return x, true
+	case float64:
+		if x == math.Trunc(x) && x >= math.MinInt64 && x < math.MaxInt64 {
+			return int64(x), true
+		}
+	case bool:
+		if x {
+			return 1, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+func tplFloat(v any) (float64, bool) {
+	switch x := v.(type) {
+	case int64:
+		return float64(x), true
+	case float64:
+		return x, true
+	case bool:
+		if x {
+			return 1, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+func tplEqual(a, b any) bool {
+	if fa, ok := tplFloat(a); ok {
+		if _, isBool := a.(bool); !isBool {
+			if fb, ok := tplFloat(b); ok {
+				if _, isBool := b.(bool); !isBool {
+					return fa == fb
+				}
+			}
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func tplCompare(a, b any) (int, error) {
+	if fa, ok := tplFloat(a); ok {
+		if fb, ok := tplFloat(b); ok {
+			switch {
+			case fa < fb:
+				return -1, nil
+			case fa > fb:
+				return 1, nil
+			}
+			return 0, nil
+		}
+	}
+	if sa, ok := a.(string); ok {
+		if sb, ok := b.(string); ok {
+			return strings.Compare(sa, sb), nil
+		}
+	}
+	return 0, fmt.Errorf("template render: cannot compare %s with %s", tplTypeName(a), tplTypeName(b))
+}
+
+func tplContains(container, item any) bool {
+	switch c := container.(type) {
+	case string:
+		return strings.Contains(c, tplString(item))
+	case []any:
+		for _, x := range c {
+			if tplEqual(x, item) {
+				return true
+			}
+		}
+	case map[string]any:
+		_, ok := c[tplString(item)]
+		return ok
+	}
+	return false
+}
+
+func tplArith(op string, a, b any) (any, error) {
+	if op == "+" {
+		if sa, ok := a.([]any); ok {
+			if sb, ok := b.([]any); ok {
+				return append(append([]any(nil), sa...), sb...), nil
+			}
+		}
+		if sa, ok := a.(string); ok {
+			if sb, ok := b.(string); ok {
+				if err := tplCheckSize(int64(len(sa)) + int64(len(sb))); err != nil {
+					return nil, err
+				}
+				return sa + sb, nil
+			}
+		}
+	}
+	if op == "*" {
+		if s, ok := a.(string); ok {
+			if n, ok := tplInt(b); ok && n >= 0 {
+				if len(s) > 0 && n > maxLocalRenderBytes/int64(len(s)) {
+					return nil, tplCheckSize(maxLocalRenderBytes + 1)
+				}
+				return strings.Repeat(s, int(n)), nil
+			}
+		}
+	}
+	ia, aInt := a.(int64)
+	ib, bInt := b.(int64)
+	if aInt && bInt {
+		switch op {
+		case "+":
+			if (ib > 0 && ia > math.MaxInt64-ib) || (ib < 0 && ia < math.MinInt64-ib) {
+				return nil, errTplOverflow
+			}
+			return ia + ib, nil
+		case "-":
+			if (ib < 0 && ia > math.MaxInt64+ib) || (ib > 0 && ia < math.MinInt64+ib) {
+				return nil, errTplOverflow
+			}
+			return ia - ib, nil
+		case "*":
+			return tplMulInt(ia, ib)
+		case "//":
+			if ib == 0 {
+				return nil, fmt.Errorf("template render: division by zero")
+			}
+			if ia == math.MinInt64 && ib == -1 {
+				return nil, errTplOverflow
+			}
+			q := ia / ib
+			if (ia%ib != 0) && ((ia < 0) != (ib < 0)) {
+				q--
+			}
+			return q, nil
+		case "%":
+			if ib == 0 {
+				return nil, fmt.Errorf("template render: division by zero")
+			}
+			m := ia % ib
+			if m != 0 && ((m < 0) != (ib < 0)) {
+				m += ib
+			}
+			return m, nil
+		case "**":
+			if ib >= 0 {
+				return tplPowInt(ia, ib)
+			}
+		}
+	}
+	fa, ok1 := tplFloat(a)
+	fb, ok2 := tplFloat(b)
+	if !ok1 || !ok2 {
+		return nil, fmt.Errorf("template render: unsupported operand types for %s: %s and %s", op, tplTypeName(a), tplTypeName(b))
+	}
+	switch op {
+	case "+":
+		return tplFinite(fa + fb)
+	case "-":
+		return tplFinite(fa - fb)
+	case "*":
+		return tplFinite(fa * fb)
+	case "/":
+		if fb == 0 {
+			return nil, fmt.Errorf("template render: division by zero")
+		}
+		return tplFinite(fa / fb)
+	case "//":
+		if fb == 0 {
+			return nil, fmt.Errorf("template render: division by zero")
+		}
+		return tplFinite(math.Floor(fa / fb))
+	case "%":
+		if fb == 0 {
+			return nil, fmt.Errorf("template render: division by zero")
+		}
+		return tplFinite(math.Mod(fa, fb))
+	case "**":
+		return tplFinite(math.Pow(fa, fb))
+	}
+	return nil, fmt.Errorf("template render: unknown operator %s", op)
+}
+
+// errTplOverflow is the render error for integer results beyond 64
+// bits, which MiniJinja also rejects rather than wrap.
+var errTplOverflow = errors.New("template render: integer overflow")
+
+func tplMulInt(a, b int64) (int64, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	p := a * b
+	if p/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return 0, errTplOverflow
+	}
+	return p, nil
+}
+
+// tplPowInt raises base to a non-negative exp by squaring, failing
+// on overflow.
+func tplPowInt(base, exp int64) (int64, error) {
+	result := int64(1)
+	for exp > 0 {
+		var err error
+		if exp&1 == 1 {
+			if result, err = tplMulInt(result, base); err != nil {
+				return 0, err
+			}
+		}
+		exp >>= 1
+		if exp > 0 {
+			if base, err = tplMulInt(base, base); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// tplFinite returns f, or a render error when it is infinite or NaN.
+func tplFinite(f float64) (any, error) {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return nil, fmt.Errorf("template render: result is not a finite number")
+	}
+	return f, nil
+}
+
+// tplFloatToInt truncates f to an integer, failing when it does not
+// fit in 64 bits.
+func tplFloatToInt(f float64) (int64, error) {
+	if math.IsNaN(f) || f >= math.MaxInt64 || f < math.MinInt64 {
+		return 0, errTplOverflow
+	}
+	return int64(f), nil
+}
+
+func tplEscapeHTML(s string) string {
+	return strings.NewReplacer(
+		"&", "&amp;",
+		"<", "&lt;",
+		">", "&gt;",
+		`"`, "&quot;",
+		"'", "&#x27;",
+		"/", "&#x2f;",
+	).Replace(s)
+}
+
+func sortedTplKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
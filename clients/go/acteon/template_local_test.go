@@ -0,0 +1,124 @@
+package acteon
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestRenderTemplateLocal(t *testing.T) {
+	payload := map[string]any{
+		"name":  "Ada",
+		"count": 3,
+		"items": []any{"a", "b", "c"},
+		"user":  map[string]any{"email": "ada@example.com", "admin": true},
+		"html":  "<b>",
+	}
+	cases := []struct {
+		tpl, want string
+	}{
+		{"Hello {{ name }}!", "Hello Ada!"},
+		{"{{ user.email }} / {{ user['admin'] }}", "ada@example.com / true"},
+		{"{{ count * 2 + 1 }}", "7"},
+		{"{{ name | upper }}-{{ items | join(',') }}-{{ items | length }}", "ADA-a,b,c-3"},
+		{"{{ missing | default('none') }}", "none"},
+		{"{% if count > 2 %}many{% elif count %}some{% else %}none{% endif %}", "many"},
+		{"{% for i in items %}{{ loop.index }}{{ i }}{% if not loop.last %} {% endif %}{% endfor %}", "1a 2b 3c"},
+		{"{% for i in [] %}x{% else %}empty{% endfor %}", "empty"},
+		{"{% set greeting = 'hi ' ~ name %}{{ greeting }}", "hi Ada"},
+		{"{% raw %}{{ name }}{% endraw %}", "{{ name }}"},
+		{"a{# note #}b", "ab"},
+		{"a   {{- name -}}   b", "aAdab"},
+		{"{{ user.admin is defined }} {{ nope is defined }}", "true false"},
+		{"{{ html }}", "<b>"},
+		{"{{ html | escape }}", "&lt;b&gt;"},
+		{"{{ name[1:] }} {{ items[1:2] }} {{ items[::-1] | join }} {{ name[:-1] }}", `da ["b"] cba Ad`},
+		{"{% for i in [1, 2, 3] if i > 1 %}{{ loop.index }}{{ i }}{% endfor %}", "1223"},
+		{"{% for i in items if i == 'z' %}x{% else %}none{% endfor %}", "none"},
+		{"{{ 1e3 }} {{ 2.5E-1 }}", "1000.0 0.25"},
+	}
+	for _, tc := range cases {
+		got, err := RenderTemplateLocal(tc.tpl, payload)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", tc.tpl, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("%q: got %q, want %q", tc.tpl, got, tc.want)
+		}
+	}
+}
+
+func TestRenderTemplateLocalNeedsServer(t *testing.T) {
+	for _, tpl := range []string{
+		`{% include "header" %}`,
+		`{% extends "base" %}`,
+		`{{ name | frobnicate }}`,
+		`{{ 99999999999999999999 }}`,
+		`{% for i in name recursive %}{% endfor %}`,
+	} {
+		_, err := RenderTemplateLocal(tpl, map[string]any{"name": "x"})
+		if !errors.Is(err, ErrTemplateNeedsServer) {
+			t.Errorf("%q: expected ErrTemplateNeedsServer, got %v", tpl, err)
+		}
+	}
+}
+
+func TestRenderTemplateLocalSyntaxError(t *testing.T) {
+	_, err := RenderTemplateLocal("line one\n{% if x %}unterminated", nil)
+	var syn *TemplateSyntaxError
+	if !errors.As(err, &syn) {
+		t.Fatalf("expected *TemplateSyntaxError, got %v", err)
+	}
+	if syn.Line != 2 {
+		t.Errorf("Line = %d, want 2", syn.Line)
+	}
+}
+
+func TestRenderTemplateLocalRejectsBadInput(t *testing.T) {
+	for _, tpl := range []string{
+		`{% ! %}`,
+		`{% if 'x %}{% endif %}`,
+		`{% for x in ! %}{% endfor %}`,
+		`{% set x = 'y %}`,
+		`{{ ! }}`,
+		`{{ x|indent(-1) }}`,
+		`{{ 'a' * 100000000000 }}`,
+		`{{ 'ab' * 600000 }}`,
+		`{{ x|indent(100000000000) }}`,
+		`{{ [[1, 2], [3]]|tojson(100000000000) }}`,
+		`{{ range(100000000000)|length }}`,
+		`{{ range(-9223372036854775807, 9223372036854775807, 1)|length }}`,
+		`{{ 9223372036854775807 + 1 }}`,
+		`{{ n ** 100000 }}`,
+		`{{ big|int }}`,
+		`{{ f|round(400) }}`,
+		`{{ 1e308 * 10 }}`,
+		`{{ x[::0] }}`,
+	} {
+		payload := map[string]any{"x": "a\nb", "n": 3, "big": 1e20, "f": 1.5}
+		if _, err := RenderTemplateLocal(tpl, payload); err == nil {
+			t.Errorf("%q: expected an error", tpl)
+		}
+	}
+}
+
+func TestRenderProfileLocal(t *testing.T) {
+	fields := map[string]TemplateProfileField{
+		"subject": json.RawMessage(`"Alert: {{ title }}"`),
+		"body":    json.RawMessage(`{"$ref": "body-tpl"}`),
+	}
+	templates := map[string]string{"body-tpl": "Severity {{ severity }}"}
+	out, err := RenderProfileLocal(fields, templates, map[string]any{"title": "disk", "severity": "high"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out["subject"] != "Alert: disk" || out["body"] != "Severity high" {
+		t.Errorf("unexpected output: %+v", out)
+	}
+
+	fields["body"] = json.RawMessage(`{"$ref": "missing"}`)
+	if _, err := RenderProfileLocal(fields, templates, nil); err == nil {
+		t.Error("expected error for unknown $ref")
+	}
+}
@@ -0,0 +1,239 @@
+// Package templatetest renders a template or profile against a
+// directory of sample payloads and compares the output with committed
+// golden files, reporting differences as test failures.
+//
+// A fixture directory holds one pair of files per case:
+//
+//	disk-full.payload.json  {"host": "db-1", "usage": 97}
+//	disk-full.golden.json   {"subject": "Disk full on db-1", "body": "..."}
+//
+// The golden file maps each rendered field to its expected output. A
+// single template renders to one field named after the template.
+//
+// Typical use from a CI test, rendering locally:
+//
+//	func TestAlertProfile(t *testing.T) {
+//		r := templatetest.LocalProfile(fields, templates)
+//		templatetest.Run(t, r, "testdata/alert")
+//	}
+//
+// or against a gateway with Preview(client, "alert", "ns", "tenant")
+// for templates that use features the local renderer cannot handle.
+//
+// Set ACTEON_TEMPLATETEST_UPDATE=1 to rewrite the golden files from
+// the current output instead of comparing; the resulting diff is what
+// reviewers see.
+package templatetest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+// UpdateEnv is the environment variable that switches Run into golden
+// file update mode.
+const UpdateEnv = "ACTEON_TEMPLATETEST_UPDATE"
+
+const (
+	payloadSuffix = ".payload.json"
+	goldenSuffix  = ".golden.json"
+)
+
+// Renderer renders one payload to a map of field name to output.
+type Renderer interface {
+	Render(ctx context.Context, payload map[string]any) (map[string]string, error)
+}
+
+// RendererFunc adapts a function to the Renderer interface.
+type RendererFunc func(ctx context.Context, payload map[string]any) (map[string]string, error)
+
+// Render calls f.
+func (f RendererFunc) Render(ctx context.Context, payload map[string]any) (map[string]string, error) {
+	return f(ctx, payload)
+}
+
+// Previewer is the subset of *acteon.Client that Preview needs.
+type Previewer interface {
+	RenderPreview(ctx context.Context, req *acteon.RenderPreviewRequest) (*acteon.RenderPreviewResponse, error)
+}
+
+// LocalTemplate renders a single template with acteon.RenderTemplateLocal.
+// The output is reported under the field name.
+func LocalTemplate(name, content string) Renderer {
+	return RendererFunc(func(_ context.Context, payload map[string]any) (map[string]string, error) {
+		out, err := acteon.RenderTemplateLocal(content, payload)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]string{name: out}, nil
+	})
+}
+
+// LocalProfile renders every field of a profile with
+// acteon.RenderProfileLocal, resolving `$ref` fields against templates.
+func LocalProfile(fields map[string]acteon.TemplateProfileField, templates map[string]string) Renderer {
+	return RendererFunc(func(_ context.Context, payload map[string]any) (map[string]string, error) {
+		return acteon.RenderProfileLocal(fields, templates, payload)
+	})
+}
+
+// Preview renders a stored profile on the gateway with RenderPreview.
+func Preview(client Previewer, profile, namespace, tenant string) Renderer {
+	return RendererFunc(func(ctx context.Context, payload map[string]any) (map[string]string, error) {
+		resp, err := client.RenderPreview(ctx, &acteon.RenderPreviewRequest{
+			Profile:   profile,
+			Namespace: namespace,
+			Tenant:    tenant,
+			Payload:   payload,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Rendered, nil
+	})
+}
+
+// Case is one golden-file case loaded from a directory.
+type Case struct {
+	Name       string
+	Payload    map[string]any
+	Golden     map[string]string
+	GoldenPath string
+}
+
+// LoadCases reads every `<case>.payload.json` in dir together with its
+// `<case>.golden.json`, sorted by case name. A missing golden file is
+// an error unless update mode is on.
+func LoadCases(dir string) ([]Case, error) {
+	payloads, err := filepath.Glob(filepath.Join(dir, "*"+payloadSuffix))
+	if err != nil {
+		return nil, err
+	}
+	if len(payloads) == 0 {
+		return nil, fmt.Errorf("templatetest: no %s payloads in %s", payloadSuffix, dir)
+	}
+	sort.Strings(payloads)
+
+	update := os.Getenv(UpdateEnv) != ""
+	cases := make([]Case, 0, len(payloads))
+	for _, p := range payloads {
+		name := strings.TrimSuffix(filepath.Base(p), payloadSuffix)
+		c := Case{Name: name, GoldenPath: filepath.Join(dir, name+goldenSuffix)}
+		if err := readJSON(p, &c.Payload); err != nil {
+			return nil, err
+		}
+		if err := readJSON(c.GoldenPath, &c.Golden); err != nil && !(update && os.IsNotExist(err)) {
+			return nil, err
+		}
+		cases = append(cases, c)
+	}
+	return cases, nil
+}
+
+// Run loads the cases in dir, renders each payload with r, and reports
+// each case as a subtest of t.
+func Run(t *testing.T, r Renderer, dir string) {
+	t.Helper()
+	cases, err := LoadCases(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	update := os.Getenv(UpdateEnv) != ""
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			got, err := r.Render(context.Background(), c.Payload)
+			if err != nil {
+				t.Fatalf("render: %v", err)
+			}
+			if update {
+				if err := writeGolden(c.GoldenPath, got); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			for _, problem := range Diff(c.Golden, got) {
+				t.Error(problem)
+			}
+		})
+	}
+}
+
+// Diff returns a description of each field where got differs from
+// want, including fields present in only one of them.
+func Diff(want, got map[string]string) []string {
+	fields := make(map[string]bool, len(want)+len(got))
+	for k := range want {
+		fields[k] = true
+	}
+	for k := range got {
+		fields[k] = true
+	}
+	names := make([]string, 0, len(fields))
+	for k := range fields {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	var problems []string
+	for _, name := range names {
+		w, inWant := want[name]
+		g, inGot := got[name]
+		switch {
+		case !inGot:
+			problems = append(problems, fmt.Sprintf("field %q missing from output", name))
+		case !inWant:
+			problems = append(problems, fmt.Sprintf("field %q rendered but not in golden file: %q", name, g))
+		case w != g:
+			problems = append(problems, fmt.Sprintf("field %q differs:\n%s", name, lineDiff(w, g)))
+		}
+	}
+	return problems
+}
+
+// lineDiff shows the first differing line of want and got with its
+// line number, which is enough to locate the change in a long message.
+func lineDiff(want, got string) string {
+	wl := strings.Split(want, "\n")
+	gl := strings.Split(got, "\n")
+	for i := 0; i < len(wl) || i < len(gl); i++ {
+		var w, g string
+		if i < len(wl) {
+			w = wl[i]
+		}
+		if i < len(gl) {
+			g = gl[i]
+		}
+		if i >= len(wl) || i >= len(gl) || w != g {
+			return fmt.Sprintf("  line %d\n  - %q\n  + %q", i+1, w, g)
+		}
+	}
+	return ""
+}
+
+func writeGolden(path string, rendered map[string]string) error {
+	data, err := json.MarshalIndent(rendered, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+func readJSON(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("templatetest: %s: %w", path, err)
+	}
+	return nil
+}
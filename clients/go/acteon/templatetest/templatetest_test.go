@@ -0,0 +1,90 @@
+package templatetest
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+type fakePreviewer struct {
+	got []*acteon.RenderPreviewRequest
+}
+
+func (f *fakePreviewer) RenderPreview(_ context.Context, req *acteon.RenderPreviewRequest) (*acteon.RenderPreviewResponse, error) {
+	f.got = append(f.got, req)
+	return &acteon.RenderPreviewResponse{Rendered: map[string]string{"subject": "Disk full on " + req.Payload["host"].(string)}}, nil
+}
+
+func writeCase(t *testing.T, dir, name, payload, golden string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name+payloadSuffix), []byte(payload), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if golden != "" {
+		if err := os.WriteFile(filepath.Join(dir, name+goldenSuffix), []byte(golden), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestRunLocalProfile(t *testing.T) {
+	dir := t.TempDir()
+	writeCase(t, dir, "disk-full", `{"host":"db-1","usage":97}`,
+		`{"subject":"Disk full on db-1","body":"usage 97%"}`)
+
+	fields := map[string]acteon.TemplateProfileField{
+		"subject": json.RawMessage(`"Disk full on {{ host }}"`),
+		"body":    json.RawMessage(`{"$ref":"body"}`),
+	}
+	Run(t, LocalProfile(fields, map[string]string{"body": "usage {{ usage }}%"}), dir)
+}
+
+func TestRunPreview(t *testing.T) {
+	dir := t.TempDir()
+	writeCase(t, dir, "a", `{"host":"db-1"}`, `{"subject":"Disk full on db-1"}`)
+	writeCase(t, dir, "b", `{"host":"db-2"}`, `{"subject":"Disk full on db-2"}`)
+
+	p := &fakePreviewer{}
+	Run(t, Preview(p, "alert", "ns", "t"), dir)
+	if len(p.got) != 2 || p.got[0].Profile != "alert" || p.got[0].Namespace != "ns" {
+		t.Errorf("requests = %+v", p.got)
+	}
+}
+
+func TestDiffReportsChangedAndMissingFields(t *testing.T) {
+	problems := Diff(
+		map[string]string{"subject": "hi", "body": "line 1\nline 2"},
+		map[string]string{"body": "line 1\nline two", "extra": "x"},
+	)
+	joined := strings.Join(problems, "\n")
+	for _, want := range []string{
+		`field "subject" missing from output`,
+		`field "extra" rendered but not in golden file`,
+		"line 2\n  - \"line 2\"\n  + \"line two\"",
+	} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("problems missing %q:\n%s", want, joined)
+		}
+	}
+}
+
+func TestUpdateModeWritesGoldenFiles(t *testing.T) {
+	t.Setenv(UpdateEnv, "1")
+	dir := t.TempDir()
+	writeCase(t, dir, "new-case", `{"name":"Ada"}`, "")
+
+	Run(t, LocalTemplate("greeting", "Hello {{ name }}"), dir)
+
+	data, err := os.ReadFile(filepath.Join(dir, "new-case"+goldenSuffix))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"greeting": "Hello Ada"`) {
+		t.Errorf("golden file = %s", data)
+	}
+}
@@ -0,0 +1,136 @@
+// Per-tenant concurrency limiting for the Go client.
+//
+// WithTenantConcurrencyLimit caps how many dispatch requests each
+// tenant can have in flight through one client. A multi-tenant
+// dispatcher sharing a client then cannot let one busy tenant take
+// every connection and starve the rest before the gateway's own
+// throttling has a chance to react.
+
+package acteon
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// WithTenantConcurrencyLimit limits Dispatch, DispatchDryRun, and the
+// batch dispatch methods to n requests in flight per tenant. A call
+// over the limit waits until one of its tenant's requests finishes or
+// ctx is done, in which case it fails with a ConnectionError. Each
+// tenant queues separately and in arrival order, so a tenant at its
+// limit never delays another tenant's dispatches.
+//
+// A batch takes one slot from each tenant it holds actions for. A slot
+// is held for the request including WithRetryPolicy retries, but not
+// while waiting out a throttled outcome under WithThrottleRetry. n of
+// zero or less removes the limit.
+func WithTenantConcurrencyLimit(n int) ClientOption {
+	return func(c *Client) {
+		c.tenantLimit = nil
+		if n > 0 {
+			c.tenantLimit = &tenantLimiter{limit: n, tenants: map[string]*tenantSlots{}}
+		}
+	}
+}
+
+// tenantLimiter is the client's WithTenantConcurrencyLimit setting.
+type tenantLimiter struct {
+	limit int
+
+	mu      sync.Mutex
+	tenants map[string]*tenantSlots
+}
+
+// tenantSlots is one tenant's share of a tenantLimiter. Tenants with
+// nothing in flight are dropped from the map.
+type tenantSlots struct {
+	inFlight int
+	// waiters are closed in order as slots free up, handing the slot
+	// over without decrementing inFlight.
+	waiters []chan struct{}
+}
+
+func (l *tenantLimiter) acquire(ctx context.Context, tenant string) error {
+	l.mu.Lock()
+	s := l.tenants[tenant]
+	if s == nil {
+		s = &tenantSlots{}
+		l.tenants[tenant] = s
+	}
+	if s.inFlight < l.limit {
+		s.inFlight++
+		l.mu.Unlock()
+		return nil
+	}
+	ready := make(chan struct{})
+	s.waiters = append(s.waiters, ready)
+	l.mu.Unlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	select {
+	case <-ready:
+		// The slot was handed over as ctx ended; pass it on.
+		l.releaseLocked(tenant)
+	default:
+		s.waiters = slices.DeleteFunc(s.waiters, func(w chan struct{}) bool { return w == ready })
+	}
+	return &ConnectionError{Message: ctx.Err().Error()}
+}
+
+func (l *tenantLimiter) release(tenant string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.releaseLocked(tenant)
+}
+
+// releaseLocked frees one of tenant's slots. l.mu is held.
+func (l *tenantLimiter) releaseLocked(tenant string) {
+	s := l.tenants[tenant]
+	if len(s.waiters) > 0 {
+		close(s.waiters[0])
+		s.waiters = s.waiters[1:]
+		return
+	}
+	s.inFlight--
+	if s.inFlight == 0 {
+		delete(l.tenants, tenant)
+	}
+}
+
+// acquireTenants takes a WithTenantConcurrencyLimit slot for each
+// tenant in actions and returns the function that gives them back.
+func (c *Client) acquireTenants(ctx context.Context, actions ...*Action) (func(), error) {
+	l := c.tenantLimit
+	if l == nil {
+		return func() {}, nil
+	}
+	var tenants []string
+	for _, a := range actions {
+		if a != nil {
+			tenants = append(tenants, a.Tenant)
+		}
+	}
+	// Taking slots in a fixed order keeps two batches that share
+	// tenants from each holding what the other waits for.
+	slices.Sort(tenants)
+	tenants = slices.Compact(tenants)
+	release := func(held []string) {
+		for _, t := range held {
+			l.release(t)
+		}
+	}
+	for i, t := range tenants {
+		if err := l.acquire(ctx, t); err != nil {
+			release(tenants[:i])
+			return nil, err
+		}
+	}
+	return func() { release(tenants) }, nil
+}
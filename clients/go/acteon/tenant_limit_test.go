@@ -0,0 +1,77 @@
+package acteon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTenantConcurrencyLimit(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, peak := map[string]int{}, map[string]int{}
+	arrived := make(chan string, 16)
+	unblock := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var action Action
+		_ = json.NewDecoder(r.Body).Decode(&action)
+		mu.Lock()
+		inFlight[action.Tenant]++
+		peak[action.Tenant] = max(peak[action.Tenant], inFlight[action.Tenant])
+		mu.Unlock()
+		arrived <- action.Tenant
+		if action.Tenant == "noisy" {
+			<-unblock
+		}
+		mu.Lock()
+		inFlight[action.Tenant]--
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"Executed":{}}`))
+	}))
+	defer srv.Close()
+	c := NewClient(srv.URL, WithTenantConcurrencyLimit(2))
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Dispatch(ctx, NewAction("ns", "noisy", "email", "send", nil)); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	<-arrived
+	<-arrived
+
+	// The noisy tenant is at its limit; another tenant is not held up.
+	if _, err := c.Dispatch(ctx, NewAction("ns", "quiet", "email", "send", nil)); err != nil {
+		t.Fatal(err)
+	}
+	if tenant := <-arrived; tenant != "quiet" {
+		t.Errorf("third request to arrive was for %q", tenant)
+	}
+
+	// A queued call gives up with its context.
+	short, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	_, err := c.Dispatch(short, NewAction("ns", "noisy", "email", "send", nil))
+	var connErr *ConnectionError
+	if !errors.As(err, &connErr) {
+		t.Errorf("queued dispatch = %v, want a ConnectionError", err)
+	}
+
+	close(unblock)
+	wg.Wait()
+	if peak["noisy"] != 2 {
+		t.Errorf("noisy tenant peaked at %d in flight, want 2", peak["noisy"])
+	}
+	if n := len(c.tenantLimit.tenants); n != 0 {
+		t.Errorf("%d tenants still hold slots", n)
+	}
+}
@@ -0,0 +1,197 @@
+// Throttle policy surface for the Go client.
+
+package acteon
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// ThrottleService manages throttle policies.
+type ThrottleService struct {
+	c *Client
+}
+
+// Throttles returns the client's ThrottleService.
+func (c *Client) Throttles() *ThrottleService {
+	return &ThrottleService{c: c}
+}
+
+// Create creates a throttle policy.
+func (s *ThrottleService) Create(ctx context.Context, req *CreateThrottleRequest) (*ThrottlePolicy, error) {
+	resp, err := s.c.doRequest(ctx, http.MethodPost, "/v1/throttles", req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusCreated {
+		var result ThrottlePolicy
+		if err := s.c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to create throttle")
+}
+
+// List lists throttle policies with optional namespace,
+// tenant, provider, and action type filters.
+func (s *ThrottleService) List(ctx context.Context, namespace, tenant, provider, actionType *string) (*ListThrottlesResponse, error) {
+	result, err := s.list(ctx, namespace, tenant, provider, actionType)
+	if err != nil {
+		return nil, err
+	}
+	if selector, ok := labelSelectorFrom(ctx); ok {
+		result.Throttles = selectLabels(result.Throttles, selector, func(t ThrottlePolicy) map[string]string { return t.Labels })
+		result.Count = len(result.Throttles)
+	}
+	return result, nil
+}
+
+func (s *ThrottleService) list(ctx context.Context, namespace, tenant, provider, actionType *string) (*ListThrottlesResponse, error) {
+	params := url.Values{}
+	if namespace != nil {
+		params.Set("namespace", *namespace)
+	}
+	if tenant != nil {
+		params.Set("tenant", *tenant)
+	}
+	if provider != nil {
+		params.Set("provider", *provider)
+	}
+	if actionType != nil {
+		params.Set("action_type", *actionType)
+	}
+
+	path := "/v1/throttles"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	resp, err := s.c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to list throttles"}
+	}
+
+	var result ListThrottlesResponse
+	if err := s.c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &result, nil
+}
+
+// Get gets a single throttle policy by ID.
+func (s *ThrottleService) Get(ctx context.Context, throttleID string) (*ThrottlePolicy, error) {
+	path := fmt.Sprintf("/v1/throttles/%s", throttleID)
+
+	resp, err := s.c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: "Failed to get throttle"}
+	}
+
+	var result ThrottlePolicy
+	if err := s.c.decodeBody(resp.Body, &result); err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+	return &result, nil
+}
+
+// Update updates a throttle policy. The new limit applies to
+// the current window.
+func (s *ThrottleService) Update(ctx context.Context, throttleID string, update *UpdateThrottleRequest) (*ThrottlePolicy, error) {
+	resp, err := s.c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/v1/throttles/%s", throttleID), update)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &ConnectionError{Message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var result ThrottlePolicy
+		if err := s.c.decodeJSON(body, &result); err != nil {
+			return nil, &ConnectionError{Message: err.Error()}
+		}
+		return &result, nil
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Throttle not found: %s", throttleID)}
+	}
+
+	return nil, errorFromResponse(resp, body, "Failed to update throttle")
+}
+
+// Delete deletes a throttle policy.
+func (s *ThrottleService) Delete(ctx context.Context, throttleID, namespace, tenant string) error {
+	params := url.Values{}
+	params.Set("namespace", namespace)
+	params.Set("tenant", tenant)
+	path := fmt.Sprintf("/v1/throttles/%s?%s", throttleID, params.Encode())
+
+	resp, err := s.c.doRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return &HTTPError{Status: resp.StatusCode, Message: fmt.Sprintf("Throttle not found: %s", throttleID)}
+	}
+	return &HTTPError{Status: resp.StatusCode, Message: "Failed to delete throttle"}
+}
+
+// CreateThrottle is shorthand for c.Throttles().Create.
+func (c *Client) CreateThrottle(ctx context.Context, req *CreateThrottleRequest) (*ThrottlePolicy, error) {
+	return c.Throttles().Create(ctx, req)
+}
+
+// ListThrottles is shorthand for c.Throttles().List.
+func (c *Client) ListThrottles(ctx context.Context, namespace, tenant, provider, actionType *string) (*ListThrottlesResponse, error) {
+	return c.Throttles().List(ctx, namespace, tenant, provider, actionType)
+}
+
+// GetThrottle is shorthand for c.Throttles().Get.
+func (c *Client) GetThrottle(ctx context.Context, throttleID string) (*ThrottlePolicy, error) {
+	return c.Throttles().Get(ctx, throttleID)
+}
+
+// UpdateThrottle is shorthand for c.Throttles().Update.
+func (c *Client) UpdateThrottle(ctx context.Context, throttleID string, update *UpdateThrottleRequest) (*ThrottlePolicy, error) {
+	return c.Throttles().Update(ctx, throttleID, update)
+}
+
+// DeleteThrottle is shorthand for c.Throttles().Delete.
+func (c *Client) DeleteThrottle(ctx context.Context, throttleID, namespace, tenant string) error {
+	return c.Throttles().Delete(ctx, throttleID, namespace, tenant)
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+func newApplyCmd(a *app) *cobra.Command {
+	var (
+		file          string
+		opts          acteon.ApplyOptions
+		showUnchanged bool
+	)
+	cmd := &cobra.Command{
+		Use:   "apply -f MANIFEST",
+		Short: "Converge the gateway to a YAML manifest",
+		Long: "Create, update, and with --prune delete quotas, retention policies,\n" +
+			"templates, profiles, recurring actions, and rule toggles so the gateway\n" +
+			"matches the manifest. Use --dry-run to print the plan without applying it.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			f, err := os.Open(file)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			manifest, err := acteon.ParseManifest(f)
+			if err != nil {
+				return err
+			}
+			res, err := a.client.Apply(cmd.Context(), manifest, opts)
+			if res == nil {
+				return err
+			}
+			var rows [][]string
+			for _, c := range res.Changes {
+				if c.Action == acteon.ApplyUnchanged && !showUnchanged {
+					continue
+				}
+				rows = append(rows, []string{
+					c.Action, c.Kind, orDash(c.Namespace), orDash(c.Tenant), c.Name, orDash(strings.Join(c.Fields, ",")),
+				})
+			}
+			if a.output != "json" && len(rows) == 0 {
+				fmt.Fprintln(a.out, "no changes")
+				return err
+			}
+			if perr := a.printList(res, []string{"ACTION", "KIND", "NAMESPACE", "TENANT", "NAME", "FIELDS"}, rows); perr != nil {
+				return perr
+			}
+			return err
+		},
+	}
+	f := cmd.Flags()
+	f.StringVarP(&file, "file", "f", "", "manifest file")
+	f.BoolVar(&opts.Prune, "prune", false, "delete resources the manifest does not declare")
+	f.BoolVar(&opts.DryRun, "dry-run", false, "print the plan without applying it")
+	f.BoolVar(&showUnchanged, "show-unchanged", false, "also list resources that are already up to date")
+	_ = cmd.MarkFlagRequired("file")
+	return cmd
+}
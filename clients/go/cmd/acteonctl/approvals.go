@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func newApprovalsCmd(a *app) *cobra.Command {
+	cmd := &cobra.Command{Use: "approvals", Short: "List and decide pending approvals"}
+
+	var s scope
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List approvals",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			res, err := a.client.ListApprovals(cmd.Context(), s.namespace, s.tenant)
+			if err != nil {
+				return err
+			}
+			rows := make([][]string, len(res.Approvals))
+			for i, ap := range res.Approvals {
+				rows[i] = []string{ap.Token, string(ap.Status), ap.Rule, fmtTime(ap.CreatedAt), fmtTime(ap.ExpiresAt)}
+			}
+			return a.printList(res, []string{"ID", "STATUS", "RULE", "CREATED", "EXPIRES"}, rows)
+		},
+	}
+	s.register(list, true)
+
+	cmd.AddCommand(list, newDecideCmd(a, true), newDecideCmd(a, false))
+	return cmd
+}
+
+// newDecideCmd builds `approve` or `reject`. The signature, expiry, and
+// key ID come from the approval link the gateway sent.
+func newDecideCmd(a *app, approve bool) *cobra.Command {
+	var (
+		s         scope
+		sig, kid  string
+		expiresAt int64
+	)
+	use, short := "reject ID", "Reject a pending action"
+	if approve {
+		use, short = "approve ID", "Approve a pending action"
+	}
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			decide := a.client.Reject
+			if approve {
+				decide = a.client.Approve
+			}
+			res, err := decide(cmd.Context(), s.namespace, s.tenant, args[0], sig, expiresAt, kid)
+			if err != nil {
+				return err
+			}
+			if a.output == "json" {
+				return a.print(res)
+			}
+			fmt.Fprintf(a.out, "%s  %s\n", res.ID, res.Status)
+			return nil
+		},
+	}
+	s.register(cmd, true)
+	cmd.Flags().StringVar(&sig, "sig", "", "HMAC signature from the approval link")
+	cmd.Flags().Int64Var(&expiresAt, "expires-at", 0, "expiry (Unix seconds) from the approval link")
+	cmd.Flags().StringVar(&kid, "kid", "", "key ID from the approval link")
+	_ = cmd.MarkFlagRequired("sig")
+	_ = cmd.MarkFlagRequired("expires-at")
+	return cmd
+}
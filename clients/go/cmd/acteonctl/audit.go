@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+func newAuditCmd(a *app) *cobra.Command {
+	cmd := &cobra.Command{Use: "audit", Short: "Query and replay the audit trail"}
+
+	var q acteon.AuditQuery
+	query := &cobra.Command{
+		Use:   "query",
+		Short: "Query audit records",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			page, err := a.client.QueryAudit(cmd.Context(), &q)
+			if err != nil {
+				return err
+			}
+			rows := make([][]string, len(page.Records))
+			for i, r := range page.Records {
+				rows[i] = []string{
+					r.ActionID, fmtTime(r.DispatchedAt), r.Namespace, r.Tenant,
+					r.Provider, r.ActionType, r.Outcome, fmtStrPtr(r.MatchedRule),
+					strconv.FormatInt(r.DurationMs, 10),
+				}
+			}
+			if err := a.printList(page, []string{"ACTION ID", "DISPATCHED", "NAMESPACE", "TENANT", "PROVIDER", "TYPE", "OUTCOME", "RULE", "MS"}, rows); err != nil {
+				return err
+			}
+			if a.output != "json" && page.NextCursor != "" {
+				fmt.Fprintf(a.out, "\nmore results: --cursor %s\n", page.NextCursor)
+			}
+			return nil
+		},
+	}
+	f := query.Flags()
+	f.StringVarP(&q.Namespace, "namespace", "n", "", "namespace")
+	f.StringVarP(&q.Tenant, "tenant", "t", "", "tenant")
+	f.StringVar(&q.Provider, "provider", "", "provider")
+	f.StringVar(&q.ActionType, "type", "", "action type")
+	f.StringVar(&q.Outcome, "outcome", "", "outcome")
+	f.IntVar(&q.Limit, "limit", 50, "maximum records")
+	f.StringVar(&q.Cursor, "cursor", "", "cursor from a previous page")
+
+	get := &cobra.Command{
+		Use:   "get ACTION_ID",
+		Short: "Show one audit record",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			record, err := a.client.GetAuditRecord(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			if record == nil {
+				return fmt.Errorf("audit record %s not found", args[0])
+			}
+			return a.print(record)
+		},
+	}
+
+	cmd.AddCommand(query, get, newReplayCmd(a))
+	return cmd
+}
+
+func newReplayCmd(a *app) *cobra.Command {
+	var (
+		rq       acteon.ReplayQuery
+		from, to string
+	)
+	cmd := &cobra.Command{
+		Use:   "replay [ACTION_ID]",
+		Short: "Replay one action, or every action matching the filters",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				res, err := a.client.ReplayAction(cmd.Context(), args[0])
+				if err != nil {
+					return err
+				}
+				return a.print(res)
+			}
+			var err error
+			if rq.From, err = parseTimeFlag(from); err != nil {
+				return err
+			}
+			if rq.To, err = parseTimeFlag(to); err != nil {
+				return err
+			}
+			summary, err := a.client.ReplayAudit(cmd.Context(), &rq)
+			if err != nil {
+				return err
+			}
+			if a.output == "json" {
+				return a.print(summary)
+			}
+			fmt.Fprintf(a.out, "replayed %d, failed %d, skipped %d\n", summary.Replayed, summary.Failed, summary.Skipped)
+			return nil
+		},
+	}
+	f := cmd.Flags()
+	f.StringVarP(&rq.Namespace, "namespace", "n", "", "namespace")
+	f.StringVarP(&rq.Tenant, "tenant", "t", "", "tenant")
+	f.StringVar(&rq.Provider, "provider", "", "provider")
+	f.StringVar(&rq.ActionType, "type", "", "action type")
+	f.StringVar(&rq.Outcome, "outcome", "", "outcome")
+	f.StringVar(&rq.MatchedRule, "rule", "", "matched rule")
+	f.StringVar(&from, "from", "", "start time (RFC 3339) or duration ago, e.g. 2h")
+	f.StringVar(&to, "to", "", "end time (RFC 3339) or duration ago")
+	f.IntVar(&rq.Limit, "limit", 0, "maximum actions to replay")
+	return cmd
+}
+
+// parseTimeFlag accepts an RFC 3339 time or a duration meaning that
+// long ago.
+func parseTimeFlag(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		t := time.Now().Add(-d)
+		return &t, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time %q: want RFC 3339 or a duration like 2h", s)
+	}
+	return &t, nil
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+func newChainsCmd(a *app) *cobra.Command {
+	cmd := &cobra.Command{Use: "chains", Short: "Inspect and cancel chain executions"}
+
+	var (
+		ls     scope
+		status string
+	)
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List chain executions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			res, err := a.client.ListChains(cmd.Context(), ls.namespace, ls.tenant, optional(status))
+			if err != nil {
+				return err
+			}
+			rows := make([][]string, len(res.Chains))
+			for i, c := range res.Chains {
+				rows[i] = []string{
+					c.ChainID, c.ChainName, string(c.Status),
+					fmt.Sprintf("%d/%d", c.CurrentStep, c.TotalSteps), fmtTime(c.StartedAt), fmtTime(c.UpdatedAt),
+				}
+			}
+			return a.printList(res, []string{"CHAIN ID", "NAME", "STATUS", "STEP", "STARTED", "UPDATED"}, rows)
+		},
+	}
+	ls.register(list, true)
+	list.Flags().StringVar(&status, "status", "", "filter by status, e.g. running or failed")
+
+	var gs scope
+	get := &cobra.Command{
+		Use:   "get CHAIN_ID",
+		Short: "Show a chain execution and its steps",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			detail, err := a.client.GetChain(cmd.Context(), args[0], gs.namespace, gs.tenant)
+			if err != nil {
+				return err
+			}
+			if detail == nil {
+				return fmt.Errorf("chain %s not found", args[0])
+			}
+			if a.output == "json" {
+				return a.print(detail)
+			}
+			fmt.Fprintf(a.out, "%s  %s  %s\n\n", detail.ChainID, detail.ChainName, detail.Status)
+			rows := make([][]string, len(detail.Steps))
+			for i, s := range detail.Steps {
+				rows[i] = []string{s.Name, s.Provider, string(s.Status), fmtStrPtr(s.Error)}
+			}
+			return a.table([]string{"STEP", "PROVIDER", "STATUS", "ERROR"}, rows)
+		},
+	}
+	gs.register(get, true)
+
+	var (
+		cs     scope
+		reason string
+	)
+	cancel := &cobra.Command{
+		Use:   "cancel CHAIN_ID",
+		Short: "Cancel a running chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			detail, err := a.client.CancelChain(cmd.Context(), args[0], &acteon.CancelChainRequest{
+				Namespace: cs.namespace,
+				Tenant:    cs.tenant,
+				Reason:    optional(reason),
+			})
+			if err != nil {
+				return err
+			}
+			if a.output == "json" {
+				return a.print(detail)
+			}
+			fmt.Fprintf(a.out, "%s  %s\n", detail.ChainID, detail.Status)
+			return nil
+		},
+	}
+	cs.register(cancel, true)
+	cancel.Flags().StringVar(&reason, "reason", "", "cancellation reason")
+
+	cmd.AddCommand(list, get, cancel)
+	return cmd
+}
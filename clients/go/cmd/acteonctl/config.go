@@ -0,0 +1,181 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+)
+
+// config is the acteonctl config file:
+//
+//	{
+//	  "current_profile": "prod",
+//	  "profiles": {
+//	    "prod":  {"server": "https://acteon.example.com", "api_key_env": "ACTEON_PROD_KEY"},
+//	    "local": {"server": "http://localhost:8080"}
+//	  }
+//	}
+//
+// A profile may hold the API key inline in api_key, but api_key_env,
+// which names an environment variable to read it from, keeps the key
+// out of the file.
+type config struct {
+	CurrentProfile string             `json:"current_profile,omitempty"`
+	Profiles       map[string]profile `json:"profiles"`
+}
+
+type profile struct {
+	Server    string `json:"server"`
+	APIKey    string `json:"api_key,omitempty"`
+	APIKeyEnv string `json:"api_key_env,omitempty"`
+}
+
+func (p profile) apiKey() string {
+	if p.APIKeyEnv != "" {
+		if v := os.Getenv(p.APIKeyEnv); v != "" {
+			return v
+		}
+	}
+	return p.APIKey
+}
+
+func defaultConfigPath() string {
+	if p := os.Getenv("ACTEONCTL_CONFIG"); p != "" {
+		return p
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "acteonctl.json"
+	}
+	return filepath.Join(dir, "acteonctl", "config.json")
+}
+
+// loadConfig reads the config file. A missing file is an empty config.
+func loadConfig(path string) (*config, error) {
+	cfg := &config{Profiles: map[string]profile{}}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if cfg.Profiles == nil {
+		cfg.Profiles = map[string]profile{}
+	}
+	return cfg, nil
+}
+
+func (c *config) save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o600)
+}
+
+// resolve returns the named profile, or the current profile when name
+// is empty. With no name and no current profile it returns an empty
+// profile so that flags and environment variables can supply
+// everything.
+func (c *config) resolve(name string) (profile, error) {
+	if name == "" {
+		name = c.CurrentProfile
+	}
+	if name == "" {
+		return profile{}, nil
+	}
+	p, ok := c.Profiles[name]
+	if !ok {
+		return profile{}, fmt.Errorf("profile %q not found in config", name)
+	}
+	return p, nil
+}
+
+func newConfigCmd(a *app) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "config",
+		Short:       "Manage connection profiles",
+		Annotations: map[string]string{"offline": "true"},
+	}
+
+	var p profile
+	set := &cobra.Command{
+		Use:         "set-profile NAME",
+		Short:       "Create or update a profile",
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{"offline": "true"},
+		RunE: func(_ *cobra.Command, args []string) error {
+			cfg, err := loadConfig(a.config)
+			if err != nil {
+				return err
+			}
+			cfg.Profiles[args[0]] = p
+			if cfg.CurrentProfile == "" {
+				cfg.CurrentProfile = args[0]
+			}
+			return cfg.save(a.config)
+		},
+	}
+	set.Flags().StringVar(&p.Server, "server", "", "gateway URL")
+	set.Flags().StringVar(&p.APIKey, "api-key", "", "API key stored in the file")
+	set.Flags().StringVar(&p.APIKeyEnv, "api-key-env", "", "environment variable holding the API key")
+
+	use := &cobra.Command{
+		Use:         "use NAME",
+		Short:       "Select the current profile",
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{"offline": "true"},
+		RunE: func(_ *cobra.Command, args []string) error {
+			cfg, err := loadConfig(a.config)
+			if err != nil {
+				return err
+			}
+			if _, ok := cfg.Profiles[args[0]]; !ok {
+				return fmt.Errorf("profile %q not found in config", args[0])
+			}
+			cfg.CurrentProfile = args[0]
+			return cfg.save(a.config)
+		},
+	}
+
+	list := &cobra.Command{
+		Use:         "list",
+		Short:       "List profiles",
+		Annotations: map[string]string{"offline": "true"},
+		RunE: func(_ *cobra.Command, _ []string) error {
+			cfg, err := loadConfig(a.config)
+			if err != nil {
+				return err
+			}
+			names := make([]string, 0, len(cfg.Profiles))
+			for name := range cfg.Profiles {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			rows := make([][]string, len(names))
+			for i, name := range names {
+				current := ""
+				if name == cfg.CurrentProfile {
+					current = "*"
+				}
+				rows[i] = []string{current, name, cfg.Profiles[name].Server}
+			}
+			return a.table([]string{"", "NAME", "SERVER"}, rows)
+		},
+	}
+
+	cmd.AddCommand(set, use, list)
+	return cmd
+}
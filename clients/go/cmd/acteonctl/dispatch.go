@@ -0,0 +1,117 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+// scope holds the --namespace and --tenant flags shared by most
+// commands.
+type scope struct {
+	namespace string
+	tenant    string
+}
+
+func (s *scope) register(cmd *cobra.Command, required bool) {
+	cmd.Flags().StringVarP(&s.namespace, "namespace", "n", "", "namespace")
+	cmd.Flags().StringVarP(&s.tenant, "tenant", "t", "", "tenant")
+	if required {
+		_ = cmd.MarkFlagRequired("namespace")
+		_ = cmd.MarkFlagRequired("tenant")
+	}
+}
+
+// newDispatchCmd builds `dispatch`, or `dry-run` when dryRun is set.
+// `dispatch --dry-run` is the same as `dry-run`.
+func newDispatchCmd(a *app, dryRun bool) *cobra.Command {
+	var (
+		s          scope
+		provider   string
+		actionType string
+		payload    string
+		dedupKey   string
+	)
+	cmd := &cobra.Command{
+		Use:   "dispatch",
+		Short: "Dispatch an action",
+		Example: `  acteonctl dispatch -n alerts -t acme --provider email --type send \
+    --payload '{"to":"ops@example.com","subject":"disk full"}'`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			data, err := readPayload(payload)
+			if err != nil {
+				return err
+			}
+			action := acteon.NewAction(s.namespace, s.tenant, provider, actionType, data)
+			if dedupKey != "" {
+				action.WithDedupKey(dedupKey)
+			}
+
+			var outcome *acteon.ActionOutcome
+			if dryRun {
+				outcome, err = a.client.DispatchDryRun(cmd.Context(), action)
+			} else {
+				outcome, err = a.client.Dispatch(cmd.Context(), action)
+			}
+			if err != nil {
+				return err
+			}
+			if a.output == "json" {
+				return a.print(outcome)
+			}
+			fmt.Fprintf(a.out, "%s  %s\n", action.ID, describeOutcome(outcome))
+			return nil
+		},
+	}
+	if dryRun {
+		cmd.Use = "dry-run"
+		cmd.Short = "Evaluate rules for an action without executing it"
+		cmd.Example = ""
+	} else {
+		cmd.Flags().BoolVar(&dryRun, "dry-run", false, "evaluate rules without executing")
+	}
+	s.register(cmd, true)
+	cmd.Flags().StringVar(&provider, "provider", "", "target provider")
+	cmd.Flags().StringVar(&actionType, "type", "", "action type")
+	cmd.Flags().StringVar(&payload, "payload", "", "JSON payload, @file, or @- for stdin")
+	cmd.Flags().StringVar(&dedupKey, "dedup-key", "", "deduplication key")
+	_ = cmd.MarkFlagRequired("provider")
+	_ = cmd.MarkFlagRequired("type")
+	return cmd
+}
+
+// describeOutcome renders an outcome on one line.
+func describeOutcome(o *acteon.ActionOutcome) string {
+	switch o.Type {
+	case acteon.OutcomeExecuted:
+		if o.Response != nil {
+			return fmt.Sprintf("executed (%s)", o.Response.Status)
+		}
+		return "executed"
+	case acteon.OutcomeSuppressed:
+		return "suppressed by rule " + o.Rule
+	case acteon.OutcomeRerouted:
+		return fmt.Sprintf("rerouted %s -> %s", o.OriginalProvider, o.NewProvider)
+	case acteon.OutcomeThrottled:
+		return fmt.Sprintf("throttled, retry after %s", o.RetryAfter)
+	case acteon.OutcomeFailed:
+		if o.Error != nil {
+			return fmt.Sprintf("failed: [%s] %s", o.Error.Code, o.Error.Message)
+		}
+		return "failed"
+	case acteon.OutcomeDryRun:
+		rule := "no rule"
+		if o.MatchedRule != nil {
+			rule = "rule " + *o.MatchedRule
+		}
+		return fmt.Sprintf("dry run: %s (%s), provider %s", o.Verdict, rule, o.WouldBeProvider)
+	case acteon.OutcomeScheduled:
+		return "scheduled for " + o.ScheduledFor
+	case acteon.OutcomeQuotaExceeded:
+		return fmt.Sprintf("quota exceeded: %d/%d (%s)", o.Used, o.Limit, o.OverageBehavior)
+	}
+	return string(o.Type)
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+func newDlqCmd(a *app) *cobra.Command {
+	cmd := &cobra.Command{Use: "dlq", Short: "Inspect and retry the dead-letter queue"}
+
+	stats := &cobra.Command{
+		Use:   "stats",
+		Short: "Show dead-letter queue counts by provider",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			res, err := a.client.DlqStatsDetailed(cmd.Context())
+			if err != nil {
+				return err
+			}
+			providers := make([]string, 0, len(res.ByProvider))
+			for p := range res.ByProvider {
+				providers = append(providers, p)
+			}
+			sort.Strings(providers)
+			rows := make([][]string, len(providers))
+			for i, p := range providers {
+				rows[i] = []string{p, strconv.Itoa(res.ByProvider[p])}
+			}
+			if err := a.printList(res, []string{"PROVIDER", "ENTRIES"}, rows); err != nil {
+				return err
+			}
+			if a.output != "json" {
+				fmt.Fprintf(a.out, "\ntotal %d\n", res.Count)
+			}
+			return nil
+		},
+	}
+
+	var filter acteon.DlqFilter
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List dead-letter entries",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			res, err := a.client.ListDlq(cmd.Context(), filter)
+			if err != nil {
+				return err
+			}
+			rows := make([][]string, len(res.Entries))
+			for i, e := range res.Entries {
+				rows[i] = []string{
+					e.ActionID, fmtTime(time.Unix(int64(e.Timestamp), 0)), e.Namespace, e.Tenant,
+					e.Provider, e.ActionType, strconv.Itoa(e.Attempts), e.Error,
+				}
+			}
+			return a.printList(res, []string{"ACTION ID", "FAILED", "NAMESPACE", "TENANT", "PROVIDER", "TYPE", "ATTEMPTS", "ERROR"}, rows)
+		},
+	}
+	list.Flags().StringVarP(&filter.Namespace, "namespace", "n", "", "namespace")
+	list.Flags().StringVarP(&filter.Tenant, "tenant", "t", "", "tenant")
+	list.Flags().StringVar(&filter.Provider, "provider", "", "provider")
+	list.Flags().IntVar(&filter.Limit, "limit", 50, "maximum entries")
+
+	retry := &cobra.Command{
+		Use:   "retry ACTION_ID",
+		Short: "Re-dispatch a dead-letter entry",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			res, err := a.client.RetryDlqEntry(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			if a.output == "json" {
+				return a.print(res)
+			}
+			if !res.Success {
+				return fmt.Errorf("retry of %s failed: %s", res.ActionID, res.Error)
+			}
+			fmt.Fprintf(a.out, "%s  %s\n", res.ActionID, res.Outcome)
+			return nil
+		},
+	}
+
+	del := &cobra.Command{
+		Use:   "delete ACTION_ID",
+		Short: "Remove a dead-letter entry",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := a.client.DeleteDlqEntry(cmd.Context(), args[0]); err != nil {
+				return err
+			}
+			fmt.Fprintf(a.out, "deleted %s\n", args[0])
+			return nil
+		},
+	}
+
+	cmd.AddCommand(stats, list, retry, del)
+	return cmd
+}
@@ -0,0 +1,121 @@
+// Command acteonctl is an operator CLI for the Acteon gateway built on
+// the Go client. It covers dispatch and dry-run, audit query and
+// replay, approvals, recurring actions, quotas, the dead-letter queue,
+// chains, live event tailing, and declarative sync from a manifest.
+//
+// Connection settings come from flags, then ACTEON_SERVER and
+// ACTEON_API_KEY, then the selected profile in the config file (see
+// `acteonctl config --help`).
+//
+//	acteonctl --profile prod audit query --tenant acme --limit 20
+//	acteonctl dispatch -n alerts -t acme --provider email --type send --payload @msg.json
+//	acteonctl tail --namespace alerts -o json
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+
+	"github.com/spf13/cobra"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+// app holds the global flags and the client built from them.
+type app struct {
+	profile string
+	server  string
+	apiKey  string
+	output  string
+	config  string
+
+	out    io.Writer
+	client *acteon.Client
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if err := newRootCmd(os.Stdout).ExecuteContext(ctx); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+func newRootCmd(out io.Writer) *cobra.Command {
+	a := &app{out: out}
+	root := &cobra.Command{
+		Use:           "acteonctl",
+		Short:         "Operate an Acteon gateway from the command line",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+			if cmd.Annotations["offline"] == "true" {
+				return nil
+			}
+			return a.connect()
+		},
+	}
+	root.SetOut(out)
+
+	flags := root.PersistentFlags()
+	flags.StringVar(&a.profile, "profile", "", "config profile to use (default: the config's current profile)")
+	flags.StringVar(&a.server, "server", "", "gateway URL (overrides profile and ACTEON_SERVER)")
+	flags.StringVar(&a.apiKey, "api-key", "", "API key (overrides profile and ACTEON_API_KEY)")
+	flags.StringVarP(&a.output, "output", "o", "table", "output format: table or json")
+	flags.StringVar(&a.config, "config", defaultConfigPath(), "path to the config file")
+
+	root.AddCommand(
+		newConfigCmd(a),
+		newDispatchCmd(a, false),
+		newDispatchCmd(a, true),
+		newAuditCmd(a),
+		newApprovalsCmd(a),
+		newRecurringCmd(a),
+		newQuotasCmd(a),
+		newDlqCmd(a),
+		newChainsCmd(a),
+		newTailCmd(a),
+		newApplyCmd(a),
+	)
+	return root
+}
+
+// connect resolves connection settings and builds the client.
+func (a *app) connect() error {
+	if a.output != "table" && a.output != "json" {
+		return fmt.Errorf("unknown output format %q (want table or json)", a.output)
+	}
+	cfg, err := loadConfig(a.config)
+	if err != nil {
+		return err
+	}
+	p, err := cfg.resolve(a.profile)
+	if err != nil {
+		return err
+	}
+	server := firstNonEmpty(a.server, os.Getenv("ACTEON_SERVER"), p.Server)
+	if server == "" {
+		return fmt.Errorf("no server configured: pass --server, set ACTEON_SERVER, or add a profile")
+	}
+	apiKey := firstNonEmpty(a.apiKey, os.Getenv("ACTEON_API_KEY"), p.apiKey())
+
+	var opts []acteon.ClientOption
+	if apiKey != "" {
+		opts = append(opts, acteon.WithAPIKey(apiKey))
+	}
+	a.client = acteon.NewClient(server, opts...)
+	return nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// run executes acteonctl with args against a config in a temp dir and
+// returns its output.
+func run(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	var out bytes.Buffer
+	root := newRootCmd(&out)
+	root.SetArgs(append([]string{"--config", filepath.Join(t.TempDir(), "config.json")}, args...))
+	err := root.Execute()
+	return out.String(), err
+}
+
+func TestDispatchSendsAction(t *testing.T) {
+	var got map[string]any
+	var auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &got)
+		_, _ = w.Write([]byte(`{"Executed":{"status":"success","body":{},"headers":{}}}`))
+	}))
+	defer srv.Close()
+
+	out, err := run(t, "--server", srv.URL, "--api-key", "k1",
+		"dispatch", "-n", "alerts", "-t", "acme", "--provider", "email", "--type", "send",
+		"--payload", `{"to":"ops@example.com"}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if auth != "Bearer k1" {
+		t.Errorf("auth = %q", auth)
+	}
+	if got["namespace"] != "alerts" || got["provider"] != "email" || got["payload"].(map[string]any)["to"] != "ops@example.com" {
+		t.Errorf("action = %v", got)
+	}
+	if !strings.Contains(out, "executed (success)") {
+		t.Errorf("output = %q", out)
+	}
+}
+
+func TestAuditQueryTableAndJSON(t *testing.T) {
+	var query string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		_, _ = w.Write([]byte(`{"records":[{"id":"r1","action_id":"act-1","namespace":"alerts","tenant":"acme",
+			"provider":"email","action_type":"send","verdict":"allow","outcome":"executed","duration_ms":12,
+			"dispatched_at":"2026-03-01T10:00:00Z"}],"limit":50,"offset":0}`))
+	}))
+	defer srv.Close()
+
+	out, err := run(t, "--server", srv.URL, "audit", "query", "-t", "acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(query, "tenant=acme") {
+		t.Errorf("query = %s", query)
+	}
+	if !strings.HasPrefix(out, "ACTION ID") || !strings.Contains(out, "act-1") {
+		t.Errorf("table output = %q", out)
+	}
+
+	out, err = run(t, "--server", srv.URL, "-o", "json", "audit", "query")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var page map[string]any
+	if err := json.Unmarshal([]byte(out), &page); err != nil {
+		t.Errorf("json output = %q: %v", out, err)
+	}
+}
+
+func TestProfilesResolveServerAndKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	t.Setenv("PROD_KEY", "from-env")
+	cfg := &config{
+		CurrentProfile: "prod",
+		Profiles: map[string]profile{
+			"prod":  {Server: "https://prod.example.com", APIKeyEnv: "PROD_KEY"},
+			"local": {Server: "http://localhost:8080", APIKey: "inline"},
+		},
+	}
+	if err := cfg.save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := loadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := loaded.resolve("")
+	if err != nil || p.Server != "https://prod.example.com" || p.apiKey() != "from-env" {
+		t.Errorf("current profile = %+v (%v), key %q", p, err, p.apiKey())
+	}
+	p, _ = loaded.resolve("local")
+	if p.apiKey() != "inline" {
+		t.Errorf("local key = %q", p.apiKey())
+	}
+	if _, err := loaded.resolve("staging"); err == nil {
+		t.Error("expected error for unknown profile")
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0o600 {
+		t.Errorf("config mode = %v, %v", info.Mode(), err)
+	}
+}
+
+func TestMissingServerIsAnError(t *testing.T) {
+	t.Setenv("ACTEON_SERVER", "")
+	_, err := run(t, "dlq", "stats")
+	if err == nil || !strings.Contains(err.Error(), "no server configured") {
+		t.Errorf("err = %v", err)
+	}
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+)
+
+func newQuotasCmd(a *app) *cobra.Command {
+	cmd := &cobra.Command{Use: "quotas", Short: "Inspect and manage tenant quotas"}
+
+	var s scope
+	var provider string
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List quota policies",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			res, err := a.client.ListQuotas(cmd.Context(), optional(s.namespace), optional(s.tenant), optional(provider), nil)
+			if err != nil {
+				return err
+			}
+			rows := make([][]string, len(res.Quotas))
+			for i, q := range res.Quotas {
+				rows[i] = []string{
+					q.ID, q.Namespace, q.Tenant, orDash(q.Provider),
+					strconv.FormatInt(q.MaxActions, 10), q.Window, q.OverageBehavior, strconv.FormatBool(q.Enabled),
+				}
+			}
+			return a.printList(res, []string{"ID", "NAMESPACE", "TENANT", "PROVIDER", "MAX", "WINDOW", "OVERAGE", "ENABLED"}, rows)
+		},
+	}
+	s.register(list, false)
+	list.Flags().StringVar(&provider, "provider", "", "provider")
+
+	get := &cobra.Command{
+		Use:   "get ID",
+		Short: "Show a quota policy",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			q, err := a.client.GetQuota(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			return a.print(q)
+		},
+	}
+
+	usage := &cobra.Command{
+		Use:   "usage ID",
+		Short: "Show current usage against a quota",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			u, err := a.client.GetQuotaUsage(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			if a.output == "json" {
+				return a.print(u)
+			}
+			fmt.Fprintf(a.out, "%d/%d used (%d remaining) in %s window, resets %s\n",
+				u.Used, u.Limit, u.Remaining, u.Window, fmtTime(u.ResetsAt))
+			return nil
+		},
+	}
+
+	var ds scope
+	del := &cobra.Command{
+		Use:   "delete ID",
+		Short: "Delete a quota policy",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := a.client.DeleteQuota(cmd.Context(), args[0], ds.namespace, ds.tenant); err != nil {
+				return err
+			}
+			fmt.Fprintf(a.out, "deleted %s\n", args[0])
+			return nil
+		},
+	}
+	ds.register(del, true)
+
+	cmd.AddCommand(list, get, usage, del)
+	return cmd
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+func newRecurringCmd(a *app) *cobra.Command {
+	cmd := &cobra.Command{Use: "recurring", Short: "Manage recurring actions"}
+
+	var (
+		filter acteon.RecurringFilter
+		status string
+	)
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List recurring actions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			filter.Status = acteon.RecurringStatus(status)
+			res, err := a.client.ListRecurring(cmd.Context(), &filter)
+			if err != nil {
+				return err
+			}
+			rows := make([][]string, len(res.RecurringActions))
+			for i, r := range res.RecurringActions {
+				rows[i] = []string{
+					r.ID, r.CronExpr, r.Timezone, strconv.FormatBool(r.Enabled),
+					r.Provider, r.ActionType, strconv.Itoa(r.ExecutionCount), fmtTimePtr(r.NextExecutionAt),
+				}
+			}
+			return a.printList(res, []string{"ID", "CRON", "TZ", "ENABLED", "PROVIDER", "TYPE", "RUNS", "NEXT"}, rows)
+		},
+	}
+	list.Flags().StringVarP(&filter.Namespace, "namespace", "n", "", "namespace")
+	list.Flags().StringVarP(&filter.Tenant, "tenant", "t", "", "tenant")
+	list.Flags().StringVar(&status, "status", "", "active or paused")
+	list.Flags().IntVar(&filter.Limit, "limit", 0, "maximum results")
+	_ = list.MarkFlagRequired("namespace")
+	_ = list.MarkFlagRequired("tenant")
+
+	cmd.AddCommand(
+		list,
+		recurringIDCmd(a, "get", "Show a recurring action", func(cmd *cobra.Command, id string, s scope) (any, error) {
+			detail, err := a.client.GetRecurring(cmd.Context(), id, s.namespace, s.tenant)
+			if err == nil && detail == nil {
+				err = fmt.Errorf("recurring action %s not found", id)
+			}
+			return detail, err
+		}),
+		recurringIDCmd(a, "pause", "Pause a recurring action", func(cmd *cobra.Command, id string, s scope) (any, error) {
+			return a.client.PauseRecurring(cmd.Context(), id, s.namespace, s.tenant)
+		}),
+		recurringIDCmd(a, "resume", "Resume a paused recurring action", func(cmd *cobra.Command, id string, s scope) (any, error) {
+			return a.client.ResumeRecurring(cmd.Context(), id, s.namespace, s.tenant)
+		}),
+		recurringIDCmd(a, "delete", "Delete a recurring action", func(cmd *cobra.Command, id string, s scope) (any, error) {
+			if err := a.client.DeleteRecurring(cmd.Context(), id, s.namespace, s.tenant); err != nil {
+				return nil, err
+			}
+			fmt.Fprintf(a.out, "deleted %s\n", id)
+			return nil, nil
+		}),
+	)
+	return cmd
+}
+
+// recurringIDCmd builds a subcommand that acts on one recurring action
+// by ID and prints the result, if any.
+func recurringIDCmd(a *app, use, short string, run func(cmd *cobra.Command, id string, s scope) (any, error)) *cobra.Command {
+	var s scope
+	cmd := &cobra.Command{
+		Use:   use + " ID",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			res, err := run(cmd, args[0], s)
+			if err != nil || res == nil {
+				return err
+			}
+			return a.print(res)
+		},
+	}
+	s.register(cmd, true)
+	return cmd
+}
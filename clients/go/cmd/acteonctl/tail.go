@@ -0,0 +1,55 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/penserai/acteon/clients/go/acteon"
+)
+
+func newTailCmd(a *app) *cobra.Command {
+	var namespace, actionType, outcome, eventType, chainID, lastEventID string
+	cmd := &cobra.Command{
+		Use:   "tail",
+		Short: "Stream live gateway events",
+		Long: "Stream live gateway events until interrupted. With -o json each event\n" +
+			"is printed as one JSON object per line.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			events, err := a.client.Stream(cmd.Context(), &acteon.StreamOptions{
+				Namespace:   optional(namespace),
+				ActionType:  optional(actionType),
+				Outcome:     optional(outcome),
+				EventType:   optional(eventType),
+				ChainID:     optional(chainID),
+				LastEventID: optional(lastEventID),
+			})
+			if err != nil {
+				return err
+			}
+			for ev := range events {
+				if a.output == "json" {
+					if err := json.NewEncoder(a.out).Encode(ev); err != nil {
+						return err
+					}
+					continue
+				}
+				fmt.Fprintf(a.out, "%s  %-18s %s\n", time.Now().Format("15:04:05"), ev.Event, ev.Data)
+			}
+			// The stream ends when the user interrupts or the server
+			// closes it; neither is an error.
+			return nil
+		},
+	}
+	f := cmd.Flags()
+	f.StringVarP(&namespace, "namespace", "n", "", "only events for this namespace")
+	f.StringVar(&actionType, "type", "", "only events for this action type")
+	f.StringVar(&outcome, "outcome", "", "only events with this outcome")
+	f.StringVar(&eventType, "event", "", "only this event type")
+	f.StringVar(&chainID, "chain", "", "only events for this chain")
+	f.StringVar(&lastEventID, "since-event", "", "resume after this event ID")
+	return cmd
+}